@@ -0,0 +1,51 @@
+// Command audit inspects the audit trail of administrative actions.
+//
+// Usage:
+//
+//	audit verify
+//
+// verify walks the whole hash chain and recomputes every hash. It fails on the first event
+// that does not match, then prints the hash of the last event: store it outside the database,
+// and compare it on the next run, to detect the chain being rewritten as a whole.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"log"
+	"os"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lmsgprefix)
+	log.SetPrefix("audit: ")
+
+	if len(os.Args) != 2 || os.Args[1] != "verify" {
+		log.Fatalf("usage: %s verify", os.Args[0])
+	}
+
+	start := time.Now()
+
+	log.Println("connecting to database...")
+
+	ctx := lo.Must(postgres.NewContext(context.Background(), config.PostgresPresetDefault))
+
+	log.Println("verifying audit chain...")
+
+	res, err := core.NewAuditEventVerify(dao.NewAuditEventList()).Exec(ctx)
+	if err != nil {
+		log.Fatalf("verification failed: %v", err)
+	}
+
+	log.Printf("done — %d event(s) verified, completed in %s", res.Checked, time.Since(start).Round(time.Millisecond))
+	log.Printf("head: %s", lo.Ternary(res.Head == nil, "(empty chain)", hex.EncodeToString(res.Head)))
+}
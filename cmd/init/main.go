@@ -1,10 +1,13 @@
-// Command init reconciles the super-admin account with the credentials given in
-// SUPER_ADMIN_EMAIL and SUPER_ADMIN_PASSWORD. It is idempotent and safe to run on every
-// deploy: a missing account is created with the super-admin role, and an existing account
-// whose password or role has drifted is brought back in line.
+// Command init seeds the roles shipped with the service, then reconciles the super-admin
+// account with the credentials given in SUPER_ADMIN_EMAIL and SUPER_ADMIN_PASSWORD. It is
+// idempotent and safe to run on every deploy: missing roles are created, and stored roles are
+// left as administrators made them; a missing account is created with the super-admin role,
+// and an existing account whose password has drifted, or that lost the super-admin role, is
+// brought back in line.
 //
-// When either variable is empty the command logs a warning and exits without touching the
-// database, so the bootstrap step can be disabled by leaving the variables unset.
+// When either variable is empty the command logs a warning and skips the super-admin
+// bootstrap, so that step can be disabled by leaving the variables unset. Roles are seeded
+// regardless.
 package main
 
 import (
@@ -41,9 +44,31 @@ func main() {
 		log.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
 	}
 
+	log.Println("connecting to database...")
+
+	ctx = lo.Must(postgres.NewContext(ctx, cfg.Postgres))
+
+	daoAuditEventInsert := dao.NewAuditEventInsert()
+	transactor := postgres.NewTransactor(nil)
+
+	serviceRoleSeed := core.NewRoleSeed(
+		dao.NewRoleList(),
+		dao.NewRoleInsert(),
+		daoAuditEventInsert,
+		transactor,
+	)
+
+	log.Println("seeding roles...")
+
+	seeded := lo.Must(serviceRoleSeed.Exec(ctx, &core.RoleSeedRequest{
+		Permissions: config.PermissionsConfigDefault,
+	}))
+
+	log.Printf("roles created: %v", seeded.Created)
+
 	if env.SuperAdminEmail == "" {
 		cfg.Logger.Warn(ctx, "SUPER_ADMIN_EMAIL not set — skipping super-admin bootstrap")
-		log.Printf("done — skipped (no SUPER_ADMIN_EMAIL), completed in %s",
+		log.Printf("done — super-admin skipped (no SUPER_ADMIN_EMAIL), completed in %s",
 			time.Since(start).Round(time.Millisecond))
 
 		return
@@ -51,27 +76,24 @@ func main() {
 
 	if env.SuperAdminPassword == "" {
 		cfg.Logger.Warn(ctx, "SUPER_ADMIN_PASSWORD not set — skipping super-admin bootstrap")
-		log.Printf("done — skipped (no SUPER_ADMIN_PASSWORD), completed in %s",
+		log.Printf("done — super-admin skipped (no SUPER_ADMIN_PASSWORD), completed in %s",
 			time.Since(start).Round(time.Millisecond))
 
 		return
 	}
 
-	log.Println("connecting to database...")
-
-	ctx = lo.Must(postgres.NewContext(ctx, cfg.Postgres))
-
 	daoCredentialsInsert := dao.NewCredentialsInsert()
 	daoCredentialsSelectByEmail := dao.NewCredentialsSelectByEmail()
 	daoCredentialsUpdatePassword := dao.NewCredentialsUpdatePassword()
 	daoCredentialsUpdateRole := dao.NewCredentialsUpdateRole()
-
 	service := core.NewCredentialsCreateSuperAdmin(
 		daoCredentialsInsert,
 		daoCredentialsSelectByEmail,
 		daoCredentialsUpdatePassword,
 		daoCredentialsUpdateRole,
-		postgres.NewTransactor(nil),
+		daoAuditEventInsert,
+		transactor,
+		cfg.Emails,
 	)
 
 	log.Printf("ensuring super-admin credentials for %s", env.SuperAdminEmail)
@@ -0,0 +1,70 @@
+// Command janitor cleans up the short_codes table once, then exits: it soft-deletes the short
+// codes that expired without being redeemed, then removes for good those deleted for longer than
+// SHORT_CODES_JANITOR_RETENTION.
+//
+// The REST service already runs the same cleanup every SHORT_CODES_JANITOR_INTERVAL. This command
+// is meant for a scheduled job, or to catch up on a backlog by hand. It is safe to run alongside
+// the service: when an instance is already cleaning up, the command does nothing.
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/config/env"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func main() {
+	log.SetFlags(log.LstdFlags | log.Lmsgprefix)
+	log.SetPrefix("janitor: ")
+
+	start := time.Now()
+
+	cfg := config.AppPresetDefault
+	ctx := context.Background()
+
+	otel.SetAppName(cfg.App.Name)
+
+	lo.Must0(otel.Init(cfg.Otel))
+	defer cfg.Otel.Flush()
+
+	if env.GcloudProjectId == "" {
+		log.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
+	}
+
+	log.Println("connecting to database...")
+
+	ctx = lo.Must(postgres.NewContext(ctx, cfg.Postgres))
+
+	serviceShortCodeJanitor := core.NewShortCodeJanitor(
+		dao.NewShortCodeJanitorLock(),
+		dao.NewShortCodeExpire(),
+		dao.NewShortCodePurge(),
+		cfg.ShortCodesJanitor,
+	)
+
+	log.Println("cleaning up short codes...")
+
+	res := lo.Must(serviceShortCodeJanitor.Exec(ctx, &core.ShortCodeJanitorRequest{}))
+
+	if !res.Elected {
+		log.Printf("done — skipped (another instance is cleaning up), completed in %s",
+			time.Since(start).Round(time.Millisecond))
+
+		return
+	}
+
+	log.Printf(
+		"done — %d code(s) expired, %d code(s) purged, completed in %s",
+		res.Expired, res.Purged, time.Since(start).Round(time.Millisecond),
+	)
+}
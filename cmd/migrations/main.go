@@ -1,5 +1,7 @@
-// Command migrations applies pending SQL migrations to the authentication database.
-// Run this once on first deploy and after each schema change.
+// Command migrations applies pending SQL migrations to the authentication database,
+// then recomputes the canonical email of every account under the current provider
+// rules. Run this once on first deploy, after each schema change, and after the
+// email provider rules change.
 package main
 
 import (
@@ -14,6 +16,8 @@ import (
 	"github.com/a-novel-kit/golib/postgres"
 
 	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
 )
 
@@ -39,6 +43,27 @@ func main() {
 	log.Println("applying pending migrations...")
 	lo.Must0(postgres.RunMigrationsContext(ctx, migrations.Migrations))
 
+	log.Println("recomputing canonical emails...")
+
+	canonicalized := lo.Must(core.NewCredentialsCanonicalizeEmails(
+		dao.NewCredentialsList(), dao.NewCredentialsUpdateEmailCanonical(), config.EmailsPresetDefault,
+	).Exec(ctx))
+
+	log.Printf("%d canonical email(s) updated", canonicalized.Updated)
+
+	if len(canonicalized.Collisions) > 0 {
+		for _, collision := range canonicalized.Collisions {
+			log.Printf("  ! %s is claimed by:", collision.EmailCanonical)
+
+			for _, credentials := range collision.Credentials {
+				log.Printf("      %s (%s)", credentials.Email, credentials.ID)
+			}
+		}
+
+		log.Fatalf("%d canonical email collision(s): merge or rename these accounts, then run the migrations again",
+			len(canonicalized.Collisions))
+	}
+
 	log.Printf("done — %d migration(s) examined, completed in %s",
 		len(discovered), time.Since(start).Round(time.Millisecond))
 }
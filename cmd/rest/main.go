@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
 	"syscall"
 	"time"
@@ -32,10 +33,11 @@ import (
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
 	"github.com/a-novel/service-authentication/v2/internal/lib"
-	"github.com/a-novel/service-authentication/v2/pkg/go"
 )
 
+//nolint:maintidx // Flat wiring of constructors and routes; splitting it would only scatter the graph.
 func main() {
 	cfg := config.AppPresetDefault
 	ctx := context.Background()
@@ -77,17 +79,50 @@ func main() {
 	// =================================================================================================================
 
 	daoShortCodeDelete := dao.NewShortCodeDelete()
+	daoShortCodeIncrementAttempts := dao.NewShortCodeIncrementAttempts()
+	daoShortCodeExpire := dao.NewShortCodeExpire()
 	daoShortCodeInsert := dao.NewShortCodeInsert()
+	daoShortCodeJanitorLock := dao.NewShortCodeJanitorLock()
+	daoShortCodeList := dao.NewShortCodeList()
+	daoShortCodeListByTargets := dao.NewShortCodeListByTargets()
+	daoShortCodePurge := dao.NewShortCodePurge()
 	daoShortCodeSelect := dao.NewShortCodeSelect()
+	daoShortCodeSelectLatest := dao.NewShortCodeSelectLatest()
+
+	daoLoginEventDeviceExists := dao.NewLoginEventDeviceExists()
+	daoLoginEventInsert := dao.NewLoginEventInsert()
+	daoLoginEventList := dao.NewLoginEventList()
+
+	daoAuditEventInsert := dao.NewAuditEventInsert()
+	daoAuditEventList := dao.NewAuditEventList()
+	daoAuditEventListByUser := dao.NewAuditEventListByUser()
+
+	daoRoleDelete := dao.NewRoleDelete()
+	daoRoleInsert := dao.NewRoleInsert()
+	daoRoleList := dao.NewRoleList()
+	daoRoleUpdate := dao.NewRoleUpdate()
+
+	daoCredentialRoleGrantDeleteExpired := dao.NewCredentialRoleGrantDeleteExpired()
+	daoCredentialRoleGrantInsert := dao.NewCredentialRoleGrantInsert()
 
 	daoCredentialsExist := dao.NewCredentialsExist()
 	daoTransactor := postgres.NewTransactor(nil)
 
+	daoCredentialsCount := dao.NewCredentialsCount()
+	daoCredentialsEventInsert := dao.NewCredentialsEventInsert()
+	daoCredentialsEventList := dao.NewCredentialsEventList()
 	daoCredentialsInsert := dao.NewCredentialsInsert()
 	daoCredentialsList := dao.NewCredentialsList()
+	daoCredentialsMerge := dao.NewCredentialsMerge()
+	daoCredentialsRedirectSelect := dao.NewCredentialsRedirectSelect()
 	daoCredentialsSelect := dao.NewCredentialsSelect()
+	daoCredentialsSelectBatch := dao.NewCredentialsSelectBatch()
 	daoCredentialsSelectByEmail := dao.NewCredentialsSelectByEmail()
 	daoCredentialsUpdateEmail := dao.NewCredentialsUpdateEmail()
+	daoCredentialsUpdateEmailVerified := dao.NewCredentialsUpdateEmailVerified()
+	daoCredentialsUpdateLastLogin := dao.NewCredentialsUpdateLastLogin()
+	daoCredentialsUpdateLocale := dao.NewCredentialsUpdateLocale()
+	daoCredentialsUpdateNotices := dao.NewCredentialsUpdateNotices()
 	daoCredentialsUpdatePassword := dao.NewCredentialsUpdatePassword()
 	daoCredentialsUpdateRole := dao.NewCredentialsUpdateRole()
 
@@ -95,11 +130,39 @@ func main() {
 	// SERVICES
 	// =================================================================================================================
 
-	serviceShortCodeConsume := core.NewShortCodeConsume(daoShortCodeSelect, daoShortCodeDelete)
-	serviceShortCodeCreate := core.NewShortCodeCreate(daoShortCodeInsert, cfg.ShortCodesConfig)
+	// The roles are loaded before serving: without them, every permission check fails.
+	roleRegistry := core.NewRoleRegistry(daoRoleList)
+	lo.Must0(roleRegistry.Load(ctx))
+
+	go roleRegistry.Watch(ctx, cfg.Roles.RefreshInterval)
+
+	serviceShortCodeConsume := core.NewShortCodeConsume(
+		daoShortCodeSelect, daoShortCodeDelete, daoShortCodeIncrementAttempts, cfg.ShortCodesConfig,
+	)
+	serviceShortCodeVerify := core.NewShortCodeVerify(
+		daoShortCodeSelectLatest, daoShortCodeDelete, daoShortCodeIncrementAttempts, cfg.ShortCodesConfig,
+	)
+	serviceShortCodeCreate := core.NewShortCodeCreate(daoShortCodeInsert, daoShortCodeSelect, cfg.ShortCodesConfig)
+	serviceShortCodeJanitor := core.NewShortCodeJanitor(
+		daoShortCodeJanitorLock, daoShortCodeExpire, daoShortCodePurge, cfg.ShortCodesJanitor,
+	)
+	serviceShortCodeList := core.NewShortCodeList(daoShortCodeList, daoAuditEventInsert, daoTransactor)
+	serviceShortCodeRevoke := core.NewShortCodeRevoke(daoShortCodeDelete, daoAuditEventInsert, daoTransactor)
+	// Every instance tries on each tick; the one holding the lock cleans up, the others skip.
+	go serviceShortCodeJanitor.Watch(ctx, cfg.ShortCodesJanitor.Interval)
+
 	serviceShortCodeCreateEmailUpdate := core.NewShortCodeCreateEmailUpdate(
 		serviceShortCodeCreate,
 		daoCredentialsSelectByEmail,
+		daoCredentialsSelect,
+		smtpSender,
+		cfg.ShortCodesConfig,
+		cfg.SmtpUrlsConfig,
+		cfg.Emails,
+	)
+	serviceShortCodeCreateEmailVerification := core.NewShortCodeCreateEmailVerification(
+		serviceShortCodeCreate,
+		daoCredentialsSelect,
 		smtpSender,
 		cfg.ShortCodesConfig,
 		cfg.SmtpUrlsConfig,
@@ -110,6 +173,7 @@ func main() {
 		smtpSender,
 		cfg.ShortCodesConfig,
 		cfg.SmtpUrlsConfig,
+		cfg.Emails,
 	)
 	serviceShortCodeCreateRegister := core.NewShortCodeCreateRegister(
 		serviceShortCodeCreate,
@@ -117,39 +181,170 @@ func main() {
 		smtpSender,
 		cfg.ShortCodesConfig,
 		cfg.SmtpUrlsConfig,
+		cfg.Registration,
+		cfg.Emails,
+	)
+	serviceShortCodeCreateInvite := core.NewShortCodeCreateInvite(
+		serviceShortCodeCreate,
+		daoCredentialsSelectByEmail,
+		daoCredentialsSelect,
+		roleRegistry,
+		smtpSender,
+		cfg.ShortCodesConfig,
+		cfg.SmtpUrlsConfig,
+		cfg.Emails,
 	)
 
 	serviceCredentialsCreate := core.NewCredentialsCreate(
-		daoCredentialsInsert, serviceShortCodeConsume, jsonKeysClient, daoTransactor,
+		daoCredentialsInsert, serviceShortCodeConsume, jsonKeysClient, daoTransactor, cfg.Registration, cfg.Emails,
+	)
+	serviceCredentialsCreateInvite := core.NewCredentialsCreateInvite(
+		daoCredentialsInsert,
+		daoCredentialsSelect,
+		serviceShortCodeConsume,
+		jsonKeysClient,
+		daoTransactor,
+		roleRegistry,
+		cfg.Emails,
+	)
+	serviceCredentialsExist := core.NewCredentialsExist(daoCredentialsExist, cfg.Emails)
+	serviceCredentialsExport := core.NewCredentialsExport(
+		daoCredentialsSelect,
+		daoShortCodeListByTargets,
+		daoLoginEventList,
+		daoAuditEventListByUser,
+		daoAuditEventInsert,
+		daoTransactor,
+	)
+	serviceCredentialsGet := core.NewCredentialsGet(daoCredentialsSelect, daoAuditEventInsert, daoTransactor)
+	serviceCredentialsGetBatch := core.NewCredentialsGetBatch(
+		daoCredentialsSelectBatch, daoAuditEventInsert, daoTransactor,
+	)
+	serviceCredentialsGrantRole := core.NewCredentialsGrantRole(
+		daoCredentialRoleGrantInsert,
+		daoCredentialsSelect,
+		daoAuditEventInsert,
+		daoTransactor,
+		roleRegistry,
+		cfg.Roles,
+	)
+	serviceCredentialsGrantRoleSweep := core.NewCredentialsGrantRoleSweep(
+		daoCredentialRoleGrantDeleteExpired, daoAuditEventInsert, daoTransactor,
+	)
+	serviceCredentialsList := core.NewCredentialsList(
+		daoCredentialsList, daoCredentialsCount, daoAuditEventInsert, daoTransactor,
 	)
-	serviceCredentialsExist := core.NewCredentialsExist(daoCredentialsExist)
-	serviceCredentialsGet := core.NewCredentialsGet(daoCredentialsSelect)
-	serviceCredentialsList := core.NewCredentialsList(daoCredentialsList)
 	serviceCredentialsUpdateEmail := core.NewCredentialsUpdateEmail(
-		daoCredentialsUpdateEmail, serviceShortCodeConsume, daoTransactor,
+		daoCredentialsUpdateEmail,
+		daoCredentialsSelect,
+		serviceShortCodeConsume,
+		smtpSender,
+		daoTransactor,
+		cfg.Emails,
 	)
+	serviceCredentialsMerge := core.NewCredentialsMerge(
+		daoCredentialsMerge,
+		daoCredentialsSelect,
+		daoCredentialsUpdateRole,
+		daoAuditEventInsert,
+		daoCredentialsEventInsert,
+		daoTransactor,
+		roleRegistry,
+	)
+	serviceCredentialsEventList := core.NewCredentialsEventList(daoCredentialsEventList)
+	serviceCredentialsPolicyTargetGet := core.NewCredentialsPolicyTargetGet(daoCredentialsSelect, roleRegistry)
+	serviceCredentialsRedirectGet := core.NewCredentialsRedirectGet(daoCredentialsRedirectSelect)
+	serviceCredentialsUpdateLocale := core.NewCredentialsUpdateLocale(daoCredentialsUpdateLocale)
+	serviceCredentialsUpdateNotices := core.NewCredentialsUpdateNotices(daoCredentialsUpdateNotices)
 	serviceCredentialsUpdatePassword := core.NewCredentialsUpdatePassword(
-		daoCredentialsUpdatePassword, daoCredentialsSelect, serviceShortCodeConsume, daoTransactor,
+		daoCredentialsUpdatePassword, daoCredentialsSelect, serviceShortCodeConsume, smtpSender, daoTransactor,
 	)
+	// Expired grants are no longer honored; the sweep reverts them in the database and records it.
+	go serviceCredentialsGrantRoleSweep.Watch(ctx, cfg.Roles.GrantSweepInterval)
+
 	serviceCredentialsUpdateRole := core.NewCredentialsUpdateRole(
 		daoCredentialsUpdateRole,
 		daoCredentialsSelect,
+		daoAuditEventInsert,
+		daoTransactor,
+		roleRegistry,
+	)
+	serviceCredentialsVerifyEmail := core.NewCredentialsVerifyEmail(
+		daoCredentialsUpdateEmailVerified, daoCredentialsSelect, serviceShortCodeConsume, daoTransactor,
+	)
+
+	serviceLoginEventList := core.NewLoginEventList(daoLoginEventList, daoAuditEventInsert, daoTransactor)
+
+	serviceAuditEventList := core.NewAuditEventList(daoAuditEventList)
+
+	servicePermissionsCheck := core.NewPermissionsCheck(serviceVerifyAccessToken, daoCredentialsSelect, roleRegistry)
+	servicePermissionsRoles := core.NewPermissionsRoles(roleRegistry)
+
+	serviceRoleCreate := core.NewRoleCreate(
+		daoRoleInsert, daoRoleList, daoAuditEventInsert, daoTransactor, roleRegistry,
+	)
+	serviceRoleDelete := core.NewRoleDelete(daoRoleDelete, daoAuditEventInsert, daoTransactor, roleRegistry)
+	serviceRoleList := core.NewRoleList(daoRoleList)
+	serviceRoleUpdate := core.NewRoleUpdate(
+		daoRoleUpdate, daoRoleList, daoAuditEventInsert, daoTransactor, roleRegistry,
 	)
 
-	serviceTokenCreate := core.NewTokenCreate(daoCredentialsSelectByEmail, jsonKeysClient)
+	serviceTokenCreate := core.NewTokenCreate(
+		daoCredentialsSelectByEmail,
+		daoLoginEventInsert,
+		daoCredentialsUpdateLastLogin,
+		daoLoginEventDeviceExists,
+		jsonKeysClient,
+		smtpSender,
+		cfg.Emails,
+	)
 	serviceTokenCreateAnon := core.NewTokenCreateAnon(jsonKeysClient)
 	serviceTokenRefresh := core.NewTokenRefresh(
 		daoCredentialsSelect,
+		daoLoginEventInsert,
+		daoCredentialsRedirectSelect,
 		jsonKeysClient,
 		serviceVerifyAccessToken,
 		serviceVerifyRefreshToken,
+		cfg.LoginEvents,
 	)
 
 	// =================================================================================================================
 	// MIDDLEWARES
 	// =================================================================================================================
 
-	withAuth := serviceauthentication.NewAuthHandler(serviceVerifyAccessToken, cfg.Permissions, cfg.Logger)
+	// The registry resolves the permissions on every request, so role changes apply without a restart.
+	middlewareAuth := middlewares.NewAuth(serviceVerifyAccessToken, roleRegistry, cfg.Logger)
+	// Verifying a short code tells whether a guess is right, so it is limited per client, on top of the
+	// attempts limit of the code.
+	middlewareShortCodeVerifyRateLimit := middlewares.NewRateLimit(cfg.ShortCodesVerify, cfg.Logger)
+	// Policies gate routes on rules permissions cannot express. They compile at startup, so a deployment with an
+	// invalid policy fails to start.
+	policyEngine := lo.Must(lib.NewPolicyEngine(cfg.Policies.Expressions()))
+	middlewarePolicy := middlewares.NewPolicy(policyEngine, roleRegistry, cfg.Logger)
+	// Resolves the account a role, grant or merge request acts on, for policies comparing it with the caller.
+	credentialsPolicyTarget := handlers.NewCredentialsPolicyTarget(serviceCredentialsPolicyTargetGet)
+
+	withAuth := func(r chi.Router, permissions ...string) chi.Router {
+		// Wildcard grants expand against the known permissions: a route checking another one
+		// would only be reachable through exact grants.
+		for _, permission := range permissions {
+			if !slices.Contains(cfg.Permissions.Known, permission) {
+				log.Fatalf("route permission %q is not listed in the known permissions", permission)
+			}
+		}
+
+		return r.With(middlewareAuth.Middleware(permissions))
+	}
+
+	// withPolicy mounts routes behind a policy, on top of the permissions of withAuth, whose claims it reads.
+	withPolicy := func(r chi.Router, policy string, resolveTarget middlewares.PolicyTargetResolver) chi.Router {
+		if !policyEngine.Has(policy) {
+			log.Fatalf("route policy %q is not declared in the policies", policy)
+		}
+
+		return r.With(middlewarePolicy.Middleware(policy, resolveTarget))
+	}
 
 	// =================================================================================================================
 	// HANDLERS
@@ -160,10 +355,30 @@ func main() {
 
 	handlerClaimsGet := handlers.NewClaimsGet(cfg.Logger)
 
+	handlerAuditList := handlers.NewAuditList(serviceAuditEventList, cfg.Logger)
+
+	handlerPermissionsCheck := handlers.NewPermissionsCheck(servicePermissionsCheck, cfg.Logger)
+	handlerPermissionsRoles := handlers.NewPermissionsRoles(servicePermissionsRoles, cfg.Logger)
+
+	handlerRoleCreate := handlers.NewRoleCreate(serviceRoleCreate, cfg.Logger)
+	handlerRoleDelete := handlers.NewRoleDelete(serviceRoleDelete, cfg.Logger)
+	handlerRoleList := handlers.NewRoleList(serviceRoleList, cfg.Logger)
+	handlerRoleUpdate := handlers.NewRoleUpdate(serviceRoleUpdate, cfg.Logger)
+
 	handlerCredentialsCreate := handlers.NewCredentialsCreate(serviceCredentialsCreate, cfg.Logger)
+	handlerCredentialsCreateInvite := handlers.NewCredentialsCreateInvite(serviceCredentialsCreateInvite, cfg.Logger)
 	handlerCredentialsExist := handlers.NewCredentialsExist(serviceCredentialsExist, cfg.Logger)
+	handlerCredentialsExport := handlers.NewCredentialsExport(serviceCredentialsExport, cfg.Logger)
+	handlerCredentialsExportUser := handlers.NewCredentialsExportUser(serviceCredentialsExport, cfg.Logger)
 	handlerCredentialsGet := handlers.NewCredentialsGet(serviceCredentialsGet, cfg.Logger)
+	handlerCredentialsGetBatch := handlers.NewCredentialsGetBatch(serviceCredentialsGetBatch, cfg.Logger)
+	handlerCredentialsGrantRole := handlers.NewCredentialsGrantRole(serviceCredentialsGrantRole, cfg.Logger)
 	handlerCredentialsList := handlers.NewCredentialsList(serviceCredentialsList, cfg.Logger)
+	handlerCredentialsLogins := handlers.NewCredentialsLogins(serviceLoginEventList, cfg.Logger)
+	handlerCredentialsLoginsUser := handlers.NewCredentialsLoginsUser(serviceLoginEventList, cfg.Logger)
+	handlerCredentialsMerge := handlers.NewCredentialsMerge(serviceCredentialsMerge, cfg.Logger)
+	handlerCredentialsEventList := handlers.NewCredentialsEventList(serviceCredentialsEventList, cfg.Logger)
+	handlerCredentialsRedirectGet := handlers.NewCredentialsRedirectGet(serviceCredentialsRedirectGet, cfg.Logger)
 	handlerCredentialsResetPassword := handlers.NewCredentialsResetPassword(
 		serviceCredentialsUpdatePassword,
 		cfg.Logger,
@@ -176,15 +391,31 @@ func main() {
 		serviceCredentialsUpdateEmail,
 		cfg.Logger,
 	)
+	handlerCredentialsUpdateLocale := handlers.NewCredentialsUpdateLocale(
+		serviceCredentialsUpdateLocale,
+		cfg.Logger,
+	)
+	handlerCredentialsUpdateNotices := handlers.NewCredentialsUpdateNotices(
+		serviceCredentialsUpdateNotices,
+		cfg.Logger,
+	)
 	handlerCredentialsUpdateRole := handlers.NewCredentialsUpdateRole(
 		serviceCredentialsUpdateRole,
 		cfg.Logger,
 	)
+	handlerCredentialsVerifyEmail := handlers.NewCredentialsVerifyEmail(
+		serviceCredentialsVerifyEmail,
+		cfg.Logger,
+	)
 
 	handlerShortCodeCreateEmailUpdate := handlers.NewShortCodeCreateEmailUpdate(
 		serviceShortCodeCreateEmailUpdate,
 		cfg.Logger,
 	)
+	handlerShortCodeCreateEmailVerification := handlers.NewShortCodeCreateEmailVerification(
+		serviceShortCodeCreateEmailVerification,
+		cfg.Logger,
+	)
 	handlerShortCodeCreatePasswordReset := handlers.NewShortCodeCreatePasswordReset(
 		serviceShortCodeCreatePasswordReset,
 		cfg.Logger,
@@ -193,6 +424,33 @@ func main() {
 		serviceShortCodeCreateRegister,
 		cfg.Logger,
 	)
+	handlerShortCodeCreateInvite := handlers.NewShortCodeCreateInvite(
+		serviceShortCodeCreateInvite,
+		cfg.Logger,
+	)
+	handlerShortCodeResendEmailUpdate := handlers.NewShortCodeResendEmailUpdate(
+		serviceShortCodeCreateEmailUpdate,
+		cfg.Logger,
+	)
+	handlerShortCodeResendEmailVerification := handlers.NewShortCodeResendEmailVerification(
+		serviceShortCodeCreateEmailVerification,
+		cfg.Logger,
+	)
+	handlerShortCodeResendPasswordReset := handlers.NewShortCodeResendPasswordReset(
+		serviceShortCodeCreatePasswordReset,
+		cfg.Logger,
+	)
+	handlerShortCodeResendRegister := handlers.NewShortCodeResendRegister(
+		serviceShortCodeCreateRegister,
+		cfg.Logger,
+	)
+	handlerShortCodeResendInvite := handlers.NewShortCodeResendInvite(
+		serviceShortCodeCreateInvite,
+		cfg.Logger,
+	)
+	handlerShortCodeList := handlers.NewShortCodeList(serviceShortCodeList, cfg.Logger)
+	handlerShortCodeRevoke := handlers.NewShortCodeRevoke(serviceShortCodeRevoke, cfg.Logger)
+	handlerShortCodeVerify := handlers.NewShortCodeVerify(serviceShortCodeVerify, cfg.Logger)
 
 	handlerTokenCreate := handlers.NewTokenCreate(serviceTokenCreate, cfg.Logger)
 	handlerTokenCreateAnon := handlers.NewTokenCreateAnon(serviceTokenCreateAnon, cfg.Logger)
@@ -205,6 +463,8 @@ func main() {
 	router := chi.NewRouter()
 
 	router.Use(middleware.Recoverer)
+	// The request ID links audit events to the request that caused them.
+	router.Use(middleware.RequestID)
 	router.Use(middleware.ClientIPFromRemoteAddr)
 	router.Use(middleware.Timeout(cfg.Rest.Timeouts.Request))
 	router.Use(middleware.RequestSize(cfg.Rest.MaxRequestSize))
@@ -213,6 +473,8 @@ func main() {
 		AllowedOrigins:   cfg.Rest.Cors.AllowedOrigins,
 		AllowedHeaders:   cfg.Rest.Cors.AllowedHeaders,
 		AllowCredentials: cfg.Rest.Cors.AllowCredentials,
+		// Browsers hide response headers from scripts unless they are listed here.
+		ExposedHeaders: []string{handlers.HeaderNextCursor, handlers.HeaderTotalCount},
 		AllowedMethods: []string{
 			http.MethodHead,
 			http.MethodGet,
@@ -239,24 +501,78 @@ func main() {
 
 		api.Route("/credentials", func(r chi.Router) {
 			withAuth(r, "credentials:get").Get("/", handlerCredentialsGet.ServeHTTP)
+			withAuth(r, "credentials:get").Post("/batch", handlerCredentialsGetBatch.ServeHTTP)
 			withAuth(r, "credentials:exist").Head("/", handlerCredentialsExist.ServeHTTP)
 			withAuth(r, "credentials:list").Get("/all", handlerCredentialsList.ServeHTTP)
+			withAuth(r, "credentials:export").Get("/export", handlerCredentialsExport.ServeHTTP)
+			withAuth(r, "credentials:export:user").Get("/export/user", handlerCredentialsExportUser.ServeHTTP)
+			withAuth(r, "credentials:logins").Get("/logins", handlerCredentialsLogins.ServeHTTP)
+			withAuth(r, "credentials:logins:user").Get("/logins/user", handlerCredentialsLoginsUser.ServeHTTP)
 
 			withAuth(r, "credentials:create").Put("/", handlerCredentialsCreate.ServeHTTP)
+			withAuth(r, "credentials:create").Put("/invite", handlerCredentialsCreateInvite.ServeHTTP)
 			withAuth(r, "credentials:email:patch").
 				Patch("/email", handlerCredentialsUpdateEmail.ServeHTTP)
+			withAuth(r, "credentials:email:verify").
+				Patch("/email/verify", handlerCredentialsVerifyEmail.ServeHTTP)
+			withAuth(r, "credentials:locale:patch").
+				Patch("/locale", handlerCredentialsUpdateLocale.ServeHTTP)
+			withAuth(r, "credentials:notices:patch").
+				Patch("/notices", handlerCredentialsUpdateNotices.ServeHTTP)
 			withAuth(r, "credentials:password:patch").
 				Patch("/password", handlerCredentialsUpdatePassword.ServeHTTP)
 			withAuth(r, "credentials:password:reset").
 				Put("/password", handlerCredentialsResetPassword.ServeHTTP)
-			withAuth(r, "credentials:role:patch").
+			// The services check the ranks against locked rows too: the policies let a deployment tighten the rule.
+			withPolicy(withAuth(r, "credentials:role:patch"), "outranksTarget", credentialsPolicyTarget.BodyField("userID")).
 				Patch("/role", handlerCredentialsUpdateRole.ServeHTTP)
+			withPolicy(withAuth(r, "credentials:role:grant"), "outranksTarget", credentialsPolicyTarget.BodyField("userID")).
+				Put("/role/grant", handlerCredentialsGrantRole.ServeHTTP)
+			withPolicy(withAuth(r, "credentials:merge"), "outranksTarget", credentialsPolicyTarget.BodyField("sourceID")).
+				Post("/merge", handlerCredentialsMerge.ServeHTTP)
+			withAuth(r, "credentials:redirect").Get("/redirect", handlerCredentialsRedirectGet.ServeHTTP)
+			withAuth(r, "credentials:events").Get("/events", handlerCredentialsEventList.ServeHTTP)
+		})
+
+		api.Route("/audit", func(r chi.Router) {
+			withAuth(r, "audit:list").Get("/", handlerAuditList.ServeHTTP)
+		})
+
+		api.Route("/permissions", func(r chi.Router) {
+			withAuth(r, "permissions:check").Post("/check", handlerPermissionsCheck.ServeHTTP)
+			withAuth(r, "permissions:roles").Get("/roles", handlerPermissionsRoles.ServeHTTP)
+		})
+
+		api.Route("/roles", func(r chi.Router) {
+			withAuth(r, "roles:list").Get("/", handlerRoleList.ServeHTTP)
+			withAuth(r, "roles:create").Put("/", handlerRoleCreate.ServeHTTP)
+			withAuth(r, "roles:patch").Patch("/", handlerRoleUpdate.ServeHTTP)
+			withAuth(r, "roles:delete").Delete("/", handlerRoleDelete.ServeHTTP)
 		})
 
 		api.Route("/short-code", func(r chi.Router) {
 			withAuth(r, "shortCode:register").Put("/register", handlerShortCodeCreateRegister.ServeHTTP)
+			withAuth(r, "shortCode:invite").Put("/invite", handlerShortCodeCreateInvite.ServeHTTP)
 			withAuth(r, "shortCode:email:update").Put("/update-email", handlerShortCodeCreateEmailUpdate.ServeHTTP)
+			withAuth(r, "shortCode:email:verify").
+				Put("/verify-email", handlerShortCodeCreateEmailVerification.ServeHTTP)
 			withAuth(r, "shortCode:password:reset").Put("/update-password", handlerShortCodeCreatePasswordReset.ServeHTTP)
+
+			withAuth(r, "shortCode:register").Post("/register/resend", handlerShortCodeResendRegister.ServeHTTP)
+			withAuth(r, "shortCode:invite").Post("/invite/resend", handlerShortCodeResendInvite.ServeHTTP)
+			withAuth(r, "shortCode:email:update").
+				Post("/update-email/resend", handlerShortCodeResendEmailUpdate.ServeHTTP)
+			withAuth(r, "shortCode:email:verify").
+				Post("/verify-email/resend", handlerShortCodeResendEmailVerification.ServeHTTP)
+			withAuth(r, "shortCode:password:reset").
+				Post("/update-password/resend", handlerShortCodeResendPasswordReset.ServeHTTP)
+
+			withAuth(r, "shortCode:verify").
+				With(middlewareShortCodeVerifyRateLimit.Middleware()).
+				Post("/verify", handlerShortCodeVerify.ServeHTTP)
+
+			withAuth(r, "shortCode:admin").Get("/", handlerShortCodeList.ServeHTTP)
+			withAuth(r, "shortCode:admin").Delete("/{id}", handlerShortCodeRevoke.ServeHTTP)
 		})
 	})
 
@@ -279,7 +595,12 @@ func main() {
 		cfg.Rest.Timeouts.Shutdown,
 		serviceShortCodeCreateRegister,
 		serviceShortCodeCreateEmailUpdate,
+		serviceShortCodeCreateEmailVerification,
 		serviceShortCodeCreatePasswordReset,
+		serviceShortCodeCreateInvite,
+		serviceCredentialsUpdateEmail,
+		serviceCredentialsUpdatePassword,
+		serviceTokenCreate,
 	)
 }
 
@@ -68,11 +68,38 @@ var AppPresetDefault = App{
 		),
 	},
 	Permissions:      PermissionsConfigDefault,
+	Policies:         PoliciesConfigDefault,
 	ShortCodesConfig: ShortCodesPresetDefault,
 	SmtpUrlsConfig: SmtpUrls{
 		UpdateEmail:    env.PlatformAuthUpdateEmailUrl,
 		UpdatePassword: env.PlatformAuthUpdatePasswordUrl,
 		Register:       env.PlatformAuthRegisterUrl,
+		Invite:         env.PlatformAuthInviteUrl,
+		VerifyEmail:    env.PlatformAuthVerifyEmailUrl,
+	},
+	Registration: Registration{
+		Mode:            env.RegistrationMode,
+		AllowedDomains:  env.RegistrationAllowedDomains,
+		DeniedDomains:   env.RegistrationDeniedDomains,
+		BlockDisposable: env.RegistrationBlockDisposable,
+	},
+	Emails: EmailsPresetDefault,
+	LoginEvents: LoginEvents{
+		RefreshSampleRate: env.LoginEventsRefreshSampleRate,
+	},
+	Roles: Roles{
+		RefreshInterval:    env.RolesRefreshInterval,
+		GrantMaxDuration:   env.RolesGrantMaxDuration,
+		GrantSweepInterval: env.RolesGrantSweepInterval,
+	},
+	ShortCodesJanitor: ShortCodesJanitor{
+		Interval:  env.ShortCodesJanitorInterval,
+		Retention: env.ShortCodesJanitorRetention,
+		BatchSize: env.ShortCodesJanitorBatchSize,
+	},
+	ShortCodesVerify: RateLimit{
+		Interval: env.ShortCodesVerifyRateLimitInterval,
+		Burst:    env.ShortCodesVerifyRateLimitBurst,
 	},
 
 	Smtp: lo.Ternary[smtp.Sender](env.SmtpAddr == "", smtp.NewDebugSender(nil), &smtp.ProdSender{
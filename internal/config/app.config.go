@@ -54,10 +54,17 @@ type App struct {
 	App  Main `json:"app"  yaml:"app"`
 	Rest Rest `json:"rest" yaml:"rest"`
 
-	DependenciesConfig Dependencies `json:"dependencies" yaml:"dependencies"`
-	Permissions        Permissions  `json:"permissions"  yaml:"permissions"`
-	ShortCodesConfig   ShortCodes   `json:"shortCodes"   yaml:"shortCodes"`
-	SmtpUrlsConfig     SmtpUrls     `json:"smtpUrls"     yaml:"smtpUrls"`
+	DependenciesConfig Dependencies      `json:"dependencies"      yaml:"dependencies"`
+	Permissions        Permissions       `json:"permissions"       yaml:"permissions"`
+	Policies           Policies          `json:"policies"          yaml:"policies"`
+	ShortCodesConfig   ShortCodes        `json:"shortCodes"        yaml:"shortCodes"`
+	ShortCodesJanitor  ShortCodesJanitor `json:"shortCodesJanitor" yaml:"shortCodesJanitor"`
+	ShortCodesVerify   RateLimit         `json:"shortCodesVerify"  yaml:"shortCodesVerify"`
+	SmtpUrlsConfig     SmtpUrls          `json:"smtpUrls"          yaml:"smtpUrls"`
+	Registration       Registration      `json:"registration"      yaml:"registration"`
+	Emails             Emails            `json:"emails"            yaml:"emails"`
+	LoginEvents        LoginEvents       `json:"loginEvents"       yaml:"loginEvents"`
+	Roles              Roles             `json:"roles"             yaml:"roles"`
 
 	Smtp       smtp.Sender        `json:"smtp"       yaml:"smtp"`
 	Otel       otel.Config        `json:"otel"       yaml:"otel"`
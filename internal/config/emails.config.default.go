@@ -0,0 +1,16 @@
+package config
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/a-novel-kit/golib/config"
+)
+
+//go:embed emails.config.yaml
+var defaultEmailsFile []byte
+
+// EmailsPresetDefault is the default email configuration, loaded from the embedded
+// emails.config.yaml.
+var EmailsPresetDefault = config.MustUnmarshal[Emails](yaml.Unmarshal, defaultEmailsFile)
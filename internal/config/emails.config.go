@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+// Emails configures how email addresses are compared. Addresses are identified by their
+// canonical form, see [lib.CanonicalEmail].
+type Emails struct {
+	// Providers holds the mailbox rules of specific providers, keyed by domain. Changing
+	// them changes the canonical form of existing addresses: run the migrations command
+	// again to recompute it.
+	Providers map[string]lib.EmailProvider `json:"providers" yaml:"providers"`
+}
+
+// Canonical returns the canonical form of email under the configured provider rules.
+func (emails Emails) Canonical(email string) (string, error) {
+	return lib.CanonicalEmail(email, emails.Providers)
+}
@@ -1,6 +1,8 @@
 package env
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"time"
 
@@ -15,6 +17,26 @@ func getEnv(name string) string {
 	return os.Getenv(prefix + name)
 }
 
+var errNotPositive = errors.New("value is not positive")
+
+// positiveParser wraps another parser and rejects any value that is not strictly positive. It
+// guards the intervals, timeouts and sizes a zero or negative value would break at runtime: a
+// ticker panics on it, and a batch loop never ends.
+func positiveParser[T int | int64 | time.Duration](parser func(string) (T, error)) func(string) (T, error) {
+	return func(value string) (T, error) {
+		raw, err := parser(value)
+		if err != nil {
+			return raw, err
+		}
+
+		if raw <= 0 {
+			return raw, fmt.Errorf("%w: %s", errNotPositive, value)
+		}
+
+		return raw, nil
+	}
+}
+
 // Default values for environment variables, if applicable.
 const (
 	SmtpTimeoutDefault = 20 * time.Second
@@ -24,9 +46,27 @@ const (
 	PlatformEmailUpdateUrlDefault   = "/ext/email/validate"
 	PlatformPasswordResetUrlDefault = "/ext/password/reset"
 	PlatformAccountCreateUrlDefault = "/ext/account/create"
+	PlatformAccountInviteUrlDefault = "/ext/account/invite"
+	PlatformEmailVerifyUrlDefault   = "/ext/email/verify"
 
 	AppNameDefault = "service-authentication"
 
+	RegistrationModeDefault            = "open"
+	RegistrationBlockDisposableDefault = false
+
+	LoginEventsRefreshSampleRateDefault = 0.1
+
+	RolesRefreshIntervalDefault    = 30 * time.Second
+	RolesGrantMaxDurationDefault   = 24 * time.Hour
+	RolesGrantSweepIntervalDefault = time.Minute
+
+	ShortCodesJanitorIntervalDefault  = 10 * time.Minute
+	ShortCodesJanitorRetentionDefault = 30 * 24 * time.Hour
+	ShortCodesJanitorBatchSizeDefault = 500
+
+	ShortCodesVerifyRateLimitIntervalDefault = 6 * time.Second
+	ShortCodesVerifyRateLimitBurstDefault    = 5
+
 	ServiceJsonKeysHostDefault = "localhost"
 	ServiceJsonKeysPortDefault = 8080
 
@@ -71,10 +111,30 @@ var (
 	platformAuthUpdateEmailUrl    = getEnv("PLATFORM_AUTH_URL_UPDATE_EMAIL")
 	platformAuthUpdatePasswordUrl = getEnv("PLATFORM_AUTH_URL_UPDATE_PASSWORD")
 	platformAuthRegisterUrl       = getEnv("PLATFORM_AUTH_URL_REGISTER")
+	platformAuthInviteUrl         = getEnv("PLATFORM_AUTH_URL_INVITE")
+	platformAuthVerifyEmailUrl    = getEnv("PLATFORM_AUTH_URL_VERIFY_EMAIL")
 
 	serviceJsonKeysHost = getEnv("SERVICE_JSON_KEYS_HOST")
 	serviceJsonKeysPort = getEnv("SERVICE_JSON_KEYS_PORT")
 
+	registrationMode            = getEnv("REGISTRATION_MODE")
+	registrationAllowedDomains  = getEnv("REGISTRATION_ALLOWED_DOMAINS")
+	registrationDeniedDomains   = getEnv("REGISTRATION_DENIED_DOMAINS")
+	registrationBlockDisposable = getEnv("REGISTRATION_BLOCK_DISPOSABLE")
+
+	loginEventsRefreshSampleRate = getEnv("LOGIN_EVENTS_REFRESH_SAMPLE_RATE")
+
+	rolesRefreshInterval    = getEnv("ROLES_REFRESH_INTERVAL")
+	rolesGrantMaxDuration   = getEnv("ROLES_GRANT_MAX_DURATION")
+	rolesGrantSweepInterval = getEnv("ROLES_GRANT_SWEEP_INTERVAL")
+
+	shortCodesJanitorInterval  = getEnv("SHORT_CODES_JANITOR_INTERVAL")
+	shortCodesJanitorRetention = getEnv("SHORT_CODES_JANITOR_RETENTION")
+	shortCodesJanitorBatchSize = getEnv("SHORT_CODES_JANITOR_BATCH_SIZE")
+
+	shortCodesVerifyRateLimitInterval = getEnv("SHORT_CODES_VERIFY_RATE_LIMIT_INTERVAL")
+	shortCodesVerifyRateLimitBurst    = getEnv("SHORT_CODES_VERIFY_RATE_LIMIT_BURST")
+
 	smtpAddr             = getEnv("SMTP_ADDR")
 	smtpSenderName       = getEnv("SMTP_SENDER_NAME")
 	smtpSenderEmail      = getEnv("SMTP_SENDER_EMAIL")
@@ -141,6 +201,83 @@ var (
 		PlatformAuthUrl+PlatformAccountCreateUrlDefault,
 		config.StringParser,
 	)
+	// PlatformAuthInviteUrl is the web client page linked from invitation emails to
+	// accept the invitation and create the account.
+	PlatformAuthInviteUrl = config.LoadEnv(
+		platformAuthInviteUrl,
+		PlatformAuthUrl+PlatformAccountInviteUrlDefault,
+		config.StringParser,
+	)
+	// PlatformAuthVerifyEmailUrl is the web client page linked from verification emails to
+	// confirm the account's current address.
+	PlatformAuthVerifyEmailUrl = config.LoadEnv(
+		platformAuthVerifyEmailUrl,
+		PlatformAuthUrl+PlatformEmailVerifyUrlDefault,
+		config.StringParser,
+	)
+
+	// RegistrationMode restricts self-registration: "open", "domain-allowlist" or "closed".
+	RegistrationMode = config.LoadEnv(
+		registrationMode,
+		RegistrationModeDefault,
+		config.EnumParser(config.StringParser, "open", "domain-allowlist", "closed"),
+	)
+	// RegistrationAllowedDomains are the only email domains allowed to register in
+	// "domain-allowlist" mode.
+	RegistrationAllowedDomains = config.LoadEnv(
+		registrationAllowedDomains, []string(nil), config.SliceParser(config.StringParser),
+	)
+	// RegistrationDeniedDomains are email domains never allowed to register.
+	RegistrationDeniedDomains = config.LoadEnv(
+		registrationDeniedDomains, []string(nil), config.SliceParser(config.StringParser),
+	)
+	// RegistrationBlockDisposable rejects registrations from known disposable email providers.
+	RegistrationBlockDisposable = config.LoadEnv(
+		registrationBlockDisposable, RegistrationBlockDisposableDefault, config.BoolParser,
+	)
+
+	// LoginEventsRefreshSampleRate is the share of successful token refreshes recorded in the
+	// login history, between 0 and 1.
+	LoginEventsRefreshSampleRate = config.LoadEnv(
+		loginEventsRefreshSampleRate, LoginEventsRefreshSampleRateDefault, config.Float64Parser,
+	)
+
+	// RolesRefreshInterval is how often an instance reloads the role definitions from the
+	// database, to pick up the changes made through another instance.
+	RolesRefreshInterval = config.LoadEnv(
+		rolesRefreshInterval, RolesRefreshIntervalDefault, positiveParser(config.DurationParser),
+	)
+	// RolesGrantMaxDuration is the longest a temporary role grant may last.
+	RolesGrantMaxDuration = config.LoadEnv(
+		rolesGrantMaxDuration, RolesGrantMaxDurationDefault, positiveParser(config.DurationParser),
+	)
+	// RolesGrantSweepInterval is how often an instance reverts the expired temporary role grants.
+	RolesGrantSweepInterval = config.LoadEnv(
+		rolesGrantSweepInterval, RolesGrantSweepIntervalDefault, positiveParser(config.DurationParser),
+	)
+
+	// ShortCodesJanitorInterval is how often an instance tries to clean up the short codes table.
+	ShortCodesJanitorInterval = config.LoadEnv(
+		shortCodesJanitorInterval, ShortCodesJanitorIntervalDefault, positiveParser(config.DurationParser),
+	)
+	// ShortCodesJanitorRetention is how long a deleted short code is kept before it is removed.
+	ShortCodesJanitorRetention = config.LoadEnv(
+		shortCodesJanitorRetention, ShortCodesJanitorRetentionDefault, positiveParser(config.DurationParser),
+	)
+	// ShortCodesJanitorBatchSize caps the short codes changed in a single transaction.
+	ShortCodesJanitorBatchSize = config.LoadEnv(
+		shortCodesJanitorBatchSize, ShortCodesJanitorBatchSizeDefault, positiveParser(config.IntParser),
+	)
+
+	// ShortCodesVerifyRateLimitInterval is how long a client waits to verify another short code,
+	// once its burst is spent.
+	ShortCodesVerifyRateLimitInterval = config.LoadEnv(
+		shortCodesVerifyRateLimitInterval, ShortCodesVerifyRateLimitIntervalDefault, positiveParser(config.DurationParser),
+	)
+	// ShortCodesVerifyRateLimitBurst is how many short codes a client may verify at once.
+	ShortCodesVerifyRateLimitBurst = config.LoadEnv(
+		shortCodesVerifyRateLimitBurst, ShortCodesVerifyRateLimitBurstDefault, positiveParser(config.IntParser),
+	)
 
 	// ServiceJsonKeysHost points to the host name (without protocol / port) on which the JSON Keys Service is hosted.
 	//
@@ -165,9 +302,9 @@ var (
 	// SmtpSenderDomain is the domain used when sending emails. It should match the host of SmtpAddr.
 	SmtpSenderDomain = smtpSenderDomain
 	// SmtpTimeout bounds how long a single email send may take.
-	SmtpTimeout = config.LoadEnv(smtpTimeout, SmtpTimeoutDefault, config.DurationParser)
+	SmtpTimeout = config.LoadEnv(smtpTimeout, SmtpTimeoutDefault, positiveParser(config.DurationParser))
 	// SmtpMaxConcurrent caps concurrent email deliveries; excess sends wait for a slot.
-	SmtpMaxConcurrent = config.LoadEnv(smtpMaxConcurrent, SmtpMaxConcurrentDefault, config.IntParser)
+	SmtpMaxConcurrent = config.LoadEnv(smtpMaxConcurrent, SmtpMaxConcurrentDefault, positiveParser(config.IntParser))
 	// SmtpForceUnencrypted lets the SMTP client send plain credentials over a non-TLS
 	// connection, which Go otherwise permits only towards localhost. Local runs under
 	// Docker need it because the mail host answers to another name.
@@ -183,15 +320,29 @@ var (
 	Otel = config.LoadEnv(otel, false, config.BoolParser)
 
 	// RestPort is the port on which the rest server will listen for incoming requests.
-	RestPort              = config.LoadEnv(restPort, RestPortDefault, config.IntParser)
-	RestMaxRequestSize    = config.LoadEnv(restMaxRequestSize, RestMaxRequestSizeDefault, config.Int64Parser)
-	RestTimeoutRead       = config.LoadEnv(restTimeoutRead, RestTimeoutReadDefault, config.DurationParser)
-	RestTimeoutReadHeader = config.LoadEnv(restTimeoutReadHeader, RestTimeoutReadHeaderDefault, config.DurationParser)
-	RestTimeoutWrite      = config.LoadEnv(restTimeoutWrite, RestTimeoutWriteDefault, config.DurationParser)
-	RestTimeoutIdle       = config.LoadEnv(restTimeoutIdle, RestTimeoutIdleDefault, config.DurationParser)
-	RestTimeoutRequest    = config.LoadEnv(restTimeoutRequest, RestTimeoutRequestDefault, config.DurationParser)
-	RestTimeoutShutdown   = config.LoadEnv(restTimeoutShutdown, RestTimeoutShutdownDefault, config.DurationParser)
-	CorsAllowedOrigins    = config.LoadEnv(
+	RestPort           = config.LoadEnv(restPort, RestPortDefault, config.IntParser)
+	RestMaxRequestSize = config.LoadEnv(
+		restMaxRequestSize, RestMaxRequestSizeDefault, positiveParser(config.Int64Parser),
+	)
+	RestTimeoutRead = config.LoadEnv(
+		restTimeoutRead, RestTimeoutReadDefault, positiveParser(config.DurationParser),
+	)
+	RestTimeoutReadHeader = config.LoadEnv(
+		restTimeoutReadHeader, RestTimeoutReadHeaderDefault, positiveParser(config.DurationParser),
+	)
+	RestTimeoutWrite = config.LoadEnv(
+		restTimeoutWrite, RestTimeoutWriteDefault, positiveParser(config.DurationParser),
+	)
+	RestTimeoutIdle = config.LoadEnv(
+		restTimeoutIdle, RestTimeoutIdleDefault, positiveParser(config.DurationParser),
+	)
+	RestTimeoutRequest = config.LoadEnv(
+		restTimeoutRequest, RestTimeoutRequestDefault, positiveParser(config.DurationParser),
+	)
+	RestTimeoutShutdown = config.LoadEnv(
+		restTimeoutShutdown, RestTimeoutShutdownDefault, positiveParser(config.DurationParser),
+	)
+	CorsAllowedOrigins = config.LoadEnv(
 		corsAllowedOrigins, CorsAllowedOriginsDefault, config.SliceParser(config.StringParser),
 	)
 	CorsAllowedHeaders = config.LoadEnv(
@@ -9,3 +9,7 @@ const (
 
 // KnownLangs lists every language code the service can render content in.
 var KnownLangs = []string{LangFR, LangEN}
+
+// LangDefault is the language of the emails sent when neither the request, the account nor
+// the client expresses a preference.
+const LangDefault = LangEN
@@ -0,0 +1,9 @@
+package config
+
+// LoginEvents configures the login history recorded in the login_events table.
+type LoginEvents struct {
+	// RefreshSampleRate is the share of successful token refreshes recorded, between 0 and 1.
+	// Refreshes happen every few minutes for each active session, so recording them all would
+	// bury the sign-ins in the history. Sign-ins and failed attempts are always recorded.
+	RefreshSampleRate float64 `json:"refreshSampleRate" yaml:"refreshSampleRate"`
+}
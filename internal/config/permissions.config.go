@@ -4,6 +4,11 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-authentication/v2/internal/lib"
 )
 
 // ErrUnknownRole reports a role name that the permission configuration does not
@@ -12,13 +17,32 @@ import (
 // alike denied affected accounts everything with no signal.
 var ErrUnknownRole = errors.New("unknown role")
 
+// ErrUnmatchedPermission reports a wildcard permission pattern that matches none of the known
+// permissions. Such a pattern grants nothing, and is most likely a typo.
+var ErrUnmatchedPermission = errors.New("permission pattern matches no known permission")
+
+const (
+	// PermissionWildcard is a permission segment that matches any segment. As the last segment,
+	// it matches any number of trailing segments: credentials:* matches credentials:get and
+	// credentials:password:patch alike.
+	PermissionWildcard = "*"
+	// PermissionNegation prefixes a permission, or a pattern, that a role revokes: the role does
+	// not grant it, even when it grants a matching pattern or inherits it.
+	PermissionNegation = "!"
+	// permissionSeparator separates the segments of a permission.
+	permissionSeparator = ":"
+)
+
 // A Role bundles the permissions granted to its holders. A role may inherit other
 // roles to accumulate their permissions, and its priority ranks it against the rest
 // of the hierarchy.
 type Role struct {
 	// Inherits pulls in the permissions of every listed role. Circular inheritance
 	// between roles is not allowed.
-	Inherits    []string `json:"inherits"    yaml:"inherits"`
+	Inherits []string `json:"inherits" yaml:"inherits"`
+	// Permissions lists the permissions the role grants. An entry may be a wildcard pattern
+	// (credentials:*), and may be negated (!credentials:role:patch) to revoke what the role
+	// would otherwise grant or inherit. See [MatchPermission].
 	Permissions []string `json:"permissions" yaml:"permissions"`
 	// Priority ranks this role in the hierarchy; a higher value outranks a lower one.
 	Priority int `json:"priority" yaml:"priority"`
@@ -40,9 +64,37 @@ const (
 // Permissions is the role/permission configuration: it maps each role identifier
 // to its Role definition.
 type Permissions struct {
+	// Known lists every permission the routes check. Wildcard patterns expand against it, and
+	// one that matches none of them is rejected. It can be left empty when no role uses
+	// patterns.
+	Known []string        `json:"known" yaml:"known"`
 	Roles map[string]Role `json:"roles" yaml:"roles"`
 }
 
+// MatchPermission reports whether a permission matches a pattern. A pattern without wildcard
+// matches only itself. A [PermissionWildcard] segment matches any single segment, except as the
+// last segment of the pattern, where it matches one or more trailing segments.
+func MatchPermission(pattern, permission string) bool {
+	patternSegments := strings.Split(pattern, permissionSeparator)
+	permissionSegments := strings.Split(permission, permissionSeparator)
+
+	for i, segment := range patternSegments {
+		if i >= len(permissionSegments) {
+			return false
+		}
+
+		if segment == PermissionWildcard && i == len(patternSegments)-1 {
+			return true
+		}
+
+		if segment != PermissionWildcard && segment != permissionSegments[i] {
+			return false
+		}
+	}
+
+	return len(patternSegments) == len(permissionSegments)
+}
+
 // Priority returns the rank of a role, and ErrUnknownRole naming it when the
 // configuration defines no such role. Callers ranking a role read from the
 // database must use this rather than indexing Roles directly: a map miss yields
@@ -56,3 +108,77 @@ func (p Permissions) Priority(role string) (int, error) {
 
 	return r.Priority, nil
 }
+
+// Rank returns the rank of an account holding the given roles: the priority of the highest one.
+// An account without roles ranks 0, like auth:anon. It fails with ErrUnknownRole like
+// [Permissions.Priority] when one of the roles is not defined.
+func (p Permissions) Rank(roles []string) (int, error) {
+	var rank int
+
+	for _, role := range roles {
+		priority, err := p.Priority(role)
+		if err != nil {
+			return 0, err
+		}
+
+		rank = max(rank, priority)
+	}
+
+	return rank, nil
+}
+
+// Resolve flattens the inheritance of the roles: it returns, for every role, the permissions it
+// grants, inherited ones included, without duplicates. Wildcard patterns are expanded against
+// the known permissions, and the permissions a role negates are removed from what it grants and
+// inherits; the roles inheriting it do not get them back, unless they grant them again.
+//
+// It fails with ErrUnknownRole when a role inherits one that is not defined, with
+// ErrUnmatchedPermission when a pattern matches no known permission, and with
+// lib.ErrCircularDependency when the inheritance has a cycle.
+func (p Permissions) Resolve() (map[string][]string, error) {
+	granted := make(map[string][]string, len(p.Roles))
+	negated := make(map[string][]string, len(p.Roles))
+
+	for name, role := range p.Roles {
+		for _, parent := range role.Inherits {
+			if _, ok := p.Roles[parent]; !ok {
+				return nil, fmt.Errorf("%w: %q, inherited by %q", ErrUnknownRole, parent, name)
+			}
+		}
+
+		for _, permission := range role.Permissions {
+			pattern, negative := strings.CutPrefix(permission, PermissionNegation)
+
+			matched := []string{pattern}
+
+			if strings.Contains(pattern, PermissionWildcard) {
+				matched = lo.Filter(p.Known, func(item string, _ int) bool {
+					return MatchPermission(pattern, item)
+				})
+				if len(matched) == 0 {
+					return nil, fmt.Errorf("%w: %q, granted by %q", ErrUnmatchedPermission, permission, name)
+				}
+			}
+
+			if negative {
+				negated[name] = append(negated[name], pattern)
+			} else {
+				granted[name] = append(granted[name], matched...)
+			}
+		}
+	}
+
+	return lib.ResolveDependantsFunc(
+		granted,
+		lo.MapEntries(p.Roles, func(key string, value Role) (string, []string) {
+			return key, value.Inherits
+		}),
+		func(name string, own, inherited []string) []string {
+			return lo.Filter(lo.Uniq(append(own, inherited...)), func(permission string, _ int) bool {
+				return !lo.ContainsBy(negated[name], func(pattern string) bool {
+					return MatchPermission(pattern, permission)
+				})
+			})
+		},
+	)
+}
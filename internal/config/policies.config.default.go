@@ -0,0 +1,16 @@
+package config
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/a-novel-kit/golib/config"
+)
+
+//go:embed policies.config.yaml
+var defaultPoliciesFile []byte
+
+// PoliciesConfigDefault is the built-in policy set, loaded from the embedded
+// policies.config.yaml.
+var PoliciesConfigDefault = config.MustUnmarshal[Policies](yaml.Unmarshal, defaultPoliciesFile)
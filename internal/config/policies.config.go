@@ -0,0 +1,28 @@
+package config
+
+// A Policy is an authorization rule, written as a CEL expression (https://cel.dev) that returns
+// true to allow the request. It reads three maps: claims, that describe the caller; request, that
+// describes the HTTP request; and target, that describes the resource the request acts on, as
+// resolved by the route.
+type Policy struct {
+	// Description tells what the policy enforces, for the people reading the configuration.
+	Description string `json:"description" yaml:"description"`
+	// Expression is the CEL expression of the policy.
+	Expression string `json:"expression" yaml:"expression"`
+}
+
+// Policies is the policy configuration: it maps each policy name to its definition. Routes
+// reference policies by name, on top of the permissions they require.
+type Policies struct {
+	Rules map[string]Policy `json:"rules" yaml:"rules"`
+}
+
+// Expressions maps each policy name to its expression.
+func (p Policies) Expressions() map[string]string {
+	expressions := make(map[string]string, len(p.Rules))
+	for name, rule := range p.Rules {
+		expressions[name] = rule.Expression
+	}
+
+	return expressions
+}
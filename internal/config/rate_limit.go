@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+// RateLimit caps how often a single client, identified by its IP address, may call a route. Each
+// client starts with Burst requests, and regains one every Interval.
+type RateLimit struct {
+	// Interval is how long a client waits to regain a request, once its burst is spent.
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// Burst is how many requests a client may send at once.
+	Burst int `json:"burst" yaml:"burst"`
+}
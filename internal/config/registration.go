@@ -0,0 +1,103 @@
+package config
+
+import (
+	_ "embed"
+	"strings"
+)
+
+const (
+	// RegistrationModeOpen lets any email address register, minus the denied domains.
+	RegistrationModeOpen = "open"
+	// RegistrationModeDomainAllowlist only lets addresses from Registration.AllowedDomains
+	// register.
+	RegistrationModeDomainAllowlist = "domain-allowlist"
+	// RegistrationModeClosed disables self-registration. Accounts can still be created
+	// through invitations.
+	RegistrationModeClosed = "closed"
+)
+
+// KnownRegistrationModes enumerates every valid value for [Registration.Mode].
+var KnownRegistrationModes = []string{
+	RegistrationModeOpen,
+	RegistrationModeDomainAllowlist,
+	RegistrationModeClosed,
+}
+
+//go:embed disposable_domains.txt
+var disposableDomainsFile string
+
+// DisposableEmailDomains is the built-in list of throwaway email providers, keyed by
+// domain, loaded from the embedded disposable_domains.txt.
+var DisposableEmailDomains = parseDomainList(disposableDomainsFile)
+
+func parseDomainList(file string) map[string]bool {
+	domains := make(map[string]bool)
+
+	for line := range strings.Lines(file) {
+		line = strings.ToLower(strings.TrimSpace(line))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		domains[line] = true
+	}
+
+	return domains
+}
+
+// Registration restricts who can create an account through self-registration. Domains
+// are matched case-insensitively, and a listed domain also covers its subdomains.
+type Registration struct {
+	// Mode is one of the RegistrationMode* constants. An empty mode is open.
+	Mode string `json:"mode" yaml:"mode"`
+	// AllowedDomains are the only email domains that may register, in
+	// RegistrationModeDomainAllowlist mode. Ignored in other modes.
+	AllowedDomains []string `json:"allowedDomains" yaml:"allowedDomains"`
+	// DeniedDomains may never register. The deny list wins over the allow list.
+	DeniedDomains []string `json:"deniedDomains" yaml:"deniedDomains"`
+	// BlockDisposable rejects the domains of [DisposableEmailDomains].
+	BlockDisposable bool `json:"blockDisposable" yaml:"blockDisposable"`
+}
+
+// EmailDomain returns the lowercased domain of an email address, or an empty string if
+// the address has none.
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+
+	return strings.ToLower(email[at+1:])
+}
+
+// DomainMatches reports whether domain is one of the listed domains, or a subdomain of
+// one of them.
+func DomainMatches(domain string, list []string) bool {
+	for _, item := range list {
+		item = strings.ToLower(item)
+		if domain == item || strings.HasSuffix(domain, "."+item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDisposableDomain reports whether domain, or one of its parent domains, is in
+// [DisposableEmailDomains].
+func IsDisposableDomain(domain string) bool {
+	for domain != "" {
+		if DisposableEmailDomains[domain] {
+			return true
+		}
+
+		_, parent, ok := strings.Cut(domain, ".")
+		if !ok {
+			return false
+		}
+
+		domain = parent
+	}
+
+	return false
+}
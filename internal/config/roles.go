@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+// Roles configures how the role definitions stored in the roles table are kept up to date, and
+// the temporary role grants.
+type Roles struct {
+	// RefreshInterval is how often an instance reloads the definitions. An instance applies its
+	// own changes at once; the changes made through other instances apply within this interval.
+	RefreshInterval time.Duration `json:"refreshInterval" yaml:"refreshInterval"`
+	// GrantMaxDuration is the longest a temporary role grant may last.
+	GrantMaxDuration time.Duration `json:"grantMaxDuration" yaml:"grantMaxDuration"`
+	// GrantSweepInterval is how often an instance reverts the expired temporary role grants. An
+	// expired grant stops being honored at once: the sweep only removes it, and records the revert.
+	GrantSweepInterval time.Duration `json:"grantSweepInterval" yaml:"grantSweepInterval"`
+}
@@ -5,16 +5,53 @@ import (
 	"time"
 )
 
+const (
+	// ShortCodeFormatURLSafe generates codes of URL-safe letters and digits, mixing cases. It
+	// suits codes delivered in a link. It is the format of a usage that sets none.
+	ShortCodeFormatURLSafe = "url-safe"
+	// ShortCodeFormatNumeric generates codes of decimal digits only, for one-time passwords
+	// typed on a phone.
+	ShortCodeFormatNumeric = "numeric"
+	// ShortCodeFormatCrockford generates codes of Crockford's base32, grouped by four, such as
+	// ABCD-EFGH. The alphabet leaves out the letters easily confused with a digit, and input is
+	// always compared regardless of case, separators, and those confusions.
+	ShortCodeFormatCrockford = "crockford-base32"
+)
+
+// KnownShortCodeFormats enumerates every valid value for [ShortCodeUsage.Format].
+var KnownShortCodeFormats = []string{
+	ShortCodeFormatURLSafe,
+	ShortCodeFormatNumeric,
+	ShortCodeFormatCrockford,
+}
+
 // ShortCodeUsage holds the settings for a single short-code usage.
 type ShortCodeUsage struct {
 	// TTL is how long a short code issued for this usage stays valid.
 	TTL time.Duration `json:"ttl" yaml:"ttl"`
+	// Format is the alphabet and layout of the codes issued for this usage, one of
+	// KnownShortCodeFormats. Empty means ShortCodeFormatURLSafe.
+	Format string `json:"format" yaml:"format"`
+	// Length is the number of characters drawn for a code of this usage, separators excluded.
+	// Zero falls back to ShortCodes.Size.
+	Length int `json:"length" yaml:"length"`
+	// CaseInsensitive compares the url-safe codes of this usage regardless of case. The other
+	// formats have a single case anyway. Codes issued before it changes may no longer be
+	// redeemed.
+	CaseInsensitive bool `json:"caseInsensitive" yaml:"caseInsensitive"`
+	// MaxAttempts is how many times redeeming a short code issued for this usage may fail. The
+	// code is deleted on the last failed attempt, and the user must request a new one. Zero
+	// allows any number of attempts.
+	MaxAttempts int `json:"maxAttempts" yaml:"maxAttempts"`
+	// ResendCooldown is how long after a code of this usage is issued the same target may have it
+	// resent. Zero lets it be resent at any time.
+	ResendCooldown time.Duration `json:"resendCooldown" yaml:"resendCooldown"`
 }
 
 // ShortCodes configures the one-time codes emailed to users to authorize sensitive
 // actions such as registration, email changes, and password resets.
 type ShortCodes struct {
-	// Size is the character length of a generated code.
+	// Size is the character length of a generated code, for the usages that set no Length.
 	Size int `json:"size" yaml:"size"`
 	// Usages holds the per-usage settings, keyed by usage name.
 	Usages map[string]ShortCodeUsage `json:"usages" yaml:"usages"`
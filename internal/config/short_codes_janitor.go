@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+// ShortCodesJanitor configures the janitor cleaning up the short_codes table. It soft-deletes the
+// codes that expired without being redeemed, then removes for good the codes deleted for longer
+// than the retention.
+type ShortCodesJanitor struct {
+	// Interval is how often the janitor runs. Every instance tries, and only one of them runs at a
+	// time.
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// Retention is how long a deleted code is kept, to investigate how it was used, before it is
+	// removed.
+	Retention time.Duration `json:"retention" yaml:"retention"`
+	// BatchSize caps the rows changed in a single transaction, so the janitor never locks much of
+	// the table at once.
+	BatchSize int `json:"batchSize" yaml:"batchSize"`
+}
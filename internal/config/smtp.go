@@ -8,4 +8,6 @@ type SmtpUrls struct {
 	UpdateEmail    string `json:"updateEmail"    yaml:"updateEmail"`
 	UpdatePassword string `json:"updatePassword" yaml:"updatePassword"`
 	Register       string `json:"register"       yaml:"register"`
+	Invite         string `json:"invite"         yaml:"invite"`
+	VerifyEmail    string `json:"verifyEmail"    yaml:"verifyEmail"`
 }
@@ -1,6 +1,10 @@
 package core
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // AccessTokenClaims is the JWT payload of an access token issued by this service. It is
 // embedded in the JWT signed by the json-keys service and decoded back by the auth
@@ -21,4 +25,12 @@ type AccessTokenClaims struct {
 	// token-refresh flow requires the two to match, which binds the pair: revoking a
 	// refresh token revokes every access token derived from it.
 	RefreshTokenID string `json:"refreshTokenID,omitempty"`
+	// EmailVerified reports whether the user's email was verified when the token was
+	// signed. A verification takes effect on the next token refresh.
+	EmailVerified bool `json:"emailVerified,omitempty"`
+	// GrantExpiresAt is when the first temporary role grant among Roles expires. The auth
+	// middleware refuses the token past it, even if the token itself has not expired, so a
+	// grant never outlives its expiry: the next refresh signs a token without the role. Nil
+	// when Roles hold no temporary grant.
+	GrantExpiresAt *time.Time `json:"grantExpiresAt,omitempty"`
 }
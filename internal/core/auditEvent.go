@@ -0,0 +1,218 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+const (
+	// AuditActionCredentialsGet is an administrator reading an account by ID.
+	AuditActionCredentialsGet = "credentials.get"
+	// AuditActionCredentialsList is an administrator browsing accounts.
+	AuditActionCredentialsList = "credentials.list"
+	// AuditActionCredentialsExport is an administrator exporting the personal data of an account.
+	AuditActionCredentialsExport = "credentials.export"
+	// AuditActionCredentialsLogins is an administrator reading the login history of an account.
+	AuditActionCredentialsLogins = "credentials.logins"
+	// AuditActionCredentialsUpdateRole is a change of the role of an account.
+	AuditActionCredentialsUpdateRole = "credentials.updateRole"
+	// AuditActionCredentialsGrantRole is a role granted to an account for a limited time.
+	AuditActionCredentialsGrantRole = "credentials.grantRole"
+	// AuditActionCredentialsGrantRoleExpire is the revert of a temporary role grant, once it
+	// expired. It is run by the system: the event has no actor.
+	AuditActionCredentialsGrantRoleExpire = "credentials.grantRole.expire"
+	// AuditActionCredentialsMerge is the merge of an account into another one. The event targets
+	// the destination; its After snapshot names the merged account, so other services can move
+	// their data to the destination.
+	AuditActionCredentialsMerge = "credentials.merge"
+	// AuditActionCredentialsSuperAdminCreate is the creation of the super-admin account at
+	// bootstrap.
+	AuditActionCredentialsSuperAdminCreate = "credentials.superAdmin.create"
+	// AuditActionCredentialsSuperAdminUpdate is the reset of an existing super-admin account at
+	// bootstrap: its password is replaced, and its role raised if needed.
+	AuditActionCredentialsSuperAdminUpdate = "credentials.superAdmin.update"
+	// AuditActionRolesCreate is the creation of a role.
+	AuditActionRolesCreate = "roles.create"
+	// AuditActionRolesUpdate is a change of the definition of a role.
+	AuditActionRolesUpdate = "roles.update"
+	// AuditActionRolesDelete is the deletion of a role.
+	AuditActionRolesDelete = "roles.delete"
+	// AuditActionShortCodesList is an administrator browsing short codes.
+	AuditActionShortCodesList = "shortCodes.list"
+	// AuditActionShortCodesRevoke is an administrator revoking a short code, for instance
+	// after a leak.
+	AuditActionShortCodesRevoke = "shortCodes.revoke"
+)
+
+// AuditEvent is an administrative action, as recorded in the audit chain.
+type AuditEvent struct {
+	ID  uuid.UUID
+	Seq int64
+	// ActorID is the user that performed the action. Nil for actions run by the system.
+	ActorID *uuid.UUID
+	// TargetID is the account the action applied to, if any.
+	TargetID *uuid.UUID
+	// Action is one of the AuditAction* constants.
+	Action string
+	// Before and After are JSON snapshots of the state the action changed, when it changed any.
+	Before    json.RawMessage
+	After     json.RawMessage
+	RequestID string
+	CreatedAt time.Time
+	// Hash chains the event to the one before it.
+	Hash []byte
+}
+
+func loadAuditEvent(item *dao.AuditEvent, _ int) *AuditEvent {
+	return &AuditEvent{
+		ID:        item.ID,
+		Seq:       item.Seq,
+		ActorID:   item.ActorID,
+		TargetID:  item.TargetID,
+		Action:    item.Action,
+		Before:    item.Before,
+		After:     item.After,
+		RequestID: item.RequestID,
+		CreatedAt: item.CreatedAt,
+		Hash:      item.Hash,
+	}
+}
+
+// auditCredentialsState is the snapshot of an account stored in the Before and After fields of
+// the events that change it. It holds the fields administrators can change, nothing sensitive.
+type auditCredentialsState struct {
+	Roles []string `json:"roles"`
+	// MergedFrom is the account merged into the target, on merge events.
+	MergedFrom *uuid.UUID `json:"mergedFrom,omitempty"`
+}
+
+// auditRoleGrantState is the snapshot of a temporary role grant stored in the After field of the
+// grant event, and in the Before field of its revert.
+type auditRoleGrantState struct {
+	ID        uuid.UUID  `json:"id"`
+	Role      string     `json:"role"`
+	Reason    string     `json:"reason"`
+	GrantedBy *uuid.UUID `json:"grantedBy,omitempty"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+}
+
+func newAuditRoleGrantState(grant *dao.CredentialRoleGrant) *auditRoleGrantState {
+	return &auditRoleGrantState{
+		ID:        grant.ID,
+		Role:      grant.Role,
+		Reason:    grant.Reason,
+		GrantedBy: grant.GrantedBy,
+		ExpiresAt: grant.ExpiresAt,
+	}
+}
+
+// auditRoleState is the snapshot of a role stored in the Before and After fields of the events
+// that change it. Role events target no account: the role is named here.
+type auditRoleState struct {
+	Name        string   `json:"name"`
+	Priority    int      `json:"priority"`
+	Permissions []string `json:"permissions"`
+	Inherits    []string `json:"inherits"`
+}
+
+func newAuditRoleState(role *dao.Role) *auditRoleState {
+	return &auditRoleState{
+		Name:        role.Name,
+		Priority:    role.Priority,
+		Permissions: role.Permissions,
+		Inherits:    role.Inherits,
+	}
+}
+
+// auditShortCodeState is the snapshot of a short code stored in the Before and After fields of
+// the events that change it. Short codes are not accounts: the event targets none, and the code
+// is named here. Neither the hash nor the flow data are recorded.
+type auditShortCodeState struct {
+	ID             uuid.UUID `json:"id"`
+	Usage          string    `json:"usage"`
+	Target         string    `json:"target"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	DeletedComment *string   `json:"deletedComment,omitempty"`
+}
+
+func newAuditShortCodeState(shortCode *dao.ShortCode) *auditShortCodeState {
+	return &auditShortCodeState{
+		ID:             shortCode.ID,
+		Usage:          shortCode.Usage,
+		Target:         shortCode.Target,
+		ExpiresAt:      shortCode.ExpiresAt,
+		DeletedComment: shortCode.DeletedComment,
+	}
+}
+
+// auditEventRecorder is the DAO surface recordAuditEvent needs. Service-level interfaces
+// (e.g. CredentialsUpdateRoleDaoAuditEventInsert) already match this shape.
+type auditEventRecorder interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// auditEventData describes the action to record. Before and After are snapshots, such as
+// auditCredentialsState, encoded as JSON; leave them nil when the action changed no state.
+type auditEventData struct {
+	ActorID   *uuid.UUID
+	TargetID  *uuid.UUID
+	Action    string
+	Before    any
+	After     any
+	RequestID string
+	// Read marks an action that changed nothing. Reads are recorded outside the hash chain, so
+	// lookups do not wait on the chain lock.
+	Read bool
+}
+
+// recordAuditEvent appends an action to the audit chain, or records it outside the chain when
+// it is a read. Unlike the login history, the trail is not best-effort: callers run it in the
+// transaction of the action, and an error must abort that action.
+func recordAuditEvent(ctx context.Context, recorder auditEventRecorder, data *auditEventData) error {
+	ctx, span := otel.Tracer().Start(ctx, "core.recordAuditEvent")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("auditEvent.action", data.Action),
+		attribute.String("auditEvent.requestID", data.RequestID),
+	)
+
+	var before, after []byte
+
+	if data.Before != nil {
+		before = lo.Must(json.Marshal(data.Before))
+	}
+
+	if data.After != nil {
+		after = lo.Must(json.Marshal(data.After))
+	}
+
+	_, err := recorder.Exec(ctx, &dao.AuditEventInsertRequest{
+		ID:        uuid.New(),
+		ActorID:   data.ActorID,
+		TargetID:  data.TargetID,
+		Action:    data.Action,
+		Before:    before,
+		After:     after,
+		RequestID: data.RequestID,
+		Now:       time.Now(),
+		Unchained: data.Read,
+	})
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("insert audit event: %w", err))
+	}
+
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type AuditEventListDao interface {
+	Exec(ctx context.Context, request *dao.AuditEventListRequest) ([]*dao.AuditEvent, error)
+}
+
+type AuditEventListRequest struct {
+	// ActorID and TargetID, if set, restrict the result to the actions of that user, or on that
+	// account.
+	ActorID  *uuid.UUID
+	TargetID *uuid.UUID
+	Limit    int `validate:"required,min=1,max=100"`
+	Offset   int `validate:"min=0"`
+}
+
+// AuditEventList returns the audit trail of administrative actions, newest first.
+type AuditEventList struct {
+	dao AuditEventListDao
+}
+
+func NewAuditEventList(dao AuditEventListDao) *AuditEventList {
+	return &AuditEventList{
+		dao: dao,
+	}
+}
+
+func (service *AuditEventList) Exec(ctx context.Context, request *AuditEventListRequest) ([]*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.AuditEventList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Bool("request.actorID", request.ActorID != nil),
+		attribute.Bool("request.targetID", request.TargetID != nil),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	entities, err := service.dao.Exec(ctx, &dao.AuditEventListRequest{
+		Limit:    request.Limit,
+		Offset:   request.Offset,
+		ActorID:  request.ActorID,
+		TargetID: request.TargetID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list audit events: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("response.count", len(entities)))
+
+	return otel.ReportSuccess(span, lo.Map(entities, loadAuditEvent)), nil
+}
@@ -0,0 +1,153 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestAuditEventList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		resp []*dao.AuditEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.AuditEventListRequest
+
+		daoMock *daoMock
+
+		expect    []*core.AuditEvent
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.AuditEventListRequest{
+				ActorID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				TargetID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Limit:    10,
+				Offset:   5,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.AuditEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Seq:       2,
+						ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						Action:    core.AuditActionCredentialsUpdateRole,
+						Before:    []byte(`{"role":"auth:user"}`),
+						After:     []byte(`{"role":"auth:admin"}`),
+						RequestID: "request-1",
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						Hash:      []byte("hash"),
+					},
+				},
+			},
+
+			expect: []*core.AuditEvent{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					Seq:       2,
+					ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					Action:    core.AuditActionCredentialsUpdateRole,
+					Before:    json.RawMessage(`{"role":"auth:user"}`),
+					After:     json.RawMessage(`{"role":"auth:admin"}`),
+					RequestID: "request-1",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Hash:      []byte("hash"),
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &core.AuditEventListRequest{
+				Limit: 10,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.AuditEvent{},
+			},
+
+			expect: []*core.AuditEvent{},
+		},
+		{
+			name: "Error/DAO",
+
+			request: &core.AuditEventListRequest{
+				Limit: 10,
+			},
+
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/LimitTooHigh",
+
+			request: &core.AuditEventListRequest{
+				Limit: 101,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NoLimit",
+
+			request: &core.AuditEventListRequest{},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockAuditEventListDao(t)
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, &dao.AuditEventListRequest{
+						Limit:    testCase.request.Limit,
+						Offset:   testCase.request.Offset,
+						ActorID:  testCase.request.ActorID,
+						TargetID: testCase.request.TargetID,
+					}).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			service := core.NewAuditEventList(mockDao)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
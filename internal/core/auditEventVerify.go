@@ -0,0 +1,86 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// ErrAuditEventVerifyBrokenChain is returned when the stored hash of an event does not match its
+// content: the event, or one before it, was altered, inserted or removed outside the service.
+var ErrAuditEventVerifyBrokenChain = errors.New("audit chain is broken")
+
+// auditEventVerifyBatchSize is the number of events loaded at once while walking the chain.
+const auditEventVerifyBatchSize = 500
+
+type AuditEventVerifyDao interface {
+	Exec(ctx context.Context, request *dao.AuditEventListRequest) ([]*dao.AuditEvent, error)
+}
+
+type AuditEventVerifyResponse struct {
+	// Checked is the number of events in the chain.
+	Checked int
+	// Head is the hash of the last event, nil for an empty chain. Storing it outside the database
+	// lets a later verification detect the chain being truncated or rewritten as a whole.
+	Head []byte
+}
+
+// AuditEventVerify walks the audit chain in order, and recomputes the hash of every event. Reads,
+// recorded outside the chain, are not checked.
+type AuditEventVerify struct {
+	dao AuditEventVerifyDao
+}
+
+func NewAuditEventVerify(dao AuditEventVerifyDao) *AuditEventVerify {
+	return &AuditEventVerify{
+		dao: dao,
+	}
+}
+
+func (service *AuditEventVerify) Exec(ctx context.Context) (*AuditEventVerifyResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.AuditEventVerify")
+	defer span.End()
+
+	response := new(AuditEventVerifyResponse)
+
+	var afterSeq *int64
+
+	for {
+		events, err := service.dao.Exec(ctx, &dao.AuditEventListRequest{
+			Limit:     auditEventVerifyBatchSize,
+			AfterSeq:  afterSeq,
+			Ascending: true,
+			Chained:   true,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("list audit events: %w", err))
+		}
+
+		for _, event := range events {
+			if !bytes.Equal(event.Hash, dao.AuditEventHash(response.Head, event)) {
+				return nil, otel.ReportError(span, fmt.Errorf(
+					"%w: event %s (seq %d)", ErrAuditEventVerifyBrokenChain, event.ID, event.Seq,
+				))
+			}
+
+			response.Head = event.Hash
+			response.Checked++
+			afterSeq = &event.Seq
+		}
+
+		if len(events) < auditEventVerifyBatchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("response.checked", response.Checked))
+
+	return otel.ReportSuccess(span, response), nil
+}
@@ -0,0 +1,146 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// auditEventChain builds a valid chain of size events.
+func auditEventChain(size int) []*dao.AuditEvent {
+	events := make([]*dao.AuditEvent, size)
+
+	var previous []byte
+
+	for i := range events {
+		events[i] = &dao.AuditEvent{
+			ID:        uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", i+1)),
+			Seq:       int64(i + 1),
+			Action:    core.AuditActionCredentialsList,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, i, 0, time.UTC),
+		}
+		events[i].Hash = dao.AuditEventHash(previous, events[i])
+		previous = events[i].Hash
+	}
+
+	return events
+}
+
+func TestAuditEventVerify(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		afterSeq *int64
+		resp     []*dao.AuditEvent
+		err      error
+	}
+
+	chain := auditEventChain(502)
+
+	tampered := auditEventChain(3)
+	tampered[1].Action = core.AuditActionCredentialsGet
+
+	testCases := []struct {
+		name string
+
+		daoMocks []*daoMock
+
+		expect    *core.AuditEventVerifyResponse
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			daoMocks: []*daoMock{
+				{resp: chain[:2]},
+			},
+
+			expect: &core.AuditEventVerifyResponse{
+				Checked: 2,
+				Head:    chain[1].Hash,
+			},
+		},
+		{
+			name: "Success/Batches",
+
+			daoMocks: []*daoMock{
+				{resp: chain[:500]},
+				{afterSeq: lo.ToPtr(int64(500)), resp: chain[500:]},
+			},
+
+			expect: &core.AuditEventVerifyResponse{
+				Checked: 502,
+				Head:    chain[501].Hash,
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			daoMocks: []*daoMock{
+				{resp: []*dao.AuditEvent{}},
+			},
+
+			expect: &core.AuditEventVerifyResponse{},
+		},
+		{
+			name: "Error/BrokenChain",
+
+			daoMocks: []*daoMock{
+				{resp: tampered},
+			},
+
+			expectErr: core.ErrAuditEventVerifyBrokenChain,
+		},
+		{
+			name: "Error/DAO",
+
+			daoMocks: []*daoMock{
+				{err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockAuditEventVerifyDao(t)
+
+			for _, daoMock := range testCase.daoMocks {
+				mockDao.EXPECT().
+					Exec(mock.Anything, &dao.AuditEventListRequest{
+						Limit:     500,
+						AfterSeq:  daoMock.afterSeq,
+						Ascending: true,
+						Chained:   true,
+					}).
+					Return(daoMock.resp, daoMock.err).
+					Once()
+			}
+
+			service := core.NewAuditEventVerify(mockDao)
+
+			resp, err := service.Exec(ctx)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
@@ -3,33 +3,29 @@ package core
 import (
 	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
-
-	"github.com/a-novel/service-authentication/v2/internal/config"
 )
 
 // Credentials is a user account as the core layer exposes it: identity, email,
-// current role, and audit timestamps. The stored password hash stays in the DAO
+// current roles, and audit timestamps. The stored password hash stays in the DAO
 // layer and is never carried on this type.
 type Credentials struct {
-	ID        uuid.UUID
-	Email     string
-	Role      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-}
-
-// ValidateCredentialsRole is a go-playground/validator field-level validator that
-// accepts a string only when it names a role defined in the default permissions
-// configuration. It is registered under the "role" tag at package init.
-func ValidateCredentialsRole(fl validator.FieldLevel) bool {
-	val := fl.Field().String()
-	for role := range config.PermissionsConfigDefault.Roles {
-		if val == role {
-			return true
-		}
-	}
-
-	return false
+	ID    uuid.UUID
+	Email string
+	// Roles are the roles the user holds, sorted by name.
+	Roles []string
+	// EmailVerifiedAt is when the user last proved control of Email. Nil when the
+	// address was never verified.
+	EmailVerifiedAt *time.Time
+	// Locale is the language the user receives emails in. Empty when the user expressed
+	// no preference.
+	Locale string
+	// NoticesOptOut is true when the user turned off the notices that are not critical to the
+	// security of their account, such as the sign-in from a new device.
+	NoticesOptOut bool
+	// LastLoginAt is when the user last signed in with their password. Nil when they
+	// never did.
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
@@ -0,0 +1,201 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// credentialsCanonicalizeEmailsBatchSize is the number of credentials read per page.
+const credentialsCanonicalizeEmailsBatchSize = 500
+
+// CredentialsCanonicalizeEmailsDaoList pages through every credential; satisfied by
+// [dao.CredentialsList].
+type CredentialsCanonicalizeEmailsDaoList interface {
+	Exec(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error)
+}
+
+// CredentialsCanonicalizeEmailsDao stores a recomputed canonical email.
+type CredentialsCanonicalizeEmailsDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsUpdateEmailCanonicalRequest) (*dao.Credentials, error)
+}
+
+// CanonicalEmailCollision lists accounts that would share the same canonical email. They
+// keep their current canonical email until they are merged or renamed.
+type CanonicalEmailCollision struct {
+	EmailCanonical string
+	Credentials    []*Credentials
+}
+
+// CredentialsCanonicalizeEmailsResult reports the outcome of [CredentialsCanonicalizeEmails].
+type CredentialsCanonicalizeEmailsResult struct {
+	// Updated is the number of accounts whose canonical email changed.
+	Updated int
+	// Collisions lists the accounts that could not be updated, by canonical email.
+	Collisions []*CanonicalEmailCollision
+}
+
+// CredentialsCanonicalizeEmails recomputes the canonical email of every account, under the
+// current provider rules. It runs after the migrations: the SQL backfill can only lowercase,
+// and changing the provider rules changes the canonical form of existing addresses.
+//
+// Accounts whose canonical emails would collide are left untouched and reported.
+type CredentialsCanonicalizeEmails struct {
+	daoList CredentialsCanonicalizeEmailsDaoList
+	dao     CredentialsCanonicalizeEmailsDao
+	emails  config.Emails
+}
+
+func NewCredentialsCanonicalizeEmails(
+	daoList CredentialsCanonicalizeEmailsDaoList,
+	dao CredentialsCanonicalizeEmailsDao,
+	emails config.Emails,
+) *CredentialsCanonicalizeEmails {
+	return &CredentialsCanonicalizeEmails{
+		daoList: daoList,
+		dao:     dao,
+		emails:  emails,
+	}
+}
+
+type credentialsCanonicalEmail struct {
+	credentials *dao.Credentials
+	canonical   string
+}
+
+func (service *CredentialsCanonicalizeEmails) Exec(ctx context.Context) (*CredentialsCanonicalizeEmailsResult, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsCanonicalizeEmails")
+	defer span.End()
+
+	entries, err := service.list(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("credentials.count", len(entries)))
+
+	result := new(CredentialsCanonicalizeEmailsResult)
+
+	byCanonical := make(map[string][]*credentialsCanonicalEmail)
+	for _, entry := range entries {
+		byCanonical[entry.canonical] = append(byCanonical[entry.canonical], entry)
+	}
+
+	var pending []*credentialsCanonicalEmail
+
+	for _, entry := range entries {
+		group := byCanonical[entry.canonical]
+
+		switch {
+		case len(group) > 1:
+			// Reported once, from its first member.
+			if group[0] == entry {
+				result.Collisions = append(result.Collisions, newCanonicalEmailCollision(entry.canonical, group...))
+			}
+		case entry.canonical != entry.credentials.EmailCanonical:
+			pending = append(pending, entry)
+		}
+	}
+
+	// A canonical email may still be held by an account that is updated later in the same run,
+	// so updates that collide are retried until a pass makes no progress.
+	for len(pending) > 0 {
+		var retry []*credentialsCanonicalEmail
+
+		for _, entry := range pending {
+			_, err = service.dao.Exec(ctx, &dao.CredentialsUpdateEmailCanonicalRequest{
+				ID:             entry.credentials.ID,
+				EmailCanonical: entry.canonical,
+			})
+
+			switch {
+			case errors.Is(err, dao.ErrCredentialsUpdateEmailCanonicalAlreadyExists):
+				retry = append(retry, entry)
+			case err != nil:
+				return nil, otel.ReportError(span, fmt.Errorf("update canonical email: %w", err))
+			default:
+				result.Updated++
+			}
+		}
+
+		if len(retry) == len(pending) {
+			// The remaining canonical emails are held by accounts left untouched.
+			for _, entry := range retry {
+				result.Collisions = append(result.Collisions, newCanonicalEmailCollision(entry.canonical, entry))
+			}
+
+			break
+		}
+
+		pending = retry
+	}
+
+	slices.SortFunc(result.Collisions, func(a, b *CanonicalEmailCollision) int {
+		return strings.Compare(a.EmailCanonical, b.EmailCanonical)
+	})
+
+	span.SetAttributes(
+		attribute.Int("result.updated", result.Updated),
+		attribute.Int("result.collisions", len(result.Collisions)),
+	)
+
+	return otel.ReportSuccess(span, result), nil
+}
+
+// list reads every credential, with its canonical email under the current rules.
+func (service *CredentialsCanonicalizeEmails) list(ctx context.Context) ([]*credentialsCanonicalEmail, error) {
+	var (
+		entries []*credentialsCanonicalEmail
+		after   *dao.CredentialsListKey
+	)
+
+	for {
+		page, err := service.daoList.Exec(ctx, &dao.CredentialsListRequest{
+			Limit:     credentialsCanonicalizeEmailsBatchSize,
+			After:     after,
+			Ascending: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list credentials: %w", err)
+		}
+
+		for _, credentials := range page {
+			canonical, err := service.emails.Canonical(credentials.Email)
+			if err != nil {
+				return nil, fmt.Errorf("canonicalize email of %s: %w", credentials.ID, err)
+			}
+
+			entries = append(entries, &credentialsCanonicalEmail{credentials: credentials, canonical: canonical})
+		}
+
+		if len(page) < credentialsCanonicalizeEmailsBatchSize {
+			return entries, nil
+		}
+
+		last := page[len(page)-1]
+		after = &dao.CredentialsListKey{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
+func newCanonicalEmailCollision(canonical string, entries ...*credentialsCanonicalEmail) *CanonicalEmailCollision {
+	collision := &CanonicalEmailCollision{EmailCanonical: canonical}
+
+	for _, entry := range entries {
+		collision.Credentials = append(collision.Credentials, &Credentials{
+			ID:    entry.credentials.ID,
+			Email: entry.credentials.Email,
+			Roles: entry.credentials.Roles,
+		})
+	}
+
+	return collision
+}
@@ -0,0 +1,231 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestCredentialsCanonicalizeEmails(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	emails := config.Emails{
+		Providers: map[string]lib.EmailProvider{
+			"gmail.com": {IgnoreDots: true, TagSeparator: "+"},
+		},
+	}
+
+	credentials := func(id int, email, canonical string) *dao.Credentials {
+		return &dao.Credentials{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-00000000000" + string(rune('0'+id))),
+			Email:          email,
+			EmailCanonical: canonical,
+			Roles:          []string{config.RoleUser},
+		}
+	}
+
+	type listMock struct {
+		resp []*dao.Credentials
+		err  error
+	}
+
+	type updateMock struct {
+		req *dao.CredentialsUpdateEmailCanonicalRequest
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		listMock    *listMock
+		updateMocks []*updateMock
+
+		expect    *core.CredentialsCanonicalizeEmailsResult
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			listMock: &listMock{
+				resp: []*dao.Credentials{
+					credentials(1, "User@Example.com", "user@example.com"),
+					credentials(2, "Jo.hn@gmail.com", "jo.hn@gmail.com"),
+					credentials(3, "ja.ne@gmail.com", "ja.ne@gmail.com"),
+					credentials(4, "jane+news@gmail.com", "jane+news@gmail.com"),
+				},
+			},
+
+			updateMocks: []*updateMock{
+				{
+					req: &dao.CredentialsUpdateEmailCanonicalRequest{
+						ID:             credentials(2, "", "").ID,
+						EmailCanonical: "john@gmail.com",
+					},
+				},
+			},
+
+			expect: &core.CredentialsCanonicalizeEmailsResult{
+				Updated: 1,
+				Collisions: []*core.CanonicalEmailCollision{
+					{
+						EmailCanonical: "jane@gmail.com",
+						Credentials: []*core.Credentials{
+							{ID: credentials(3, "", "").ID, Email: "ja.ne@gmail.com", Roles: []string{config.RoleUser}},
+							{ID: credentials(4, "", "").ID, Email: "jane+news@gmail.com", Roles: []string{config.RoleUser}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Success/Retry",
+
+			listMock: &listMock{
+				resp: []*dao.Credentials{
+					credentials(1, "a.b@gmail.com", "a.b@gmail.com"),
+					// Holds the canonical email account 1 needs until it is updated itself.
+					credentials(2, "ab@example.com", "ab@gmail.com"),
+				},
+			},
+
+			updateMocks: []*updateMock{
+				{
+					req: &dao.CredentialsUpdateEmailCanonicalRequest{
+						ID:             credentials(1, "", "").ID,
+						EmailCanonical: "ab@gmail.com",
+					},
+					err: dao.ErrCredentialsUpdateEmailCanonicalAlreadyExists,
+				},
+				{
+					req: &dao.CredentialsUpdateEmailCanonicalRequest{
+						ID:             credentials(2, "", "").ID,
+						EmailCanonical: "ab@example.com",
+					},
+				},
+				{
+					req: &dao.CredentialsUpdateEmailCanonicalRequest{
+						ID:             credentials(1, "", "").ID,
+						EmailCanonical: "ab@gmail.com",
+					},
+				},
+			},
+
+			expect: &core.CredentialsCanonicalizeEmailsResult{Updated: 2},
+		},
+		{
+			name: "Success/HeldByUntouchedAccount",
+
+			listMock: &listMock{
+				resp: []*dao.Credentials{
+					credentials(1, "c.d@gmail.com", "c.d@gmail.com"),
+				},
+			},
+
+			updateMocks: []*updateMock{
+				{
+					req: &dao.CredentialsUpdateEmailCanonicalRequest{
+						ID:             credentials(1, "", "").ID,
+						EmailCanonical: "cd@gmail.com",
+					},
+					err: dao.ErrCredentialsUpdateEmailCanonicalAlreadyExists,
+				},
+			},
+
+			expect: &core.CredentialsCanonicalizeEmailsResult{
+				Collisions: []*core.CanonicalEmailCollision{
+					{
+						EmailCanonical: "cd@gmail.com",
+						Credentials: []*core.Credentials{
+							{ID: credentials(1, "", "").ID, Email: "c.d@gmail.com", Roles: []string{config.RoleUser}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Success/UpToDate",
+
+			listMock: &listMock{
+				resp: []*dao.Credentials{
+					credentials(1, "User@Example.com", "user@example.com"),
+				},
+			},
+
+			expect: &core.CredentialsCanonicalizeEmailsResult{},
+		},
+		{
+			name: "Error/List",
+
+			listMock: &listMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Update",
+
+			listMock: &listMock{
+				resp: []*dao.Credentials{
+					credentials(1, "Jo.hn@gmail.com", "jo.hn@gmail.com"),
+				},
+			},
+
+			updateMocks: []*updateMock{
+				{
+					req: &dao.CredentialsUpdateEmailCanonicalRequest{
+						ID:             credentials(1, "", "").ID,
+						EmailCanonical: "john@gmail.com",
+					},
+					err: errFoo,
+				},
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			daoList := coremocks.NewMockCredentialsCanonicalizeEmailsDaoList(t)
+			mockDao := coremocks.NewMockCredentialsCanonicalizeEmailsDao(t)
+
+			if testCase.listMock != nil {
+				daoList.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsListRequest{Limit: 500, Ascending: true}).
+					Return(testCase.listMock.resp, testCase.listMock.err)
+			}
+
+			for _, updateMock := range testCase.updateMocks {
+				mockDao.EXPECT().
+					Exec(mock.Anything, updateMock.req).
+					Return(nil, updateMock.err).
+					Once()
+			}
+
+			service := core.NewCredentialsCanonicalizeEmails(daoList, mockDao, emails)
+
+			resp, err := service.Exec(ctx)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoList.AssertExpectations(t)
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
@@ -45,16 +45,23 @@ type CredentialsCreateRequest struct {
 	Password string `validate:"required,min=4,max=1024"`
 	// ShortCode is the verification code sent to the user's email during registration.
 	ShortCode string `validate:"required,max=1024"`
+	// Lang is the language the user wants to receive emails in. Optional.
+	Lang string `validate:"omitempty,langs"`
 }
 
 // CredentialsCreate implements user registration with email verification.
 // The registration flow requires a valid short code that was previously sent
 // to the user's email address to verify ownership.
+//
+// The registration policy is checked again here, so codes issued before the policy
+// was tightened cannot be used to register anymore.
 type CredentialsCreate struct {
 	dao                     CredentialsCreateDao
 	serviceShortCodeConsume CredentialsCreateServiceShortCodeConsume
 	serviceSignClaims       CredentialsCreateServiceSignClaims
 	transactor              transaction.Transactor
+	registration            config.Registration
+	emails                  config.Emails
 }
 
 func NewCredentialsCreate(
@@ -62,12 +69,16 @@ func NewCredentialsCreate(
 	serviceShortCodeConsume CredentialsCreateServiceShortCodeConsume,
 	serviceSignClaims CredentialsCreateServiceSignClaims,
 	transactor transaction.Transactor,
+	registration config.Registration,
+	emails config.Emails,
 ) *CredentialsCreate {
 	return &CredentialsCreate{
 		dao:                     dao,
 		serviceShortCodeConsume: serviceShortCodeConsume,
 		serviceSignClaims:       serviceSignClaims,
 		transactor:              transactor,
+		registration:            registration,
+		emails:                  emails,
 	}
 }
 
@@ -80,7 +91,10 @@ func (service *CredentialsCreate) Exec(ctx context.Context, request *Credentials
 	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsCreate")
 	defer span.End()
 
-	span.SetAttributes(attribute.String("email", request.Email))
+	span.SetAttributes(
+		attribute.String("email", request.Email),
+		attribute.String("lang", request.Lang),
+	)
 	// The password and short code never go on the span. A redaction still carries its length.
 
 	err := validate.Struct(request)
@@ -88,6 +102,16 @@ func (service *CredentialsCreate) Exec(ctx context.Context, request *Credentials
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	err = checkRegistration(service.registration, request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	encryptedPassword, err := lib.GenerateArgon2(request.Password, lib.Argon2ParamsDefault)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("encrypt password: %w", err))
@@ -95,22 +119,23 @@ func (service *CredentialsCreate) Exec(ctx context.Context, request *Credentials
 
 	var credentials *dao.Credentials
 
-	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
-		_, err = service.serviceShortCodeConsume.Exec(ctx, &ShortCodeConsumeRequest{
-			Usage:  ShortCodeUsageRegister,
-			Target: request.Email,
-			Code:   request.ShortCode,
-		})
-		if err != nil {
-			return fmt.Errorf("consume short code: %w", err)
-		}
+	err = consumeShortCodeWithinTx(ctx, service.transactor, service.serviceShortCodeConsume, &ShortCodeConsumeRequest{
+		Usage:  ShortCodeUsageRegister,
+		Target: email,
+		Code:   request.ShortCode,
+	}, func(ctx context.Context, _ *ShortCode) error {
+		now := time.Now()
 
+		// The code was redeemed from the address it was sent to, which proves the user controls it.
 		credentials, err = service.dao.Exec(ctx, &dao.CredentialsInsertRequest{
-			ID:       uuid.New(),
-			Email:    request.Email,
-			Password: encryptedPassword,
-			Now:      time.Now(),
-			Role:     config.RoleUser,
+			ID:              uuid.New(),
+			Email:           request.Email,
+			EmailCanonical:  email,
+			Password:        encryptedPassword,
+			Now:             now,
+			Roles:           []string{config.RoleUser},
+			EmailVerifiedAt: &now,
+			Locale:          request.Lang,
 		})
 		if err != nil {
 			return fmt.Errorf("insert credentials: %w", err)
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+
+	"github.com/a-novel/service-json-keys/v2/pkg/go"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+// CredentialsCreateInviteDao provides credential insertion capabilities.
+type CredentialsCreateInviteDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error)
+}
+
+// CredentialsCreateInviteDaoCredentialsSelect loads the inviter, to check it may still
+// grant the invited role.
+type CredentialsCreateInviteDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+// CredentialsCreateInviteServiceShortCodeConsume validates and consumes invitation short codes.
+type CredentialsCreateInviteServiceShortCodeConsume interface {
+	Exec(ctx context.Context, request *ShortCodeConsumeRequest) (*ShortCode, error)
+}
+
+// CredentialsCreateInviteServiceSignClaims provides JWT signing capabilities.
+type CredentialsCreateInviteServiceSignClaims interface {
+	ClaimsSign(
+		ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption,
+	) (*servicejsonkeys.ClaimsSignResponse, error)
+}
+
+// CredentialsCreateInviteRoles ranks the invited role against the inviter's; satisfied by
+// [RoleRegistry].
+type CredentialsCreateInviteRoles interface {
+	Priority(role string) (int, error)
+	Rank(roles []string) (int, error)
+}
+
+// CredentialsCreateInviteRequest contains the data required to accept an invitation.
+type CredentialsCreateInviteRequest struct {
+	// Email is the invited email address.
+	Email string `validate:"required,email,max=1024"`
+	// Password is the plaintext password, hashed with Argon2id before storage.
+	Password string `validate:"required,min=4,max=1024"`
+	// ShortCode is the invitation code sent to the user's email.
+	ShortCode string `validate:"required,max=1024"`
+	// Lang is the language the user wants to receive emails in. Optional.
+	Lang string `validate:"omitempty,langs"`
+}
+
+// CredentialsCreateInvite registers a new user from an invitation issued by
+// [ShortCodeCreateInvite]. It works like [CredentialsCreate], except the account
+// receives the role carried by the invitation instead of the default user role.
+//
+// The inviter's role is checked again on acceptance: an inviter demoted since the
+// invitation was issued cannot grant a role above its new one.
+type CredentialsCreateInvite struct {
+	dao                     CredentialsCreateInviteDao
+	daoCredentialsSelect    CredentialsCreateInviteDaoCredentialsSelect
+	serviceShortCodeConsume CredentialsCreateInviteServiceShortCodeConsume
+	serviceSignClaims       CredentialsCreateInviteServiceSignClaims
+	transactor              transaction.Transactor
+	roles                   CredentialsCreateInviteRoles
+	emails                  config.Emails
+}
+
+func NewCredentialsCreateInvite(
+	dao CredentialsCreateInviteDao,
+	daoCredentialsSelect CredentialsCreateInviteDaoCredentialsSelect,
+	serviceShortCodeConsume CredentialsCreateInviteServiceShortCodeConsume,
+	serviceSignClaims CredentialsCreateInviteServiceSignClaims,
+	transactor transaction.Transactor,
+	roles CredentialsCreateInviteRoles,
+	emails config.Emails,
+) *CredentialsCreateInvite {
+	return &CredentialsCreateInvite{
+		dao:                     dao,
+		daoCredentialsSelect:    daoCredentialsSelect,
+		serviceShortCodeConsume: serviceShortCodeConsume,
+		serviceSignClaims:       serviceSignClaims,
+		transactor:              transactor,
+		roles:                   roles,
+		emails:                  emails,
+	}
+}
+
+// Exec atomically consumes the invitation and creates the account, then returns a
+// fresh access/refresh token pair. If any step fails, the transaction is rolled back:
+// no user is created and the invitation remains usable.
+func (service *CredentialsCreateInvite) Exec(
+	ctx context.Context, request *CredentialsCreateInviteRequest,
+) (*Token, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsCreateInvite")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("email", request.Email),
+		attribute.String("lang", request.Lang),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	encryptedPassword, err := lib.GenerateArgon2(request.Password, lib.Argon2ParamsDefault)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("encrypt password: %w", err))
+	}
+
+	var credentials *dao.Credentials
+
+	err = consumeShortCodeWithinTx(ctx, service.transactor, service.serviceShortCodeConsume, &ShortCodeConsumeRequest{
+		Usage:  ShortCodeUsageInvite,
+		Target: email,
+		Code:   request.ShortCode,
+	}, func(ctx context.Context, shortCode *ShortCode) error {
+		var txErr error
+
+		var invite ShortCodeInviteData
+
+		txErr = json.Unmarshal(shortCode.Data, &invite)
+		if txErr != nil {
+			return fmt.Errorf("unmarshal short code data: %w", txErr)
+		}
+
+		span.SetAttributes(
+			attribute.String("invite.role", invite.Role),
+			attribute.String("invite.inviterID", invite.InviterID.String()),
+		)
+
+		inviter, txErr := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{
+			ID: invite.InviterID,
+		})
+		if txErr != nil {
+			return fmt.Errorf("select inviter credentials: %w", txErr)
+		}
+
+		txErr = checkInviteRole(service.roles, inviter.Roles, invite.Role)
+		if txErr != nil {
+			return txErr
+		}
+
+		now := time.Now()
+
+		// The code was redeemed from the address it was sent to, which proves the user controls it.
+		credentials, txErr = service.dao.Exec(ctx, &dao.CredentialsInsertRequest{
+			ID:              uuid.New(),
+			Email:           request.Email,
+			EmailCanonical:  email,
+			Password:        encryptedPassword,
+			Now:             now,
+			Roles:           []string{invite.Role},
+			EmailVerifiedAt: &now,
+			Locale:          request.Lang,
+		})
+		if txErr != nil {
+			return fmt.Errorf("insert credentials: %w", txErr)
+		}
+
+		span.SetAttributes(attribute.String("credentials.id", credentials.ID.String()))
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	tokens, err := signTokenPair(ctx, service.serviceSignClaims, credentials)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("sign token pair: %w", err))
+	}
+
+	return otel.ReportSuccess(span, tokens), nil
+}
@@ -0,0 +1,284 @@
+package core_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/pkg/go"
+
+	"github.com/a-novel-kit/golib/grpcf"
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestCredentialsCreateInvite(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	inviterID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type serviceShortCodeConsumeMock struct {
+		resp *core.ShortCode
+		err  error
+	}
+
+	type daoCredentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	type daoMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	inviteCode := func(role string) *core.ShortCode {
+		return &core.ShortCode{
+			Usage:  core.ShortCodeUsageInvite,
+			Target: "user@provider.com",
+			Data:   []byte(`{"role":"` + role + `","inviterID":"00000000-0000-0000-0000-000000000001"}`),
+		}
+	}
+
+	created := &dao.Credentials{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Email:           "user@provider.com",
+		Password:        "password-hashed",
+		CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Roles:           []string{config.RoleAdmin},
+	}
+
+	request := &core.CredentialsCreateInviteRequest{
+		Email:     "user@provider.com",
+		Password:  "password",
+		ShortCode: "short-code",
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsCreateInviteRequest
+
+		serviceShortCodeConsumeMock *serviceShortCodeConsumeMock
+		daoCredentialsSelectMock    *daoCredentialsSelectMock
+		daoMock                     *daoMock
+		expectRole                  string
+		signTokens                  bool
+
+		expect    *core.Token
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				resp: inviteCode(config.RoleAdmin),
+			},
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleSuperAdmin}},
+			},
+			daoMock: &daoMock{
+				resp: created,
+			},
+			expectRole: config.RoleAdmin,
+			signTokens: true,
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/Lang",
+
+			request: &core.CredentialsCreateInviteRequest{
+				Email:     "user@provider.com",
+				Password:  "password",
+				ShortCode: "short-code",
+				Lang:      config.LangFR,
+			},
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				resp: inviteCode(config.RoleAdmin),
+			},
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleSuperAdmin}},
+			},
+			daoMock: &daoMock{
+				resp: created,
+			},
+			expectRole: config.RoleAdmin,
+			signTokens: true,
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Error/InviterDemoted",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				resp: inviteCode(config.RoleAdmin),
+			},
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleUser}},
+			},
+
+			expectErr: core.ErrCredentialsUpdateRoleToHigher,
+		},
+		{
+			name: "Error/InviterNotFound",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				resp: inviteCode(config.RoleAdmin),
+			},
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "Error/ConsumeShortCode",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				err: core.ErrShortCodeConsumeInvalid,
+			},
+
+			expectErr: core.ErrShortCodeConsumeInvalid,
+		},
+		{
+			name: "Error/CreateCredentials",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				resp: inviteCode(config.RoleAdmin),
+			},
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleAdmin}},
+			},
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+			expectRole: config.RoleAdmin,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/PasswordTooShort",
+
+			request: &core.CredentialsCreateInviteRequest{
+				Email:     "user@provider.com",
+				Password:  "abc",
+				ShortCode: "short-code",
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockCredentialsCreateInviteDao(t)
+			daoCredentialsSelect := coremocks.NewMockCredentialsCreateInviteDaoCredentialsSelect(t)
+			serviceShortCodeConsume := coremocks.NewMockCredentialsCreateInviteServiceShortCodeConsume(t)
+			serviceSignClaims := coremocks.NewMockCredentialsCreateInviteServiceSignClaims(t)
+
+			if testCase.serviceShortCodeConsumeMock != nil {
+				serviceShortCodeConsume.EXPECT().
+					Exec(mock.Anything, &core.ShortCodeConsumeRequest{
+						Usage:  core.ShortCodeUsageInvite,
+						Target: strings.ToLower(testCase.request.Email),
+						Code:   testCase.request.ShortCode,
+					}).
+					Return(testCase.serviceShortCodeConsumeMock.resp, testCase.serviceShortCodeConsumeMock.err)
+			}
+
+			if testCase.daoCredentialsSelectMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: inviterID}).
+					Return(testCase.daoCredentialsSelectMock.resp, testCase.daoCredentialsSelectMock.err)
+			}
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsInsertRequest) bool {
+						return assert.Equal(t, testCase.request.Email, data.Email) &&
+							assert.Equal(t, strings.ToLower(testCase.request.Email), data.EmailCanonical) &&
+							assert.NotEqual(t, uuid.Nil, data.ID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Minute) &&
+							assert.NoError(t, lib.CompareArgon2(testCase.request.Password, data.Password)) &&
+							assert.Equal(t, []string{testCase.expectRole}, data.Roles) &&
+							assert.Equal(t, testCase.request.Lang, data.Locale) &&
+							assert.NotNil(t, data.EmailVerifiedAt)
+					})).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			if testCase.signTokens {
+				serviceSignClaims.EXPECT().
+					ClaimsSign(mock.Anything, &servicejsonkeys.ClaimsSignRequest{
+						Usage: servicejsonkeys.KeyUsageAuthRefresh,
+						Payload: lo.Must(grpcf.MarshalJSONAsAny(core.RefreshTokenClaimsForm{
+							UserID: testCase.daoMock.resp.ID,
+						})),
+					}).
+					Return(&servicejsonkeys.ClaimsSignResponse{Token: mockUnsignedRefreshToken}, nil)
+
+				serviceSignClaims.EXPECT().
+					ClaimsSign(mock.Anything, &servicejsonkeys.ClaimsSignRequest{
+						Usage: servicejsonkeys.KeyUsageAuth,
+						Payload: lo.Must(grpcf.MarshalJSONAsAny(core.AccessTokenClaims{
+							UserID:         &testCase.daoMock.resp.ID,
+							Roles:          testCase.daoMock.resp.Roles,
+							RefreshTokenID: mockUnsignedJTI,
+							EmailVerified:  true,
+						})),
+					}).
+					Return(&servicejsonkeys.ClaimsSignResponse{Token: "access-token"}, nil)
+			}
+
+			service := core.NewCredentialsCreateInvite(
+				mockDao, daoCredentialsSelect, serviceShortCodeConsume, serviceSignClaims,
+				transactiontest.NewTransactor(), config.PermissionsConfigDefault, config.EmailsPresetDefault,
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
+			serviceShortCodeConsume.AssertExpectations(t)
+			serviceSignClaims.AssertExpectations(t)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/a-novel-kit/golib/otel"
 	"github.com/a-novel-kit/golib/transaction"
@@ -28,6 +29,9 @@ type CredentialsCreateSuperAdminDaoUpdatePassword interface {
 type CredentialsCreateSuperAdminDaoUpdateRole interface {
 	Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)
 }
+type CredentialsCreateSuperAdminDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
 
 // CredentialsCreateSuperAdminRequest carries the email and password of the
 // super-admin account to provision.
@@ -38,13 +42,16 @@ type CredentialsCreateSuperAdminRequest struct {
 
 // CredentialsCreateSuperAdmin idempotently provisions a super-admin account for
 // bootstrap. Given an email and password it creates the account when absent, and
-// otherwise resets that account's password and raises its role to super-admin.
+// otherwise resets that account's password and grants it the super-admin role.
+// Either outcome is recorded in the audit trail, with no actor.
 type CredentialsCreateSuperAdmin struct {
-	dao               CredentialsCreateSuperAdminDao
-	daoSelect         CredentialsCreateSuperAdminDaoSelect
-	daoUpdatePassword CredentialsCreateSuperAdminDaoUpdatePassword
-	daoUpdateRole     CredentialsCreateSuperAdminDaoUpdateRole
-	transactor        transaction.Transactor
+	dao                 CredentialsCreateSuperAdminDao
+	daoSelect           CredentialsCreateSuperAdminDaoSelect
+	daoUpdatePassword   CredentialsCreateSuperAdminDaoUpdatePassword
+	daoUpdateRole       CredentialsCreateSuperAdminDaoUpdateRole
+	daoAuditEventInsert CredentialsCreateSuperAdminDaoAuditEventInsert
+	transactor          transaction.Transactor
+	emails              config.Emails
 }
 
 func NewCredentialsCreateSuperAdmin(
@@ -52,21 +59,25 @@ func NewCredentialsCreateSuperAdmin(
 	daoSelect CredentialsCreateSuperAdminDaoSelect,
 	daoUpdatePassword CredentialsCreateSuperAdminDaoUpdatePassword,
 	daoUpdateRole CredentialsCreateSuperAdminDaoUpdateRole,
+	daoAuditEventInsert CredentialsCreateSuperAdminDaoAuditEventInsert,
 	transactor transaction.Transactor,
+	emails config.Emails,
 ) *CredentialsCreateSuperAdmin {
 	return &CredentialsCreateSuperAdmin{
-		dao:               dao,
-		daoSelect:         daoSelect,
-		daoUpdatePassword: daoUpdatePassword,
-		daoUpdateRole:     daoUpdateRole,
-		transactor:        transactor,
+		dao:                 dao,
+		daoSelect:           daoSelect,
+		daoUpdatePassword:   daoUpdatePassword,
+		daoUpdateRole:       daoUpdateRole,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+		emails:              emails,
 	}
 }
 
 // Exec provisions the super-admin account described by the request, running the
 // lookup and the create-or-update in one transaction. The password is hashed with
 // Argon2id before storage. An existing account keeps its identity and creation
-// time; only its password and, when needed, its role are updated.
+// time and roles; only its password is updated, and the super-admin role granted when missing.
 func (service *CredentialsCreateSuperAdmin) Exec(
 	ctx context.Context, request *CredentialsCreateSuperAdminRequest,
 ) (*Credentials, error) {
@@ -78,6 +89,11 @@ func (service *CredentialsCreateSuperAdmin) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	encryptedPassword, err := lib.GenerateArgon2(request.Password, lib.Argon2ParamsDefault)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("encrypt password: %w", err))
@@ -89,27 +105,34 @@ func (service *CredentialsCreateSuperAdmin) Exec(
 		now := time.Now()
 
 		credentials, err = service.daoSelect.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
-			Email: request.Email,
+			Email: email,
 		})
 		if errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) {
 			credentials, err = service.dao.Exec(ctx, &dao.CredentialsInsertRequest{
-				ID:       uuid.New(),
-				Email:    request.Email,
-				Password: encryptedPassword,
-				Now:      now,
-				Role:     config.RoleSuperAdmin,
+				ID:             uuid.New(),
+				Email:          request.Email,
+				EmailCanonical: email,
+				Password:       encryptedPassword,
+				Now:            now,
+				Roles:          []string{config.RoleSuperAdmin},
 			})
 			if err != nil {
 				return fmt.Errorf("insert credentials: %w", err)
 			}
 
-			return nil
+			return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+				TargetID: &credentials.ID,
+				Action:   AuditActionCredentialsSuperAdminCreate,
+				After:    &auditCredentialsState{Roles: credentials.Roles},
+			})
 		}
 		// The not-found branch returned above, so anything left is a real lookup failure.
 		if err != nil {
 			return err
 		}
 
+		before := &auditCredentialsState{Roles: credentials.Roles}
+
 		credentials, err = service.daoUpdatePassword.Exec(ctx, &dao.CredentialsUpdatePasswordRequest{
 			ID:       credentials.ID,
 			Password: encryptedPassword,
@@ -119,28 +142,37 @@ func (service *CredentialsCreateSuperAdmin) Exec(
 			return fmt.Errorf("update password: %w", err)
 		}
 
-		if credentials.Role != config.RoleSuperAdmin {
+		if !lo.Contains(credentials.Roles, config.RoleSuperAdmin) {
 			credentials, err = service.daoUpdateRole.Exec(ctx, &dao.CredentialsUpdateRoleRequest{
-				ID:   credentials.ID,
-				Role: config.RoleSuperAdmin,
-				Now:  now,
+				ID:  credentials.ID,
+				Add: []string{config.RoleSuperAdmin},
+				Now: now,
 			})
 			if err != nil {
 				return fmt.Errorf("update role: %w", err)
 			}
 		}
 
-		return nil
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			TargetID: &credentials.ID,
+			Action:   AuditActionCredentialsSuperAdminUpdate,
+			Before:   before,
+			After:    &auditCredentialsState{Roles: credentials.Roles},
+		})
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
 	}
 
 	return otel.ReportSuccess(span, &Credentials{
-		ID:        credentials.ID,
-		Email:     credentials.Email,
-		Role:      credentials.Role,
-		CreatedAt: credentials.CreatedAt,
-		UpdatedAt: credentials.UpdatedAt,
+		ID:              credentials.ID,
+		Email:           credentials.Email,
+		Roles:           credentials.Roles,
+		EmailVerifiedAt: credentials.EmailVerifiedAt,
+		Locale:          credentials.Locale,
+		NoticesOptOut:   credentials.NoticesOptOut,
+		LastLoginAt:     credentials.LastLoginAt,
+		CreatedAt:       credentials.CreatedAt,
+		UpdatedAt:       credentials.UpdatedAt,
 	}), nil
 }
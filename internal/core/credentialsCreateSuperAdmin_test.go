@@ -3,6 +3,7 @@ package core_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -47,6 +48,14 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 		err  error
 	}
 
+	type daoAuditEventInsertMock struct {
+		action string
+		// before is the expected JSON state before the action, empty when there is none.
+		before string
+		after  string
+		err    error
+	}
+
 	testCases := []struct {
 		name string
 
@@ -57,6 +66,8 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 		daoUpdatePasswordMock *daoUpdatePasswordMock
 		daoUpdateRoleMock     *daoUpdateRoleMock
 
+		daoAuditEventInsertMock *daoAuditEventInsertMock
+
 		expect    *core.Credentials
 		expectErr error
 	}{
@@ -76,16 +87,21 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "Louvre",
-					Role:      config.RoleSuperAdmin,
+					Roles:     []string{config.RoleSuperAdmin},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
+			daoAuditEventInsertMock: &daoAuditEventInsertMock{
+				action: core.AuditActionCredentialsSuperAdminCreate,
+				after:  `{"roles":["auth:superadmin"]}`,
+			},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "superadmin@provider.com",
-				Role:      config.RoleSuperAdmin,
+				Roles:     []string{config.RoleSuperAdmin},
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
@@ -103,7 +119,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleSuperAdmin,
+					Roles:     []string{config.RoleSuperAdmin},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -113,16 +129,22 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleSuperAdmin,
+					Roles:     []string{config.RoleSuperAdmin},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
+			daoAuditEventInsertMock: &daoAuditEventInsertMock{
+				action: core.AuditActionCredentialsSuperAdminUpdate,
+				before: `{"roles":["auth:superadmin"]}`,
+				after:  `{"roles":["auth:superadmin"]}`,
+			},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "superadmin@provider.com",
-				Role:      config.RoleSuperAdmin,
+				Roles:     []string{config.RoleSuperAdmin},
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
@@ -140,7 +162,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -150,7 +172,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -160,16 +182,22 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleSuperAdmin,
+					Roles:     []string{config.RoleSuperAdmin, config.RoleUser},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
+			daoAuditEventInsertMock: &daoAuditEventInsertMock{
+				action: core.AuditActionCredentialsSuperAdminUpdate,
+				before: `{"roles":["auth:user"]}`,
+				after:  `{"roles":["auth:superadmin","auth:user"]}`,
+			},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "superadmin@provider.com",
-				Role:      config.RoleSuperAdmin,
+				Roles:     []string{config.RoleSuperAdmin, config.RoleUser},
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
@@ -219,7 +247,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleSuperAdmin,
+					Roles:     []string{config.RoleSuperAdmin},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -243,7 +271,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -253,7 +281,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcdef",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -264,6 +292,31 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 
 			expectErr: errFoo,
 		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.CredentialsCreateSuperAdminRequest{
+				Email:    "superadmin@provider.com",
+				Password: "Louvre",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles: []string{config.RoleSuperAdmin},
+				},
+			},
+			daoAuditEventInsertMock: &daoAuditEventInsertMock{
+				action: core.AuditActionCredentialsSuperAdminCreate,
+				after:  `{"roles":["auth:superadmin"]}`,
+				err:    errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "Error/PasswordTooShort",
 
@@ -290,16 +343,21 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "superadmin@provider.com",
 					Password:  "abcd",
-					Role:      config.RoleSuperAdmin,
+					Roles:     []string{config.RoleSuperAdmin},
 					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
+			daoAuditEventInsertMock: &daoAuditEventInsertMock{
+				action: core.AuditActionCredentialsSuperAdminCreate,
+				after:  `{"roles":["auth:superadmin"]}`,
+			},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "superadmin@provider.com",
-				Role:      config.RoleSuperAdmin,
+				Roles:     []string{config.RoleSuperAdmin},
 				CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
@@ -317,22 +375,24 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 				daoSelect := coremocks.NewMockCredentialsCreateSuperAdminDaoSelect(t)
 				daoUpdatePassword := coremocks.NewMockCredentialsCreateSuperAdminDaoUpdatePassword(t)
 				daoUpdateRole := coremocks.NewMockCredentialsCreateSuperAdminDaoUpdateRole(t)
+				daoAuditEventInsert := coremocks.NewMockCredentialsCreateSuperAdminDaoAuditEventInsert(t)
 
 				if testCase.daoMock != nil {
 					mockDao.EXPECT().
 						Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsInsertRequest) bool {
 							return assert.Equal(t, testCase.request.Email, data.Email) &&
+								assert.Equal(t, strings.ToLower(testCase.request.Email), data.EmailCanonical) &&
 								assert.NotEqual(t, uuid.Nil, data.ID) &&
 								assert.WithinDuration(t, time.Now(), data.Now, time.Minute) &&
 								assert.NoError(t, lib.CompareArgon2(testCase.request.Password, data.Password)) &&
-								assert.Equal(t, config.RoleSuperAdmin, data.Role)
+								assert.Equal(t, []string{config.RoleSuperAdmin}, data.Roles)
 						})).
 						Return(testCase.daoMock.resp, testCase.daoMock.err)
 				}
 
 				if testCase.daoSelectMock != nil {
 					daoSelect.EXPECT().
-						Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{Email: testCase.request.Email}).
+						Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{Email: strings.ToLower(testCase.request.Email)}).
 						Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
 				}
 
@@ -351,13 +411,28 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 						Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsUpdateRoleRequest) bool {
 							return assert.Equal(t, testCase.daoSelectMock.resp.ID, data.ID) &&
 								assert.WithinDuration(t, time.Now(), data.Now, time.Minute) &&
-								assert.Equal(t, config.RoleSuperAdmin, data.Role)
+								assert.Equal(t, []string{config.RoleSuperAdmin}, data.Add) &&
+								assert.Empty(t, data.Remove)
 						})).
 						Return(testCase.daoUpdateRoleMock.resp, testCase.daoUpdateRoleMock.err)
 				}
 
+				if testCase.daoAuditEventInsertMock != nil {
+					daoAuditEventInsert.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+							return assert.Equal(t, testCase.daoAuditEventInsertMock.action, data.Action) &&
+								assert.Nil(t, data.ActorID) &&
+								assert.Equal(t, uuid.MustParse("00000000-0000-0000-0000-000000000001"), *data.TargetID) &&
+								assert.Equal(t, testCase.daoAuditEventInsertMock.before, string(data.Before)) &&
+								assert.Equal(t, testCase.daoAuditEventInsertMock.after, string(data.After)) &&
+								assert.WithinDuration(t, time.Now(), data.Now, time.Minute)
+						})).
+						Return(&dao.AuditEvent{}, testCase.daoAuditEventInsertMock.err)
+				}
+
 				service := core.NewCredentialsCreateSuperAdmin(
-					mockDao, daoSelect, daoUpdatePassword, daoUpdateRole, transactiontest.NewTransactor(),
+					mockDao, daoSelect, daoUpdatePassword, daoUpdateRole, daoAuditEventInsert,
+					transactiontest.NewTransactor(), config.EmailsPresetDefault,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -368,6 +443,7 @@ func TestCredentialsCreateSuperAdmin(t *testing.T) {
 				daoSelect.AssertExpectations(t)
 				daoUpdatePassword.AssertExpectations(t)
 				daoUpdateRole.AssertExpectations(t)
+				daoAuditEventInsert.AssertExpectations(t)
 			})
 		})
 	}
@@ -3,6 +3,7 @@ package core_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,7 +53,8 @@ func TestCredentialsCreateRequest(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		request *core.CredentialsCreateRequest
+		request      *core.CredentialsCreateRequest
+		registration config.Registration
 
 		daoMock                     *daoMock
 		issueTokenMock              *issueTokenMock
@@ -80,7 +82,42 @@ func TestCredentialsCreateRequest(t *testing.T) {
 					Password:  "password-2-hashed",
 					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
+				},
+			},
+
+			serviceSignClaimsMock: &serviceSignClaimsMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/EmailCase",
+
+			request: &core.CredentialsCreateRequest{
+				Email:     "User@Provider.com",
+				Password:  "password-2",
+				ShortCode: "short-code",
+			},
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:     "User@Provider.com",
+					Password:  "password-2-hashed",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					Roles:     []string{config.RoleUser},
 				},
 			},
 
@@ -147,7 +184,7 @@ func TestCredentialsCreateRequest(t *testing.T) {
 					Password:  "password-2-hashed",
 					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 				},
 			},
 
@@ -177,7 +214,7 @@ func TestCredentialsCreateRequest(t *testing.T) {
 					Password:  "password-2-hashed",
 					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 				},
 			},
 
@@ -187,6 +224,33 @@ func TestCredentialsCreateRequest(t *testing.T) {
 
 			expectErr: errFoo,
 		},
+		{
+			name: "Error/RegistrationClosed",
+
+			request: &core.CredentialsCreateRequest{
+				Email:     "user@provider.com",
+				Password:  "password-2",
+				ShortCode: "short-code",
+			},
+			registration: config.Registration{Mode: config.RegistrationModeClosed},
+
+			expectErr: core.ErrRegistrationClosed,
+		},
+		{
+			name: "Error/RegistrationDomainNotAllowed",
+
+			request: &core.CredentialsCreateRequest{
+				Email:     "user@provider.com",
+				Password:  "password-2",
+				ShortCode: "short-code",
+			},
+			registration: config.Registration{
+				Mode:           config.RegistrationModeDomainAllowlist,
+				AllowedDomains: []string{"company.com"},
+			},
+
+			expectErr: core.ErrRegistrationDomainNotAllowed,
+		},
 		{
 			name: "Error/PasswordTooShort",
 
@@ -216,7 +280,7 @@ func TestCredentialsCreateRequest(t *testing.T) {
 					Password:  "abcd-hashed",
 					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 				},
 			},
 
@@ -250,7 +314,7 @@ func TestCredentialsCreateRequest(t *testing.T) {
 					serviceShortCodeConsume.EXPECT().
 						Exec(mock.Anything, &core.ShortCodeConsumeRequest{
 							Usage:  core.ShortCodeUsageRegister,
-							Target: testCase.request.Email,
+							Target: strings.ToLower(testCase.request.Email),
 							Code:   testCase.request.ShortCode,
 						}).
 						Return(nil, testCase.serviceShortCodeConsumeMock.err)
@@ -260,10 +324,13 @@ func TestCredentialsCreateRequest(t *testing.T) {
 					mockDao.EXPECT().
 						Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsInsertRequest) bool {
 							return assert.Equal(t, testCase.request.Email, data.Email) &&
+								assert.Equal(t, strings.ToLower(testCase.request.Email), data.EmailCanonical) &&
 								assert.NotEqual(t, uuid.Nil, data.ID) &&
 								assert.WithinDuration(t, time.Now(), data.Now, time.Minute) &&
 								assert.NoError(t, lib.CompareArgon2(testCase.request.Password, data.Password)) &&
-								assert.Equal(t, config.RoleUser, data.Role)
+								assert.Equal(t, []string{config.RoleUser}, data.Roles) &&
+								assert.Equal(t, testCase.request.Lang, data.Locale) &&
+								assert.NotNil(t, data.EmailVerifiedAt)
 						})).
 						Return(
 							testCase.daoMock.resp,
@@ -293,7 +360,7 @@ func TestCredentialsCreateRequest(t *testing.T) {
 							Usage: servicejsonkeys.KeyUsageAuth,
 							Payload: lo.Must(grpcf.MarshalJSONAsAny(core.AccessTokenClaims{
 								UserID:         &testCase.daoMock.resp.ID,
-								Roles:          []string{testCase.daoMock.resp.Role},
+								Roles:          testCase.daoMock.resp.Roles,
 								RefreshTokenID: mockUnsignedJTI,
 							})),
 						}).
@@ -302,6 +369,7 @@ func TestCredentialsCreateRequest(t *testing.T) {
 
 				service := core.NewCredentialsCreate(
 					mockDao, serviceShortCodeConsume, serviceSignClaims, transactiontest.NewTransactor(),
+					testCase.registration, config.EmailsPresetDefault,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -331,7 +399,9 @@ func TestCredentialsCreateIsAtomic(t *testing.T) {
 
 	transactor := transactiontest.NewFailingTransactor(errNoTransaction)
 
-	service := core.NewCredentialsCreate(mockDao, serviceShortCodeConsume, serviceSignClaims, transactor)
+	service := core.NewCredentialsCreate(
+		mockDao, serviceShortCodeConsume, serviceSignClaims, transactor, config.Registration{}, config.EmailsPresetDefault,
+	)
 
 	resp, err := service.Exec(t.Context(), &core.CredentialsCreateRequest{
 		Email:     "user@provider.com",
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// CredentialsEvent is an account change other services act on, such as a merge.
+type CredentialsEvent struct {
+	ID uuid.UUID
+	// Seq is the position of the event in the outbox. Consumers store the last one they handled,
+	// and resume after it.
+	Seq int64
+	// Kind is one of the dao.CredentialsEventKind* constants.
+	Kind string
+	// UserID is the account the event is about. For a merge, the destination.
+	UserID uuid.UUID
+	// SourceID is, for a merge, the deleted account whose data moves to UserID.
+	SourceID  *uuid.UUID
+	CreatedAt time.Time
+}
+
+func loadCredentialsEvent(item *dao.CredentialsEvent, _ int) *CredentialsEvent {
+	return &CredentialsEvent{
+		ID:        item.ID,
+		Seq:       item.Seq,
+		Kind:      item.Kind,
+		UserID:    item.UserID,
+		SourceID:  item.SourceID,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+type CredentialsEventListDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsEventListRequest) ([]*dao.CredentialsEvent, error)
+}
+
+type CredentialsEventListRequest struct {
+	// AfterSeq, if set, resumes the listing right after the event at that position.
+	AfterSeq *int64 `validate:"omitempty,min=0"`
+	Limit    int    `validate:"required,min=1,max=100"`
+}
+
+// CredentialsEventList returns the outbox of account changes, oldest first.
+//
+// Events are written in the transaction of the change they describe, so a consumer that reads
+// them in order, and resumes after the last Seq it handled, sees every change exactly once.
+type CredentialsEventList struct {
+	dao CredentialsEventListDao
+}
+
+func NewCredentialsEventList(dao CredentialsEventListDao) *CredentialsEventList {
+	return &CredentialsEventList{
+		dao: dao,
+	}
+}
+
+func (service *CredentialsEventList) Exec(
+	ctx context.Context, request *CredentialsEventListRequest,
+) ([]*CredentialsEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsEventList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Bool("request.afterSeq", request.AfterSeq != nil),
+		attribute.Int("request.limit", request.Limit),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	entities, err := service.dao.Exec(ctx, &dao.CredentialsEventListRequest{
+		Limit:    request.Limit,
+		AfterSeq: request.AfterSeq,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list credentials events: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("response.count", len(entities)))
+
+	return otel.ReportSuccess(span, lo.Map(entities, loadCredentialsEvent)), nil
+}
@@ -0,0 +1,148 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsEventList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		resp []*dao.CredentialsEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsEventListRequest
+
+		daoMock *daoMock
+
+		expect    []*core.CredentialsEvent
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.CredentialsEventListRequest{
+				AfterSeq: lo.ToPtr[int64](3),
+				Limit:    10,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.CredentialsEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Seq:       4,
+						Kind:      dao.CredentialsEventKindMerge,
+						UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						SourceID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*core.CredentialsEvent{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					Seq:       4,
+					Kind:      dao.CredentialsEventKindMerge,
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					SourceID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &core.CredentialsEventListRequest{
+				Limit: 10,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.CredentialsEvent{},
+			},
+
+			expect: []*core.CredentialsEvent{},
+		},
+		{
+			name: "Error/DAO",
+
+			request: &core.CredentialsEventListRequest{
+				Limit: 10,
+			},
+
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/LimitTooHigh",
+
+			request: &core.CredentialsEventListRequest{
+				Limit: 101,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NoLimit",
+
+			request: &core.CredentialsEventListRequest{},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NegativeAfterSeq",
+
+			request: &core.CredentialsEventListRequest{
+				AfterSeq: lo.ToPtr[int64](-1),
+				Limit:    10,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockCredentialsEventListDao(t)
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsEventListRequest{
+						Limit:    testCase.request.Limit,
+						AfterSeq: testCase.request.AfterSeq,
+					}).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			service := core.NewCredentialsEventList(mockDao)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
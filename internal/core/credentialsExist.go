@@ -9,6 +9,7 @@ import (
 
 	"github.com/a-novel-kit/golib/otel"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 )
 
@@ -23,12 +24,14 @@ type CredentialsExistRequest struct {
 // CredentialsExist reports whether an account is already registered for a given
 // email, letting callers reject duplicate registrations before doing further work.
 type CredentialsExist struct {
-	dao CredentialsExistDao
+	dao    CredentialsExistDao
+	emails config.Emails
 }
 
-func NewCredentialsExist(dao CredentialsExistDao) *CredentialsExist {
+func NewCredentialsExist(dao CredentialsExistDao, emails config.Emails) *CredentialsExist {
 	return &CredentialsExist{
-		dao: dao,
+		dao:    dao,
+		emails: emails,
 	}
 }
 
@@ -41,8 +44,13 @@ func (service *CredentialsExist) Exec(ctx context.Context, request *CredentialsE
 		return false, errors.Join(err, ErrInvalidRequest)
 	}
 
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return false, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	exists, err := service.dao.Exec(ctx, &dao.CredentialsExistRequest{
-		Email: request.Email,
+		Email: email,
 	})
 	if err != nil {
 		return false, otel.ReportError(span, fmt.Errorf("check email existence: %w", err))
@@ -2,11 +2,13 @@ package core_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
@@ -69,12 +71,12 @@ func TestCredentialsExist(t *testing.T) {
 			if testCase.daoMock != nil {
 				mockDao.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsExistRequest{
-						Email: testCase.request.Email,
+						Email: strings.ToLower(testCase.request.Email),
 					}).
 					Return(testCase.daoMock.resp, testCase.daoMock.err)
 			}
 
-			service := core.NewCredentialsExist(mockDao)
+			service := core.NewCredentialsExist(mockDao, config.EmailsPresetDefault)
 
 			resp, err := service.Exec(t.Context(), testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type CredentialsExportDaoCredentials interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+type CredentialsExportDaoShortCodes interface {
+	Exec(ctx context.Context, request *dao.ShortCodeListByTargetsRequest) ([]*dao.ShortCode, error)
+}
+
+type CredentialsExportDaoLoginEvents interface {
+	Exec(ctx context.Context, request *dao.LoginEventListRequest) ([]*dao.LoginEvent, error)
+}
+
+type CredentialsExportDaoAuditEvents interface {
+	Exec(ctx context.Context, request *dao.AuditEventListByUserRequest) ([]*dao.AuditEvent, error)
+}
+
+type CredentialsExportDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type CredentialsExportRequest struct {
+	ID uuid.UUID `validate:"required"`
+	// CurrentUserID and RequestID identify the export in the audit trail, when the caller
+	// exports another account.
+	CurrentUserID uuid.UUID
+	RequestID     string
+}
+
+// PersonalDataShortCode is the exported view of a short code issued for an account.
+// The code hash is never exported; everything else, including the reason a code was
+// deleted early, is.
+type PersonalDataShortCode struct {
+	ID     uuid.UUID
+	Usage  string
+	Target string
+	// Data is the raw payload stored with the code (for example, the new email of an
+	// email-change request).
+	Data []byte
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	DeletedAt      *time.Time
+	DeletedComment *string
+}
+
+// PersonalData bundles every record the service holds about a single account.
+type PersonalData struct {
+	Credentials *Credentials
+	// ShortCodes lists every code issued to the account, whether addressed to its
+	// current email or to its ID, newest first.
+	ShortCodes []*PersonalDataShortCode
+	// LoginEvents is the whole login history of the account, newest first.
+	LoginEvents []*LoginEvent
+	// AuditEvents lists the administrative actions the account performed, or that applied to
+	// it, newest first.
+	AuditEvents []*AuditEvent
+}
+
+// CredentialsExport assembles the personal data held about an account, to answer
+// subject-access requests.
+//
+// Sessions are not persisted: tokens are signed and verified statelessly, so there is
+// nothing to export beyond the records above.
+//
+// An export of another account than the caller's is recorded in the audit trail, like
+// [CredentialsGet].
+type CredentialsExport struct {
+	daoCredentials      CredentialsExportDaoCredentials
+	daoShortCodes       CredentialsExportDaoShortCodes
+	daoLoginEvents      CredentialsExportDaoLoginEvents
+	daoAuditEvents      CredentialsExportDaoAuditEvents
+	daoAuditEventInsert CredentialsExportDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewCredentialsExport(
+	daoCredentials CredentialsExportDaoCredentials,
+	daoShortCodes CredentialsExportDaoShortCodes,
+	daoLoginEvents CredentialsExportDaoLoginEvents,
+	daoAuditEvents CredentialsExportDaoAuditEvents,
+	daoAuditEventInsert CredentialsExportDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *CredentialsExport {
+	return &CredentialsExport{
+		daoCredentials:      daoCredentials,
+		daoShortCodes:       daoShortCodes,
+		daoLoginEvents:      daoLoginEvents,
+		daoAuditEvents:      daoAuditEvents,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+func (service *CredentialsExport) Exec(
+	ctx context.Context, request *CredentialsExportRequest,
+) (*PersonalData, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsExport")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", request.ID.String()))
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var (
+		credentials *dao.Credentials
+		shortCodes  []*dao.ShortCode
+		loginEvents []*dao.LoginEvent
+		auditEvents []*dao.AuditEvent
+	)
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		credentials, err = service.daoCredentials.Exec(ctx, &dao.CredentialsSelectRequest{ID: request.ID})
+		if err != nil {
+			return fmt.Errorf("select credentials: %w", err)
+		}
+
+		// Registration codes are addressed to the email, the other flows to the user ID. Codes
+		// issued before emails were canonicalized are addressed to the email as typed.
+		shortCodes, err = service.daoShortCodes.Exec(ctx, &dao.ShortCodeListByTargetsRequest{
+			Targets: lo.Uniq([]string{credentials.Email, credentials.EmailCanonical, credentials.ID.String()}),
+		})
+		if err != nil {
+			return fmt.Errorf("list short codes: %w", err)
+		}
+
+		// No limit: the export covers the whole history.
+		loginEvents, err = service.daoLoginEvents.Exec(ctx, &dao.LoginEventListRequest{UserID: credentials.ID})
+		if err != nil {
+			return fmt.Errorf("list login events: %w", err)
+		}
+
+		// Listed before the export records its own event: the bundle describes the account as it
+		// was when requested.
+		auditEvents, err = service.daoAuditEvents.Exec(ctx, &dao.AuditEventListByUserRequest{UserID: credentials.ID})
+		if err != nil {
+			return fmt.Errorf("list audit events: %w", err)
+		}
+
+		// Users exporting their own data are not administrators at work.
+		if request.CurrentUserID == request.ID {
+			return nil
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			TargetID:  &credentials.ID,
+			Action:    AuditActionCredentialsExport,
+			RequestID: request.RequestID,
+			Read:      true,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.Int("response.shortCodes.count", len(shortCodes)),
+		attribute.Int("response.loginEvents.count", len(loginEvents)),
+		attribute.Int("response.auditEvents.count", len(auditEvents)),
+	)
+
+	return otel.ReportSuccess(span, &PersonalData{
+		Credentials: &Credentials{
+			ID:              credentials.ID,
+			Email:           credentials.Email,
+			Roles:           credentials.Roles,
+			EmailVerifiedAt: credentials.EmailVerifiedAt,
+			Locale:          credentials.Locale,
+			NoticesOptOut:   credentials.NoticesOptOut,
+			LastLoginAt:     credentials.LastLoginAt,
+			CreatedAt:       credentials.CreatedAt,
+			UpdatedAt:       credentials.UpdatedAt,
+		},
+		ShortCodes: lo.Map(shortCodes, func(item *dao.ShortCode, _ int) *PersonalDataShortCode {
+			return &PersonalDataShortCode{
+				ID:             item.ID,
+				Usage:          item.Usage,
+				Target:         item.Target,
+				Data:           item.Data,
+				CreatedAt:      item.CreatedAt,
+				ExpiresAt:      item.ExpiresAt,
+				DeletedAt:      item.DeletedAt,
+				DeletedComment: item.DeletedComment,
+			}
+		}),
+		LoginEvents: lo.Map(loginEvents, loadLoginEvent),
+		AuditEvents: lo.Map(auditEvents, loadAuditEvent),
+	}), nil
+}
@@ -0,0 +1,458 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsExport(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type credentialsMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	type shortCodesMock struct {
+		resp []*dao.ShortCode
+		err  error
+	}
+
+	type loginEventsMock struct {
+		resp []*dao.LoginEvent
+		err  error
+	}
+
+	type auditEventsMock struct {
+		resp []*dao.AuditEvent
+		err  error
+	}
+
+	type auditEventInsertMock struct {
+		err error
+	}
+
+	credentials := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "User@Email.com",
+		EmailCanonical: "user@email.com",
+		Password:       "password-hash",
+		Roles:          []string{config.RoleUser},
+		CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsExportRequest
+
+		credentialsMock *credentialsMock
+		shortCodesMock  *shortCodesMock
+		loginEventsMock *loginEventsMock
+		auditEventsMock *auditEventsMock
+		// auditEventInsertMock is set when the caller exports another account.
+		auditEventInsertMock *auditEventInsertMock
+
+		expect    *core.PersonalData
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				resp: []*dao.ShortCode{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Usage:     core.ShortCodeUsageResetPassword,
+						Target:    "00000000-0000-0000-0000-000000000001",
+						CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+						ExpiresAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:             uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+						Usage:          core.ShortCodeUsageRegister,
+						Target:         "user@email.com",
+						CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						ExpiresAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						DeletedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+						DeletedComment: lo.ToPtr(dao.ShortCodeDeleteConsumed),
+					},
+				},
+			},
+
+			loginEventsMock: &loginEventsMock{
+				resp: []*dao.LoginEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000021"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Email:     "User@Email.com",
+						Kind:      dao.LoginEventKindLogin,
+						Outcome:   dao.LoginEventOutcomeSuccess,
+						IP:        "192.0.2.1",
+						UserAgent: "Mozilla/5.0",
+						CreatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			auditEventsMock: &auditEventsMock{
+				resp: []*dao.AuditEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000031"),
+						Seq:       2,
+						ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+						TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Action:    core.AuditActionCredentialsUpdateRole,
+						Before:    []byte(`{"roles":["auth:user"]}`),
+						After:     []byte(`{"roles":["auth:admin"]}`),
+						RequestID: "request-1",
+						CreatedAt: time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC),
+						Hash:      []byte("hash"),
+					},
+				},
+			},
+
+			expect: &core.PersonalData{
+				Credentials: &core.Credentials{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "User@Email.com",
+					Roles:     []string{config.RoleUser},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				ShortCodes: []*core.PersonalDataShortCode{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Usage:     core.ShortCodeUsageResetPassword,
+						Target:    "00000000-0000-0000-0000-000000000001",
+						CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+						ExpiresAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:             uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+						Usage:          core.ShortCodeUsageRegister,
+						Target:         "user@email.com",
+						CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						ExpiresAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						DeletedAt:      lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+						DeletedComment: lo.ToPtr(dao.ShortCodeDeleteConsumed),
+					},
+				},
+				LoginEvents: []*core.LoginEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000021"),
+						Email:     "User@Email.com",
+						Kind:      dao.LoginEventKindLogin,
+						Outcome:   dao.LoginEventOutcomeSuccess,
+						IP:        "192.0.2.1",
+						UserAgent: "Mozilla/5.0",
+						CreatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				AuditEvents: []*core.AuditEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000031"),
+						Seq:       2,
+						ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+						TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Action:    core.AuditActionCredentialsUpdateRole,
+						Before:    []byte(`{"roles":["auth:user"]}`),
+						After:     []byte(`{"roles":["auth:admin"]}`),
+						RequestID: "request-1",
+						CreatedAt: time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC),
+						Hash:      []byte("hash"),
+					},
+				},
+			},
+		},
+		{
+			name: "Success/NoShortCodes",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				resp: []*dao.ShortCode{},
+			},
+
+			loginEventsMock: &loginEventsMock{
+				resp: []*dao.LoginEvent{},
+			},
+
+			auditEventsMock: &auditEventsMock{
+				resp: []*dao.AuditEvent{},
+			},
+
+			expect: &core.PersonalData{
+				Credentials: &core.Credentials{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "User@Email.com",
+					Roles:     []string{config.RoleUser},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				ShortCodes:  []*core.PersonalDataShortCode{},
+				LoginEvents: []*core.LoginEvent{},
+				AuditEvents: []*core.AuditEvent{},
+			},
+		},
+		{
+			name: "Success/OtherUser",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				RequestID:     "request-1",
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				resp: []*dao.ShortCode{},
+			},
+
+			loginEventsMock: &loginEventsMock{
+				resp: []*dao.LoginEvent{},
+			},
+
+			auditEventsMock: &auditEventsMock{
+				resp: []*dao.AuditEvent{},
+			},
+
+			auditEventInsertMock: &auditEventInsertMock{},
+
+			expect: &core.PersonalData{
+				Credentials: &core.Credentials{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "User@Email.com",
+					Roles:     []string{config.RoleUser},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				ShortCodes:  []*core.PersonalDataShortCode{},
+				LoginEvents: []*core.LoginEvent{},
+				AuditEvents: []*core.AuditEvent{},
+			},
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				resp: []*dao.ShortCode{},
+			},
+
+			loginEventsMock: &loginEventsMock{
+				resp: []*dao.LoginEvent{},
+			},
+
+			auditEventsMock: &auditEventsMock{
+				resp: []*dao.AuditEvent{},
+			},
+
+			auditEventInsertMock: &auditEventInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SelectCredentials",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			credentialsMock: &credentialsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ListShortCodes",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ListLoginEvents",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				resp: []*dao.ShortCode{},
+			},
+
+			loginEventsMock: &loginEventsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ListAuditEvents",
+
+			request: &core.CredentialsExportRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			credentialsMock: &credentialsMock{
+				resp: credentials,
+			},
+
+			shortCodesMock: &shortCodesMock{
+				resp: []*dao.ShortCode{},
+			},
+
+			loginEventsMock: &loginEventsMock{
+				resp: []*dao.LoginEvent{},
+			},
+
+			auditEventsMock: &auditEventsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &core.CredentialsExportRequest{},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			daoCredentials := coremocks.NewMockCredentialsExportDaoCredentials(t)
+			daoShortCodes := coremocks.NewMockCredentialsExportDaoShortCodes(t)
+			daoLoginEvents := coremocks.NewMockCredentialsExportDaoLoginEvents(t)
+			daoAuditEvents := coremocks.NewMockCredentialsExportDaoAuditEvents(t)
+			daoAuditEventInsert := coremocks.NewMockCredentialsExportDaoAuditEventInsert(t)
+
+			if testCase.credentialsMock != nil {
+				daoCredentials.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.ID}).
+					Return(testCase.credentialsMock.resp, testCase.credentialsMock.err)
+			}
+
+			if testCase.shortCodesMock != nil {
+				daoShortCodes.EXPECT().
+					Exec(mock.Anything, &dao.ShortCodeListByTargetsRequest{
+						Targets: []string{credentials.Email, credentials.EmailCanonical, credentials.ID.String()},
+					}).
+					Return(testCase.shortCodesMock.resp, testCase.shortCodesMock.err)
+			}
+
+			if testCase.loginEventsMock != nil {
+				daoLoginEvents.EXPECT().
+					Exec(mock.Anything, &dao.LoginEventListRequest{UserID: credentials.ID}).
+					Return(testCase.loginEventsMock.resp, testCase.loginEventsMock.err)
+			}
+
+			if testCase.auditEventsMock != nil {
+				daoAuditEvents.EXPECT().
+					Exec(mock.Anything, &dao.AuditEventListByUserRequest{UserID: credentials.ID}).
+					Return(testCase.auditEventsMock.resp, testCase.auditEventsMock.err)
+			}
+
+			if testCase.auditEventInsertMock != nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						return assert.Equal(t, core.AuditActionCredentialsExport, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Equal(t, &testCase.request.ID, data.TargetID) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID) &&
+							assert.True(t, data.Unchained)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertMock.err)
+			}
+
+			service := core.NewCredentialsExport(
+				daoCredentials,
+				daoShortCodes,
+				daoLoginEvents,
+				daoAuditEvents,
+				daoAuditEventInsert,
+				transactiontest.NewTransactor(),
+			)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoCredentials.AssertExpectations(t)
+			daoShortCodes.AssertExpectations(t)
+			daoLoginEvents.AssertExpectations(t)
+			daoAuditEvents.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
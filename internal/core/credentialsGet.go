@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
 
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 )
@@ -16,18 +17,34 @@ type CredentialsGetDao interface {
 	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
 }
 
+type CredentialsGetDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
 type CredentialsGetRequest struct {
 	ID uuid.UUID
+	// CurrentUserID and RequestID identify the lookup in the audit trail.
+	CurrentUserID uuid.UUID
+	RequestID     string
 }
 
-// CredentialsGet retrieves a single account by its ID.
+// CredentialsGet retrieves a single account by its ID. The lookup is recorded in the audit
+// trail: an account is never returned without a trace of who read it.
 type CredentialsGet struct {
-	dao CredentialsGetDao
+	dao                 CredentialsGetDao
+	daoAuditEventInsert CredentialsGetDaoAuditEventInsert
+	transactor          transaction.Transactor
 }
 
-func NewCredentialsGet(dao CredentialsGetDao) *CredentialsGet {
+func NewCredentialsGet(
+	dao CredentialsGetDao,
+	daoAuditEventInsert CredentialsGetDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *CredentialsGet {
 	return &CredentialsGet{
-		dao: dao,
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
 	}
 }
 
@@ -39,22 +56,43 @@ func (service *CredentialsGet) Exec(
 
 	span.SetAttributes(attribute.String("user.id", request.ID.String()))
 
-	entity, err := service.dao.Exec(ctx, &dao.CredentialsSelectRequest{ID: request.ID})
+	var entity *dao.Credentials
+
+	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		entity, err = service.dao.Exec(ctx, &dao.CredentialsSelectRequest{ID: request.ID})
+		if err != nil {
+			return fmt.Errorf("select credentials: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			TargetID:  &entity.ID,
+			Action:    AuditActionCredentialsGet,
+			RequestID: request.RequestID,
+			Read:      true,
+		})
+	})
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("select credentials: %w", err))
+		return nil, otel.ReportError(span, err)
 	}
 
 	span.SetAttributes(
 		attribute.String("dao.entity.id", entity.ID.String()),
 		attribute.String("dao.entity.email", entity.Email),
-		attribute.String("dao.entity.role", entity.Role),
+		attribute.StringSlice("dao.entity.roles", entity.Roles),
 	)
 
 	return otel.ReportSuccess(span, &Credentials{
-		ID:        entity.ID,
-		Email:     entity.Email,
-		Role:      entity.Role,
-		CreatedAt: entity.CreatedAt,
-		UpdatedAt: entity.UpdatedAt,
+		ID:              entity.ID,
+		Email:           entity.Email,
+		Roles:           entity.Roles,
+		EmailVerifiedAt: entity.EmailVerifiedAt,
+		Locale:          entity.Locale,
+		NoticesOptOut:   entity.NoticesOptOut,
+		LastLoginAt:     entity.LastLoginAt,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
 	}), nil
 }
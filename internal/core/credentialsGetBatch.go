@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type CredentialsGetBatchDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectBatchRequest) ([]*dao.Credentials, error)
+}
+
+type CredentialsGetBatchDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type CredentialsGetBatchRequest struct {
+	IDs []uuid.UUID `validate:"required,min=1,max=500"`
+	// CurrentUserID and RequestID identify the lookup in the audit trail.
+	CurrentUserID uuid.UUID
+	RequestID     string
+}
+
+// CredentialsBatch is the result of [CredentialsGetBatch].
+type CredentialsBatch struct {
+	// Credentials found, in the order of the request.
+	Credentials []*Credentials
+	// Missing lists the requested IDs that match no account, in the order of the request.
+	Missing []uuid.UUID
+}
+
+// CredentialsGetBatch retrieves a set of accounts by their IDs, in a single query.
+//
+// Unknown IDs are not an error: they are reported in the Missing field of the result, so
+// callers resolving references can tell deleted accounts apart from lookup failures.
+// Duplicate IDs are only looked up and returned once.
+//
+// Like [CredentialsGet], every account returned is recorded in the audit trail.
+type CredentialsGetBatch struct {
+	dao                 CredentialsGetBatchDao
+	daoAuditEventInsert CredentialsGetBatchDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewCredentialsGetBatch(
+	dao CredentialsGetBatchDao,
+	daoAuditEventInsert CredentialsGetBatchDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *CredentialsGetBatch {
+	return &CredentialsGetBatch{
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+func (service *CredentialsGetBatch) Exec(
+	ctx context.Context, request *CredentialsGetBatchRequest,
+) (*CredentialsBatch, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsGetBatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("request.count", len(request.IDs)))
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	ids := lo.Uniq(request.IDs)
+
+	var entities []*dao.Credentials
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		entities, err = service.dao.Exec(ctx, &dao.CredentialsSelectBatchRequest{IDs: ids})
+		if err != nil {
+			return fmt.Errorf("select credentials: %w", err)
+		}
+
+		for _, entity := range entities {
+			err = recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+				ActorID:   &request.CurrentUserID,
+				TargetID:  &entity.ID,
+				Action:    AuditActionCredentialsGet,
+				RequestID: request.RequestID,
+				Read:      true,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	byID := lo.SliceToMap(entities, func(item *dao.Credentials) (uuid.UUID, *dao.Credentials) {
+		return item.ID, item
+	})
+
+	batch := &CredentialsBatch{
+		Credentials: make([]*Credentials, 0, len(entities)),
+		Missing:     make([]uuid.UUID, 0),
+	}
+
+	for _, id := range ids {
+		entity, ok := byID[id]
+		if !ok {
+			batch.Missing = append(batch.Missing, id)
+
+			continue
+		}
+
+		batch.Credentials = append(batch.Credentials, &Credentials{
+			ID:              entity.ID,
+			Email:           entity.Email,
+			Roles:           entity.Roles,
+			EmailVerifiedAt: entity.EmailVerifiedAt,
+			Locale:          entity.Locale,
+			NoticesOptOut:   entity.NoticesOptOut,
+			LastLoginAt:     entity.LastLoginAt,
+			CreatedAt:       entity.CreatedAt,
+			UpdatedAt:       entity.UpdatedAt,
+		})
+	}
+
+	span.SetAttributes(
+		attribute.Int("response.found", len(batch.Credentials)),
+		attribute.Int("response.missing", len(batch.Missing)),
+	)
+
+	return otel.ReportSuccess(span, batch), nil
+}
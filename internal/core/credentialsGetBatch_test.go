@@ -0,0 +1,209 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsGetBatch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		req  *dao.CredentialsSelectBatchRequest
+		resp []*dao.Credentials
+		err  error
+	}
+
+	cred1 := &dao.Credentials{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:     "user1@email.com",
+		Roles:     []string{config.RoleUser},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	cred2 := &dao.Credentials{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Email:     "user2@email.com",
+		Roles:     []string{config.RoleAdmin},
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	missingID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+
+	toCore := func(item *dao.Credentials) *core.Credentials {
+		return &core.Credentials{
+			ID:        item.ID,
+			Email:     item.Email,
+			Roles:     item.Roles,
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsGetBatchRequest
+
+		daoMock *daoMock
+		// auditEventInsertErr is returned by the audit trail, which records every account found.
+		auditEventInsertErr error
+
+		expect    *core.CredentialsBatch
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.CredentialsGetBatchRequest{
+				IDs:           []uuid.UUID{cred2.ID, missingID, cred1.ID},
+				CurrentUserID: callerID,
+				RequestID:     "request-1",
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsSelectBatchRequest{
+					IDs: []uuid.UUID{cred2.ID, missingID, cred1.ID},
+				},
+				resp: []*dao.Credentials{cred1, cred2},
+			},
+
+			expect: &core.CredentialsBatch{
+				Credentials: []*core.Credentials{toCore(cred2), toCore(cred1)},
+				Missing:     []uuid.UUID{missingID},
+			},
+		},
+		{
+			name: "Success/Duplicates",
+
+			request: &core.CredentialsGetBatchRequest{
+				IDs:           []uuid.UUID{cred1.ID, cred1.ID},
+				CurrentUserID: callerID,
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsSelectBatchRequest{
+					IDs: []uuid.UUID{cred1.ID},
+				},
+				resp: []*dao.Credentials{cred1},
+			},
+
+			expect: &core.CredentialsBatch{
+				Credentials: []*core.Credentials{toCore(cred1)},
+				Missing:     []uuid.UUID{},
+			},
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.CredentialsGetBatchRequest{
+				IDs:           []uuid.UUID{cred1.ID},
+				CurrentUserID: callerID,
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsSelectBatchRequest{
+					IDs: []uuid.UUID{cred1.ID},
+				},
+				resp: []*dao.Credentials{cred1},
+			},
+
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Empty",
+
+			request: &core.CredentialsGetBatchRequest{},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/TooMany",
+
+			request: &core.CredentialsGetBatchRequest{
+				IDs: make([]uuid.UUID, 501),
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error",
+
+			request: &core.CredentialsGetBatchRequest{
+				IDs: []uuid.UUID{cred1.ID},
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsSelectBatchRequest{
+					IDs: []uuid.UUID{cred1.ID},
+				},
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockCredentialsGetBatchDao(t)
+			mockDaoAuditEventInsert := coremocks.NewMockCredentialsGetBatchDaoAuditEventInsert(t)
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, testCase.daoMock.req).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+
+				for _, entity := range testCase.daoMock.resp {
+					mockDaoAuditEventInsert.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+							return data.TargetID != nil && *data.TargetID == entity.ID
+						})).
+						RunAndReturn(func(_ context.Context, data *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+							assert.Equal(t, core.AuditActionCredentialsGet, data.Action)
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID)
+							assert.Equal(t, testCase.request.RequestID, data.RequestID)
+							assert.True(t, data.Unchained)
+
+							return &dao.AuditEvent{}, testCase.auditEventInsertErr
+						}).
+						Once()
+				}
+			}
+
+			service := core.NewCredentialsGetBatch(
+				mockDao, mockDaoAuditEventInsert, transactiontest.NewTransactor(),
+			)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			mockDaoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
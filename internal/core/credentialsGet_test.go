@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
 	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
@@ -31,6 +34,9 @@ func TestCredentialsGet(t *testing.T) {
 		request *core.CredentialsGetRequest
 
 		daoMock *daoMock
+		// auditEventInsertErr is returned by the audit trail, which records every lookup that
+		// found an account.
+		auditEventInsertErr error
 
 		expect    *core.Credentials
 		expectErr error
@@ -39,14 +45,16 @@ func TestCredentialsGet(t *testing.T) {
 			name: "Success",
 
 			request: &core.CredentialsGetRequest{
-				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				RequestID:     "request-1",
 			},
 
 			daoMock: &daoMock{
 				resp: &dao.Credentials{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "user1@email.com",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
@@ -55,11 +63,30 @@ func TestCredentialsGet(t *testing.T) {
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "user1@email.com",
-				Role:      config.RoleUser,
+				Roles:     []string{config.RoleUser},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.CredentialsGetRequest{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
 		{
 			name: "Error",
 
@@ -82,6 +109,7 @@ func TestCredentialsGet(t *testing.T) {
 			ctx := t.Context()
 
 			mockDao := coremocks.NewMockCredentialsGetDao(t)
+			mockDaoAuditEventInsert := coremocks.NewMockCredentialsGetDaoAuditEventInsert(t)
 
 			if testCase.daoMock != nil {
 				mockDao.EXPECT().
@@ -89,13 +117,28 @@ func TestCredentialsGet(t *testing.T) {
 					Return(testCase.daoMock.resp, testCase.daoMock.err)
 			}
 
-			service := core.NewCredentialsGet(mockDao)
+			if testCase.daoMock != nil && testCase.daoMock.err == nil {
+				mockDaoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						return assert.Equal(t, core.AuditActionCredentialsGet, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Equal(t, &testCase.request.ID, data.TargetID) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID) &&
+							assert.Nil(t, data.Before) &&
+							assert.Nil(t, data.After) &&
+							assert.True(t, data.Unchained)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			service := core.NewCredentialsGet(mockDao, mockDaoAuditEventInsert, transactiontest.NewTransactor())
 
 			resp, err := service.Exec(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
 			require.Equal(t, testCase.expect, resp)
 
 			mockDao.AssertExpectations(t)
+			mockDaoAuditEventInsert.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,201 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// ErrCredentialsGrantRoleAlreadyHeld is returned by [CredentialsGrantRole.Exec] when the target
+// already holds the role for good: a temporary grant would change nothing.
+var ErrCredentialsGrantRoleAlreadyHeld = errors.New("user already holds the role")
+
+type CredentialsGrantRoleDao interface {
+	Exec(ctx context.Context, request *dao.CredentialRoleGrantInsertRequest) (*dao.CredentialRoleGrant, error)
+}
+
+type CredentialsGrantRoleDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+type CredentialsGrantRoleDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// CredentialsGrantRoleRoles ranks roles against the current definitions; satisfied by
+// [RoleRegistry].
+type CredentialsGrantRoleRoles interface {
+	Priority(role string) (int, error)
+	Rank(roles []string) (int, error)
+}
+
+// RoleGrant is a role granted to a user for a limited time.
+type RoleGrant struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Role   string
+	// Reason explains why the role was granted.
+	Reason string
+	// GrantedBy is the user that granted the role. Nil once that user is deleted.
+	GrantedBy *uuid.UUID
+	CreatedAt time.Time
+	// ExpiresAt is when the grant stops being honored.
+	ExpiresAt time.Time
+}
+
+func loadRoleGrant(item *dao.CredentialRoleGrant) *RoleGrant {
+	return &RoleGrant{
+		ID:        item.ID,
+		UserID:    item.CredentialID,
+		Role:      item.Role,
+		Reason:    item.Reason,
+		GrantedBy: item.GrantedBy,
+		CreatedAt: item.CreatedAt,
+		ExpiresAt: item.ExpiresAt,
+	}
+}
+
+type CredentialsGrantRoleRequest struct {
+	TargetUserID  uuid.UUID
+	CurrentUserID uuid.UUID
+	Role          string `validate:"required,role"`
+	// Reason explains why the role is granted. It is recorded in the audit trail.
+	Reason string `validate:"required,max=512"`
+	// ExpiresAt is when the grant ends. It must be in the future, and within
+	// config.Roles.GrantMaxDuration.
+	ExpiresAt time.Time `validate:"required"`
+	// RequestID identifies the request in the audit trail.
+	RequestID string
+}
+
+// CredentialsGrantRole grants a role to a target user for a limited time, on behalf of an acting
+// user. The rank rules of [CredentialsUpdateRole] apply: the actor cannot grant a role to itself,
+// nor a role above its own rank, nor to a user whose rank is at or above its own. Ranks only
+// count the roles users hold for good: a temporary grant extends what a user may do, not what it
+// may grant.
+//
+// Tokens carry the role until the grant expires; [CredentialsGrantRoleSweep] then reverts it.
+// Every grant is recorded in the audit trail, in the transaction of the grant.
+type CredentialsGrantRole struct {
+	dao                  CredentialsGrantRoleDao
+	daoCredentialsSelect CredentialsGrantRoleDaoCredentialsSelect
+	daoAuditEventInsert  CredentialsGrantRoleDaoAuditEventInsert
+	transactor           transaction.Transactor
+	roles                CredentialsGrantRoleRoles
+	config               config.Roles
+}
+
+func NewCredentialsGrantRole(
+	dao CredentialsGrantRoleDao,
+	daoCredentialsSelect CredentialsGrantRoleDaoCredentialsSelect,
+	daoAuditEventInsert CredentialsGrantRoleDaoAuditEventInsert,
+	transactor transaction.Transactor,
+	roles CredentialsGrantRoleRoles,
+	config config.Roles,
+) *CredentialsGrantRole {
+	return &CredentialsGrantRole{
+		dao:                  dao,
+		daoCredentialsSelect: daoCredentialsSelect,
+		daoAuditEventInsert:  daoAuditEventInsert,
+		transactor:           transactor,
+		roles:                roles,
+		config:               config,
+	}
+}
+
+func (service *CredentialsGrantRole) Exec(
+	ctx context.Context, request *CredentialsGrantRoleRequest,
+) (*RoleGrant, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsGrantRole")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("target.id", request.TargetUserID.String()),
+		attribute.String("actor.id", request.CurrentUserID.String()),
+		attribute.String("grant.role", request.Role),
+		attribute.String("grant.expiresAt", request.ExpiresAt.String()),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	now := time.Now()
+
+	if !request.ExpiresAt.After(now) {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: grant expires in the past", ErrInvalidRequest))
+	}
+
+	if request.ExpiresAt.Sub(now) > service.config.GrantMaxDuration {
+		return nil, otel.ReportError(span, fmt.Errorf(
+			"%w: grant lasts longer than %s", ErrInvalidRequest, service.config.GrantMaxDuration,
+		))
+	}
+
+	if request.CurrentUserID == request.TargetUserID {
+		return nil, otel.ReportError(span, ErrCredentialsUpdateRoleSelfUpdate)
+	}
+
+	var grant *dao.CredentialRoleGrant
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Same as CredentialsUpdateRole: the ranks are checked against locked rows.
+		selected, err := selectRoleUpdateCredentials(
+			ctx, service.daoCredentialsSelect, request.TargetUserID, request.CurrentUserID,
+		)
+		if err != nil {
+			return err
+		}
+
+		targetCredentials, currentCredentials := selected[0], selected[1]
+
+		if slices.Contains(targetCredentials.Roles, request.Role) {
+			return fmt.Errorf("%w: %s", ErrCredentialsGrantRoleAlreadyHeld, request.Role)
+		}
+
+		err = checkUpdateRoles(
+			service.roles, targetCredentials.Roles, currentCredentials.Roles, []string{request.Role}, nil,
+		)
+		if err != nil {
+			return err
+		}
+
+		grant, err = service.dao.Exec(ctx, &dao.CredentialRoleGrantInsertRequest{
+			ID:           uuid.New(),
+			CredentialID: request.TargetUserID,
+			Role:         request.Role,
+			Reason:       request.Reason,
+			GrantedBy:    &request.CurrentUserID,
+			Now:          now,
+			ExpiresAt:    request.ExpiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("insert grant: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			TargetID:  &request.TargetUserID,
+			Action:    AuditActionCredentialsGrantRole,
+			After:     newAuditRoleGrantState(grant),
+			RequestID: request.RequestID,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadRoleGrant(grant)), nil
+}
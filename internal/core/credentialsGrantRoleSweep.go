@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// credentialsGrantRoleSweepBatchSize caps the grants reverted in a single transaction.
+const credentialsGrantRoleSweepBatchSize = 100
+
+type CredentialsGrantRoleSweepDao interface {
+	Exec(
+		ctx context.Context, request *dao.CredentialRoleGrantDeleteExpiredRequest,
+	) ([]*dao.CredentialRoleGrant, error)
+}
+
+type CredentialsGrantRoleSweepDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type CredentialsGrantRoleSweepRequest struct{}
+
+// CredentialsGrantRoleSweep reverts the temporary role grants that expired, and records each
+// revert in the audit trail, with no actor.
+//
+// An expired grant is no longer honored even before it is reverted: tokens stop carrying the
+// role at once. The sweep only removes it from the database. Instances can sweep concurrently:
+// each grant is reverted once.
+type CredentialsGrantRoleSweep struct {
+	dao                 CredentialsGrantRoleSweepDao
+	daoAuditEventInsert CredentialsGrantRoleSweepDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewCredentialsGrantRoleSweep(
+	dao CredentialsGrantRoleSweepDao,
+	daoAuditEventInsert CredentialsGrantRoleSweepDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *CredentialsGrantRoleSweep {
+	return &CredentialsGrantRoleSweep{
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+// Exec reverts every grant expired at call time, and returns them.
+func (service *CredentialsGrantRoleSweep) Exec(
+	ctx context.Context, _ *CredentialsGrantRoleSweepRequest,
+) ([]*RoleGrant, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsGrantRoleSweep")
+	defer span.End()
+
+	now := time.Now()
+	reverted := make([]*RoleGrant, 0)
+
+	for {
+		batch, err := service.sweepBatch(ctx, now)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		reverted = append(reverted, batch...)
+
+		if len(batch) < credentialsGrantRoleSweepBatchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("grants.reverted", len(reverted)))
+
+	return otel.ReportSuccess(span, reverted), nil
+}
+
+// Watch sweeps the expired grants every interval, until ctx is done. A failed sweep is logged,
+// and retried on the next tick.
+func (service *CredentialsGrantRoleSweep) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := service.Exec(ctx, &CredentialsGrantRoleSweepRequest{})
+			if err != nil {
+				otel.Logger().ErrorContext(ctx, fmt.Errorf("sweep expired role grants: %w", err).Error())
+			}
+		}
+	}
+}
+
+// sweepBatch reverts a batch of expired grants in a single transaction: a grant is deleted
+// along with the record of its revert, or not at all.
+func (service *CredentialsGrantRoleSweep) sweepBatch(ctx context.Context, now time.Time) ([]*RoleGrant, error) {
+	var reverted []*RoleGrant
+
+	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		grants, err := service.dao.Exec(ctx, &dao.CredentialRoleGrantDeleteExpiredRequest{
+			Now:   now,
+			Limit: credentialsGrantRoleSweepBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("delete expired grants: %w", err)
+		}
+
+		reverted = make([]*RoleGrant, 0, len(grants))
+
+		for _, grant := range grants {
+			err = recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+				TargetID: &grant.CredentialID,
+				Action:   AuditActionCredentialsGrantRoleExpire,
+				Before:   newAuditRoleGrantState(grant),
+			})
+			if err != nil {
+				return err
+			}
+
+			reverted = append(reverted, loadRoleGrant(grant))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run transaction: %w", err)
+	}
+
+	return reverted, nil
+}
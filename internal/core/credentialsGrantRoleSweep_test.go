@@ -0,0 +1,157 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsGrantRoleSweep(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	newGrants := func(count int) []*dao.CredentialRoleGrant {
+		grants := make([]*dao.CredentialRoleGrant, count)
+
+		for i := range grants {
+			grants[i] = &dao.CredentialRoleGrant{
+				ID:           uuid.New(),
+				CredentialID: uuid.New(),
+				Role:         config.RoleAdmin,
+				Reason:       "debug account",
+				CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC),
+			}
+		}
+
+		return grants
+	}
+
+	type deleteExpiredMock struct {
+		resp []*dao.CredentialRoleGrant
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		// deleteExpiredMocks are the successive batches returned by the DAO.
+		deleteExpiredMocks  []*deleteExpiredMock
+		auditEventInsertErr error
+
+		expectReverted int
+		expectErr      error
+	}{
+		{
+			name: "Success",
+
+			deleteExpiredMocks: []*deleteExpiredMock{{resp: newGrants(2)}},
+
+			expectReverted: 2,
+		},
+		{
+			name: "Success/NoneExpired",
+
+			deleteExpiredMocks: []*deleteExpiredMock{{resp: []*dao.CredentialRoleGrant{}}},
+
+			expectReverted: 0,
+		},
+		{
+			// A full batch may not be the last one: the sweep keeps going until a batch comes short.
+			name: "Success/Batches",
+
+			deleteExpiredMocks: []*deleteExpiredMock{
+				{resp: newGrants(100)},
+				{resp: newGrants(3)},
+			},
+
+			expectReverted: 103,
+		},
+		{
+			name: "DeleteExpiredError",
+
+			deleteExpiredMocks: []*deleteExpiredMock{{err: errFoo}},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "AuditEventInsertError",
+
+			deleteExpiredMocks:  []*deleteExpiredMock{{resp: newGrants(1)}},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockCredentialsGrantRoleSweepDao(t)
+			daoAuditEventInsert := coremocks.NewMockCredentialsGrantRoleSweepDaoAuditEventInsert(t)
+
+			for _, deleteExpiredMock := range testCase.deleteExpiredMocks {
+				mockDao.EXPECT().
+					Exec(
+						mock.Anything,
+						mock.MatchedBy(func(data *dao.CredentialRoleGrantDeleteExpiredRequest) bool {
+							return assert.Equal(t, 100, data.Limit) &&
+								assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+						}),
+					).
+					Return(deleteExpiredMock.resp, deleteExpiredMock.err).
+					Once()
+
+				for _, grant := range deleteExpiredMock.resp {
+					daoAuditEventInsert.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+								if data.TargetID == nil || *data.TargetID != grant.CredentialID {
+									return false
+								}
+
+								var before map[string]any
+
+								return assert.Equal(t, core.AuditActionCredentialsGrantRoleExpire, data.Action) &&
+									assert.Nil(t, data.ActorID) &&
+									assert.NoError(t, json.Unmarshal(data.Before, &before)) &&
+									assert.Equal(t, grant.ID.String(), before["id"]) &&
+									assert.Nil(t, data.After)
+							}),
+						).
+						Return(&dao.AuditEvent{}, testCase.auditEventInsertErr).
+						Maybe()
+				}
+			}
+
+			service := core.NewCredentialsGrantRoleSweep(mockDao, daoAuditEventInsert, transactiontest.NewTransactor())
+
+			resp, err := service.Exec(ctx, &core.CredentialsGrantRoleSweepRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if testCase.expectErr == nil {
+				require.Len(t, resp, testCase.expectReverted)
+			}
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
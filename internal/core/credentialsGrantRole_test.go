@@ -0,0 +1,324 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsGrantRole(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	targetID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	grantID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	rolesConfig := config.Roles{GrantMaxDuration: 24 * time.Hour}
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	type credentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	type grantInsertMock struct {
+		resp *dao.CredentialRoleGrant
+		err  error
+	}
+
+	newCredentials := func(id uuid.UUID, roles ...string) *dao.Credentials {
+		return &dao.Credentials{
+			ID:        id,
+			Email:     "user@email.com",
+			Roles:     roles,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	newGrant := func(role string) *dao.CredentialRoleGrant {
+		return &dao.CredentialRoleGrant{
+			ID:           grantID,
+			CredentialID: targetID,
+			Role:         role,
+			Reason:       "debug account",
+			GrantedBy:    &callerID,
+			CreatedAt:    time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			ExpiresAt:    expiresAt,
+		}
+	}
+
+	newRequest := func(role string) *core.CredentialsGrantRoleRequest {
+		return &core.CredentialsGrantRoleRequest{
+			TargetUserID:  targetID,
+			CurrentUserID: callerID,
+			Role:          role,
+			Reason:        "debug account",
+			ExpiresAt:     expiresAt,
+			RequestID:     "request-1",
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsGrantRoleRequest
+
+		daoCredentialsSelectTargetMock *credentialsSelectMock
+		daoCredentialsSelectCallerMock *credentialsSelectMock
+		grantInsertMock                *grantInsertMock
+		// auditEventInsertErr is returned by the audit trail, which records every successful grant.
+		auditEventInsertErr error
+
+		expect    *core.RoleGrant
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{
+				resp: newCredentials(callerID, config.RoleSuperAdmin),
+			},
+
+			grantInsertMock: &grantInsertMock{resp: newGrant(config.RoleAdmin)},
+
+			expect: &core.RoleGrant{
+				ID:        grantID,
+				UserID:    targetID,
+				Role:      config.RoleAdmin,
+				Reason:    "debug account",
+				GrantedBy: &callerID,
+				CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				ExpiresAt: expiresAt,
+			},
+		},
+		{
+			name: "AlreadyHeld",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{
+				resp: newCredentials(targetID, config.RoleAdmin, config.RoleUser),
+			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{
+				resp: newCredentials(callerID, config.RoleSuperAdmin),
+			},
+
+			expectErr: core.ErrCredentialsGrantRoleAlreadyHeld,
+		},
+		{
+			name: "HigherThanCaller",
+
+			request: newRequest(config.RoleSuperAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleAdmin)},
+
+			expectErr: core.ErrCredentialsUpdateRoleToHigher,
+		},
+		{
+			name: "ToSuperior",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{
+				resp: newCredentials(targetID, config.RoleSuperAdmin),
+			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleAdmin)},
+
+			expectErr: core.ErrCredentialsUpdateRoleDowngradeSuperior,
+		},
+		{
+			name: "SelfGrant",
+
+			request: &core.CredentialsGrantRoleRequest{
+				TargetUserID:  callerID,
+				CurrentUserID: callerID,
+				Role:          config.RoleAdmin,
+				Reason:        "debug account",
+				ExpiresAt:     expiresAt,
+			},
+
+			expectErr: core.ErrCredentialsUpdateRoleSelfUpdate,
+		},
+		{
+			name: "ExpiresInPast",
+
+			request: &core.CredentialsGrantRoleRequest{
+				TargetUserID:  targetID,
+				CurrentUserID: callerID,
+				Role:          config.RoleAdmin,
+				Reason:        "debug account",
+				ExpiresAt:     time.Now().Add(-time.Minute),
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "ExceedsMaxDuration",
+
+			request: &core.CredentialsGrantRoleRequest{
+				TargetUserID:  targetID,
+				CurrentUserID: callerID,
+				Role:          config.RoleAdmin,
+				Reason:        "debug account",
+				ExpiresAt:     time.Now().Add(25 * time.Hour),
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "MissingReason",
+
+			request: &core.CredentialsGrantRoleRequest{
+				TargetUserID:  targetID,
+				CurrentUserID: callerID,
+				Role:          config.RoleAdmin,
+				ExpiresAt:     expiresAt,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "SelectTargetCredentialsError",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectCallerCredentialsError",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InsertError",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{
+				resp: newCredentials(callerID, config.RoleSuperAdmin),
+			},
+
+			grantInsertMock: &grantInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "AuditEventInsertError",
+
+			request: newRequest(config.RoleAdmin),
+
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{
+				resp: newCredentials(callerID, config.RoleSuperAdmin),
+			},
+
+			grantInsertMock:     &grantInsertMock{resp: newGrant(config.RoleAdmin)},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockCredentialsGrantRoleDao(t)
+			daoCredentialsSelect := coremocks.NewMockCredentialsGrantRoleDaoCredentialsSelect(t)
+			daoAuditEventInsert := coremocks.NewMockCredentialsGrantRoleDaoAuditEventInsert(t)
+
+			if testCase.daoCredentialsSelectTargetMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.TargetUserID, Lock: true}).
+					Return(testCase.daoCredentialsSelectTargetMock.resp, testCase.daoCredentialsSelectTargetMock.err)
+			}
+
+			if testCase.daoCredentialsSelectCallerMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.CurrentUserID, Lock: true}).
+					Return(testCase.daoCredentialsSelectCallerMock.resp, testCase.daoCredentialsSelectCallerMock.err)
+			}
+
+			if testCase.grantInsertMock != nil {
+				mockDao.EXPECT().
+					Exec(
+						mock.Anything,
+						mock.MatchedBy(func(data *dao.CredentialRoleGrantInsertRequest) bool {
+							return assert.NotEqual(t, uuid.Nil, data.ID) &&
+								assert.Equal(t, testCase.request.TargetUserID, data.CredentialID) &&
+								assert.Equal(t, testCase.request.Role, data.Role) &&
+								assert.Equal(t, testCase.request.Reason, data.Reason) &&
+								assert.Equal(t, &testCase.request.CurrentUserID, data.GrantedBy) &&
+								assert.Equal(t, testCase.request.ExpiresAt, data.ExpiresAt) &&
+								assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+						}),
+					).
+					Return(testCase.grantInsertMock.resp, testCase.grantInsertMock.err)
+			}
+
+			if testCase.grantInsertMock != nil && testCase.grantInsertMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(
+						mock.Anything,
+						mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+							var after map[string]any
+
+							return assert.Equal(t, core.AuditActionCredentialsGrantRole, data.Action) &&
+								assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+								assert.Equal(t, &testCase.request.TargetUserID, data.TargetID) &&
+								assert.Nil(t, data.Before) &&
+								assert.NoError(t, json.Unmarshal(data.After, &after)) &&
+								assert.Equal(t, testCase.request.Role, after["role"]) &&
+								assert.Equal(t, testCase.request.Reason, after["reason"]) &&
+								assert.Equal(t, testCase.request.RequestID, data.RequestID)
+						}),
+					).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			service := core.NewCredentialsGrantRole(
+				mockDao, daoCredentialsSelect, daoAuditEventInsert, transactiontest.NewTransactor(),
+				config.PermissionsConfigDefault, rolesConfig,
+			)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
@@ -2,42 +2,168 @@ package core
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
 
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 )
 
+const (
+	// CredentialsListOrderDesc lists the newest accounts first. This is the default.
+	CredentialsListOrderDesc = "desc"
+	// CredentialsListOrderAsc lists the oldest accounts first.
+	CredentialsListOrderAsc = "asc"
+)
+
+const (
+	// CredentialsListEmailContains matches accounts whose email contains the search.
+	// This is the default.
+	CredentialsListEmailContains = "contains"
+	// CredentialsListEmailPrefix matches accounts whose email starts with the search.
+	CredentialsListEmailPrefix = "prefix"
+)
+
+// ErrCredentialsListInvalidCursor is returned when the cursor of a [CredentialsListRequest]
+// was not produced by [CredentialsList]. It is joined with [ErrInvalidRequest].
+var ErrCredentialsListInvalidCursor = errors.New("invalid cursor")
+
 type CredentialsListDao interface {
 	Exec(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error)
 }
 
+type CredentialsListDaoCount interface {
+	Exec(ctx context.Context, request *dao.CredentialsCountRequest) (int, error)
+}
+
+type CredentialsListDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
 type CredentialsListRequest struct {
-	Limit  int      `validate:"required,min=1,max=100"`
-	Offset int      `validate:"min=0"`
+	Limit int `validate:"required,min=1,max=100"`
+	// Offset skips leading results. It cannot be combined with Cursor, which should
+	// be preferred: offsets get slower the deeper they go.
+	Offset int      `validate:"min=0,excluded_with=Cursor"`
 	Roles  []string `validate:"min=0,max=10,dive,role"`
+
+	// Cursor resumes the listing where a previous page stopped. It is the
+	// NextCursor of that page, and is only valid with the same filters and order.
+	Cursor string `validate:"max=1024"`
+
+	// Email, if set, searches accounts by email, case-insensitively. EmailMatch
+	// selects how it is matched, and defaults to CredentialsListEmailContains.
+	Email      string `validate:"max=1024"`
+	EmailMatch string `validate:"omitempty,oneof=contains prefix"`
+
+	// CreatedAfter and CreatedBefore bound the creation date of the listed accounts,
+	// as a half-open range [CreatedAfter, CreatedBefore).
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	// InactiveSince, if set, only lists accounts that did not sign in since that date,
+	// including those that never signed in.
+	InactiveSince *time.Time
+
+	// Order selects the listing order, and defaults to CredentialsListOrderDesc.
+	Order string `validate:"omitempty,oneof=asc desc"`
+
+	// WithTotal requests the number of accounts matching the filters, regardless of
+	// pagination. It costs an extra query.
+	WithTotal bool
+
+	// CurrentUserID and RequestID identify the listing in the audit trail.
+	CurrentUserID uuid.UUID
+	RequestID     string
+}
+
+// CredentialsListPage is a page of accounts returned by [CredentialsList].
+type CredentialsListPage struct {
+	Credentials []*Credentials
+	// NextCursor resumes the listing after this page. It is empty on the last page.
+	NextCursor string
+	// Total is the number of accounts matching the filters, when requested.
+	Total *int
+}
+
+// credentialsListCursor is the decoded form of a listing cursor: the position of the last
+// account of a page in the (created_at, id) order. It is serialized as base64-encoded
+// JSON, which callers should treat as opaque.
+type credentialsListCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+}
+
+func encodeCredentialsListCursor(credentials *dao.Credentials) string {
+	raw := lo.Must(json.Marshal(credentialsListCursor{CreatedAt: credentials.CreatedAt, ID: credentials.ID}))
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCredentialsListCursor(cursor string) (*dao.CredentialsListKey, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.Join(err, ErrCredentialsListInvalidCursor)
+	}
+
+	var decoded credentialsListCursor
+
+	err = json.Unmarshal(raw, &decoded)
+	if err != nil {
+		return nil, errors.Join(err, ErrCredentialsListInvalidCursor)
+	}
+
+	if decoded.CreatedAt.IsZero() || decoded.ID == uuid.Nil {
+		return nil, ErrCredentialsListInvalidCursor
+	}
+
+	return &dao.CredentialsListKey{CreatedAt: decoded.CreatedAt, ID: decoded.ID}, nil
 }
 
-// CredentialsList returns a paginated page of accounts, optionally narrowed to a
-// set of roles.
+// CredentialsList returns a paginated page of accounts, optionally narrowed by role,
+// email, creation date and inactivity.
+//
+// Pages are chained with an opaque cursor over the immutable (created_at, id) key, so
+// accounts created or updated between two requests never shift the remaining pages.
+//
+// Every page served is recorded in the audit trail.
 type CredentialsList struct {
-	dao CredentialsListDao
+	dao                 CredentialsListDao
+	daoCount            CredentialsListDaoCount
+	daoAuditEventInsert CredentialsListDaoAuditEventInsert
+	transactor          transaction.Transactor
 }
 
-func NewCredentialsList(dao CredentialsListDao) *CredentialsList {
+func NewCredentialsList(
+	dao CredentialsListDao,
+	daoCount CredentialsListDaoCount,
+	daoAuditEventInsert CredentialsListDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *CredentialsList {
 	return &CredentialsList{
-		dao: dao,
+		dao:                 dao,
+		daoCount:            daoCount,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
 	}
 }
 
 func (service *CredentialsList) Exec(
 	ctx context.Context, request *CredentialsListRequest,
-) ([]*Credentials, error) {
+) (*CredentialsListPage, error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsList")
 	defer span.End()
 
@@ -46,24 +172,85 @@ func (service *CredentialsList) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	entities, err := service.dao.Exec(ctx, &dao.CredentialsListRequest{
-		Limit:  request.Limit,
-		Offset: request.Offset,
-		Roles:  request.Roles,
+	after, err := decodeCredentialsListCursor(request.Cursor)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	emailPrefix := request.EmailMatch == CredentialsListEmailPrefix
+
+	var entities []*dao.Credentials
+
+	page := new(CredentialsListPage)
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// One extra row tells whether another page follows, without a count.
+		entities, err = service.dao.Exec(ctx, &dao.CredentialsListRequest{
+			Limit:         request.Limit + 1,
+			Offset:        request.Offset,
+			Roles:         request.Roles,
+			Email:         request.Email,
+			EmailPrefix:   emailPrefix,
+			CreatedAfter:  request.CreatedAfter,
+			CreatedBefore: request.CreatedBefore,
+			InactiveSince: request.InactiveSince,
+			After:         after,
+			Ascending:     request.Order == CredentialsListOrderAsc,
+		})
+		if err != nil {
+			return fmt.Errorf("list credentials: %w", err)
+		}
+
+		if request.WithTotal {
+			total, err := service.daoCount.Exec(ctx, &dao.CredentialsCountRequest{
+				Roles:         request.Roles,
+				Email:         request.Email,
+				EmailPrefix:   emailPrefix,
+				CreatedAfter:  request.CreatedAfter,
+				CreatedBefore: request.CreatedBefore,
+				InactiveSince: request.InactiveSince,
+			})
+			if err != nil {
+				return fmt.Errorf("count credentials: %w", err)
+			}
+
+			page.Total = &total
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			Action:    AuditActionCredentialsList,
+			RequestID: request.RequestID,
+			Read:      true,
+		})
 	})
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("list credentials: %w", err))
+		return nil, otel.ReportError(span, err)
+	}
+
+	if len(entities) > request.Limit {
+		entities = entities[:request.Limit]
+		page.NextCursor = encodeCredentialsListCursor(entities[len(entities)-1])
 	}
 
-	span.SetAttributes(attribute.Int("response.count", len(entities)))
+	span.SetAttributes(
+		attribute.Int("response.count", len(entities)),
+		attribute.Bool("response.hasNext", page.NextCursor != ""),
+	)
 
-	return otel.ReportSuccess(span, lo.Map(entities, func(item *dao.Credentials, _ int) *Credentials {
+	page.Credentials = lo.Map(entities, func(item *dao.Credentials, _ int) *Credentials {
 		return &Credentials{
-			ID:        item.ID,
-			Email:     item.Email,
-			Role:      item.Role,
-			CreatedAt: item.CreatedAt,
-			UpdatedAt: item.UpdatedAt,
+			ID:              item.ID,
+			Email:           item.Email,
+			Roles:           item.Roles,
+			EmailVerifiedAt: item.EmailVerifiedAt,
+			Locale:          item.Locale,
+			NoticesOptOut:   item.NoticesOptOut,
+			LastLoginAt:     item.LastLoginAt,
+			CreatedAt:       item.CreatedAt,
+			UpdatedAt:       item.UpdatedAt,
 		}
-	})), nil
+	})
+
+	return otel.ReportSuccess(span, page), nil
 }
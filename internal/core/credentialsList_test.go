@@ -1,14 +1,19 @@
 package core_test
 
 import (
+	"encoding/base64"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
 	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
@@ -21,78 +26,207 @@ func TestCredentialsList(t *testing.T) {
 	errFoo := errors.New("foo")
 
 	type daoMock struct {
+		req  *dao.CredentialsListRequest
 		resp []*dao.Credentials
 		err  error
 	}
 
+	type daoCountMock struct {
+		req  *dao.CredentialsCountRequest
+		resp int
+		err  error
+	}
+
+	cred3 := &dao.Credentials{
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Email:       "user3@email.com",
+		Roles:       []string{config.RoleUser},
+		LastLoginAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	cred2 := &dao.Credentials{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Email:     "user2@email.com",
+		Roles:     []string{config.RoleAdmin},
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	cred1 := &dao.Credentials{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:     "user1@email.com",
+		Roles:     []string{config.RoleUser},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	toCore := func(item *dao.Credentials) *core.Credentials {
+		return &core.Credentials{
+			ID:          item.ID,
+			Email:       item.Email,
+			Roles:       item.Roles,
+			LastLoginAt: item.LastLoginAt,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+	}
+
+	// The cursor of cred2, as issued when it ends a page.
+	cred2Cursor := base64.RawURLEncoding.EncodeToString(
+		[]byte(`{"c":"2021-01-02T00:00:00Z","i":"00000000-0000-0000-0000-000000000002"}`),
+	)
+
 	testCases := []struct {
 		name string
 
 		request *core.CredentialsListRequest
 
-		daoMock *daoMock
+		daoMock      *daoMock
+		daoCountMock *daoCountMock
+		// auditEventInsertErr is returned by the audit trail, which records every page served.
+		auditEventInsertErr error
 
-		expect    []*core.Credentials
+		expect    *core.CredentialsListPage
 		expectErr error
 	}{
 		{
 			name: "Success",
 
 			request: &core.CredentialsListRequest{
-				Limit:  10,
-				Offset: 0,
-				Roles:  []string{config.RoleUser},
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				RequestID:     "request-1",
+				Limit:         10,
+				Offset:        0,
+				Roles:         []string{config.RoleUser},
 			},
 
 			daoMock: &daoMock{
-				resp: []*dao.Credentials{
-					{
-						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-						Email:     "user3@email.com",
-						Role:      config.RoleUser,
-						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
-					},
-					{
-						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-						Email:     "user2@email.com",
-						Role:      config.RoleAdmin,
-						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					},
-					{
-						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-						Email:     "user1@email.com",
-						Role:      config.RoleUser,
-						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					},
+				req: &dao.CredentialsListRequest{
+					Limit: 11,
+					Roles: []string{config.RoleUser},
 				},
+				resp: []*dao.Credentials{cred3, cred2, cred1},
+			},
+
+			expect: &core.CredentialsListPage{
+				Credentials: []*core.Credentials{toCore(cred3), toCore(cred2), toCore(cred1)},
+			},
+		},
+		{
+			name: "Success/NextCursor",
+
+			request: &core.CredentialsListRequest{
+				Limit: 2,
 			},
 
-			expect: []*core.Credentials{
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-					Email:     "user3@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			daoMock: &daoMock{
+				req: &dao.CredentialsListRequest{
+					Limit: 3,
 				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user2@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				resp: []*dao.Credentials{cred3, cred2, cred1},
+			},
+
+			expect: &core.CredentialsListPage{
+				Credentials: []*core.Credentials{toCore(cred3), toCore(cred2)},
+				NextCursor:  cred2Cursor,
+			},
+		},
+		{
+			name: "Success/Cursor",
+
+			request: &core.CredentialsListRequest{
+				Limit:  2,
+				Cursor: cred2Cursor,
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsListRequest{
+					Limit: 3,
+					After: &dao.CredentialsListKey{CreatedAt: cred2.CreatedAt, ID: cred2.ID},
+				},
+				resp: []*dao.Credentials{cred1},
+			},
+
+			expect: &core.CredentialsListPage{
+				Credentials: []*core.Credentials{toCore(cred1)},
+			},
+		},
+		{
+			name: "Success/Filters",
+
+			request: &core.CredentialsListRequest{
+				Limit:         10,
+				Roles:         []string{config.RoleUser},
+				Email:         "user",
+				EmailMatch:    core.CredentialsListEmailPrefix,
+				CreatedAfter:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedBefore: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+				InactiveSince: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+				Order:         core.CredentialsListOrderAsc,
+				WithTotal:     true,
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsListRequest{
+					Limit:         11,
+					Roles:         []string{config.RoleUser},
+					Email:         "user",
+					EmailPrefix:   true,
+					CreatedAfter:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					CreatedBefore: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+					InactiveSince: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+					Ascending:     true,
 				},
-				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "user1@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				resp: []*dao.Credentials{cred1, cred3},
+			},
+
+			daoCountMock: &daoCountMock{
+				req: &dao.CredentialsCountRequest{
+					Roles:         []string{config.RoleUser},
+					Email:         "user",
+					EmailPrefix:   true,
+					CreatedAfter:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					CreatedBefore: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+					InactiveSince: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
 				},
+				resp: 2,
+			},
+
+			expect: &core.CredentialsListPage{
+				Credentials: []*core.Credentials{toCore(cred1), toCore(cred3)},
+				Total:       lo.ToPtr(2),
+			},
+		},
+		{
+			name: "Error/InvalidCursor",
+
+			request: &core.CredentialsListRequest{
+				Limit:  10,
+				Cursor: "not-a-cursor",
 			},
+
+			expectErr: core.ErrCredentialsListInvalidCursor,
+		},
+		{
+			name: "Error/CursorWithOffset",
+
+			request: &core.CredentialsListRequest{
+				Limit:  10,
+				Offset: 2,
+				Cursor: cred2Cursor,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidOrder",
+
+			request: &core.CredentialsListRequest{
+				Limit: 10,
+				Order: "sideways",
+			},
+
+			expectErr: core.ErrInvalidRequest,
 		},
 		{
 			name: "Error",
@@ -104,6 +238,50 @@ func TestCredentialsList(t *testing.T) {
 			},
 
 			daoMock: &daoMock{
+				req: &dao.CredentialsListRequest{
+					Limit: 11,
+					Roles: []string{config.RoleUser},
+				},
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.CredentialsListRequest{
+				Limit: 10,
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsListRequest{
+					Limit: 11,
+				},
+				resp: []*dao.Credentials{cred3},
+			},
+
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Count",
+
+			request: &core.CredentialsListRequest{
+				Limit:     10,
+				WithTotal: true,
+			},
+
+			daoMock: &daoMock{
+				req: &dao.CredentialsListRequest{
+					Limit: 11,
+				},
+				resp: []*dao.Credentials{cred3},
+			},
+
+			daoCountMock: &daoCountMock{
+				req: &dao.CredentialsCountRequest{},
 				err: errFoo,
 			},
 
@@ -118,24 +296,45 @@ func TestCredentialsList(t *testing.T) {
 			ctx := t.Context()
 
 			mockDao := coremocks.NewMockCredentialsListDao(t)
+			mockDaoCount := coremocks.NewMockCredentialsListDaoCount(t)
+			mockDaoAuditEventInsert := coremocks.NewMockCredentialsListDaoAuditEventInsert(t)
 
 			if testCase.daoMock != nil {
 				mockDao.EXPECT().
-					Exec(mock.Anything, &dao.CredentialsListRequest{
-						Limit:  testCase.request.Limit,
-						Offset: testCase.request.Offset,
-						Roles:  testCase.request.Roles,
-					}).
+					Exec(mock.Anything, testCase.daoMock.req).
 					Return(testCase.daoMock.resp, testCase.daoMock.err)
 			}
 
-			service := core.NewCredentialsList(mockDao)
+			if testCase.daoCountMock != nil {
+				mockDaoCount.EXPECT().
+					Exec(mock.Anything, testCase.daoCountMock.req).
+					Return(testCase.daoCountMock.resp, testCase.daoCountMock.err)
+			}
+
+			if testCase.daoMock != nil && testCase.daoMock.err == nil &&
+				(testCase.daoCountMock == nil || testCase.daoCountMock.err == nil) {
+				mockDaoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						return assert.Equal(t, core.AuditActionCredentialsList, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Nil(t, data.TargetID) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID) &&
+							assert.True(t, data.Unchained)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			service := core.NewCredentialsList(
+				mockDao, mockDaoCount, mockDaoAuditEventInsert, transactiontest.NewTransactor(),
+			)
 
 			resp, err := service.Exec(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
 			require.Equal(t, testCase.expect, resp)
 
 			mockDao.AssertExpectations(t)
+			mockDaoCount.AssertExpectations(t)
+			mockDaoAuditEventInsert.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,245 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+var (
+	// ErrCredentialsMergeSameAccount is returned by [CredentialsMerge.Exec] when the source and
+	// the destination are the same account.
+	ErrCredentialsMergeSameAccount = errors.New("cannot merge an account into itself")
+	// ErrCredentialsMergeSelf is returned by [CredentialsMerge.Exec] when the actor is the source:
+	// a user never deletes its own account through a merge, even a super-admin.
+	ErrCredentialsMergeSelf = errors.New("user is not allowed to merge its own account")
+	// ErrCredentialsMergeSuperior is returned by [CredentialsMerge.Exec] when the source ranks
+	// equal to or higher than the actor. Like [CredentialsUpdateRole] with its target, an actor
+	// only merges away the accounts of users ranked below it.
+	ErrCredentialsMergeSuperior = errors.New("user can only merge accounts from a lower role")
+)
+
+type CredentialsMergeDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsMergeRequest) (*dao.CredentialsRedirect, error)
+}
+
+type CredentialsMergeDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+type CredentialsMergeDaoCredentialsUpdateRole interface {
+	Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)
+}
+
+type CredentialsMergeDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type CredentialsMergeDaoCredentialsEventInsert interface {
+	Exec(ctx context.Context, request *dao.CredentialsEventInsertRequest) (*dao.CredentialsEvent, error)
+}
+
+// CredentialsMergeRoles ranks roles against the current definitions; satisfied by
+// [RoleRegistry].
+type CredentialsMergeRoles interface {
+	Rank(roles []string) (int, error)
+}
+
+type CredentialsMergeRequest struct {
+	// SourceID is the account to merge. It is deleted, and its ID redirects to DestinationID.
+	SourceID      uuid.UUID
+	DestinationID uuid.UUID
+	CurrentUserID uuid.UUID
+	// RequestID identifies the request in the audit trail.
+	RequestID string
+}
+
+// CredentialsMerge merges a duplicate account into another one, on behalf of an acting user.
+//
+// The source must rank below the actor, and cannot be the actor. The destination receives the
+// login history of the source, while the sessions of the source end at their next refresh, see
+// [ErrTokenRefreshAccountMerged]. It also receives the roles of the source, unless [CredentialsUpdateRole] would
+// forbid the actor to change its roles; it keeps its own roles. The source is deleted, and
+// replaced with a redirect to the destination.
+//
+// Every step runs in a single transaction, which also writes a merge event to the outbox:
+// other services read it through [CredentialsEventList] to move their data onto the destination.
+// The merge is also recorded in the audit trail.
+type CredentialsMerge struct {
+	dao                       CredentialsMergeDao
+	daoCredentialsSelect      CredentialsMergeDaoCredentialsSelect
+	daoCredentialsUpdateRole  CredentialsMergeDaoCredentialsUpdateRole
+	daoAuditEventInsert       CredentialsMergeDaoAuditEventInsert
+	daoCredentialsEventInsert CredentialsMergeDaoCredentialsEventInsert
+	transactor                transaction.Transactor
+	roles                     CredentialsMergeRoles
+}
+
+func NewCredentialsMerge(
+	dao CredentialsMergeDao,
+	daoCredentialsSelect CredentialsMergeDaoCredentialsSelect,
+	daoCredentialsUpdateRole CredentialsMergeDaoCredentialsUpdateRole,
+	daoAuditEventInsert CredentialsMergeDaoAuditEventInsert,
+	daoCredentialsEventInsert CredentialsMergeDaoCredentialsEventInsert,
+	transactor transaction.Transactor,
+	roles CredentialsMergeRoles,
+) *CredentialsMerge {
+	return &CredentialsMerge{
+		dao:                       dao,
+		daoCredentialsSelect:      daoCredentialsSelect,
+		daoCredentialsUpdateRole:  daoCredentialsUpdateRole,
+		daoAuditEventInsert:       daoAuditEventInsert,
+		daoCredentialsEventInsert: daoCredentialsEventInsert,
+		transactor:                transactor,
+		roles:                     roles,
+	}
+}
+
+func (service *CredentialsMerge) Exec(
+	ctx context.Context, request *CredentialsMergeRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsMerge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("source.id", request.SourceID.String()),
+		attribute.String("destination.id", request.DestinationID.String()),
+		attribute.String("actor.id", request.CurrentUserID.String()),
+	)
+
+	if request.SourceID == request.DestinationID {
+		return nil, otel.ReportError(span, ErrCredentialsMergeSameAccount)
+	}
+
+	if request.CurrentUserID == request.SourceID {
+		return nil, otel.ReportError(span, ErrCredentialsMergeSelf)
+	}
+
+	var destination *dao.Credentials
+
+	err := service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// The three accounts are read with their rows locked: a concurrent role update or merge
+		// cannot change the roles the checks below rely on before this one commits.
+		selected, err := selectRoleUpdateCredentials(
+			ctx, service.daoCredentialsSelect, request.SourceID, request.DestinationID, request.CurrentUserID,
+		)
+		if err != nil {
+			return err
+		}
+
+		source, actor := selected[0], selected[2]
+		destination = selected[1]
+
+		actorRank, err := service.roles.Rank(actor.Roles)
+		if err != nil {
+			return fmt.Errorf("rank current user roles: %w", err)
+		}
+
+		sourceRank, err := service.roles.Rank(source.Roles)
+		if err != nil {
+			return fmt.Errorf("rank source roles: %w", err)
+		}
+
+		if sourceRank >= actorRank {
+			return fmt.Errorf("%w: merge %v by %v", ErrCredentialsMergeSuperior, source.Roles, actor.Roles)
+		}
+
+		roles, err := service.mergedRoles(request, source, destination, actorRank)
+		if err != nil {
+			return fmt.Errorf("rank roles: %w", err)
+		}
+
+		span.SetAttributes(attribute.StringSlice("destination.roles.add", roles))
+
+		previousRoles := destination.Roles
+
+		if len(roles) > 0 {
+			destination, err = service.daoCredentialsUpdateRole.Exec(ctx, &dao.CredentialsUpdateRoleRequest{
+				ID:  request.DestinationID,
+				Add: roles,
+				Now: time.Now(),
+			})
+			if err != nil {
+				return fmt.Errorf("update destination role: %w", err)
+			}
+		}
+
+		_, err = service.dao.Exec(ctx, &dao.CredentialsMergeRequest{
+			SourceID:      request.SourceID,
+			DestinationID: request.DestinationID,
+			ActorID:       &request.CurrentUserID,
+			Now:           time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("merge credentials: %w", err)
+		}
+
+		_, err = service.daoCredentialsEventInsert.Exec(ctx, &dao.CredentialsEventInsertRequest{
+			ID:       uuid.New(),
+			Kind:     dao.CredentialsEventKindMerge,
+			UserID:   request.DestinationID,
+			SourceID: &request.SourceID,
+			Now:      time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("insert merge event: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			TargetID:  &request.DestinationID,
+			Action:    AuditActionCredentialsMerge,
+			Before:    &auditCredentialsState{Roles: previousRoles},
+			After:     &auditCredentialsState{Roles: destination.Roles, MergedFrom: &request.SourceID},
+			RequestID: request.RequestID,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &Credentials{
+		ID:              destination.ID,
+		Email:           destination.Email,
+		Roles:           destination.Roles,
+		EmailVerifiedAt: destination.EmailVerifiedAt,
+		Locale:          destination.Locale,
+		NoticesOptOut:   destination.NoticesOptOut,
+		LastLoginAt:     destination.LastLoginAt,
+		CreatedAt:       destination.CreatedAt,
+		UpdatedAt:       destination.UpdatedAt,
+	}), nil
+}
+
+// mergedRoles returns the roles of the source the destination receives. They are granted when
+// the actor could grant them through [CredentialsUpdateRole]: the actor is not the destination,
+// and the destination ranks below the actor. The source ranks below the actor, so none of its
+// roles ranks above the actor.
+func (service *CredentialsMerge) mergedRoles(
+	request *CredentialsMergeRequest, source, destination *dao.Credentials, actorRank int,
+) ([]string, error) {
+	if request.CurrentUserID == request.DestinationID {
+		return nil, nil
+	}
+
+	destinationRank, err := service.roles.Rank(destination.Roles)
+	if err != nil {
+		return nil, fmt.Errorf("rank destination roles: %w", err)
+	}
+
+	if destinationRank >= actorRank {
+		return nil, nil
+	}
+
+	return lo.Without(source.Roles, destination.Roles...), nil
+}
@@ -0,0 +1,386 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsMerge(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	sourceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	destinationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	actorID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	newCredentials := func(id uuid.UUID, roles ...string) *dao.Credentials {
+		return &dao.Credentials{
+			ID:        id,
+			Email:     id.String() + "@provider.com",
+			Roles:     roles,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsMergeRequest
+
+		// source, destination and actor are returned by the credentials lookup. Nil when the
+		// lookup is not reached.
+		source      *dao.Credentials
+		sourceErr   error
+		destination *dao.Credentials
+		actor       *dao.Credentials
+
+		// expectAdd lists the roles granted to the destination. Empty when it keeps its own.
+		expectAdd []string
+		// updated is the destination once the roles are granted.
+		updated *dao.Credentials
+		// merge and auditEvent are true when the merge, then its audit event, are reached. The
+		// outbox event is written whenever the merge succeeds.
+		merge      bool
+		mergeErr   error
+		eventErr   error
+		auditEvent bool
+		auditErr   error
+		// expectBefore and expectAfter are the states of the destination in the audit event.
+		expectBefore string
+		expectAfter  string
+
+		expect    *core.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success/GrantSourceRoles",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+				RequestID:     "request-1",
+			},
+
+			source:      newCredentials(sourceID, config.RoleAdmin),
+			destination: newCredentials(destinationID, config.RoleUser),
+			actor:       newCredentials(actorID, config.RoleSuperAdmin),
+
+			expectAdd:    []string{config.RoleAdmin},
+			updated:      newCredentials(destinationID, config.RoleAdmin, config.RoleUser),
+			merge:        true,
+			auditEvent:   true,
+			expectBefore: `{"roles":["auth:user"]}`,
+			expectAfter:  `{"roles":["auth:admin","auth:user"],"mergedFrom":"00000000-0000-0000-0000-000000000001"}`,
+
+			expect: &core.Credentials{
+				ID:        destinationID,
+				Email:     destinationID.String() + "@provider.com",
+				Roles:     []string{config.RoleAdmin, config.RoleUser},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/SourceRolesHeld",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+				RequestID:     "request-1",
+			},
+
+			source:      newCredentials(sourceID, config.RoleUser),
+			destination: newCredentials(destinationID, config.RoleAdmin, config.RoleUser),
+			actor:       newCredentials(actorID, config.RoleSuperAdmin),
+
+			merge:        true,
+			auditEvent:   true,
+			expectBefore: `{"roles":["auth:admin","auth:user"]}`,
+			expectAfter:  `{"roles":["auth:admin","auth:user"],"mergedFrom":"00000000-0000-0000-0000-000000000001"}`,
+
+			expect: &core.Credentials{
+				ID:        destinationID,
+				Email:     destinationID.String() + "@provider.com",
+				Roles:     []string{config.RoleAdmin, config.RoleUser},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// Like CredentialsUpdateRole, an actor only changes the roles of users ranked below it.
+			name: "Success/DestinationNotBelowActor",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+				RequestID:     "request-1",
+			},
+
+			source:      newCredentials(sourceID, config.RoleUser),
+			destination: newCredentials(destinationID, config.RoleAdmin),
+			actor:       newCredentials(actorID, config.RoleAdmin),
+
+			merge:        true,
+			auditEvent:   true,
+			expectBefore: `{"roles":["auth:admin"]}`,
+			expectAfter:  `{"roles":["auth:admin"],"mergedFrom":"00000000-0000-0000-0000-000000000001"}`,
+
+			expect: &core.Credentials{
+				ID:        destinationID,
+				Email:     destinationID.String() + "@provider.com",
+				Roles:     []string{config.RoleAdmin},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// Like CredentialsUpdateRole, an actor never changes its own roles.
+			name: "Success/ActorIsDestination",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: destinationID,
+				RequestID:     "request-1",
+			},
+
+			source:      newCredentials(sourceID, config.RoleAdmin),
+			destination: newCredentials(destinationID, config.RoleSuperAdmin),
+			actor:       newCredentials(destinationID, config.RoleSuperAdmin),
+
+			merge:        true,
+			auditEvent:   true,
+			expectBefore: `{"roles":["auth:superadmin"]}`,
+			expectAfter:  `{"roles":["auth:superadmin"],"mergedFrom":"00000000-0000-0000-0000-000000000001"}`,
+
+			expect: &core.Credentials{
+				ID:        destinationID,
+				Email:     destinationID.String() + "@provider.com",
+				Roles:     []string{config.RoleSuperAdmin},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/SameAccount",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: sourceID,
+				CurrentUserID: actorID,
+			},
+
+			expectErr: core.ErrCredentialsMergeSameAccount,
+		},
+		{
+			name: "Error/ActorIsSource",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: sourceID,
+			},
+
+			expectErr: core.ErrCredentialsMergeSelf,
+		},
+		{
+			// Like CredentialsUpdateRole, an actor only merges away the accounts of users ranked
+			// below it.
+			name: "Error/SourceNotBelowActor",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+			},
+
+			source:      newCredentials(sourceID, config.RoleSuperAdmin),
+			destination: newCredentials(destinationID, config.RoleUser),
+			actor:       newCredentials(actorID, config.RoleSuperAdmin),
+
+			expectErr: core.ErrCredentialsMergeSuperior,
+		},
+		{
+			name: "Error/SourceNotFound",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+			},
+
+			sourceErr: dao.ErrCredentialsSelectNotFound,
+
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "Error/Merge",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+			},
+
+			source:      newCredentials(sourceID, config.RoleUser),
+			destination: newCredentials(destinationID, config.RoleUser),
+			actor:       newCredentials(actorID, config.RoleSuperAdmin),
+
+			merge:    true,
+			mergeErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Event",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+			},
+
+			source:      newCredentials(sourceID, config.RoleUser),
+			destination: newCredentials(destinationID, config.RoleUser),
+			actor:       newCredentials(actorID, config.RoleSuperAdmin),
+
+			merge:    true,
+			eventErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.CredentialsMergeRequest{
+				SourceID:      sourceID,
+				DestinationID: destinationID,
+				CurrentUserID: actorID,
+			},
+
+			source:      newCredentials(sourceID, config.RoleUser),
+			destination: newCredentials(destinationID, config.RoleUser),
+			actor:       newCredentials(actorID, config.RoleSuperAdmin),
+
+			merge:        true,
+			auditEvent:   true,
+			auditErr:     errFoo,
+			expectBefore: `{"roles":["auth:user"]}`,
+			expectAfter:  `{"roles":["auth:user"],"mergedFrom":"00000000-0000-0000-0000-000000000001"}`,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockCredentialsMergeDao(t)
+			daoCredentialsSelect := coremocks.NewMockCredentialsMergeDaoCredentialsSelect(t)
+			daoCredentialsUpdateRole := coremocks.NewMockCredentialsMergeDaoCredentialsUpdateRole(t)
+			daoAuditEventInsert := coremocks.NewMockCredentialsMergeDaoAuditEventInsert(t)
+			daoCredentialsEventInsert := coremocks.NewMockCredentialsMergeDaoCredentialsEventInsert(t)
+
+			if testCase.source != nil || testCase.sourceErr != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.SourceID, Lock: true}).
+					Return(testCase.source, testCase.sourceErr)
+			}
+
+			if testCase.destination != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.DestinationID, Lock: true}).
+					Return(testCase.destination, nil)
+			}
+
+			if testCase.actor != nil && testCase.request.CurrentUserID != testCase.request.DestinationID {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.CurrentUserID, Lock: true}).
+					Return(testCase.actor, nil)
+			}
+
+			if len(testCase.expectAdd) > 0 {
+				daoCredentialsUpdateRole.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsUpdateRoleRequest) bool {
+						return assert.Equal(t, testCase.request.DestinationID, data.ID) &&
+							assert.Equal(t, testCase.expectAdd, data.Add) &&
+							assert.Empty(t, data.Remove) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.updated, nil)
+			}
+
+			if testCase.merge {
+				mockDao.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsMergeRequest) bool {
+						return assert.Equal(t, testCase.request.SourceID, data.SourceID) &&
+							assert.Equal(t, testCase.request.DestinationID, data.DestinationID) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(&dao.CredentialsRedirect{}, testCase.mergeErr)
+			}
+
+			if testCase.merge && testCase.mergeErr == nil {
+				daoCredentialsEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsEventInsertRequest) bool {
+						return assert.NotEqual(t, uuid.Nil, data.ID) &&
+							assert.Equal(t, dao.CredentialsEventKindMerge, data.Kind) &&
+							assert.Equal(t, testCase.request.DestinationID, data.UserID) &&
+							assert.Equal(t, &testCase.request.SourceID, data.SourceID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(&dao.CredentialsEvent{}, testCase.eventErr)
+			}
+
+			if testCase.auditEvent {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						return assert.Equal(t, core.AuditActionCredentialsMerge, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Equal(t, &testCase.request.DestinationID, data.TargetID) &&
+							assert.JSONEq(t, testCase.expectBefore, string(data.Before)) &&
+							assert.JSONEq(t, testCase.expectAfter, string(data.After)) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditErr)
+			}
+
+			service := core.NewCredentialsMerge(
+				mockDao, daoCredentialsSelect, daoCredentialsUpdateRole, daoAuditEventInsert, daoCredentialsEventInsert,
+				transactiontest.NewTransactor(), config.PermissionsConfigDefault,
+			)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
+			daoCredentialsUpdateRole.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+			daoCredentialsEventInsert.AssertExpectations(t)
+		})
+	}
+}
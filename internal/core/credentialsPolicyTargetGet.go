@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// CredentialsPolicyTarget is an account a request acts on, as authorization policies read it.
+type CredentialsPolicyTarget struct {
+	UserID uuid.UUID
+	// Rank is the rank of the account, see [RoleRegistry.Rank].
+	Rank int
+}
+
+type CredentialsPolicyTargetGetDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+type CredentialsPolicyTargetGetRoles interface {
+	Rank(roles []string) (int, error)
+}
+
+type CredentialsPolicyTargetGetRequest struct {
+	ID uuid.UUID
+}
+
+// CredentialsPolicyTargetGet returns the attributes of an account that policies compare with the
+// caller, such as its rank. It reads the current roles of the account, not the ones of its last
+// token.
+//
+// The services a policy gates still run their own checks: a policy adds to them, for the rules a
+// deployment wants on top.
+type CredentialsPolicyTargetGet struct {
+	dao   CredentialsPolicyTargetGetDao
+	roles CredentialsPolicyTargetGetRoles
+}
+
+func NewCredentialsPolicyTargetGet(
+	dao CredentialsPolicyTargetGetDao, roles CredentialsPolicyTargetGetRoles,
+) *CredentialsPolicyTargetGet {
+	return &CredentialsPolicyTargetGet{dao: dao, roles: roles}
+}
+
+func (service *CredentialsPolicyTargetGet) Exec(
+	ctx context.Context, request *CredentialsPolicyTargetGetRequest,
+) (*CredentialsPolicyTarget, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsPolicyTargetGet")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("credentials.id", request.ID.String()))
+
+	entity, err := service.dao.Exec(ctx, &dao.CredentialsSelectRequest{ID: request.ID})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select credentials: %w", err))
+	}
+
+	rank, err := service.roles.Rank(entity.Roles)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("rank roles: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("credentials.rank", rank))
+
+	return otel.ReportSuccess(span, &CredentialsPolicyTarget{
+		UserID: entity.ID,
+		Rank:   rank,
+	}), nil
+}
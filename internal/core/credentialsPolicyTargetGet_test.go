@@ -0,0 +1,94 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsPolicyTargetGet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type daoMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoMock *daoMock
+
+		expect    *core.CredentialsPolicyTarget
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{ID: userID, Roles: []string{config.RoleUser, config.RoleAdmin}},
+			},
+
+			expect: &core.CredentialsPolicyTarget{UserID: userID, Rank: 2},
+		},
+		{
+			name: "Error/NotFound",
+
+			daoMock: &daoMock{
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "Error/UnknownRole",
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{ID: userID, Roles: []string{"unknown"}},
+			},
+
+			expectErr: config.ErrUnknownRole,
+		},
+		{
+			name: "Error/DAO",
+
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockCredentialsPolicyTargetGetDao(t)
+
+			mockDao.EXPECT().
+				Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: userID}).
+				Return(testCase.daoMock.resp, testCase.daoMock.err)
+
+			service := core.NewCredentialsPolicyTargetGet(mockDao, config.PermissionsConfigDefault)
+
+			resp, err := service.Exec(t.Context(), &core.CredentialsPolicyTargetGetRequest{ID: userID})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// CredentialsRedirect is the tombstone of an account merged into another one, as other
+// services see it. The email of the merged account stays in the DAO layer.
+type CredentialsRedirect struct {
+	// SourceID is the ID of the merged, and deleted, account.
+	SourceID uuid.UUID
+	// DestinationID is the account the source was merged into.
+	DestinationID uuid.UUID
+	// CreatedAt is when the accounts were merged.
+	CreatedAt time.Time
+}
+
+type CredentialsRedirectGetDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error)
+}
+
+type CredentialsRedirectGetRequest struct {
+	// ID is the account ID to resolve.
+	ID uuid.UUID
+}
+
+// CredentialsRedirectGet resolves the ID of a merged account to the account it was merged into.
+//
+// Other services that stored the ID of a user call it when the ID no longer matches an
+// account, or when they meet the new ID of a user, to move their data onto the destination.
+// Redirects never chain, so the destination is always an existing account.
+type CredentialsRedirectGet struct {
+	dao CredentialsRedirectGetDao
+}
+
+func NewCredentialsRedirectGet(dao CredentialsRedirectGetDao) *CredentialsRedirectGet {
+	return &CredentialsRedirectGet{dao: dao}
+}
+
+func (service *CredentialsRedirectGet) Exec(
+	ctx context.Context, request *CredentialsRedirectGetRequest,
+) (*CredentialsRedirect, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsRedirectGet")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("credentials.sourceID", request.ID.String()))
+
+	entity, err := service.dao.Exec(ctx, &dao.CredentialsRedirectSelectRequest{SourceID: request.ID})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select redirect: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("dao.entity.destinationID", entity.DestinationID.String()))
+
+	return otel.ReportSuccess(span, &CredentialsRedirect{
+		SourceID:      entity.SourceID,
+		DestinationID: entity.DestinationID,
+		CreatedAt:     entity.CreatedAt,
+	}), nil
+}
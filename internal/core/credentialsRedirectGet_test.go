@@ -0,0 +1,108 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsRedirectGet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		resp *dao.CredentialsRedirect
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsRedirectGetRequest
+
+		daoMock *daoMock
+
+		expect    *core.CredentialsRedirect
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.CredentialsRedirectGetRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.CredentialsRedirect{
+					SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					DestinationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					SourceEmail:   "user1@email.com",
+					CreatedAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.CredentialsRedirect{
+				SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				DestinationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				CreatedAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &core.CredentialsRedirectGetRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoMock: &daoMock{
+				err: dao.ErrCredentialsRedirectSelectNotFound,
+			},
+
+			expectErr: dao.ErrCredentialsRedirectSelectNotFound,
+		},
+		{
+			name: "Error",
+
+			request: &core.CredentialsRedirectGetRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockCredentialsRedirectGetDao(t)
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsRedirectSelectRequest{SourceID: testCase.request.ID}).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			service := core.NewCredentialsRedirectGet(mockDao)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
@@ -5,24 +5,36 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/smtp"
 	"github.com/a-novel-kit/golib/transaction"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
 )
 
 type CredentialsUpdateEmailDao interface {
 	Exec(ctx context.Context, request *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error)
 }
+type CredentialsUpdateEmailDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
 type CredentialsUpdateEmailServiceShortCodeConsume interface {
 	Exec(ctx context.Context, request *ShortCodeConsumeRequest) (*ShortCode, error)
 }
 
+// CredentialsUpdateEmailSmtp is the mailer used to notify the previous address of the change.
+type CredentialsUpdateEmailSmtp = smtp.Sender
+
 type CredentialsUpdateEmailRequest struct {
 	UserID    uuid.UUID
 	ShortCode string `validate:"required,max=1024"`
@@ -31,24 +43,45 @@ type CredentialsUpdateEmailRequest struct {
 // CredentialsUpdateEmail applies an email change confirmed by a short code. The
 // caller does not supply the new address directly: it is carried in the short-code
 // payload, so only the address the code was issued for can take effect.
+//
+// Once the email changed, the previous address is notified: if someone else made the change,
+// its owner would otherwise never learn about it. The notice is sent regardless of the
+// account's opt-out.
 type CredentialsUpdateEmail struct {
 	dao                     CredentialsUpdateEmailDao
+	daoCredentialsSelect    CredentialsUpdateEmailDaoCredentialsSelect
 	serviceShortCodeConsume CredentialsUpdateEmailServiceShortCodeConsume
+	smtp                    smtp.Sender
 	transactor              transaction.Transactor
+	emails                  config.Emails
+
+	wg sync.WaitGroup
 }
 
 func NewCredentialsUpdateEmail(
 	dao CredentialsUpdateEmailDao,
+	daoCredentialsSelect CredentialsUpdateEmailDaoCredentialsSelect,
 	serviceShortCodeConsume CredentialsUpdateEmailServiceShortCodeConsume,
+	smtp smtp.Sender,
 	transactor transaction.Transactor,
+	emails config.Emails,
 ) *CredentialsUpdateEmail {
 	return &CredentialsUpdateEmail{
 		dao:                     dao,
+		daoCredentialsSelect:    daoCredentialsSelect,
 		serviceShortCodeConsume: serviceShortCodeConsume,
+		smtp:                    smtp,
 		transactor:              transactor,
+		emails:                  emails,
 	}
 }
 
+// Wait blocks until every in-flight notice has finished sending, so callers can drain pending
+// deliveries before shutdown.
+func (service *CredentialsUpdateEmail) Wait() {
+	service.wg.Wait()
+}
+
 func (service *CredentialsUpdateEmail) Exec(
 	ctx context.Context, request *CredentialsUpdateEmailRequest,
 ) (*Credentials, error) {
@@ -62,18 +95,14 @@ func (service *CredentialsUpdateEmail) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	var credentials *dao.Credentials
+	var credentials, previous *dao.Credentials
 
-	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
-		// Verify short code.
-		shortCode, txErr := service.serviceShortCodeConsume.Exec(ctx, &ShortCodeConsumeRequest{
-			Usage:  ShortCodeUsageValidateEmail,
-			Target: request.UserID.String(),
-			Code:   request.ShortCode,
-		})
-		if txErr != nil {
-			return fmt.Errorf("consume short code: %w", txErr)
-		}
+	err = consumeShortCodeWithinTx(ctx, service.transactor, service.serviceShortCodeConsume, &ShortCodeConsumeRequest{
+		Usage:  ShortCodeUsageValidateEmail,
+		Target: request.UserID.String(),
+		Code:   request.ShortCode,
+	}, func(ctx context.Context, shortCode *ShortCode) error {
+		var txErr error
 
 		var newEmail string
 
@@ -84,11 +113,27 @@ func (service *CredentialsUpdateEmail) Exec(
 
 		span.SetAttributes(attribute.String("shortCode.newEmail", newEmail))
 
+		// The provider rules may have changed since the code was sent, so the canonical form is
+		// computed now rather than stored with the code.
+		newEmailCanonical, txErr := service.emails.Canonical(newEmail)
+		if txErr != nil {
+			return fmt.Errorf("canonicalize email: %w", txErr)
+		}
+
+		// Keep the previous address, to notify it once the change is committed.
+		previous, txErr = service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{
+			ID: request.UserID,
+		})
+		if txErr != nil {
+			return fmt.Errorf("select credentials: %w", txErr)
+		}
+
 		// Update email.
 		credentials, txErr = service.dao.Exec(ctx, &dao.CredentialsUpdateEmailRequest{
-			ID:    request.UserID,
-			Email: newEmail,
-			Now:   time.Now(),
+			ID:             request.UserID,
+			Email:          newEmail,
+			EmailCanonical: newEmailCanonical,
+			Now:            time.Now(),
 		})
 		if txErr != nil {
 			return fmt.Errorf("update email: %w", txErr)
@@ -102,11 +147,58 @@ func (service *CredentialsUpdateEmail) Exec(
 		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
 	}
 
+	service.wg.Add(1)
+
+	go service.sendMail(
+		context.WithoutCancel(ctx),
+		previous.Email,
+		credentials.Email,
+		lo.CoalesceOrEmpty(credentials.Locale, config.LangDefault),
+	)
+
 	return otel.ReportSuccess(span, &Credentials{
-		ID:        credentials.ID,
-		Email:     credentials.Email,
-		Role:      credentials.Role,
-		CreatedAt: credentials.CreatedAt,
-		UpdatedAt: credentials.UpdatedAt,
+		ID:              credentials.ID,
+		Email:           credentials.Email,
+		Roles:           credentials.Roles,
+		EmailVerifiedAt: credentials.EmailVerifiedAt,
+		Locale:          credentials.Locale,
+		NoticesOptOut:   credentials.NoticesOptOut,
+		LastLoginAt:     credentials.LastLoginAt,
+		CreatedAt:       credentials.CreatedAt,
+		UpdatedAt:       credentials.UpdatedAt,
 	}), nil
 }
+
+func (service *CredentialsUpdateEmail) sendMail(ctx context.Context, previousEmail, newEmail, lang string) {
+	defer service.wg.Done()
+
+	_, span := otel.Tracer().Start(ctx, "service.CredentialsUpdateEmail(sendMail)")
+	defer span.End()
+	defer otel.RecoverPanic(ctx, span)
+
+	span.SetAttributes(
+		attribute.String("user.email", previousEmail),
+		attribute.String("email.lang", lang),
+	)
+
+	logger := otel.Logger()
+
+	err := service.smtp.SendMail(
+		smtp.MailUsers{{Email: previousEmail}},
+		mails.Mails.EmailChanged,
+		lang,
+		map[string]any{
+			mails.TemplateVarEmail:   newEmail,
+			mails.TemplateVarBanner:  assets.BannerBase64,
+			mails.TemplateVarPurpose: "email-changed",
+		},
+	)
+	if err != nil {
+		logger.ErrorContext(ctx, otel.ReportError(span, err).Error())
+
+		return
+	}
+
+	logger.InfoContext(ctx, "email change notice sent to "+previousEmail)
+	otel.ReportSuccessNoContent(span)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,12 +14,16 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
+	"github.com/a-novel-kit/golib/smtp"
 	"github.com/a-novel-kit/golib/transaction/transactiontest"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
 )
 
 func TestCredentialsUpdateEmail(t *testing.T) {
@@ -31,6 +36,11 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 		err  error
 	}
 
+	type daoCredentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
 	type serviceShortCodeConsumeMock struct {
 		resp *core.ShortCode
 		err  error
@@ -42,8 +52,12 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 		request *core.CredentialsUpdateEmailRequest
 
 		serviceShortCodeConsumeMock *serviceShortCodeConsumeMock
+		daoCredentialsSelectMock    *daoCredentialsSelectMock
 		daoMock                     *daoMock
 
+		// expectMailLang is the language of the change notice. Empty when no notice is sent.
+		expectMailLang string
+
 		expect    *core.Credentials
 		expectErr error
 	}{
@@ -61,14 +75,24 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 				},
 			},
 
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{
+					Email: "old@provider.com",
+				},
+			},
+
 			daoMock: &daoMock{
 				resp: &dao.Credentials{
-					Email: "user@provider.com",
+					Email:  "user@provider.com",
+					Locale: config.LangFR,
 				},
 			},
 
+			expectMailLang: config.LangFR,
+
 			expect: &core.Credentials{
-				Email: "user@provider.com",
+				Email:  "user@provider.com",
+				Locale: config.LangFR,
 			},
 		},
 		{
@@ -85,6 +109,26 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 
 			expectErr: errFoo,
 		},
+		{
+			name: "Error/SelectCredentials",
+
+			request: &core.CredentialsUpdateEmailRequest{
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ShortCode: "shortCode",
+			},
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{
+				resp: &core.ShortCode{
+					Data: []byte(`"user@provider.com"`),
+				},
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "Error/UpdateCredentialsEmail",
 
@@ -99,6 +143,12 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 				},
 			},
 
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{
+					Email: "old@provider.com",
+				},
+			},
+
 			daoMock: &daoMock{
 				err: errFoo,
 			},
@@ -115,7 +165,9 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 				t.Helper()
 
 				mockDao := coremocks.NewMockCredentialsUpdateEmailDao(t)
+				daoCredentialsSelect := coremocks.NewMockCredentialsUpdateEmailDaoCredentialsSelect(t)
 				serviceShortCodeConsume := coremocks.NewMockCredentialsUpdateEmailServiceShortCodeConsume(t)
+				smtpService := coremocks.NewMockCredentialsUpdateEmailSmtp(t)
 
 				if testCase.serviceShortCodeConsumeMock != nil {
 					serviceShortCodeConsume.EXPECT().
@@ -127,6 +179,12 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 						Return(testCase.serviceShortCodeConsumeMock.resp, testCase.serviceShortCodeConsumeMock.err)
 				}
 
+				if testCase.daoCredentialsSelectMock != nil {
+					daoCredentialsSelect.EXPECT().
+						Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.UserID}).
+						Return(testCase.daoCredentialsSelectMock.resp, testCase.daoCredentialsSelectMock.err)
+				}
+
 				if testCase.daoMock != nil {
 					mockDao.EXPECT().
 						Exec(
@@ -138,6 +196,7 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 
 								return assert.NoError(t, err) &&
 									assert.Equal(t, newEmail, data.Email) &&
+									assert.Equal(t, strings.ToLower(newEmail), data.EmailCanonical) &&
 									assert.WithinDuration(t, time.Now(), data.Now, time.Second) &&
 									assert.Equal(t, testCase.request.UserID, data.ID)
 							}),
@@ -145,16 +204,40 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 						Return(testCase.daoMock.resp, testCase.daoMock.err)
 				}
 
+				if testCase.expectMailLang != "" {
+					smtpService.EXPECT().
+						SendMail(
+							smtp.MailUsers{{Email: testCase.daoCredentialsSelectMock.resp.Email}},
+							mails.Mails.EmailChanged,
+							testCase.expectMailLang,
+							map[string]any{
+								"Email":    testCase.daoMock.resp.Email,
+								"Banner":   assets.BannerBase64,
+								"_Purpose": "email-changed",
+							},
+						).
+						Return(nil)
+				}
+
 				service := core.NewCredentialsUpdateEmail(
-					mockDao, serviceShortCodeConsume, transactiontest.NewTransactor(),
+					mockDao,
+					daoCredentialsSelect,
+					serviceShortCodeConsume,
+					smtpService,
+					transactiontest.NewTransactor(),
+					config.EmailsPresetDefault,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
 				require.Equal(t, testCase.expect, resp)
 
+				service.Wait()
+
 				mockDao.AssertExpectations(t)
+				daoCredentialsSelect.AssertExpectations(t)
 				serviceShortCodeConsume.AssertExpectations(t)
+				smtpService.AssertExpectations(t)
 			})
 		})
 	}
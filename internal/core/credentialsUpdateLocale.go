@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type CredentialsUpdateLocaleDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsUpdateLocaleRequest) (*dao.Credentials, error)
+}
+
+type CredentialsUpdateLocaleRequest struct {
+	UserID uuid.UUID
+	Lang   string `validate:"required,langs"`
+}
+
+// CredentialsUpdateLocale sets the language a user receives emails in. Mails whose request
+// does not specify a language follow it.
+type CredentialsUpdateLocale struct {
+	dao CredentialsUpdateLocaleDao
+}
+
+func NewCredentialsUpdateLocale(dao CredentialsUpdateLocaleDao) *CredentialsUpdateLocale {
+	return &CredentialsUpdateLocale{
+		dao: dao,
+	}
+}
+
+func (service *CredentialsUpdateLocale) Exec(
+	ctx context.Context, request *CredentialsUpdateLocaleRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsUpdateLocale")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", request.UserID.String()),
+		attribute.String("lang", request.Lang),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	entity, err := service.dao.Exec(ctx, &dao.CredentialsUpdateLocaleRequest{
+		ID:     request.UserID,
+		Locale: request.Lang,
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("update locale: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &Credentials{
+		ID:              entity.ID,
+		Email:           entity.Email,
+		Roles:           entity.Roles,
+		EmailVerifiedAt: entity.EmailVerifiedAt,
+		Locale:          entity.Locale,
+		NoticesOptOut:   entity.NoticesOptOut,
+		LastLoginAt:     entity.LastLoginAt,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
+	}), nil
+}
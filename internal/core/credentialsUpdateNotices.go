@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type CredentialsUpdateNoticesDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsUpdateNoticesRequest) (*dao.Credentials, error)
+}
+
+type CredentialsUpdateNoticesRequest struct {
+	UserID uuid.UUID
+	// OptOut turns off the notices that are not critical to the security of the account.
+	OptOut bool
+}
+
+// CredentialsUpdateNotices sets whether a user receives the notices that are not critical to the
+// security of their account, such as the sign-in from a new device. Notices of a password or email
+// change are always sent.
+type CredentialsUpdateNotices struct {
+	dao CredentialsUpdateNoticesDao
+}
+
+func NewCredentialsUpdateNotices(dao CredentialsUpdateNoticesDao) *CredentialsUpdateNotices {
+	return &CredentialsUpdateNotices{
+		dao: dao,
+	}
+}
+
+func (service *CredentialsUpdateNotices) Exec(
+	ctx context.Context, request *CredentialsUpdateNoticesRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsUpdateNotices")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", request.UserID.String()),
+		attribute.Bool("optOut", request.OptOut),
+	)
+
+	entity, err := service.dao.Exec(ctx, &dao.CredentialsUpdateNoticesRequest{
+		ID:            request.UserID,
+		NoticesOptOut: request.OptOut,
+		Now:           time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("update notices: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &Credentials{
+		ID:              entity.ID,
+		Email:           entity.Email,
+		Roles:           entity.Roles,
+		EmailVerifiedAt: entity.EmailVerifiedAt,
+		Locale:          entity.Locale,
+		NoticesOptOut:   entity.NoticesOptOut,
+		LastLoginAt:     entity.LastLoginAt,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
+	}), nil
+}
@@ -0,0 +1,132 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsUpdateNotices(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsUpdateNoticesRequest
+
+		daoMock *daoMock
+
+		expect    *core.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success/OptOut",
+
+			request: &core.CredentialsUpdateNoticesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OptOut: true,
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:         "user1@email.com",
+					Roles:         []string{config.RoleUser},
+					NoticesOptOut: true,
+					CreatedAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Credentials{
+				ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:         "user1@email.com",
+				Roles:         []string{config.RoleUser},
+				NoticesOptOut: true,
+				CreatedAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/OptIn",
+
+			request: &core.CredentialsUpdateNoticesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user1@email.com",
+					Roles:     []string{config.RoleUser},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Credentials{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:     "user1@email.com",
+				Roles:     []string{config.RoleUser},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/Dao",
+
+			request: &core.CredentialsUpdateNoticesRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				OptOut: true,
+			},
+
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockCredentialsUpdateNoticesDao(t)
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(request *dao.CredentialsUpdateNoticesRequest) bool {
+						return request.ID == testCase.request.UserID && request.NoticesOptOut == testCase.request.OptOut
+					})).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			service := core.NewCredentialsUpdateNotices(mockDao)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
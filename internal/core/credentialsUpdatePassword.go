@@ -4,16 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/smtp"
 	"github.com/a-novel-kit/golib/transaction"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/lib"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
 )
 
 type CredentialsUpdatePasswordDao interface {
@@ -30,6 +36,9 @@ type CredentialsUpdatePasswordServiceShortCodeConsume interface {
 	Exec(ctx context.Context, request *ShortCodeConsumeRequest) (*ShortCode, error)
 }
 
+// CredentialsUpdatePasswordSmtp is the mailer used to notify the owner of the change.
+type CredentialsUpdatePasswordSmtp = smtp.Sender
+
 type CredentialsUpdatePasswordRequest struct {
 	Password        string `validate:"required,min=4,max=1024"`
 	CurrentPassword string `validate:"required_without=ShortCode,max=1024"`
@@ -40,27 +49,41 @@ type CredentialsUpdatePasswordRequest struct {
 // CredentialsUpdatePassword changes an account's password through one of two
 // authenticated paths: a reset short code proving the caller owns the account's
 // email, or the current password proving an active session belongs to the owner.
+//
+// Once the password changed, the owner is notified at the account's address. The notice is a
+// security one: it is sent regardless of the account's opt-out.
 type CredentialsUpdatePassword struct {
 	dao                     CredentialsUpdatePasswordDao
 	daoCredentialsSelect    CredentialsUpdatePasswordDaoCredentialsSelect
 	serviceShortCodeConsume CredentialsUpdatePasswordServiceShortCodeConsume
+	smtp                    smtp.Sender
 	transactor              transaction.Transactor
+
+	wg sync.WaitGroup
 }
 
 func NewCredentialsUpdatePassword(
 	dao CredentialsUpdatePasswordDao,
 	daoCredentialsSelect CredentialsUpdatePasswordDaoCredentialsSelect,
 	serviceShortCodeConsume CredentialsUpdatePasswordServiceShortCodeConsume,
+	smtp smtp.Sender,
 	transactor transaction.Transactor,
 ) *CredentialsUpdatePassword {
 	return &CredentialsUpdatePassword{
 		dao:                     dao,
 		daoCredentialsSelect:    daoCredentialsSelect,
 		serviceShortCodeConsume: serviceShortCodeConsume,
+		smtp:                    smtp,
 		transactor:              transactor,
 	}
 }
 
+// Wait blocks until every in-flight notice has finished sending, so callers can drain pending
+// deliveries before shutdown.
+func (service *CredentialsUpdatePassword) Wait() {
+	service.wg.Wait()
+}
+
 func (service *CredentialsUpdatePassword) Exec(
 	ctx context.Context, request *CredentialsUpdatePasswordRequest,
 ) (*Credentials, error) {
@@ -81,25 +104,37 @@ func (service *CredentialsUpdatePassword) Exec(
 
 	var credentials *dao.Credentials
 
+	updatePassword := func(ctx context.Context) error {
+		credentials, err = service.dao.Exec(ctx, &dao.CredentialsUpdatePasswordRequest{
+			ID:       request.UserID,
+			Password: encryptedPassword,
+			Now:      time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("update password: %w", err)
+		}
+
+		return nil
+	}
+
 	// Verification and update share one transaction so a failed check never leaves a
 	// changed password behind.
-	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
-		switch {
-		// Reset path: the short code proves the caller owns the account's email, so no
-		// current password is required.
-		case request.ShortCode != "":
-			_, err = service.serviceShortCodeConsume.Exec(ctx, &ShortCodeConsumeRequest{
-				Usage:  ShortCodeUsageResetPassword,
-				Target: request.UserID.String(),
-				Code:   request.ShortCode,
-			})
-			if err != nil {
-				return fmt.Errorf("consume short code: %w", err)
-			}
+	switch {
+	// Reset path: the short code proves the caller owns the account's email, so no
+	// current password is required.
+	case request.ShortCode != "":
+		err = consumeShortCodeWithinTx(ctx, service.transactor, service.serviceShortCodeConsume, &ShortCodeConsumeRequest{
+			Usage:  ShortCodeUsageResetPassword,
+			Target: request.UserID.String(),
+			Code:   request.ShortCode,
+		}, func(ctx context.Context, _ *ShortCode) error {
+			return updatePassword(ctx)
+		})
 
-		// Change path: verifying the current password stops someone holding only a live
-		// session from locking the owner out of their own account.
-		case request.CurrentPassword != "":
+	// Change path: verifying the current password stops someone holding only a live
+	// session from locking the owner out of their own account.
+	default:
+		err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
 			credentials, err = service.daoCredentialsSelect.Exec(
 				ctx,
 				&dao.CredentialsSelectRequest{ID: request.UserID},
@@ -112,30 +147,67 @@ func (service *CredentialsUpdatePassword) Exec(
 			if err != nil {
 				return fmt.Errorf("compare current password: %w", err)
 			}
-		}
 
-		credentials, err = service.dao.Exec(ctx, &dao.CredentialsUpdatePasswordRequest{
-			ID:       request.UserID,
-			Password: encryptedPassword,
-			Now:      time.Now(),
+			return updatePassword(ctx)
 		})
-		if err != nil {
-			return fmt.Errorf("update password: %w", err)
-		}
+	}
 
-		return nil
-	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
 	}
 
+	service.wg.Add(1)
+
+	go service.sendMail(
+		context.WithoutCancel(ctx),
+		credentials.Email,
+		lo.CoalesceOrEmpty(credentials.Locale, config.LangDefault),
+	)
+
 	otel.ReportSuccessNoContent(span)
 
 	return &Credentials{
-		ID:        credentials.ID,
-		Email:     credentials.Email,
-		Role:      credentials.Role,
-		CreatedAt: credentials.CreatedAt,
-		UpdatedAt: credentials.UpdatedAt,
+		ID:              credentials.ID,
+		Email:           credentials.Email,
+		Roles:           credentials.Roles,
+		EmailVerifiedAt: credentials.EmailVerifiedAt,
+		Locale:          credentials.Locale,
+		NoticesOptOut:   credentials.NoticesOptOut,
+		LastLoginAt:     credentials.LastLoginAt,
+		CreatedAt:       credentials.CreatedAt,
+		UpdatedAt:       credentials.UpdatedAt,
 	}, nil
 }
+
+func (service *CredentialsUpdatePassword) sendMail(ctx context.Context, email, lang string) {
+	defer service.wg.Done()
+
+	_, span := otel.Tracer().Start(ctx, "service.CredentialsUpdatePassword(sendMail)")
+	defer span.End()
+	defer otel.RecoverPanic(ctx, span)
+
+	span.SetAttributes(
+		attribute.String("user.email", email),
+		attribute.String("email.lang", lang),
+	)
+
+	logger := otel.Logger()
+
+	err := service.smtp.SendMail(
+		smtp.MailUsers{{Email: email}},
+		mails.Mails.PasswordChanged,
+		lang,
+		map[string]any{
+			mails.TemplateVarBanner:  assets.BannerBase64,
+			mails.TemplateVarPurpose: "password-changed",
+		},
+	)
+	if err != nil {
+		logger.ErrorContext(ctx, otel.ReportError(span, err).Error())
+
+		return
+	}
+
+	logger.InfoContext(ctx, "password change notice sent to "+email)
+	otel.ReportSuccessNoContent(span)
+}
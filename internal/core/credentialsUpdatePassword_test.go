@@ -12,13 +12,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
+	"github.com/a-novel-kit/golib/smtp"
 	"github.com/a-novel-kit/golib/transaction/transactiontest"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/lib"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
 )
 
 func TestCredentialsUpdatePassword(t *testing.T) {
@@ -54,6 +58,9 @@ func TestCredentialsUpdatePassword(t *testing.T) {
 		daoCredentialsSelectMock    *daoCredentialsSelectMock
 		daoMock                     *daoMock
 
+		// expectMailLang is the language of the change notice. Empty when no notice is sent.
+		expectMailLang string
+
 		expectErr error
 	}{
 		{
@@ -70,8 +77,12 @@ func TestCredentialsUpdatePassword(t *testing.T) {
 			},
 
 			daoMock: &daoMock{
-				resp: &dao.Credentials{},
+				resp: &dao.Credentials{
+					Email: "user@provider.com",
+				},
 			},
+
+			expectMailLang: config.LangDefault,
 		},
 		{
 			name: "Success/CurrentPassword",
@@ -89,8 +100,13 @@ func TestCredentialsUpdatePassword(t *testing.T) {
 			},
 
 			daoMock: &daoMock{
-				resp: &dao.Credentials{},
+				resp: &dao.Credentials{
+					Email:  "user@provider.com",
+					Locale: config.LangFR,
+				},
 			},
+
+			expectMailLang: config.LangFR,
 		},
 		{
 			name: "Error/UploadCredentials",
@@ -192,8 +208,12 @@ func TestCredentialsUpdatePassword(t *testing.T) {
 			},
 
 			daoMock: &daoMock{
-				resp: &dao.Credentials{},
+				resp: &dao.Credentials{
+					Email: "user@provider.com",
+				},
 			},
+
+			expectMailLang: config.LangDefault,
 		},
 	}
 
@@ -207,6 +227,7 @@ func TestCredentialsUpdatePassword(t *testing.T) {
 				mockDao := coremocks.NewMockCredentialsUpdatePasswordDao(t)
 				daoCredentialsSelect := coremocks.NewMockCredentialsUpdatePasswordDaoCredentialsSelect(t)
 				serviceShortCodeConsume := coremocks.NewMockCredentialsUpdatePasswordServiceShortCodeConsume(t)
+				smtpService := coremocks.NewMockCredentialsUpdatePasswordSmtp(t)
 
 				if testCase.serviceShortCodeConsumeMock != nil {
 					serviceShortCodeConsume.EXPECT().
@@ -242,16 +263,33 @@ func TestCredentialsUpdatePassword(t *testing.T) {
 						Return(testCase.daoMock.resp, testCase.daoMock.err)
 				}
 
+				if testCase.expectMailLang != "" {
+					smtpService.EXPECT().
+						SendMail(
+							smtp.MailUsers{{Email: testCase.daoMock.resp.Email}},
+							mails.Mails.PasswordChanged,
+							testCase.expectMailLang,
+							map[string]any{
+								"Banner":   assets.BannerBase64,
+								"_Purpose": "password-changed",
+							},
+						).
+						Return(nil)
+				}
+
 				service := core.NewCredentialsUpdatePassword(
-					mockDao, daoCredentialsSelect, serviceShortCodeConsume, transactiontest.NewTransactor(),
+					mockDao, daoCredentialsSelect, serviceShortCodeConsume, smtpService, transactiontest.NewTransactor(),
 				)
 
 				_, err = service.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
 
+				service.Wait()
+
 				mockDao.AssertExpectations(t)
 				daoCredentialsSelect.AssertExpectations(t)
 				serviceShortCodeConsume.AssertExpectations(t)
+				smtpService.AssertExpectations(t)
 			})
 		})
 	}
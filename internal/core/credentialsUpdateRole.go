@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
 
-	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 )
 
@@ -21,14 +24,17 @@ var (
 	// self-demotion is never allowed, even for super-admins.
 	ErrCredentialsUpdateRoleSelfUpdate = errors.New("user is not allowed to update its own role")
 	// ErrCredentialsUpdateRoleDowngradeSuperior is returned by
-	// [CredentialsUpdateRole.Exec] when the actor tries to demote a user whose
-	// current role is equal to or higher than the actor's own.
+	// [CredentialsUpdateRole.Exec] when the actor tries to change the roles of a user
+	// whose rank is equal to or higher than the actor's own.
 	ErrCredentialsUpdateRoleDowngradeSuperior = errors.New("user can only downgrade users from a lower role")
 	// ErrCredentialsUpdateRoleToHigher is returned by [CredentialsUpdateRole.Exec]
-	// when the actor tries to promote a user to a role above the actor's own.
+	// when the actor tries to grant a role above the actor's own rank.
 	ErrCredentialsUpdateRoleToHigher = errors.New(
 		"user is not allowed to upgrade users to a higher role than its own",
 	)
+	// ErrCredentialsUpdateRoleNoRole is returned by [CredentialsUpdateRole.Exec] when the
+	// update would leave the target without any role.
+	ErrCredentialsUpdateRoleNoRole = errors.New("user must keep at least one role")
 )
 
 type CredentialsUpdateRoleDao interface {
@@ -39,27 +45,57 @@ type CredentialsUpdateRoleDaoCredentialsSelect interface {
 	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
 }
 
+type CredentialsUpdateRoleDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// CredentialsUpdateRoleRoles ranks roles against the current definitions; satisfied by
+// [RoleRegistry].
+type CredentialsUpdateRoleRoles interface {
+	Priority(role string) (int, error)
+	Rank(roles []string) (int, error)
+}
+
 type CredentialsUpdateRoleRequest struct {
 	TargetUserID  uuid.UUID
 	CurrentUserID uuid.UUID
-	Role          string `validate:"required,role"`
+	// Add lists the roles to grant to the target. Roles it already holds are ignored.
+	// At least one of Add and Remove must be set.
+	Add []string `validate:"max=16,unique,dive,role"`
+	// Remove lists the roles to revoke from the target. Roles it does not hold are ignored.
+	// A role cannot be both added and removed.
+	Remove []string `validate:"max=16,unique,dive,role"`
+	// RequestID identifies the request in the audit trail.
+	RequestID string
 }
 
-// CredentialsUpdateRole changes a target user's role on behalf of an acting user,
-// enforcing the role hierarchy: an actor cannot change its own role, promote anyone
-// above its own rank, or demote a user whose rank is at or above its own.
+// CredentialsUpdateRole grants and revokes roles of a target user on behalf of an acting
+// user, enforcing the role hierarchy on every role: an actor cannot change its own roles,
+// grant anyone a role above its own rank, or change the roles of a user whose rank is at
+// or above its own. The rank of a user is the priority of its highest role.
+//
+// Every change is recorded in the audit trail, in the transaction of the update.
 type CredentialsUpdateRole struct {
 	dao                  CredentialsUpdateRoleDao
 	daoCredentialsSelect CredentialsUpdateRoleDaoCredentialsSelect
+	daoAuditEventInsert  CredentialsUpdateRoleDaoAuditEventInsert
+	transactor           transaction.Transactor
+	roles                CredentialsUpdateRoleRoles
 }
 
 func NewCredentialsUpdateRole(
 	dao CredentialsUpdateRoleDao,
 	daoCredentialsSelect CredentialsUpdateRoleDaoCredentialsSelect,
+	daoAuditEventInsert CredentialsUpdateRoleDaoAuditEventInsert,
+	transactor transaction.Transactor,
+	roles CredentialsUpdateRoleRoles,
 ) *CredentialsUpdateRole {
 	return &CredentialsUpdateRole{
 		dao:                  dao,
 		daoCredentialsSelect: daoCredentialsSelect,
+		daoAuditEventInsert:  daoAuditEventInsert,
+		transactor:           transactor,
+		roles:                roles,
 	}
 }
 
@@ -72,7 +108,8 @@ func (service *CredentialsUpdateRole) Exec(
 	span.SetAttributes(
 		attribute.String("target.id", request.TargetUserID.String()),
 		attribute.String("actor.id", request.CurrentUserID.String()),
-		attribute.String("target.role", request.Role),
+		attribute.StringSlice("target.roles.add", request.Add),
+		attribute.StringSlice("target.roles.remove", request.Remove),
 	)
 
 	err := validate.Struct(request)
@@ -80,101 +117,167 @@ func (service *CredentialsUpdateRole) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	if len(request.Add) == 0 && len(request.Remove) == 0 {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: no role to add or remove", ErrInvalidRequest))
+	}
+
+	if conflicts := lo.Intersect(request.Add, request.Remove); len(conflicts) > 0 {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: roles both added and removed: %v", ErrInvalidRequest, conflicts))
+	}
+
 	if request.CurrentUserID == request.TargetUserID {
 		return nil, otel.ReportError(span, ErrCredentialsUpdateRoleSelfUpdate)
 	}
 
-	// request.Role passed validate:"role", so it is known; the lookup cannot fail.
-	newTargetRoleImportance, err := config.PermissionsConfigDefault.Priority(request.Role)
-	if err != nil {
-		return nil, otel.ReportError(span, err)
-	}
+	var updatedCredentials *dao.Credentials
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Both users are read in the transaction, with their rows locked: a concurrent update cannot
+		// change the roles the checks below rely on before this one commits.
+		selected, err := selectRoleUpdateCredentials(
+			ctx, service.daoCredentialsSelect, request.TargetUserID, request.CurrentUserID,
+		)
+		if err != nil {
+			return err
+		}
+
+		targetCredentials, currentCredentials := selected[0], selected[1]
+
+		span.SetAttributes(
+			attribute.String("targetCredentials.email", targetCredentials.Email),
+			attribute.String("currentCredentials.email", currentCredentials.Email),
+		)
+
+		// Only the roles that change are checked: granting a role the target already holds, or
+		// revoking one it does not, is a no-op rather than a rank violation.
+		added := lo.Without(request.Add, targetCredentials.Roles...)
+		removed := lo.Intersect(request.Remove, targetCredentials.Roles)
+
+		// Same roles as the target already holds: nothing to update.
+		if len(added) == 0 && len(removed) == 0 {
+			span.SetAttributes(attribute.Bool("noop", true))
+
+			updatedCredentials = targetCredentials
+
+			return nil
+		}
+
+		if len(removed) == len(targetCredentials.Roles) && len(added) == 0 {
+			return ErrCredentialsUpdateRoleNoRole
+		}
+
+		err = checkUpdateRoles(service.roles, targetCredentials.Roles, currentCredentials.Roles, added, removed)
+		if err != nil {
+			return err
+		}
 
-	span.SetAttributes(attribute.Int("newTargetRoleImportance", newTargetRoleImportance))
+		updatedCredentials, err = service.dao.Exec(
+			ctx,
+			&dao.CredentialsUpdateRoleRequest{
+				ID:     request.TargetUserID,
+				Add:    added,
+				Remove: removed,
+				Now:    time.Now(),
+			},
+		)
+		if err != nil {
+			return err
+		}
 
-	targetCredentials, err := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{
-		ID: request.TargetUserID,
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			TargetID:  &request.TargetUserID,
+			Action:    AuditActionCredentialsUpdateRole,
+			Before:    &auditCredentialsState{Roles: targetCredentials.Roles},
+			After:     &auditCredentialsState{Roles: updatedCredentials.Roles},
+			RequestID: request.RequestID,
+		})
 	})
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("select target credentials: %w", err))
+		return nil, otel.ReportError(span, err)
 	}
 
-	span.SetAttributes(attribute.String("targetCredentials.email", targetCredentials.Email))
+	return otel.ReportSuccess(span, &Credentials{
+		ID:              updatedCredentials.ID,
+		Email:           updatedCredentials.Email,
+		Roles:           updatedCredentials.Roles,
+		EmailVerifiedAt: updatedCredentials.EmailVerifiedAt,
+		Locale:          updatedCredentials.Locale,
+		NoticesOptOut:   updatedCredentials.NoticesOptOut,
+		LastLoginAt:     updatedCredentials.LastLoginAt,
+		CreatedAt:       updatedCredentials.CreatedAt,
+		UpdatedAt:       updatedCredentials.UpdatedAt,
+	}), nil
+}
 
-	currentCredentials, err := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{
-		ID: request.CurrentUserID,
+// selectRoleUpdateCredentials reads the accounts involved in a role change, locking their rows
+// until the transaction of ctx ends, and returns them in the order of ids. Rows are locked in ID
+// order, so two users changing the roles of each other at once cannot deadlock. An ID listed
+// twice is read once.
+func selectRoleUpdateCredentials(
+	ctx context.Context, daoCredentialsSelect CredentialsUpdateRoleDaoCredentialsSelect, ids ...uuid.UUID,
+) ([]*dao.Credentials, error) {
+	sorted := lo.Uniq(ids)
+	slices.SortFunc(sorted, func(a, b uuid.UUID) int {
+		return strings.Compare(a.String(), b.String())
 	})
-	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("select current user credentials: %w", err))
+
+	selected := make(map[uuid.UUID]*dao.Credentials, len(sorted))
+
+	for _, id := range sorted {
+		credentials, err := daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{ID: id, Lock: true})
+		if err != nil {
+			return nil, fmt.Errorf("select credentials %s: %w", id, err)
+		}
+
+		selected[id] = credentials
 	}
 
-	span.SetAttributes(attribute.String("currentCredentials.email", currentCredentials.Email))
+	return lo.Map(ids, func(id uuid.UUID, _ int) *dao.Credentials { return selected[id] }), nil
+}
 
-	// These two roles come from the database, not the request, so they carry no
-	// validation. A stored role the config no longer knows is an error here, not a
-	// silent priority 0 that would let the rank guards below compare against a rank
-	// the account does not have.
-	targetRoleIImportance, err := config.PermissionsConfigDefault.Priority(targetCredentials.Role)
+// checkUpdateRoles applies the rank rules of [CredentialsUpdateRole] to every role that
+// changes. The target and actor roles come from the database, not the request, so they
+// carry no validation: a stored role the config no longer knows is an error here, not a
+// silent priority 0 that would let the rank guards compare against a rank the account does
+// not have. An added role comes from the request: an unknown one is an invalid request.
+func checkUpdateRoles(roles roleRanker, targetRoles, currentRoles, added, removed []string) error {
+	targetRank, err := roles.Rank(targetRoles)
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("rank target role: %w", err))
+		return fmt.Errorf("rank target roles: %w", err)
 	}
 
-	currentRoleIImportance, err := config.PermissionsConfigDefault.Priority(currentCredentials.Role)
+	currentRank, err := roles.Rank(currentRoles)
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("rank current user role: %w", err))
+		return fmt.Errorf("rank current user roles: %w", err)
 	}
 
-	span.SetAttributes(
-		attribute.Int("targetRoleImportance", targetRoleIImportance),
-		attribute.Int("currentRoleImportance", currentRoleIImportance),
-	)
+	for _, role := range added {
+		priority, err := roles.Priority(role)
+		if err != nil {
+			return errors.Join(err, ErrInvalidRequest)
+		}
 
-	// User can only upgrade users up to its own role.
-	if newTargetRoleImportance >= targetRoleIImportance && newTargetRoleImportance > currentRoleIImportance {
-		return nil, otel.ReportError(span, fmt.Errorf(
-			"%w: upgrade from %s to %s",
-			ErrCredentialsUpdateRoleToHigher, currentCredentials.Role, request.Role,
-		))
-	}
+		// User can only grant roles up to its own rank.
+		if priority > currentRank {
+			return fmt.Errorf("%w: grant %s from %v", ErrCredentialsUpdateRoleToHigher, role, currentRoles)
+		}
 
-	// User can only downgrade users from a lower role.
-	if newTargetRoleImportance <= targetRoleIImportance && targetRoleIImportance >= currentRoleIImportance {
-		return nil, otel.ReportError(span, fmt.Errorf(
-			"%w: downgrade from %s to %s",
-			ErrCredentialsUpdateRoleDowngradeSuperior, currentCredentials.Role, request.Role,
-		))
+		// User can only update users from a lower rank.
+		if targetRank >= currentRank {
+			return fmt.Errorf(
+				"%w: grant %s to %v from %v", ErrCredentialsUpdateRoleDowngradeSuperior, role, targetRoles, currentRoles,
+			)
+		}
 	}
 
-	// Same rank as the target already holds: nothing to update.
-	if newTargetRoleImportance == targetRoleIImportance {
-		span.SetAttributes(attribute.Bool("noop", true))
-
-		return otel.ReportSuccess(span, &Credentials{
-			ID:        targetCredentials.ID,
-			Email:     targetCredentials.Email,
-			Role:      targetCredentials.Role,
-			CreatedAt: targetCredentials.CreatedAt,
-			UpdatedAt: targetCredentials.UpdatedAt,
-		}), nil
+	// Removed roles are held by the target, so they rank at most as high as the target:
+	// checking the target rank covers them.
+	if len(removed) > 0 && targetRank >= currentRank {
+		return fmt.Errorf(
+			"%w: revoke %v from %v by %v", ErrCredentialsUpdateRoleDowngradeSuperior, removed, targetRoles, currentRoles,
+		)
 	}
 
-	updatedCredentials, err := service.dao.Exec(
-		ctx,
-		&dao.CredentialsUpdateRoleRequest{
-			ID:   request.TargetUserID,
-			Role: request.Role,
-			Now:  time.Now(),
-		},
-	)
-	if err != nil {
-		return nil, otel.ReportError(span, err)
-	}
-
-	return otel.ReportSuccess(span, &Credentials{
-		ID:        updatedCredentials.ID,
-		Email:     updatedCredentials.Email,
-		Role:      updatedCredentials.Role,
-		CreatedAt: updatedCredentials.CreatedAt,
-		UpdatedAt: updatedCredentials.UpdatedAt,
-	}), nil
+	return nil
 }
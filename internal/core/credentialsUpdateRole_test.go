@@ -1,15 +1,19 @@
 package core_test
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
 	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
@@ -21,6 +25,9 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 	errFoo := errors.New("foo")
 
+	targetID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
 	type credentialsUpdateRoleMock struct {
 		resp *dao.Credentials
 		err  error
@@ -31,6 +38,27 @@ func TestCredentialsUpdateRole(t *testing.T) {
 		err  error
 	}
 
+	newCredentials := func(id uuid.UUID, roles ...string) *dao.Credentials {
+		return &dao.Credentials{
+			ID:        id,
+			Email:     "target@email.com",
+			Roles:     roles,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	newUpdatedCredentials := func(roles ...string) *dao.Credentials {
+		credentials := newCredentials(targetID, roles...)
+		credentials.UpdatedAt = time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)
+
+		return credentials
+	}
+
+	rolesJSON := func(roles []string) string {
+		return string(lo.Must(json.Marshal(map[string]any{"roles": roles})))
+	}
+
 	testCases := []struct {
 		name string
 
@@ -39,221 +67,256 @@ func TestCredentialsUpdateRole(t *testing.T) {
 		daoCredentialsSelectTargetMock *credentialsSelectMock
 		daoCredentialsSelectCallerMock *credentialsSelectMock
 		credentialsUpdateRoleMock      *credentialsUpdateRoleMock
+		// expectAdd and expectRemove are the roles that change, passed to the DAO.
+		expectAdd    []string
+		expectRemove []string
+		// auditEventInsertErr is returned by the audit trail, which records every successful
+		// update.
+		auditEventInsertErr error
 
 		expect    *core.Credentials
 		expectErr error
 	}{
 		{
-			name: "UpgradeToLowerRole",
+			name: "Add/LowerRole",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
+				RequestID:     "request-1",
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "target@email.com",
-					Role:      config.RoleSuperAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
 
 			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
+				resp: newUpdatedCredentials(config.RoleAdmin, config.RoleUser),
 			},
 
+			expectAdd: []string{config.RoleAdmin},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "target@email.com",
-				Role:      config.RoleAdmin,
+				Roles:     []string{config.RoleAdmin, config.RoleUser},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			},
 		},
 		{
-			name: "UpgradeToSameRole",
+			name: "Add/CallerRank",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "target@email.com",
-					Role:      config.RoleSuperAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleAdmin)},
 
 			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
+				resp: newUpdatedCredentials(config.RoleAdmin, config.RoleUser),
 			},
 
+			expectAdd: []string{config.RoleAdmin},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "target@email.com",
-				Role:      config.RoleAdmin,
+				Roles:     []string{config.RoleAdmin, config.RoleUser},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			},
 		},
 		{
-			name: "UpgradeToHigherRole",
+			name: "Add/HigherThanCaller",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleSuperAdmin,
+				Add:           []string{config.RoleSuperAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleAdmin)},
+
+			expectErr: core.ErrCredentialsUpdateRoleToHigher,
+		},
+		{
+			name: "Add/ToSuperior",
+
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:           []string{config.RoleUser},
 			},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "target@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleAdmin)},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleAdmin)},
+
+			expectErr: core.ErrCredentialsUpdateRoleDowngradeSuperior,
+		},
+		{
+			name: "Remove/FromLower",
+
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Remove:        []string{config.RoleAdmin},
 			},
 
-			expectErr: core.ErrCredentialsUpdateRoleToHigher,
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{
+				resp: newCredentials(targetID, config.RoleAdmin, config.RoleUser),
+			},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
+
+			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{resp: newUpdatedCredentials(config.RoleUser)},
+
+			expectRemove: []string{config.RoleAdmin},
+
+			expect: &core.Credentials{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:     "target@email.com",
+				Roles:     []string{config.RoleUser},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
 		},
 		{
-			name: "DowngradeFromLowerRole",
+			name: "Remove/FromSameRank",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleUser,
+				Remove:        []string{config.RoleUser},
 			},
 
 			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+				resp: newCredentials(targetID, config.RoleAdmin, config.RoleUser),
 			},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "target@email.com",
-					Role:      config.RoleSuperAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleAdmin)},
+
+			expectErr: core.ErrCredentialsUpdateRoleDowngradeSuperior,
+		},
+		{
+			name: "Remove/LastRole",
+
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Remove:        []string{config.RoleUser},
 			},
 
-			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
-				},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
+
+			expectErr: core.ErrCredentialsUpdateRoleNoRole,
+		},
+		{
+			name: "AddAndRemove",
+
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:           []string{config.RoleAdmin},
+				Remove:        []string{config.RoleUser},
 			},
 
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
+
+			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{resp: newUpdatedCredentials(config.RoleAdmin)},
+
+			expectAdd:    []string{config.RoleAdmin},
+			expectRemove: []string{config.RoleUser},
+
 			expect: &core.Credentials{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Email:     "target@email.com",
-				Role:      config.RoleUser,
+				Roles:     []string{config.RoleAdmin},
 				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 			},
 		},
 		{
-			name: "DowngradeFromSameRole",
+			// Granting held roles and revoking missing ones changes nothing, so no rank rule applies.
+			name: "Noop",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleUser,
+				Add:           []string{config.RoleAdmin},
+				Remove:        []string{config.RoleSuperAdmin},
 			},
 
 			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+				resp: newCredentials(targetID, config.RoleAdmin, config.RoleUser),
 			},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "target@email.com",
-					Role:      config.RoleAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
+
+			expect: &core.Credentials{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:     "target@email.com",
+				Roles:     []string{config.RoleAdmin, config.RoleUser},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
+		},
+		{
+			name: "NoChange",
 
-			expectErr: core.ErrCredentialsUpdateRoleDowngradeSuperior,
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			expectErr: core.ErrInvalidRequest,
 		},
+		{
+			name: "AddAndRemoveSameRole",
 
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:           []string{config.RoleAdmin},
+				Remove:        []string{config.RoleAdmin},
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
 		{
 			name: "UnknownRole",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          "foo",
+				Add:           []string{"foo"},
+			},
+
+			// Well-formed, so it passes validation, but no such role is defined.
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "MalformedRole",
+
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:           []string{"auth::admin"},
 			},
 
 			expectErr: core.ErrInvalidRequest,
@@ -264,70 +327,66 @@ func TestCredentialsUpdateRole(t *testing.T) {
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Role:          config.RoleSuperAdmin,
+				Add:           []string{config.RoleSuperAdmin},
 			},
 
 			expectErr: core.ErrCredentialsUpdateRoleSelfUpdate,
 		},
-
 		{
-			name: "UpdateRoleError",
+			name: "AuditEventError",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "target@email.com",
-					Role:      config.RoleSuperAdmin,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
 
 			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{
-				err: errFoo,
+				resp: newUpdatedCredentials(config.RoleAdmin, config.RoleUser),
 			},
 
+			expectAdd: []string{config.RoleAdmin},
+
+			auditEventInsertErr: errFoo,
+
 			expectErr: errFoo,
 		},
 		{
-			name: "SelectCurrentCredentialsError",
+			name: "UpdateRoleError",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "target@email.com",
-					Role:      config.RoleUser,
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				},
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
+
+			credentialsUpdateRoleMock: &credentialsUpdateRoleMock{err: errFoo},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				err: errFoo,
+			expectAdd: []string{config.RoleAdmin},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "SelectCurrentCredentialsError",
+
+			request: &core.CredentialsUpdateRoleRequest{
+				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:           []string{config.RoleAdmin},
 			},
 
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
+
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{err: errFoo},
+
 			expectErr: errFoo,
 		},
 		{
@@ -336,42 +395,28 @@ func TestCredentialsUpdateRole(t *testing.T) {
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				err: errFoo,
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{err: errFoo},
 
 			expectErr: errFoo,
 		},
 		{
-			// The target's stored role is one the config no longer knows — the 'user'
-			// default. Ranking it must error, not silently take priority 0 and let the
-			// hierarchy guards compare against a rank the account does not hold.
+			// The target holds a role the config no longer knows — the 'user' default. Ranking it must
+			// error, not silently take priority 0 and let the hierarchy guards compare against a rank
+			// the account does not hold.
 			name: "TargetHasUnknownStoredRole",
 
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email: "target@email.com",
-					Role:  "user",
-				},
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, "user")},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email: "caller@email.com",
-					Role:  config.RoleSuperAdmin,
-				},
-			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, config.RoleSuperAdmin)},
 
 			expectErr: config.ErrUnknownRole,
 		},
@@ -381,24 +426,12 @@ func TestCredentialsUpdateRole(t *testing.T) {
 			request: &core.CredentialsUpdateRoleRequest{
 				TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role:          config.RoleAdmin,
+				Add:           []string{config.RoleAdmin},
 			},
 
-			daoCredentialsSelectTargetMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email: "target@email.com",
-					Role:  config.RoleUser,
-				},
-			},
+			daoCredentialsSelectTargetMock: &credentialsSelectMock{resp: newCredentials(targetID, config.RoleUser)},
 
-			daoCredentialsSelectCallerMock: &credentialsSelectMock{
-				resp: &dao.Credentials{
-					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email: "caller@email.com",
-					Role:  "user",
-				},
-			},
+			daoCredentialsSelectCallerMock: &credentialsSelectMock{resp: newCredentials(callerID, "user")},
 
 			expectErr: config.ErrUnknownRole,
 		},
@@ -412,11 +445,13 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			mockDao := coremocks.NewMockCredentialsUpdateRoleDao(t)
 			daoCredentialsSelect := coremocks.NewMockCredentialsUpdateRoleDaoCredentialsSelect(t)
+			daoAuditEventInsert := coremocks.NewMockCredentialsUpdateRoleDaoAuditEventInsert(t)
 
 			if testCase.daoCredentialsSelectTargetMock != nil {
 				daoCredentialsSelect.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsSelectRequest{
-						ID: testCase.request.TargetUserID,
+						ID:   testCase.request.TargetUserID,
+						Lock: true,
 					}).
 					Return(
 						testCase.daoCredentialsSelectTargetMock.resp,
@@ -427,7 +462,8 @@ func TestCredentialsUpdateRole(t *testing.T) {
 			if testCase.daoCredentialsSelectCallerMock != nil {
 				daoCredentialsSelect.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsSelectRequest{
-						ID: testCase.request.CurrentUserID,
+						ID:   testCase.request.CurrentUserID,
+						Lock: true,
 					}).
 					Return(
 						testCase.daoCredentialsSelectCallerMock.resp,
@@ -440,7 +476,8 @@ func TestCredentialsUpdateRole(t *testing.T) {
 					Exec(
 						mock.Anything,
 						mock.MatchedBy(func(data *dao.CredentialsUpdateRoleRequest) bool {
-							return assert.Equal(t, testCase.request.Role, data.Role) &&
+							return assert.ElementsMatch(t, testCase.expectAdd, data.Add) &&
+								assert.ElementsMatch(t, testCase.expectRemove, data.Remove) &&
 								assert.WithinDuration(t, time.Now(), data.Now, time.Second) &&
 								assert.Equal(t, testCase.request.TargetUserID, data.ID)
 						}),
@@ -448,7 +485,34 @@ func TestCredentialsUpdateRole(t *testing.T) {
 					Return(testCase.credentialsUpdateRoleMock.resp, testCase.credentialsUpdateRoleMock.err)
 			}
 
-			service := core.NewCredentialsUpdateRole(mockDao, daoCredentialsSelect)
+			if testCase.credentialsUpdateRoleMock != nil && testCase.credentialsUpdateRoleMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(
+						mock.Anything,
+						mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+							return assert.Equal(t, core.AuditActionCredentialsUpdateRole, data.Action) &&
+								assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+								assert.Equal(t, &testCase.request.TargetUserID, data.TargetID) &&
+								assert.JSONEq(t,
+									rolesJSON(testCase.daoCredentialsSelectTargetMock.resp.Roles),
+									string(data.Before),
+								) &&
+								assert.JSONEq(t,
+									rolesJSON(testCase.credentialsUpdateRoleMock.resp.Roles),
+									string(data.After),
+								) &&
+								assert.Equal(t, testCase.request.RequestID, data.RequestID) &&
+								assert.WithinDuration(t, time.Now(), data.Now, time.Second) &&
+								assert.False(t, data.Unchained)
+						}),
+					).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			service := core.NewCredentialsUpdateRole(
+				mockDao, daoCredentialsSelect, daoAuditEventInsert, transactiontest.NewTransactor(),
+				config.PermissionsConfigDefault,
+			)
 
 			resp, err := service.Exec(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
@@ -456,6 +520,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			mockDao.AssertExpectations(t)
 			daoCredentialsSelect.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// ErrCredentialsVerifyEmailChanged is returned by [CredentialsVerifyEmail.Exec] when the
+// account's email changed since the code was sent: the code proves control of the old
+// address only.
+var ErrCredentialsVerifyEmailChanged = errors.New("email changed since the code was sent")
+
+type CredentialsVerifyEmailDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsUpdateEmailVerifiedRequest) (*dao.Credentials, error)
+}
+type CredentialsVerifyEmailDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+type CredentialsVerifyEmailServiceShortCodeConsume interface {
+	Exec(ctx context.Context, request *ShortCodeConsumeRequest) (*ShortCode, error)
+}
+
+type CredentialsVerifyEmailRequest struct {
+	UserID    uuid.UUID
+	ShortCode string `validate:"required,max=1024"`
+}
+
+// CredentialsVerifyEmail marks the email of an account as verified, once the user redeems
+// the code [ShortCodeCreateEmailVerification] sent to it. New tokens carry the verification;
+// existing ones pick it up on their next refresh.
+type CredentialsVerifyEmail struct {
+	dao                     CredentialsVerifyEmailDao
+	daoCredentialsSelect    CredentialsVerifyEmailDaoCredentialsSelect
+	serviceShortCodeConsume CredentialsVerifyEmailServiceShortCodeConsume
+	transactor              transaction.Transactor
+}
+
+func NewCredentialsVerifyEmail(
+	dao CredentialsVerifyEmailDao,
+	daoCredentialsSelect CredentialsVerifyEmailDaoCredentialsSelect,
+	serviceShortCodeConsume CredentialsVerifyEmailServiceShortCodeConsume,
+	transactor transaction.Transactor,
+) *CredentialsVerifyEmail {
+	return &CredentialsVerifyEmail{
+		dao:                     dao,
+		daoCredentialsSelect:    daoCredentialsSelect,
+		serviceShortCodeConsume: serviceShortCodeConsume,
+		transactor:              transactor,
+	}
+}
+
+func (service *CredentialsVerifyEmail) Exec(
+	ctx context.Context, request *CredentialsVerifyEmailRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.CredentialsVerifyEmail")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user.id", request.UserID.String()))
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var credentials *dao.Credentials
+
+	err = consumeShortCodeWithinTx(ctx, service.transactor, service.serviceShortCodeConsume, &ShortCodeConsumeRequest{
+		Usage:  ShortCodeUsageVerifyEmail,
+		Target: request.UserID.String(),
+		Code:   request.ShortCode,
+	}, func(ctx context.Context, shortCode *ShortCode) error {
+		var txErr error
+
+		var sentTo string
+
+		txErr = json.Unmarshal(shortCode.Data, &sentTo)
+		if txErr != nil {
+			return fmt.Errorf("unmarshal short code data: %w", txErr)
+		}
+
+		current, txErr := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{
+			ID: request.UserID,
+		})
+		if txErr != nil {
+			return fmt.Errorf("select credentials: %w", txErr)
+		}
+
+		if current.Email != sentTo {
+			return ErrCredentialsVerifyEmailChanged
+		}
+
+		credentials, txErr = service.dao.Exec(ctx, &dao.CredentialsUpdateEmailVerifiedRequest{
+			ID:  request.UserID,
+			Now: time.Now(),
+		})
+		if txErr != nil {
+			return fmt.Errorf("update email verified: %w", txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, &Credentials{
+		ID:              credentials.ID,
+		Email:           credentials.Email,
+		Roles:           credentials.Roles,
+		EmailVerifiedAt: credentials.EmailVerifiedAt,
+		Locale:          credentials.Locale,
+		NoticesOptOut:   credentials.NoticesOptOut,
+		LastLoginAt:     credentials.LastLoginAt,
+		CreatedAt:       credentials.CreatedAt,
+		UpdatedAt:       credentials.UpdatedAt,
+	}), nil
+}
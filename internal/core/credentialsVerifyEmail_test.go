@@ -0,0 +1,212 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestCredentialsVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type serviceShortCodeConsumeMock struct {
+		resp *core.ShortCode
+		err  error
+	}
+
+	type daoCredentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	type daoMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	verifyCode := &core.ShortCode{
+		Usage:  core.ShortCodeUsageVerifyEmail,
+		Target: userID.String(),
+		Data:   []byte(`"user@provider.com"`),
+	}
+
+	current := &dao.Credentials{
+		ID:        userID,
+		Email:     "user@provider.com",
+		Roles:     []string{config.RoleUser},
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	verified := &dao.Credentials{
+		ID:              userID,
+		Email:           "user@provider.com",
+		Roles:           []string{config.RoleUser},
+		EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+		CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	request := &core.CredentialsVerifyEmailRequest{
+		UserID:    userID,
+		ShortCode: "short-code",
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.CredentialsVerifyEmailRequest
+
+		serviceShortCodeConsumeMock *serviceShortCodeConsumeMock
+		daoCredentialsSelectMock    *daoCredentialsSelectMock
+		daoMock                     *daoMock
+
+		expect    *core.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{resp: verifyCode},
+			daoCredentialsSelectMock:    &daoCredentialsSelectMock{resp: current},
+			daoMock:                     &daoMock{resp: verified},
+
+			expect: &core.Credentials{
+				ID:              userID,
+				Email:           "user@provider.com",
+				Roles:           []string{config.RoleUser},
+				EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/EmailChanged",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{resp: verifyCode},
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{
+					ID:    userID,
+					Email: "new-user@provider.com",
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			expectErr: core.ErrCredentialsVerifyEmailChanged,
+		},
+		{
+			name: "Error/ShortCodeInvalid",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{err: core.ErrShortCodeConsumeInvalid},
+
+			expectErr: core.ErrShortCodeConsumeInvalid,
+		},
+		{
+			name: "Error/ShortCodeTooManyAttempts",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{err: core.ErrShortCodeConsumeTooManyAttempts},
+
+			expectErr: core.ErrShortCodeConsumeTooManyAttempts,
+		},
+		{
+			name: "Error/SelectCredentials",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{resp: verifyCode},
+			daoCredentialsSelectMock:    &daoCredentialsSelectMock{err: dao.ErrCredentialsSelectNotFound},
+
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "Error/Dao",
+
+			request: request,
+
+			serviceShortCodeConsumeMock: &serviceShortCodeConsumeMock{resp: verifyCode},
+			daoCredentialsSelectMock:    &daoCredentialsSelectMock{resp: current},
+			daoMock:                     &daoMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &core.CredentialsVerifyEmailRequest{UserID: userID},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockCredentialsVerifyEmailDao(t)
+			daoCredentialsSelect := coremocks.NewMockCredentialsVerifyEmailDaoCredentialsSelect(t)
+			serviceShortCodeConsume := coremocks.NewMockCredentialsVerifyEmailServiceShortCodeConsume(t)
+
+			if testCase.serviceShortCodeConsumeMock != nil {
+				serviceShortCodeConsume.EXPECT().
+					Exec(mock.Anything, &core.ShortCodeConsumeRequest{
+						Usage:  core.ShortCodeUsageVerifyEmail,
+						Target: testCase.request.UserID.String(),
+						Code:   testCase.request.ShortCode,
+					}).
+					Return(testCase.serviceShortCodeConsumeMock.resp, testCase.serviceShortCodeConsumeMock.err)
+			}
+
+			if testCase.daoCredentialsSelectMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.UserID}).
+					Return(testCase.daoCredentialsSelectMock.resp, testCase.daoCredentialsSelectMock.err)
+			}
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.CredentialsUpdateEmailVerifiedRequest) bool {
+						return assert.Equal(t, testCase.request.UserID, data.ID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Minute)
+					})).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			service := core.NewCredentialsVerifyEmail(
+				mockDao, daoCredentialsSelect, serviceShortCodeConsume, transactiontest.NewTransactor(),
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
+			serviceShortCodeConsume.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// loginEventUserAgentMaxLength bounds the user agent stored with a login event. The header is
+// client-controlled: it is truncated rather than rejected, so an oversized value never fails
+// a sign-in.
+const loginEventUserAgentMaxLength = 512
+
+// LoginEvent is an attempt to obtain a token, as recorded in the login history of a user.
+type LoginEvent struct {
+	ID uuid.UUID
+	// Kind is one of the dao.LoginEventKind* constants.
+	Kind string
+	// Outcome is one of the dao.LoginEventOutcome* constants.
+	Outcome string
+	// Email is the address submitted with a sign-in. Empty for refreshes.
+	Email string
+	// IP and UserAgent identify where the attempt came from, when known.
+	IP        string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+func loadLoginEvent(item *dao.LoginEvent, _ int) *LoginEvent {
+	return &LoginEvent{
+		ID:        item.ID,
+		Kind:      item.Kind,
+		Outcome:   item.Outcome,
+		Email:     item.Email,
+		IP:        item.IP,
+		UserAgent: item.UserAgent,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+// loginEventRecorder is the DAO surface recordLoginEvent needs. Service-level interfaces
+// (e.g. TokenCreateDaoLoginEventInsert) already match this shape.
+type loginEventRecorder interface {
+	Exec(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)
+}
+
+// recordLoginEvent adds an attempt to the login history. The history is informational:
+// a failure is logged, and never fails the attempt it describes.
+func recordLoginEvent(
+	ctx context.Context, recorder loginEventRecorder, userID *uuid.UUID, email, kind, outcome, ip, userAgent string,
+) {
+	ctx, span := otel.Tracer().Start(ctx, "core.recordLoginEvent")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("loginEvent.kind", kind),
+		attribute.String("loginEvent.outcome", outcome),
+	)
+
+	_, err := recorder.Exec(ctx, &dao.LoginEventInsertRequest{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Email:     email,
+		Kind:      kind,
+		Outcome:   outcome,
+		IP:        ip,
+		UserAgent: lo.Substring(userAgent, 0, loginEventUserAgentMaxLength),
+		Now:       time.Now(),
+	})
+	if err != nil {
+		otel.Logger().ErrorContext(ctx, otel.ReportError(span, err).Error())
+
+		return
+	}
+
+	otel.ReportSuccessNoContent(span)
+}
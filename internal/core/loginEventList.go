@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type LoginEventListDao interface {
+	Exec(ctx context.Context, request *dao.LoginEventListRequest) ([]*dao.LoginEvent, error)
+}
+
+type LoginEventListDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type LoginEventListRequest struct {
+	UserID uuid.UUID `validate:"required"`
+	Limit  int       `validate:"required,min=1,max=100"`
+	Offset int       `validate:"min=0"`
+	// CurrentUserID and RequestID identify the lookup in the audit trail, when the caller reads
+	// the history of another account.
+	CurrentUserID uuid.UUID
+	RequestID     string
+}
+
+// LoginEventList returns the login history of an account, newest first. Attempts with an
+// email that matched no account are not tied to any history.
+//
+// Reading the history of another account than the caller's is recorded in the audit trail.
+type LoginEventList struct {
+	dao                 LoginEventListDao
+	daoAuditEventInsert LoginEventListDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewLoginEventList(
+	dao LoginEventListDao,
+	daoAuditEventInsert LoginEventListDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *LoginEventList {
+	return &LoginEventList{
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+func (service *LoginEventList) Exec(
+	ctx context.Context, request *LoginEventListRequest,
+) ([]*LoginEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.LoginEventList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", request.UserID.String()),
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var entities []*dao.LoginEvent
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		entities, err = service.dao.Exec(ctx, &dao.LoginEventListRequest{
+			UserID: request.UserID,
+			Limit:  request.Limit,
+			Offset: request.Offset,
+		})
+		if err != nil {
+			return fmt.Errorf("list login events: %w", err)
+		}
+
+		if request.CurrentUserID == request.UserID {
+			return nil
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			TargetID:  &request.UserID,
+			Action:    AuditActionCredentialsLogins,
+			RequestID: request.RequestID,
+			Read:      true,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("response.count", len(entities)))
+
+	return otel.ReportSuccess(span, lo.Map(entities, loadLoginEvent)), nil
+}
@@ -0,0 +1,233 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestLoginEventList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		resp []*dao.LoginEvent
+		err  error
+	}
+
+	type auditEventInsertMock struct {
+		err error
+	}
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	adminID := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+
+	testCases := []struct {
+		name string
+
+		request *core.LoginEventListRequest
+
+		daoMock *daoMock
+		// auditEventInsertMock is set when the caller reads the history of another account.
+		auditEventInsertMock *auditEventInsertMock
+
+		expect    []*core.LoginEvent
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.LoginEventListRequest{
+				UserID:        userID,
+				Limit:         10,
+				Offset:        5,
+				CurrentUserID: userID,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.LoginEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Kind:      dao.LoginEventKindRefresh,
+						Outcome:   dao.LoginEventOutcomeSuccess,
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						UserID:    lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Email:     "user@email.com",
+						Kind:      dao.LoginEventKindLogin,
+						Outcome:   dao.LoginEventOutcomeInvalidPassword,
+						IP:        "192.0.2.1",
+						UserAgent: "Mozilla/5.0",
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*core.LoginEvent{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+					Kind:      dao.LoginEventKindRefresh,
+					Outcome:   dao.LoginEventOutcomeSuccess,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					Email:     "user@email.com",
+					Kind:      dao.LoginEventKindLogin,
+					Outcome:   dao.LoginEventOutcomeInvalidPassword,
+					IP:        "192.0.2.1",
+					UserAgent: "Mozilla/5.0",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &core.LoginEventListRequest{
+				UserID:        userID,
+				Limit:         10,
+				CurrentUserID: userID,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.LoginEvent{},
+			},
+
+			expect: []*core.LoginEvent{},
+		},
+		{
+			name: "Success/OtherUser",
+
+			request: &core.LoginEventListRequest{
+				UserID:        userID,
+				Limit:         10,
+				CurrentUserID: adminID,
+				RequestID:     "request-1",
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.LoginEvent{},
+			},
+			auditEventInsertMock: &auditEventInsertMock{},
+
+			expect: []*core.LoginEvent{},
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.LoginEventListRequest{
+				UserID:        userID,
+				Limit:         10,
+				CurrentUserID: adminID,
+			},
+
+			daoMock: &daoMock{
+				resp: []*dao.LoginEvent{},
+			},
+			auditEventInsertMock: &auditEventInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/DAO",
+
+			request: &core.LoginEventListRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Limit:  10,
+			},
+
+			daoMock: &daoMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/NoUser",
+
+			request: &core.LoginEventListRequest{
+				Limit: 10,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/LimitTooHigh",
+
+			request: &core.LoginEventListRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Limit:  101,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NoLimit",
+
+			request: &core.LoginEventListRequest{
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			mockDao := coremocks.NewMockLoginEventListDao(t)
+			mockDaoAuditEventInsert := coremocks.NewMockLoginEventListDaoAuditEventInsert(t)
+
+			if testCase.daoMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, &dao.LoginEventListRequest{
+						UserID: testCase.request.UserID,
+						Limit:  testCase.request.Limit,
+						Offset: testCase.request.Offset,
+					}).
+					Return(testCase.daoMock.resp, testCase.daoMock.err)
+			}
+
+			if testCase.auditEventInsertMock != nil {
+				mockDaoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						return assert.Equal(t, core.AuditActionCredentialsLogins, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Equal(t, &testCase.request.UserID, data.TargetID) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID) &&
+							assert.True(t, data.Unchained)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertMock.err)
+			}
+
+			service := core.NewLoginEventList(mockDao, mockDaoAuditEventInsert, transactiontest.NewTransactor())
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			mockDaoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
@@ -16,13 +16,13 @@ import (
 	"google.golang.org/grpc"
 )
 
-// NewMockCredentialsCreateDao creates a new instance of MockCredentialsCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// newMockauditEventRecorder creates a new instance of mockauditEventRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateDao(t interface {
+func newMockauditEventRecorder(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateDao {
-	mock := &MockCredentialsCreateDao{}
+}) *mockauditEventRecorder {
+	mock := &mockauditEventRecorder{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -30,40 +30,40 @@ func NewMockCredentialsCreateDao(t interface {
 	return mock
 }
 
-// MockCredentialsCreateDao is an autogenerated mock type for the CredentialsCreateDao type
-type MockCredentialsCreateDao struct {
+// mockauditEventRecorder is an autogenerated mock type for the auditEventRecorder type
+type mockauditEventRecorder struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateDao_Expecter struct {
+type mockauditEventRecorder_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateDao) EXPECT() *MockCredentialsCreateDao_Expecter {
-	return &MockCredentialsCreateDao_Expecter{mock: &_m.Mock}
+func (_m *mockauditEventRecorder) EXPECT() *mockauditEventRecorder_Expecter {
+	return &mockauditEventRecorder_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateDao
-func (_mock *MockCredentialsCreateDao) Exec(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type mockauditEventRecorder
+func (_mock *mockauditEventRecorder) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Credentials
+	var r0 *dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
+			r0 = ret.Get(0).(*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -71,27 +71,27 @@ func (_mock *MockCredentialsCreateDao) Exec(ctx context.Context, request *dao.Cr
 	return r0, r1
 }
 
-// MockCredentialsCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateDao_Exec_Call struct {
+// mockauditEventRecorder_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type mockauditEventRecorder_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsInsertRequest
-func (_e *MockCredentialsCreateDao_Expecter) Exec(ctx any, request any) *MockCredentialsCreateDao_Exec_Call {
-	return &MockCredentialsCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventInsertRequest
+func (_e *mockauditEventRecorder_Expecter) Exec(ctx any, request any) *mockauditEventRecorder_Exec_Call {
+	return &mockauditEventRecorder_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsInsertRequest)) *MockCredentialsCreateDao_Exec_Call {
+func (_c *mockauditEventRecorder_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *mockauditEventRecorder_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsInsertRequest
+		var arg1 *dao.AuditEventInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsInsertRequest)
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
 		}
 		run(
 			arg0,
@@ -101,23 +101,23 @@ func (_c *MockCredentialsCreateDao_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockCredentialsCreateDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *mockauditEventRecorder_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *mockauditEventRecorder_Exec_Call {
+	_c.Call.Return(auditEvent, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error)) *MockCredentialsCreateDao_Exec_Call {
+func (_c *mockauditEventRecorder_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *mockauditEventRecorder_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsCreateServiceShortCodeConsume creates a new instance of MockCredentialsCreateServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditEventListDao creates a new instance of MockAuditEventListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateServiceShortCodeConsume(t interface {
+func NewMockAuditEventListDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateServiceShortCodeConsume {
-	mock := &MockCredentialsCreateServiceShortCodeConsume{}
+}) *MockAuditEventListDao {
+	mock := &MockAuditEventListDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -125,40 +125,40 @@ func NewMockCredentialsCreateServiceShortCodeConsume(t interface {
 	return mock
 }
 
-// MockCredentialsCreateServiceShortCodeConsume is an autogenerated mock type for the CredentialsCreateServiceShortCodeConsume type
-type MockCredentialsCreateServiceShortCodeConsume struct {
+// MockAuditEventListDao is an autogenerated mock type for the AuditEventListDao type
+type MockAuditEventListDao struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateServiceShortCodeConsume_Expecter struct {
+type MockAuditEventListDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateServiceShortCodeConsume) EXPECT() *MockCredentialsCreateServiceShortCodeConsume_Expecter {
-	return &MockCredentialsCreateServiceShortCodeConsume_Expecter{mock: &_m.Mock}
+func (_m *MockAuditEventListDao) EXPECT() *MockAuditEventListDao_Expecter {
+	return &MockAuditEventListDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateServiceShortCodeConsume
-func (_mock *MockCredentialsCreateServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockAuditEventListDao
+func (_mock *MockAuditEventListDao) Exec(ctx context.Context, request *dao.AuditEventListRequest) ([]*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 []*dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventListRequest) ([]*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventListRequest) []*dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).([]*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -166,27 +166,27 @@ func (_mock *MockCredentialsCreateServiceShortCodeConsume) Exec(ctx context.Cont
 	return r0, r1
 }
 
-// MockCredentialsCreateServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateServiceShortCodeConsume_Exec_Call struct {
+// MockAuditEventListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAuditEventListDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeConsumeRequest
-func (_e *MockCredentialsCreateServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
-	return &MockCredentialsCreateServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventListRequest
+func (_e *MockAuditEventListDao_Expecter) Exec(ctx any, request any) *MockAuditEventListDao_Exec_Call {
+	return &MockAuditEventListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
+func (_c *MockAuditEventListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventListRequest)) *MockAuditEventListDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeConsumeRequest
+		var arg1 *dao.AuditEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+			arg1 = args[1].(*dao.AuditEventListRequest)
 		}
 		run(
 			arg0,
@@ -196,23 +196,23 @@ func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) Run(run func(c
 	return _c
 }
 
-func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockAuditEventListDao_Exec_Call) Return(auditEvents []*dao.AuditEvent, err error) *MockAuditEventListDao_Exec_Call {
+	_c.Call.Return(auditEvents, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
+func (_c *MockAuditEventListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventListRequest) ([]*dao.AuditEvent, error)) *MockAuditEventListDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsCreateServiceSignClaims creates a new instance of MockCredentialsCreateServiceSignClaims. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditEventVerifyDao creates a new instance of MockAuditEventVerifyDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateServiceSignClaims(t interface {
+func NewMockAuditEventVerifyDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateServiceSignClaims {
-	mock := &MockCredentialsCreateServiceSignClaims{}
+}) *MockAuditEventVerifyDao {
+	mock := &MockAuditEventVerifyDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -220,109 +220,94 @@ func NewMockCredentialsCreateServiceSignClaims(t interface {
 	return mock
 }
 
-// MockCredentialsCreateServiceSignClaims is an autogenerated mock type for the CredentialsCreateServiceSignClaims type
-type MockCredentialsCreateServiceSignClaims struct {
+// MockAuditEventVerifyDao is an autogenerated mock type for the AuditEventVerifyDao type
+type MockAuditEventVerifyDao struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateServiceSignClaims_Expecter struct {
+type MockAuditEventVerifyDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateServiceSignClaims) EXPECT() *MockCredentialsCreateServiceSignClaims_Expecter {
-	return &MockCredentialsCreateServiceSignClaims_Expecter{mock: &_m.Mock}
+func (_m *MockAuditEventVerifyDao) EXPECT() *MockAuditEventVerifyDao_Expecter {
+	return &MockAuditEventVerifyDao_Expecter{mock: &_m.Mock}
 }
 
-// ClaimsSign provides a mock function for the type MockCredentialsCreateServiceSignClaims
-func (_mock *MockCredentialsCreateServiceSignClaims) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
-	var tmpRet mock.Arguments
-	if len(opts) > 0 {
-		tmpRet = _mock.Called(ctx, req, opts)
-	} else {
-		tmpRet = _mock.Called(ctx, req)
-	}
-	ret := tmpRet
+// Exec provides a mock function for the type MockAuditEventVerifyDao
+func (_mock *MockAuditEventVerifyDao) Exec(ctx context.Context, request *dao.AuditEventListRequest) ([]*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClaimsSign")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 *servicejsonkeys.ClaimsSignResponse
+	var r0 []*dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
-		return returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventListRequest) ([]*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
-		r0 = returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventListRequest) []*dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
+			r0 = ret.Get(0).([]*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
-		r1 = returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCredentialsCreateServiceSignClaims_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
-type MockCredentialsCreateServiceSignClaims_ClaimsSign_Call struct {
+// MockAuditEventVerifyDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAuditEventVerifyDao_Exec_Call struct {
 	*mock.Call
 }
 
-// ClaimsSign is a helper method to define mock.On call
+// Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.ClaimsSignRequest
-//   - opts ...grpc.CallOption
-func (_e *MockCredentialsCreateServiceSignClaims_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
-	return &MockCredentialsCreateServiceSignClaims_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
-		append([]any{ctx, req}, opts...)...)}
+//   - request *dao.AuditEventListRequest
+func (_e *MockAuditEventVerifyDao_Expecter) Exec(ctx any, request any) *MockAuditEventVerifyDao_Exec_Call {
+	return &MockAuditEventVerifyDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
+func (_c *MockAuditEventVerifyDao_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventListRequest)) *MockAuditEventVerifyDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.ClaimsSignRequest
+		var arg1 *dao.AuditEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
-		}
-		var arg2 []grpc.CallOption
-		var variadicArgs []grpc.CallOption
-		if len(args) > 2 {
-			variadicArgs = args[2].([]grpc.CallOption)
+			arg1 = args[1].(*dao.AuditEventListRequest)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
-	_c.Call.Return(v, err)
+func (_c *MockAuditEventVerifyDao_Exec_Call) Return(auditEvents []*dao.AuditEvent, err error) *MockAuditEventVerifyDao_Exec_Call {
+	_c.Call.Return(auditEvents, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
+func (_c *MockAuditEventVerifyDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventListRequest) ([]*dao.AuditEvent, error)) *MockAuditEventVerifyDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsCreateSuperAdminDao creates a new instance of MockCredentialsCreateSuperAdminDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCanonicalizeEmailsDaoList creates a new instance of MockCredentialsCanonicalizeEmailsDaoList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateSuperAdminDao(t interface {
+func NewMockCredentialsCanonicalizeEmailsDaoList(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateSuperAdminDao {
-	mock := &MockCredentialsCreateSuperAdminDao{}
+}) *MockCredentialsCanonicalizeEmailsDaoList {
+	mock := &MockCredentialsCanonicalizeEmailsDaoList{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -330,40 +315,40 @@ func NewMockCredentialsCreateSuperAdminDao(t interface {
 	return mock
 }
 
-// MockCredentialsCreateSuperAdminDao is an autogenerated mock type for the CredentialsCreateSuperAdminDao type
-type MockCredentialsCreateSuperAdminDao struct {
+// MockCredentialsCanonicalizeEmailsDaoList is an autogenerated mock type for the CredentialsCanonicalizeEmailsDaoList type
+type MockCredentialsCanonicalizeEmailsDaoList struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateSuperAdminDao_Expecter struct {
+type MockCredentialsCanonicalizeEmailsDaoList_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateSuperAdminDao) EXPECT() *MockCredentialsCreateSuperAdminDao_Expecter {
-	return &MockCredentialsCreateSuperAdminDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCanonicalizeEmailsDaoList) EXPECT() *MockCredentialsCanonicalizeEmailsDaoList_Expecter {
+	return &MockCredentialsCanonicalizeEmailsDaoList_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDao
-func (_mock *MockCredentialsCreateSuperAdminDao) Exec(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCanonicalizeEmailsDaoList
+func (_mock *MockCredentialsCanonicalizeEmailsDaoList) Exec(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Credentials
+	var r0 []*dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsListRequest) ([]*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsListRequest) []*dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
+			r0 = ret.Get(0).([]*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -371,27 +356,27 @@ func (_mock *MockCredentialsCreateSuperAdminDao) Exec(ctx context.Context, reque
 	return r0, r1
 }
 
-// MockCredentialsCreateSuperAdminDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateSuperAdminDao_Exec_Call struct {
+// MockCredentialsCanonicalizeEmailsDaoList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCanonicalizeEmailsDaoList_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsInsertRequest
-func (_e *MockCredentialsCreateSuperAdminDao_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDao_Exec_Call {
-	return &MockCredentialsCreateSuperAdminDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsListRequest
+func (_e *MockCredentialsCanonicalizeEmailsDaoList_Expecter) Exec(ctx any, request any) *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call {
+	return &MockCredentialsCanonicalizeEmailsDaoList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsInsertRequest)) *MockCredentialsCreateSuperAdminDao_Exec_Call {
+func (_c *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsListRequest)) *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsInsertRequest
+		var arg1 *dao.CredentialsListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsInsertRequest)
+			arg1 = args[1].(*dao.CredentialsListRequest)
 		}
 		run(
 			arg0,
@@ -401,23 +386,23 @@ func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call) Return(credentialss []*dao.Credentials, err error) *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call {
+	_c.Call.Return(credentialss, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDao_Exec_Call {
+func (_c *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error)) *MockCredentialsCanonicalizeEmailsDaoList_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsCreateSuperAdminDaoSelect creates a new instance of MockCredentialsCreateSuperAdminDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCanonicalizeEmailsDao creates a new instance of MockCredentialsCanonicalizeEmailsDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateSuperAdminDaoSelect(t interface {
+func NewMockCredentialsCanonicalizeEmailsDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateSuperAdminDaoSelect {
-	mock := &MockCredentialsCreateSuperAdminDaoSelect{}
+}) *MockCredentialsCanonicalizeEmailsDao {
+	mock := &MockCredentialsCanonicalizeEmailsDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -425,21 +410,21 @@ func NewMockCredentialsCreateSuperAdminDaoSelect(t interface {
 	return mock
 }
 
-// MockCredentialsCreateSuperAdminDaoSelect is an autogenerated mock type for the CredentialsCreateSuperAdminDaoSelect type
-type MockCredentialsCreateSuperAdminDaoSelect struct {
+// MockCredentialsCanonicalizeEmailsDao is an autogenerated mock type for the CredentialsCanonicalizeEmailsDao type
+type MockCredentialsCanonicalizeEmailsDao struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateSuperAdminDaoSelect_Expecter struct {
+type MockCredentialsCanonicalizeEmailsDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateSuperAdminDaoSelect) EXPECT() *MockCredentialsCreateSuperAdminDaoSelect_Expecter {
-	return &MockCredentialsCreateSuperAdminDaoSelect_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCanonicalizeEmailsDao) EXPECT() *MockCredentialsCanonicalizeEmailsDao_Expecter {
+	return &MockCredentialsCanonicalizeEmailsDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoSelect
-func (_mock *MockCredentialsCreateSuperAdminDaoSelect) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCanonicalizeEmailsDao
+func (_mock *MockCredentialsCanonicalizeEmailsDao) Exec(ctx context.Context, request *dao.CredentialsUpdateEmailCanonicalRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -448,17 +433,17 @@ func (_mock *MockCredentialsCreateSuperAdminDaoSelect) Exec(ctx context.Context,
 
 	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailCanonicalRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailCanonicalRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateEmailCanonicalRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -466,27 +451,27 @@ func (_mock *MockCredentialsCreateSuperAdminDaoSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockCredentialsCreateSuperAdminDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateSuperAdminDaoSelect_Exec_Call struct {
+// MockCredentialsCanonicalizeEmailsDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCanonicalizeEmailsDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsSelectByEmailRequest
-func (_e *MockCredentialsCreateSuperAdminDaoSelect_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
-	return &MockCredentialsCreateSuperAdminDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsUpdateEmailCanonicalRequest
+func (_e *MockCredentialsCanonicalizeEmailsDao_Expecter) Exec(ctx any, request any) *MockCredentialsCanonicalizeEmailsDao_Exec_Call {
+	return &MockCredentialsCanonicalizeEmailsDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
+func (_c *MockCredentialsCanonicalizeEmailsDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateEmailCanonicalRequest)) *MockCredentialsCanonicalizeEmailsDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsSelectByEmailRequest
+		var arg1 *dao.CredentialsUpdateEmailCanonicalRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+			arg1 = args[1].(*dao.CredentialsUpdateEmailCanonicalRequest)
 		}
 		run(
 			arg0,
@@ -496,23 +481,23 @@ func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
+func (_c *MockCredentialsCanonicalizeEmailsDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCanonicalizeEmailsDao_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
+func (_c *MockCredentialsCanonicalizeEmailsDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateEmailCanonicalRequest) (*dao.Credentials, error)) *MockCredentialsCanonicalizeEmailsDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsCreateSuperAdminDaoUpdatePassword creates a new instance of MockCredentialsCreateSuperAdminDaoUpdatePassword. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateDao creates a new instance of MockCredentialsCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateSuperAdminDaoUpdatePassword(t interface {
+func NewMockCredentialsCreateDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateSuperAdminDaoUpdatePassword {
-	mock := &MockCredentialsCreateSuperAdminDaoUpdatePassword{}
+}) *MockCredentialsCreateDao {
+	mock := &MockCredentialsCreateDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -520,21 +505,21 @@ func NewMockCredentialsCreateSuperAdminDaoUpdatePassword(t interface {
 	return mock
 }
 
-// MockCredentialsCreateSuperAdminDaoUpdatePassword is an autogenerated mock type for the CredentialsCreateSuperAdminDaoUpdatePassword type
-type MockCredentialsCreateSuperAdminDaoUpdatePassword struct {
+// MockCredentialsCreateDao is an autogenerated mock type for the CredentialsCreateDao type
+type MockCredentialsCreateDao struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter struct {
+type MockCredentialsCreateDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateSuperAdminDaoUpdatePassword) EXPECT() *MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter {
-	return &MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateDao) EXPECT() *MockCredentialsCreateDao_Expecter {
+	return &MockCredentialsCreateDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoUpdatePassword
-func (_mock *MockCredentialsCreateSuperAdminDaoUpdatePassword) Exec(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateDao
+func (_mock *MockCredentialsCreateDao) Exec(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -543,17 +528,17 @@ func (_mock *MockCredentialsCreateSuperAdminDaoUpdatePassword) Exec(ctx context.
 
 	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -561,27 +546,27 @@ func (_mock *MockCredentialsCreateSuperAdminDaoUpdatePassword) Exec(ctx context.
 	return r0, r1
 }
 
-// MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call struct {
+// MockCredentialsCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsUpdatePasswordRequest
-func (_e *MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
-	return &MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsInsertRequest
+func (_e *MockCredentialsCreateDao_Expecter) Exec(ctx any, request any) *MockCredentialsCreateDao_Exec_Call {
+	return &MockCredentialsCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest)) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
+func (_c *MockCredentialsCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsInsertRequest)) *MockCredentialsCreateDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsUpdatePasswordRequest
+		var arg1 *dao.CredentialsInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsUpdatePasswordRequest)
+			arg1 = args[1].(*dao.CredentialsInsertRequest)
 		}
 		run(
 			arg0,
@@ -591,23 +576,23 @@ func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) Run(run fu
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
+func (_c *MockCredentialsCreateDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateDao_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
+func (_c *MockCredentialsCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error)) *MockCredentialsCreateDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsCreateSuperAdminDaoUpdateRole creates a new instance of MockCredentialsCreateSuperAdminDaoUpdateRole. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateServiceShortCodeConsume creates a new instance of MockCredentialsCreateServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateSuperAdminDaoUpdateRole(t interface {
+func NewMockCredentialsCreateServiceShortCodeConsume(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateSuperAdminDaoUpdateRole {
-	mock := &MockCredentialsCreateSuperAdminDaoUpdateRole{}
+}) *MockCredentialsCreateServiceShortCodeConsume {
+	mock := &MockCredentialsCreateServiceShortCodeConsume{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -615,40 +600,40 @@ func NewMockCredentialsCreateSuperAdminDaoUpdateRole(t interface {
 	return mock
 }
 
-// MockCredentialsCreateSuperAdminDaoUpdateRole is an autogenerated mock type for the CredentialsCreateSuperAdminDaoUpdateRole type
-type MockCredentialsCreateSuperAdminDaoUpdateRole struct {
+// MockCredentialsCreateServiceShortCodeConsume is an autogenerated mock type for the CredentialsCreateServiceShortCodeConsume type
+type MockCredentialsCreateServiceShortCodeConsume struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter struct {
+type MockCredentialsCreateServiceShortCodeConsume_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateSuperAdminDaoUpdateRole) EXPECT() *MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter {
-	return &MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateServiceShortCodeConsume) EXPECT() *MockCredentialsCreateServiceShortCodeConsume_Expecter {
+	return &MockCredentialsCreateServiceShortCodeConsume_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoUpdateRole
-func (_mock *MockCredentialsCreateSuperAdminDaoUpdateRole) Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateServiceShortCodeConsume
+func (_mock *MockCredentialsCreateServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Credentials
+	var r0 *core.ShortCode
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
+			r0 = ret.Get(0).(*core.ShortCode)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateRoleRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -656,27 +641,27 @@ func (_mock *MockCredentialsCreateSuperAdminDaoUpdateRole) Exec(ctx context.Cont
 	return r0, r1
 }
 
-// MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call struct {
+// MockCredentialsCreateServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateServiceShortCodeConsume_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsUpdateRoleRequest
-func (_e *MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
-	return &MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
-}
+//   - request *core.ShortCodeConsumeRequest
+func (_e *MockCredentialsCreateServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
+	return &MockCredentialsCreateServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
 
-func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest)) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
+func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsUpdateRoleRequest
+		var arg1 *core.ShortCodeConsumeRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsUpdateRoleRequest)
+			arg1 = args[1].(*core.ShortCodeConsumeRequest)
 		}
 		run(
 			arg0,
@@ -686,23 +671,23 @@ func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) Run(run func(c
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(shortCode, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
+func (_c *MockCredentialsCreateServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsCreateServiceShortCodeConsume_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsExistDao creates a new instance of MockCredentialsExistDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateServiceSignClaims creates a new instance of MockCredentialsCreateServiceSignClaims. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsExistDao(t interface {
+func NewMockCredentialsCreateServiceSignClaims(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsExistDao {
-	mock := &MockCredentialsExistDao{}
+}) *MockCredentialsCreateServiceSignClaims {
+	mock := &MockCredentialsCreateServiceSignClaims{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -710,92 +695,109 @@ func NewMockCredentialsExistDao(t interface {
 	return mock
 }
 
-// MockCredentialsExistDao is an autogenerated mock type for the CredentialsExistDao type
-type MockCredentialsExistDao struct {
+// MockCredentialsCreateServiceSignClaims is an autogenerated mock type for the CredentialsCreateServiceSignClaims type
+type MockCredentialsCreateServiceSignClaims struct {
 	mock.Mock
 }
 
-type MockCredentialsExistDao_Expecter struct {
+type MockCredentialsCreateServiceSignClaims_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsExistDao) EXPECT() *MockCredentialsExistDao_Expecter {
-	return &MockCredentialsExistDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateServiceSignClaims) EXPECT() *MockCredentialsCreateServiceSignClaims_Expecter {
+	return &MockCredentialsCreateServiceSignClaims_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsExistDao
-func (_mock *MockCredentialsExistDao) Exec(ctx context.Context, request *dao.CredentialsExistRequest) (bool, error) {
-	ret := _mock.Called(ctx, request)
+// ClaimsSign provides a mock function for the type MockCredentialsCreateServiceSignClaims
+func (_mock *MockCredentialsCreateServiceSignClaims) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exec")
+		panic("no return value specified for ClaimsSign")
 	}
 
-	var r0 bool
+	var r0 *servicejsonkeys.ClaimsSignResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsExistRequest) (bool, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsExistRequest) bool); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsExistRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCredentialsExistDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsExistDao_Exec_Call struct {
+// MockCredentialsCreateServiceSignClaims_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
+type MockCredentialsCreateServiceSignClaims_ClaimsSign_Call struct {
 	*mock.Call
 }
 
-// Exec is a helper method to define mock.On call
+// ClaimsSign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsExistRequest
-func (_e *MockCredentialsExistDao_Expecter) Exec(ctx any, request any) *MockCredentialsExistDao_Exec_Call {
-	return &MockCredentialsExistDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - req *servicejsonkeys.ClaimsSignRequest
+//   - opts ...grpc.CallOption
+func (_e *MockCredentialsCreateServiceSignClaims_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
+	return &MockCredentialsCreateServiceSignClaims_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
+		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockCredentialsExistDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsExistRequest)) *MockCredentialsExistDao_Exec_Call {
+func (_c *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsExistRequest
+		var arg1 *servicejsonkeys.ClaimsSignRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsExistRequest)
+			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
 		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
+			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCredentialsExistDao_Exec_Call) Return(b bool, err error) *MockCredentialsExistDao_Exec_Call {
-	_c.Call.Return(b, err)
+func (_c *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockCredentialsExistDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsExistRequest) (bool, error)) *MockCredentialsExistDao_Exec_Call {
+func (_c *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockCredentialsCreateServiceSignClaims_ClaimsSign_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsGetDao creates a new instance of MockCredentialsGetDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateInviteDao creates a new instance of MockCredentialsCreateInviteDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsGetDao(t interface {
+func NewMockCredentialsCreateInviteDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsGetDao {
-	mock := &MockCredentialsGetDao{}
+}) *MockCredentialsCreateInviteDao {
+	mock := &MockCredentialsCreateInviteDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -803,21 +805,21 @@ func NewMockCredentialsGetDao(t interface {
 	return mock
 }
 
-// MockCredentialsGetDao is an autogenerated mock type for the CredentialsGetDao type
-type MockCredentialsGetDao struct {
+// MockCredentialsCreateInviteDao is an autogenerated mock type for the CredentialsCreateInviteDao type
+type MockCredentialsCreateInviteDao struct {
 	mock.Mock
 }
 
-type MockCredentialsGetDao_Expecter struct {
+type MockCredentialsCreateInviteDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsGetDao) EXPECT() *MockCredentialsGetDao_Expecter {
-	return &MockCredentialsGetDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateInviteDao) EXPECT() *MockCredentialsCreateInviteDao_Expecter {
+	return &MockCredentialsCreateInviteDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsGetDao
-func (_mock *MockCredentialsGetDao) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateInviteDao
+func (_mock *MockCredentialsCreateInviteDao) Exec(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -826,17 +828,17 @@ func (_mock *MockCredentialsGetDao) Exec(ctx context.Context, request *dao.Crede
 
 	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -844,27 +846,27 @@ func (_mock *MockCredentialsGetDao) Exec(ctx context.Context, request *dao.Crede
 	return r0, r1
 }
 
-// MockCredentialsGetDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsGetDao_Exec_Call struct {
+// MockCredentialsCreateInviteDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateInviteDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsSelectRequest
-func (_e *MockCredentialsGetDao_Expecter) Exec(ctx any, request any) *MockCredentialsGetDao_Exec_Call {
-	return &MockCredentialsGetDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsInsertRequest
+func (_e *MockCredentialsCreateInviteDao_Expecter) Exec(ctx any, request any) *MockCredentialsCreateInviteDao_Exec_Call {
+	return &MockCredentialsCreateInviteDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsGetDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsGetDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsInsertRequest)) *MockCredentialsCreateInviteDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsSelectRequest
+		var arg1 *dao.CredentialsInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectRequest)
+			arg1 = args[1].(*dao.CredentialsInsertRequest)
 		}
 		run(
 			arg0,
@@ -874,23 +876,23 @@ func (_c *MockCredentialsGetDao_Exec_Call) Run(run func(ctx context.Context, req
 	return _c
 }
 
-func (_c *MockCredentialsGetDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsGetDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateInviteDao_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsGetDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsGetDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error)) *MockCredentialsCreateInviteDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsListDao creates a new instance of MockCredentialsListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateInviteDaoCredentialsSelect creates a new instance of MockCredentialsCreateInviteDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsListDao(t interface {
+func NewMockCredentialsCreateInviteDaoCredentialsSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsListDao {
-	mock := &MockCredentialsListDao{}
+}) *MockCredentialsCreateInviteDaoCredentialsSelect {
+	mock := &MockCredentialsCreateInviteDaoCredentialsSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -898,40 +900,40 @@ func NewMockCredentialsListDao(t interface {
 	return mock
 }
 
-// MockCredentialsListDao is an autogenerated mock type for the CredentialsListDao type
-type MockCredentialsListDao struct {
+// MockCredentialsCreateInviteDaoCredentialsSelect is an autogenerated mock type for the CredentialsCreateInviteDaoCredentialsSelect type
+type MockCredentialsCreateInviteDaoCredentialsSelect struct {
 	mock.Mock
 }
 
-type MockCredentialsListDao_Expecter struct {
+type MockCredentialsCreateInviteDaoCredentialsSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsListDao) EXPECT() *MockCredentialsListDao_Expecter {
-	return &MockCredentialsListDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateInviteDaoCredentialsSelect) EXPECT() *MockCredentialsCreateInviteDaoCredentialsSelect_Expecter {
+	return &MockCredentialsCreateInviteDaoCredentialsSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsListDao
-func (_mock *MockCredentialsListDao) Exec(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateInviteDaoCredentialsSelect
+func (_mock *MockCredentialsCreateInviteDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.Credentials
+	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsListRequest) ([]*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsListRequest) []*dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Credentials)
+			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsListRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -939,27 +941,27 @@ func (_mock *MockCredentialsListDao) Exec(ctx context.Context, request *dao.Cred
 	return r0, r1
 }
 
-// MockCredentialsListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsListDao_Exec_Call struct {
+// MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsListRequest
-func (_e *MockCredentialsListDao_Expecter) Exec(ctx any, request any) *MockCredentialsListDao_Exec_Call {
-	return &MockCredentialsListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsCreateInviteDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsListRequest)) *MockCredentialsListDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsListRequest
+		var arg1 *dao.CredentialsSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsListRequest)
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
 		}
 		run(
 			arg0,
@@ -969,23 +971,23 @@ func (_c *MockCredentialsListDao_Exec_Call) Run(run func(ctx context.Context, re
 	return _c
 }
 
-func (_c *MockCredentialsListDao_Exec_Call) Return(credentialss []*dao.Credentials, err error) *MockCredentialsListDao_Exec_Call {
-	_c.Call.Return(credentialss, err)
+func (_c *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error)) *MockCredentialsListDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsCreateInviteDaoCredentialsSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdateEmailDao creates a new instance of MockCredentialsUpdateEmailDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateInviteServiceShortCodeConsume creates a new instance of MockCredentialsCreateInviteServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdateEmailDao(t interface {
+func NewMockCredentialsCreateInviteServiceShortCodeConsume(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdateEmailDao {
-	mock := &MockCredentialsUpdateEmailDao{}
+}) *MockCredentialsCreateInviteServiceShortCodeConsume {
+	mock := &MockCredentialsCreateInviteServiceShortCodeConsume{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -993,40 +995,40 @@ func NewMockCredentialsUpdateEmailDao(t interface {
 	return mock
 }
 
-// MockCredentialsUpdateEmailDao is an autogenerated mock type for the CredentialsUpdateEmailDao type
-type MockCredentialsUpdateEmailDao struct {
+// MockCredentialsCreateInviteServiceShortCodeConsume is an autogenerated mock type for the CredentialsCreateInviteServiceShortCodeConsume type
+type MockCredentialsCreateInviteServiceShortCodeConsume struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdateEmailDao_Expecter struct {
+type MockCredentialsCreateInviteServiceShortCodeConsume_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdateEmailDao) EXPECT() *MockCredentialsUpdateEmailDao_Expecter {
-	return &MockCredentialsUpdateEmailDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateInviteServiceShortCodeConsume) EXPECT() *MockCredentialsCreateInviteServiceShortCodeConsume_Expecter {
+	return &MockCredentialsCreateInviteServiceShortCodeConsume_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdateEmailDao
-func (_mock *MockCredentialsUpdateEmailDao) Exec(ctx context.Context, request *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateInviteServiceShortCodeConsume
+func (_mock *MockCredentialsCreateInviteServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Credentials
+	var r0 *core.ShortCode
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
+			r0 = ret.Get(0).(*core.ShortCode)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateEmailRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1034,27 +1036,27 @@ func (_mock *MockCredentialsUpdateEmailDao) Exec(ctx context.Context, request *d
 	return r0, r1
 }
 
-// MockCredentialsUpdateEmailDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdateEmailDao_Exec_Call struct {
+// MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsUpdateEmailRequest
-func (_e *MockCredentialsUpdateEmailDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailDao_Exec_Call {
-	return &MockCredentialsUpdateEmailDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.ShortCodeConsumeRequest
+func (_e *MockCredentialsCreateInviteServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call {
+	return &MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdateEmailDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateEmailRequest)) *MockCredentialsUpdateEmailDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsUpdateEmailRequest
+		var arg1 *core.ShortCodeConsumeRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsUpdateEmailRequest)
+			arg1 = args[1].(*core.ShortCodeConsumeRequest)
 		}
 		run(
 			arg0,
@@ -1064,23 +1066,23 @@ func (_c *MockCredentialsUpdateEmailDao_Exec_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockCredentialsUpdateEmailDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateEmailDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(shortCode, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdateEmailDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error)) *MockCredentialsUpdateEmailDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsCreateInviteServiceShortCodeConsume_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdateEmailServiceShortCodeConsume creates a new instance of MockCredentialsUpdateEmailServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateInviteServiceSignClaims creates a new instance of MockCredentialsCreateInviteServiceSignClaims. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdateEmailServiceShortCodeConsume(t interface {
+func NewMockCredentialsCreateInviteServiceSignClaims(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdateEmailServiceShortCodeConsume {
-	mock := &MockCredentialsUpdateEmailServiceShortCodeConsume{}
+}) *MockCredentialsCreateInviteServiceSignClaims {
+	mock := &MockCredentialsCreateInviteServiceSignClaims{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1088,94 +1090,109 @@ func NewMockCredentialsUpdateEmailServiceShortCodeConsume(t interface {
 	return mock
 }
 
-// MockCredentialsUpdateEmailServiceShortCodeConsume is an autogenerated mock type for the CredentialsUpdateEmailServiceShortCodeConsume type
-type MockCredentialsUpdateEmailServiceShortCodeConsume struct {
+// MockCredentialsCreateInviteServiceSignClaims is an autogenerated mock type for the CredentialsCreateInviteServiceSignClaims type
+type MockCredentialsCreateInviteServiceSignClaims struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter struct {
+type MockCredentialsCreateInviteServiceSignClaims_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdateEmailServiceShortCodeConsume) EXPECT() *MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter {
-	return &MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateInviteServiceSignClaims) EXPECT() *MockCredentialsCreateInviteServiceSignClaims_Expecter {
+	return &MockCredentialsCreateInviteServiceSignClaims_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdateEmailServiceShortCodeConsume
-func (_mock *MockCredentialsUpdateEmailServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
-	ret := _mock.Called(ctx, request)
+// ClaimsSign provides a mock function for the type MockCredentialsCreateInviteServiceSignClaims
+func (_mock *MockCredentialsCreateInviteServiceSignClaims) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exec")
+		panic("no return value specified for ClaimsSign")
 	}
 
-	var r0 *core.ShortCode
+	var r0 *servicejsonkeys.ClaimsSignResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call struct {
+// MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
+type MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call struct {
 	*mock.Call
 }
 
-// Exec is a helper method to define mock.On call
+// ClaimsSign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeConsumeRequest
-func (_e *MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
-	return &MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - req *servicejsonkeys.ClaimsSignRequest
+//   - opts ...grpc.CallOption
+func (_e *MockCredentialsCreateInviteServiceSignClaims_Expecter) ClaimsSign(ctx any, req any, opts ...any) *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call {
+	return &MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
+		append([]any{ctx, req}, opts...)...)}
 }
 
-func (_c *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
+func (_c *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeConsumeRequest
+		var arg1 *servicejsonkeys.ClaimsSignRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
 		}
-		run(
-			arg0,
-			arg1,
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
+func (_c *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *MockCredentialsCreateInviteServiceSignClaims_ClaimsSign_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdatePasswordDao creates a new instance of MockCredentialsUpdatePasswordDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateInviteRoles creates a new instance of MockCredentialsCreateInviteRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdatePasswordDao(t interface {
+func NewMockCredentialsCreateInviteRoles(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdatePasswordDao {
-	mock := &MockCredentialsUpdatePasswordDao{}
+}) *MockCredentialsCreateInviteRoles {
+	mock := &MockCredentialsCreateInviteRoles{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1183,189 +1200,146 @@ func NewMockCredentialsUpdatePasswordDao(t interface {
 	return mock
 }
 
-// MockCredentialsUpdatePasswordDao is an autogenerated mock type for the CredentialsUpdatePasswordDao type
-type MockCredentialsUpdatePasswordDao struct {
+// MockCredentialsCreateInviteRoles is an autogenerated mock type for the CredentialsCreateInviteRoles type
+type MockCredentialsCreateInviteRoles struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdatePasswordDao_Expecter struct {
+type MockCredentialsCreateInviteRoles_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdatePasswordDao) EXPECT() *MockCredentialsUpdatePasswordDao_Expecter {
-	return &MockCredentialsUpdatePasswordDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateInviteRoles) EXPECT() *MockCredentialsCreateInviteRoles_Expecter {
+	return &MockCredentialsCreateInviteRoles_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdatePasswordDao
-func (_mock *MockCredentialsUpdatePasswordDao) Exec(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error) {
-	ret := _mock.Called(ctx, request)
+// Priority provides a mock function for the type MockCredentialsCreateInviteRoles
+func (_mock *MockCredentialsCreateInviteRoles) Priority(role string) (int, error) {
+	ret := _mock.Called(role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exec")
+		panic("no return value specified for Priority")
 	}
 
-	var r0 *dao.Credentials
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(role)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) *dao.Credentials); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(role)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(role)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCredentialsUpdatePasswordDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdatePasswordDao_Exec_Call struct {
+// MockCredentialsCreateInviteRoles_Priority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Priority'
+type MockCredentialsCreateInviteRoles_Priority_Call struct {
 	*mock.Call
 }
 
-// Exec is a helper method to define mock.On call
-//   - ctx context.Context
-//   - request *dao.CredentialsUpdatePasswordRequest
-func (_e *MockCredentialsUpdatePasswordDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordDao_Exec_Call {
-	return &MockCredentialsUpdatePasswordDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+// Priority is a helper method to define mock.On call
+//   - role string
+func (_e *MockCredentialsCreateInviteRoles_Expecter) Priority(role any) *MockCredentialsCreateInviteRoles_Priority_Call {
+	return &MockCredentialsCreateInviteRoles_Priority_Call{Call: _e.mock.On("Priority", role)}
 }
 
-func (_c *MockCredentialsUpdatePasswordDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest)) *MockCredentialsUpdatePasswordDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteRoles_Priority_Call) Run(run func(role string)) *MockCredentialsCreateInviteRoles_Priority_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 *dao.CredentialsUpdatePasswordRequest
-		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsUpdatePasswordRequest)
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdatePasswordDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsCreateInviteRoles_Priority_Call) Return(n int, err error) *MockCredentialsCreateInviteRoles_Priority_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)) *MockCredentialsUpdatePasswordDao_Exec_Call {
+func (_c *MockCredentialsCreateInviteRoles_Priority_Call) RunAndReturn(run func(role string) (int, error)) *MockCredentialsCreateInviteRoles_Priority_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdatePasswordDaoCredentialsSelect creates a new instance of MockCredentialsUpdatePasswordDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdatePasswordDaoCredentialsSelect(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockCredentialsUpdatePasswordDaoCredentialsSelect {
-	mock := &MockCredentialsUpdatePasswordDaoCredentialsSelect{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockCredentialsUpdatePasswordDaoCredentialsSelect is an autogenerated mock type for the CredentialsUpdatePasswordDaoCredentialsSelect type
-type MockCredentialsUpdatePasswordDaoCredentialsSelect struct {
-	mock.Mock
-}
-
-type MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockCredentialsUpdatePasswordDaoCredentialsSelect) EXPECT() *MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter {
-	return &MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter{mock: &_m.Mock}
-}
-
-// Exec provides a mock function for the type MockCredentialsUpdatePasswordDaoCredentialsSelect
-func (_mock *MockCredentialsUpdatePasswordDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
-	ret := _mock.Called(ctx, request)
+// Rank provides a mock function for the type MockCredentialsCreateInviteRoles
+func (_mock *MockCredentialsCreateInviteRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exec")
+		panic("no return value specified for Rank")
 	}
 
-	var r0 *dao.Credentials
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call struct {
+// MockCredentialsCreateInviteRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockCredentialsCreateInviteRoles_Rank_Call struct {
 	*mock.Call
 }
 
-// Exec is a helper method to define mock.On call
-//   - ctx context.Context
-//   - request *dao.CredentialsSelectRequest
-func (_e *MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
-	return &MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockCredentialsCreateInviteRoles_Expecter) Rank(roles any) *MockCredentialsCreateInviteRoles_Rank_Call {
+	return &MockCredentialsCreateInviteRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
 }
 
-func (_c *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
+func (_c *MockCredentialsCreateInviteRoles_Rank_Call) Run(run func(roles []string)) *MockCredentialsCreateInviteRoles_Rank_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 []string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 *dao.CredentialsSelectRequest
-		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectRequest)
+			arg0 = args[0].([]string)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsCreateInviteRoles_Rank_Call) Return(n int, err error) *MockCredentialsCreateInviteRoles_Rank_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
+func (_c *MockCredentialsCreateInviteRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockCredentialsCreateInviteRoles_Rank_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdatePasswordServiceShortCodeConsume creates a new instance of MockCredentialsUpdatePasswordServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateSuperAdminDao creates a new instance of MockCredentialsCreateSuperAdminDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdatePasswordServiceShortCodeConsume(t interface {
+func NewMockCredentialsCreateSuperAdminDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdatePasswordServiceShortCodeConsume {
-	mock := &MockCredentialsUpdatePasswordServiceShortCodeConsume{}
+}) *MockCredentialsCreateSuperAdminDao {
+	mock := &MockCredentialsCreateSuperAdminDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1373,40 +1347,40 @@ func NewMockCredentialsUpdatePasswordServiceShortCodeConsume(t interface {
 	return mock
 }
 
-// MockCredentialsUpdatePasswordServiceShortCodeConsume is an autogenerated mock type for the CredentialsUpdatePasswordServiceShortCodeConsume type
-type MockCredentialsUpdatePasswordServiceShortCodeConsume struct {
+// MockCredentialsCreateSuperAdminDao is an autogenerated mock type for the CredentialsCreateSuperAdminDao type
+type MockCredentialsCreateSuperAdminDao struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter struct {
+type MockCredentialsCreateSuperAdminDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdatePasswordServiceShortCodeConsume) EXPECT() *MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter {
-	return &MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateSuperAdminDao) EXPECT() *MockCredentialsCreateSuperAdminDao_Expecter {
+	return &MockCredentialsCreateSuperAdminDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdatePasswordServiceShortCodeConsume
-func (_mock *MockCredentialsUpdatePasswordServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDao
+func (_mock *MockCredentialsCreateSuperAdminDao) Exec(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsInsertRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1414,27 +1388,27 @@ func (_mock *MockCredentialsUpdatePasswordServiceShortCodeConsume) Exec(ctx cont
 	return r0, r1
 }
 
-// MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call struct {
+// MockCredentialsCreateSuperAdminDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateSuperAdminDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeConsumeRequest
-func (_e *MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
-	return &MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsInsertRequest
+func (_e *MockCredentialsCreateSuperAdminDao_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDao_Exec_Call {
+	return &MockCredentialsCreateSuperAdminDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsInsertRequest)) *MockCredentialsCreateSuperAdminDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeConsumeRequest
+		var arg1 *dao.CredentialsInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+			arg1 = args[1].(*dao.CredentialsInsertRequest)
 		}
 		run(
 			arg0,
@@ -1444,23 +1418,23 @@ func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) Run(ru
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDao_Exec_Call {
+	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsInsertRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdateRoleDao creates a new instance of MockCredentialsUpdateRoleDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateSuperAdminDaoSelect creates a new instance of MockCredentialsCreateSuperAdminDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdateRoleDao(t interface {
+func NewMockCredentialsCreateSuperAdminDaoSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdateRoleDao {
-	mock := &MockCredentialsUpdateRoleDao{}
+}) *MockCredentialsCreateSuperAdminDaoSelect {
+	mock := &MockCredentialsCreateSuperAdminDaoSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1468,21 +1442,21 @@ func NewMockCredentialsUpdateRoleDao(t interface {
 	return mock
 }
 
-// MockCredentialsUpdateRoleDao is an autogenerated mock type for the CredentialsUpdateRoleDao type
-type MockCredentialsUpdateRoleDao struct {
+// MockCredentialsCreateSuperAdminDaoSelect is an autogenerated mock type for the CredentialsCreateSuperAdminDaoSelect type
+type MockCredentialsCreateSuperAdminDaoSelect struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdateRoleDao_Expecter struct {
+type MockCredentialsCreateSuperAdminDaoSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdateRoleDao) EXPECT() *MockCredentialsUpdateRoleDao_Expecter {
-	return &MockCredentialsUpdateRoleDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateSuperAdminDaoSelect) EXPECT() *MockCredentialsCreateSuperAdminDaoSelect_Expecter {
+	return &MockCredentialsCreateSuperAdminDaoSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdateRoleDao
-func (_mock *MockCredentialsUpdateRoleDao) Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoSelect
+func (_mock *MockCredentialsCreateSuperAdminDaoSelect) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -1491,17 +1465,17 @@ func (_mock *MockCredentialsUpdateRoleDao) Exec(ctx context.Context, request *da
 
 	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateRoleRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1509,27 +1483,27 @@ func (_mock *MockCredentialsUpdateRoleDao) Exec(ctx context.Context, request *da
 	return r0, r1
 }
 
-// MockCredentialsUpdateRoleDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdateRoleDao_Exec_Call struct {
+// MockCredentialsCreateSuperAdminDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateSuperAdminDaoSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsUpdateRoleRequest
-func (_e *MockCredentialsUpdateRoleDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleDao_Exec_Call {
-	return &MockCredentialsUpdateRoleDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsSelectByEmailRequest
+func (_e *MockCredentialsCreateSuperAdminDaoSelect_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
+	return &MockCredentialsCreateSuperAdminDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdateRoleDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest)) *MockCredentialsUpdateRoleDao_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsUpdateRoleRequest
+		var arg1 *dao.CredentialsSelectByEmailRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsUpdateRoleRequest)
+			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
 		}
 		run(
 			arg0,
@@ -1539,23 +1513,23 @@ func (_c *MockCredentialsUpdateRoleDao_Exec_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockCredentialsUpdateRoleDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateRoleDao_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdateRoleDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)) *MockCredentialsUpdateRoleDao_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDaoSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdateRoleDaoCredentialsSelect creates a new instance of MockCredentialsUpdateRoleDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateSuperAdminDaoUpdatePassword creates a new instance of MockCredentialsCreateSuperAdminDaoUpdatePassword. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdateRoleDaoCredentialsSelect(t interface {
+func NewMockCredentialsCreateSuperAdminDaoUpdatePassword(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdateRoleDaoCredentialsSelect {
-	mock := &MockCredentialsUpdateRoleDaoCredentialsSelect{}
+}) *MockCredentialsCreateSuperAdminDaoUpdatePassword {
+	mock := &MockCredentialsCreateSuperAdminDaoUpdatePassword{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1563,21 +1537,21 @@ func NewMockCredentialsUpdateRoleDaoCredentialsSelect(t interface {
 	return mock
 }
 
-// MockCredentialsUpdateRoleDaoCredentialsSelect is an autogenerated mock type for the CredentialsUpdateRoleDaoCredentialsSelect type
-type MockCredentialsUpdateRoleDaoCredentialsSelect struct {
+// MockCredentialsCreateSuperAdminDaoUpdatePassword is an autogenerated mock type for the CredentialsCreateSuperAdminDaoUpdatePassword type
+type MockCredentialsCreateSuperAdminDaoUpdatePassword struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter struct {
+type MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdateRoleDaoCredentialsSelect) EXPECT() *MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter {
-	return &MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateSuperAdminDaoUpdatePassword) EXPECT() *MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter {
+	return &MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdateRoleDaoCredentialsSelect
-func (_mock *MockCredentialsUpdateRoleDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoUpdatePassword
+func (_mock *MockCredentialsCreateSuperAdminDaoUpdatePassword) Exec(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -1586,17 +1560,17 @@ func (_mock *MockCredentialsUpdateRoleDaoCredentialsSelect) Exec(ctx context.Con
 
 	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1604,27 +1578,27 @@ func (_mock *MockCredentialsUpdateRoleDaoCredentialsSelect) Exec(ctx context.Con
 	return r0, r1
 }
 
-// MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call struct {
+// MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsSelectRequest
-func (_e *MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
-	return &MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsUpdatePasswordRequest
+func (_e *MockCredentialsCreateSuperAdminDaoUpdatePassword_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
+	return &MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest)) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsSelectRequest
+		var arg1 *dao.CredentialsUpdatePasswordRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectRequest)
+			arg1 = args[1].(*dao.CredentialsUpdatePasswordRequest)
 		}
 		run(
 			arg0,
@@ -1634,23 +1608,23 @@ func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) Run(run func(
 	return _c
 }
 
-func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDaoUpdatePassword_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeConsumeDaoSelect creates a new instance of MockShortCodeConsumeDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateSuperAdminDaoUpdateRole creates a new instance of MockCredentialsCreateSuperAdminDaoUpdateRole. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeConsumeDaoSelect(t interface {
+func NewMockCredentialsCreateSuperAdminDaoUpdateRole(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeConsumeDaoSelect {
-	mock := &MockShortCodeConsumeDaoSelect{}
+}) *MockCredentialsCreateSuperAdminDaoUpdateRole {
+	mock := &MockCredentialsCreateSuperAdminDaoUpdateRole{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1658,40 +1632,40 @@ func NewMockShortCodeConsumeDaoSelect(t interface {
 	return mock
 }
 
-// MockShortCodeConsumeDaoSelect is an autogenerated mock type for the ShortCodeConsumeDaoSelect type
-type MockShortCodeConsumeDaoSelect struct {
+// MockCredentialsCreateSuperAdminDaoUpdateRole is an autogenerated mock type for the CredentialsCreateSuperAdminDaoUpdateRole type
+type MockCredentialsCreateSuperAdminDaoUpdateRole struct {
 	mock.Mock
 }
 
-type MockShortCodeConsumeDaoSelect_Expecter struct {
+type MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeConsumeDaoSelect) EXPECT() *MockShortCodeConsumeDaoSelect_Expecter {
-	return &MockShortCodeConsumeDaoSelect_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateSuperAdminDaoUpdateRole) EXPECT() *MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter {
+	return &MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeConsumeDaoSelect
-func (_mock *MockShortCodeConsumeDaoSelect) Exec(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoUpdateRole
+func (_mock *MockCredentialsCreateSuperAdminDaoUpdateRole) Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.ShortCode
+	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectRequest) *dao.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.ShortCode)
+			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateRoleRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1699,27 +1673,27 @@ func (_mock *MockShortCodeConsumeDaoSelect) Exec(ctx context.Context, request *d
 	return r0, r1
 }
 
-// MockShortCodeConsumeDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeConsumeDaoSelect_Exec_Call struct {
+// MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ShortCodeSelectRequest
-func (_e *MockShortCodeConsumeDaoSelect_Expecter) Exec(ctx any, request any) *MockShortCodeConsumeDaoSelect_Exec_Call {
-	return &MockShortCodeConsumeDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsUpdateRoleRequest
+func (_e *MockCredentialsCreateSuperAdminDaoUpdateRole_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
+	return &MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeSelectRequest)) *MockShortCodeConsumeDaoSelect_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest)) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ShortCodeSelectRequest
+		var arg1 *dao.CredentialsUpdateRoleRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ShortCodeSelectRequest)
+			arg1 = args[1].(*dao.CredentialsUpdateRoleRequest)
 		}
 		run(
 			arg0,
@@ -1729,23 +1703,23 @@ func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeConsumeDaoSelect_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
+	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)) *MockShortCodeConsumeDaoSelect_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)) *MockCredentialsCreateSuperAdminDaoUpdateRole_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeConsumeDaoDelete creates a new instance of MockShortCodeConsumeDaoDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateSuperAdminDaoAuditEventInsert creates a new instance of MockCredentialsCreateSuperAdminDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeConsumeDaoDelete(t interface {
+func NewMockCredentialsCreateSuperAdminDaoAuditEventInsert(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeConsumeDaoDelete {
-	mock := &MockShortCodeConsumeDaoDelete{}
+}) *MockCredentialsCreateSuperAdminDaoAuditEventInsert {
+	mock := &MockCredentialsCreateSuperAdminDaoAuditEventInsert{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1753,40 +1727,40 @@ func NewMockShortCodeConsumeDaoDelete(t interface {
 	return mock
 }
 
-// MockShortCodeConsumeDaoDelete is an autogenerated mock type for the ShortCodeConsumeDaoDelete type
-type MockShortCodeConsumeDaoDelete struct {
+// MockCredentialsCreateSuperAdminDaoAuditEventInsert is an autogenerated mock type for the CredentialsCreateSuperAdminDaoAuditEventInsert type
+type MockCredentialsCreateSuperAdminDaoAuditEventInsert struct {
 	mock.Mock
 }
 
-type MockShortCodeConsumeDaoDelete_Expecter struct {
+type MockCredentialsCreateSuperAdminDaoAuditEventInsert_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeConsumeDaoDelete) EXPECT() *MockShortCodeConsumeDaoDelete_Expecter {
-	return &MockShortCodeConsumeDaoDelete_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateSuperAdminDaoAuditEventInsert) EXPECT() *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Expecter {
+	return &MockCredentialsCreateSuperAdminDaoAuditEventInsert_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeConsumeDaoDelete
-func (_mock *MockShortCodeConsumeDaoDelete) Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsCreateSuperAdminDaoAuditEventInsert
+func (_mock *MockCredentialsCreateSuperAdminDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.ShortCode
+	var r0 *dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) *dao.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.ShortCode)
+			r0 = ret.Get(0).(*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeDeleteRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1794,27 +1768,27 @@ func (_mock *MockShortCodeConsumeDaoDelete) Exec(ctx context.Context, request *d
 	return r0, r1
 }
 
-// MockShortCodeConsumeDaoDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeConsumeDaoDelete_Exec_Call struct {
+// MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ShortCodeDeleteRequest
-func (_e *MockShortCodeConsumeDaoDelete_Expecter) Exec(ctx any, request any) *MockShortCodeConsumeDaoDelete_Exec_Call {
-	return &MockShortCodeConsumeDaoDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest)) *MockShortCodeConsumeDaoDelete_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ShortCodeDeleteRequest
+		var arg1 *dao.AuditEventInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ShortCodeDeleteRequest)
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
 		}
 		run(
 			arg0,
@@ -1824,23 +1798,23 @@ func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeConsumeDaoDelete_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
 	return _c
 }
 
-func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)) *MockShortCodeConsumeDaoDelete_Exec_Call {
+func (_c *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsCreateSuperAdminDaoAuditEventInsert_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateDao creates a new instance of MockShortCodeCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsEventListDao creates a new instance of MockCredentialsEventListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateDao(t interface {
+func NewMockCredentialsEventListDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateDao {
-	mock := &MockShortCodeCreateDao{}
+}) *MockCredentialsEventListDao {
+	mock := &MockCredentialsEventListDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1848,40 +1822,40 @@ func NewMockShortCodeCreateDao(t interface {
 	return mock
 }
 
-// MockShortCodeCreateDao is an autogenerated mock type for the ShortCodeCreateDao type
-type MockShortCodeCreateDao struct {
+// MockCredentialsEventListDao is an autogenerated mock type for the CredentialsEventListDao type
+type MockCredentialsEventListDao struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateDao_Expecter struct {
+type MockCredentialsEventListDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateDao) EXPECT() *MockShortCodeCreateDao_Expecter {
-	return &MockShortCodeCreateDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsEventListDao) EXPECT() *MockCredentialsEventListDao_Expecter {
+	return &MockCredentialsEventListDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreateDao
-func (_mock *MockShortCodeCreateDao) Exec(ctx context.Context, request *dao.ShortCodeInsertRequest) (*dao.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsEventListDao
+func (_mock *MockCredentialsEventListDao) Exec(ctx context.Context, request *dao.CredentialsEventListRequest) ([]*dao.CredentialsEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.ShortCode
+	var r0 []*dao.CredentialsEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeInsertRequest) (*dao.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsEventListRequest) ([]*dao.CredentialsEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeInsertRequest) *dao.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsEventListRequest) []*dao.CredentialsEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.ShortCode)
+			r0 = ret.Get(0).([]*dao.CredentialsEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsEventListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1889,27 +1863,27 @@ func (_mock *MockShortCodeCreateDao) Exec(ctx context.Context, request *dao.Shor
 	return r0, r1
 }
 
-// MockShortCodeCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreateDao_Exec_Call struct {
+// MockCredentialsEventListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsEventListDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ShortCodeInsertRequest
-func (_e *MockShortCodeCreateDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateDao_Exec_Call {
-	return &MockShortCodeCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsEventListRequest
+func (_e *MockCredentialsEventListDao_Expecter) Exec(ctx any, request any) *MockCredentialsEventListDao_Exec_Call {
+	return &MockCredentialsEventListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeInsertRequest)) *MockShortCodeCreateDao_Exec_Call {
+func (_c *MockCredentialsEventListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsEventListRequest)) *MockCredentialsEventListDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ShortCodeInsertRequest
+		var arg1 *dao.CredentialsEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ShortCodeInsertRequest)
+			arg1 = args[1].(*dao.CredentialsEventListRequest)
 		}
 		run(
 			arg0,
@@ -1919,23 +1893,23 @@ func (_c *MockShortCodeCreateDao_Exec_Call) Run(run func(ctx context.Context, re
 	return _c
 }
 
-func (_c *MockShortCodeCreateDao_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeCreateDao_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsEventListDao_Exec_Call) Return(credentialsEvents []*dao.CredentialsEvent, err error) *MockCredentialsEventListDao_Exec_Call {
+	_c.Call.Return(credentialsEvents, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeInsertRequest) (*dao.ShortCode, error)) *MockShortCodeCreateDao_Exec_Call {
+func (_c *MockCredentialsEventListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsEventListRequest) ([]*dao.CredentialsEvent, error)) *MockCredentialsEventListDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateEmailUpdateService creates a new instance of MockShortCodeCreateEmailUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExistDao creates a new instance of MockCredentialsExistDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateEmailUpdateService(t interface {
+func NewMockCredentialsExistDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateEmailUpdateService {
-	mock := &MockShortCodeCreateEmailUpdateService{}
+}) *MockCredentialsExistDao {
+	mock := &MockCredentialsExistDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1943,40 +1917,38 @@ func NewMockShortCodeCreateEmailUpdateService(t interface {
 	return mock
 }
 
-// MockShortCodeCreateEmailUpdateService is an autogenerated mock type for the ShortCodeCreateEmailUpdateService type
-type MockShortCodeCreateEmailUpdateService struct {
+// MockCredentialsExistDao is an autogenerated mock type for the CredentialsExistDao type
+type MockCredentialsExistDao struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateEmailUpdateService_Expecter struct {
+type MockCredentialsExistDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateEmailUpdateService) EXPECT() *MockShortCodeCreateEmailUpdateService_Expecter {
-	return &MockShortCodeCreateEmailUpdateService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExistDao) EXPECT() *MockCredentialsExistDao_Expecter {
+	return &MockCredentialsExistDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateService
-func (_mock *MockShortCodeCreateEmailUpdateService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsExistDao
+func (_mock *MockCredentialsExistDao) Exec(ctx context.Context, request *dao.CredentialsExistRequest) (bool, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsExistRequest) (bool, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsExistRequest) bool); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsExistRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1984,27 +1956,27 @@ func (_mock *MockShortCodeCreateEmailUpdateService) Exec(ctx context.Context, re
 	return r0, r1
 }
 
-// MockShortCodeCreateEmailUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreateEmailUpdateService_Exec_Call struct {
+// MockCredentialsExistDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExistDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeCreateRequest
-func (_e *MockShortCodeCreateEmailUpdateService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateService_Exec_Call {
-	return &MockShortCodeCreateEmailUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsExistRequest
+func (_e *MockCredentialsExistDao_Expecter) Exec(ctx any, request any) *MockCredentialsExistDao_Exec_Call {
+	return &MockCredentialsExistDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+func (_c *MockCredentialsExistDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsExistRequest)) *MockCredentialsExistDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeCreateRequest
+		var arg1 *dao.CredentialsExistRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeCreateRequest)
+			arg1 = args[1].(*dao.CredentialsExistRequest)
 		}
 		run(
 			arg0,
@@ -2014,23 +1986,23 @@ func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateEmailUpdateService_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsExistDao_Exec_Call) Return(b bool, err error) *MockCredentialsExistDao_Exec_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+func (_c *MockCredentialsExistDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsExistRequest) (bool, error)) *MockCredentialsExistDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateEmailUpdateDao creates a new instance of MockShortCodeCreateEmailUpdateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExportDaoCredentials creates a new instance of MockCredentialsExportDaoCredentials. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateEmailUpdateDao(t interface {
+func NewMockCredentialsExportDaoCredentials(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateEmailUpdateDao {
-	mock := &MockShortCodeCreateEmailUpdateDao{}
+}) *MockCredentialsExportDaoCredentials {
+	mock := &MockCredentialsExportDaoCredentials{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2038,21 +2010,21 @@ func NewMockShortCodeCreateEmailUpdateDao(t interface {
 	return mock
 }
 
-// MockShortCodeCreateEmailUpdateDao is an autogenerated mock type for the ShortCodeCreateEmailUpdateDao type
-type MockShortCodeCreateEmailUpdateDao struct {
+// MockCredentialsExportDaoCredentials is an autogenerated mock type for the CredentialsExportDaoCredentials type
+type MockCredentialsExportDaoCredentials struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateEmailUpdateDao_Expecter struct {
+type MockCredentialsExportDaoCredentials_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateEmailUpdateDao) EXPECT() *MockShortCodeCreateEmailUpdateDao_Expecter {
-	return &MockShortCodeCreateEmailUpdateDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExportDaoCredentials) EXPECT() *MockCredentialsExportDaoCredentials_Expecter {
+	return &MockCredentialsExportDaoCredentials_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateDao
-func (_mock *MockShortCodeCreateEmailUpdateDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsExportDaoCredentials
+func (_mock *MockCredentialsExportDaoCredentials) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -2061,17 +2033,17 @@ func (_mock *MockShortCodeCreateEmailUpdateDao) Exec(ctx context.Context, reques
 
 	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2079,27 +2051,27 @@ func (_mock *MockShortCodeCreateEmailUpdateDao) Exec(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockShortCodeCreateEmailUpdateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreateEmailUpdateDao_Exec_Call struct {
+// MockCredentialsExportDaoCredentials_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExportDaoCredentials_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsSelectByEmailRequest
-func (_e *MockShortCodeCreateEmailUpdateDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
-	return &MockShortCodeCreateEmailUpdateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsExportDaoCredentials_Expecter) Exec(ctx any, request any) *MockCredentialsExportDaoCredentials_Exec_Call {
+	return &MockCredentialsExportDaoCredentials_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+func (_c *MockCredentialsExportDaoCredentials_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsExportDaoCredentials_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsSelectByEmailRequest
+		var arg1 *dao.CredentialsSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
 		}
 		run(
 			arg0,
@@ -2109,23 +2081,23 @@ func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+func (_c *MockCredentialsExportDaoCredentials_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsExportDaoCredentials_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+func (_c *MockCredentialsExportDaoCredentials_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsExportDaoCredentials_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateEmailUpdateSmtp creates a new instance of MockShortCodeCreateEmailUpdateSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExportDaoShortCodes creates a new instance of MockCredentialsExportDaoShortCodes. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateEmailUpdateSmtp(t interface {
+func NewMockCredentialsExportDaoShortCodes(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateEmailUpdateSmtp {
-	mock := &MockShortCodeCreateEmailUpdateSmtp{}
+}) *MockCredentialsExportDaoShortCodes {
+	mock := &MockCredentialsExportDaoShortCodes{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2133,139 +2105,189 @@ func NewMockShortCodeCreateEmailUpdateSmtp(t interface {
 	return mock
 }
 
-// MockShortCodeCreateEmailUpdateSmtp is an autogenerated mock type for the ShortCodeCreateEmailUpdateSmtp type
-type MockShortCodeCreateEmailUpdateSmtp struct {
+// MockCredentialsExportDaoShortCodes is an autogenerated mock type for the CredentialsExportDaoShortCodes type
+type MockCredentialsExportDaoShortCodes struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateEmailUpdateSmtp_Expecter struct {
+type MockCredentialsExportDaoShortCodes_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateEmailUpdateSmtp) EXPECT() *MockShortCodeCreateEmailUpdateSmtp_Expecter {
-	return &MockShortCodeCreateEmailUpdateSmtp_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExportDaoShortCodes) EXPECT() *MockCredentialsExportDaoShortCodes_Expecter {
+	return &MockCredentialsExportDaoShortCodes_Expecter{mock: &_m.Mock}
 }
 
-// Ping provides a mock function for the type MockShortCodeCreateEmailUpdateSmtp
-func (_mock *MockShortCodeCreateEmailUpdateSmtp) Ping() error {
-	ret := _mock.Called()
+// Exec provides a mock function for the type MockCredentialsExportDaoShortCodes
+func (_mock *MockCredentialsExportDaoShortCodes) Exec(ctx context.Context, request *dao.ShortCodeListByTargetsRequest) ([]*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Ping")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	var r0 []*dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeListByTargetsRequest) ([]*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeListByTargetsRequest) []*dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ShortCode)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeListByTargetsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockShortCodeCreateEmailUpdateSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
-type MockShortCodeCreateEmailUpdateSmtp_Ping_Call struct {
+// MockCredentialsExportDaoShortCodes_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExportDaoShortCodes_Exec_Call struct {
 	*mock.Call
 }
 
-// Ping is a helper method to define mock.On call
-func (_e *MockShortCodeCreateEmailUpdateSmtp_Expecter) Ping() *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
-	return &MockShortCodeCreateEmailUpdateSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeListByTargetsRequest
+func (_e *MockCredentialsExportDaoShortCodes_Expecter) Exec(ctx any, request any) *MockCredentialsExportDaoShortCodes_Exec_Call {
+	return &MockCredentialsExportDaoShortCodes_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateEmailUpdateSmtp_Ping_Call) Run(run func()) *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
+func (_c *MockCredentialsExportDaoShortCodes_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeListByTargetsRequest)) *MockCredentialsExportDaoShortCodes_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeListByTargetsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeListByTargetsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateSmtp_Ping_Call) Return(err error) *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
-	_c.Call.Return(err)
+func (_c *MockCredentialsExportDaoShortCodes_Exec_Call) Return(shortCodes []*dao.ShortCode, err error) *MockCredentialsExportDaoShortCodes_Exec_Call {
+	_c.Call.Return(shortCodes, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
+func (_c *MockCredentialsExportDaoShortCodes_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeListByTargetsRequest) ([]*dao.ShortCode, error)) *MockCredentialsExportDaoShortCodes_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendMail provides a mock function for the type MockShortCodeCreateEmailUpdateSmtp
-func (_mock *MockShortCodeCreateEmailUpdateSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
-	ret := _mock.Called(to, t, tName, data)
+// NewMockCredentialsExportDaoLoginEvents creates a new instance of MockCredentialsExportDaoLoginEvents. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsExportDaoLoginEvents(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsExportDaoLoginEvents {
+	mock := &MockCredentialsExportDaoLoginEvents{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsExportDaoLoginEvents is an autogenerated mock type for the CredentialsExportDaoLoginEvents type
+type MockCredentialsExportDaoLoginEvents struct {
+	mock.Mock
+}
+
+type MockCredentialsExportDaoLoginEvents_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsExportDaoLoginEvents) EXPECT() *MockCredentialsExportDaoLoginEvents_Expecter {
+	return &MockCredentialsExportDaoLoginEvents_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsExportDaoLoginEvents
+func (_mock *MockCredentialsExportDaoLoginEvents) Exec(ctx context.Context, request *dao.LoginEventListRequest) ([]*dao.LoginEvent, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMail")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
-		r0 = returnFunc(to, t, tName, data)
+	var r0 []*dao.LoginEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventListRequest) ([]*dao.LoginEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventListRequest) []*dao.LoginEvent); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LoginEvent)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.LoginEventListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockShortCodeCreateEmailUpdateSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
-type MockShortCodeCreateEmailUpdateSmtp_SendMail_Call struct {
+// MockCredentialsExportDaoLoginEvents_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExportDaoLoginEvents_Exec_Call struct {
 	*mock.Call
 }
 
-// SendMail is a helper method to define mock.On call
-//   - to smtp.MailUsers
-//   - t *template.Template
-//   - tName string
-//   - data any
-func (_e *MockShortCodeCreateEmailUpdateSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
-	return &MockShortCodeCreateEmailUpdateSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.LoginEventListRequest
+func (_e *MockCredentialsExportDaoLoginEvents_Expecter) Exec(ctx any, request any) *MockCredentialsExportDaoLoginEvents_Exec_Call {
+	return &MockCredentialsExportDaoLoginEvents_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
+func (_c *MockCredentialsExportDaoLoginEvents_Exec_Call) Run(run func(ctx context.Context, request *dao.LoginEventListRequest)) *MockCredentialsExportDaoLoginEvents_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 smtp.MailUsers
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(smtp.MailUsers)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *template.Template
+		var arg1 *dao.LoginEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*template.Template)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 any
-		if args[3] != nil {
-			arg3 = args[3].(any)
+			arg1 = args[1].(*dao.LoginEventListRequest)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call) Return(err error) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
-	_c.Call.Return(err)
+func (_c *MockCredentialsExportDaoLoginEvents_Exec_Call) Return(loginEvents []*dao.LoginEvent, err error) *MockCredentialsExportDaoLoginEvents_Exec_Call {
+	_c.Call.Return(loginEvents, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
+func (_c *MockCredentialsExportDaoLoginEvents_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.LoginEventListRequest) ([]*dao.LoginEvent, error)) *MockCredentialsExportDaoLoginEvents_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreatePasswordResetService creates a new instance of MockShortCodeCreatePasswordResetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExportDaoAuditEvents creates a new instance of MockCredentialsExportDaoAuditEvents. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreatePasswordResetService(t interface {
+func NewMockCredentialsExportDaoAuditEvents(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreatePasswordResetService {
-	mock := &MockShortCodeCreatePasswordResetService{}
+}) *MockCredentialsExportDaoAuditEvents {
+	mock := &MockCredentialsExportDaoAuditEvents{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2273,40 +2295,40 @@ func NewMockShortCodeCreatePasswordResetService(t interface {
 	return mock
 }
 
-// MockShortCodeCreatePasswordResetService is an autogenerated mock type for the ShortCodeCreatePasswordResetService type
-type MockShortCodeCreatePasswordResetService struct {
+// MockCredentialsExportDaoAuditEvents is an autogenerated mock type for the CredentialsExportDaoAuditEvents type
+type MockCredentialsExportDaoAuditEvents struct {
 	mock.Mock
 }
 
-type MockShortCodeCreatePasswordResetService_Expecter struct {
+type MockCredentialsExportDaoAuditEvents_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreatePasswordResetService) EXPECT() *MockShortCodeCreatePasswordResetService_Expecter {
-	return &MockShortCodeCreatePasswordResetService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExportDaoAuditEvents) EXPECT() *MockCredentialsExportDaoAuditEvents_Expecter {
+	return &MockCredentialsExportDaoAuditEvents_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreatePasswordResetService
-func (_mock *MockShortCodeCreatePasswordResetService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsExportDaoAuditEvents
+func (_mock *MockCredentialsExportDaoAuditEvents) Exec(ctx context.Context, request *dao.AuditEventListByUserRequest) ([]*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 []*dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventListByUserRequest) ([]*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventListByUserRequest) []*dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).([]*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventListByUserRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2314,27 +2336,27 @@ func (_mock *MockShortCodeCreatePasswordResetService) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockShortCodeCreatePasswordResetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreatePasswordResetService_Exec_Call struct {
+// MockCredentialsExportDaoAuditEvents_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExportDaoAuditEvents_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeCreateRequest
-func (_e *MockShortCodeCreatePasswordResetService_Expecter) Exec(ctx any, request any) *MockShortCodeCreatePasswordResetService_Exec_Call {
-	return &MockShortCodeCreatePasswordResetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventListByUserRequest
+func (_e *MockCredentialsExportDaoAuditEvents_Expecter) Exec(ctx any, request any) *MockCredentialsExportDaoAuditEvents_Exec_Call {
+	return &MockCredentialsExportDaoAuditEvents_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreatePasswordResetService_Exec_Call {
+func (_c *MockCredentialsExportDaoAuditEvents_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventListByUserRequest)) *MockCredentialsExportDaoAuditEvents_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeCreateRequest
+		var arg1 *dao.AuditEventListByUserRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeCreateRequest)
+			arg1 = args[1].(*dao.AuditEventListByUserRequest)
 		}
 		run(
 			arg0,
@@ -2344,23 +2366,23 @@ func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreatePasswordResetService_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsExportDaoAuditEvents_Exec_Call) Return(auditEvents []*dao.AuditEvent, err error) *MockCredentialsExportDaoAuditEvents_Exec_Call {
+	_c.Call.Return(auditEvents, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreatePasswordResetService_Exec_Call {
+func (_c *MockCredentialsExportDaoAuditEvents_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventListByUserRequest) ([]*dao.AuditEvent, error)) *MockCredentialsExportDaoAuditEvents_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreatePasswordResetDao creates a new instance of MockShortCodeCreatePasswordResetDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExportDaoAuditEventInsert creates a new instance of MockCredentialsExportDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreatePasswordResetDao(t interface {
+func NewMockCredentialsExportDaoAuditEventInsert(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreatePasswordResetDao {
-	mock := &MockShortCodeCreatePasswordResetDao{}
+}) *MockCredentialsExportDaoAuditEventInsert {
+	mock := &MockCredentialsExportDaoAuditEventInsert{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2368,40 +2390,40 @@ func NewMockShortCodeCreatePasswordResetDao(t interface {
 	return mock
 }
 
-// MockShortCodeCreatePasswordResetDao is an autogenerated mock type for the ShortCodeCreatePasswordResetDao type
-type MockShortCodeCreatePasswordResetDao struct {
+// MockCredentialsExportDaoAuditEventInsert is an autogenerated mock type for the CredentialsExportDaoAuditEventInsert type
+type MockCredentialsExportDaoAuditEventInsert struct {
 	mock.Mock
 }
 
-type MockShortCodeCreatePasswordResetDao_Expecter struct {
+type MockCredentialsExportDaoAuditEventInsert_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreatePasswordResetDao) EXPECT() *MockShortCodeCreatePasswordResetDao_Expecter {
-	return &MockShortCodeCreatePasswordResetDao_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExportDaoAuditEventInsert) EXPECT() *MockCredentialsExportDaoAuditEventInsert_Expecter {
+	return &MockCredentialsExportDaoAuditEventInsert_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreatePasswordResetDao
-func (_mock *MockShortCodeCreatePasswordResetDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsExportDaoAuditEventInsert
+func (_mock *MockCredentialsExportDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Credentials
+	var r0 *dao.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
+			r0 = ret.Get(0).(*dao.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2409,27 +2431,27 @@ func (_mock *MockShortCodeCreatePasswordResetDao) Exec(ctx context.Context, requ
 	return r0, r1
 }
 
-// MockShortCodeCreatePasswordResetDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreatePasswordResetDao_Exec_Call struct {
+// MockCredentialsExportDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExportDaoAuditEventInsert_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsSelectByEmailRequest
-func (_e *MockShortCodeCreatePasswordResetDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreatePasswordResetDao_Exec_Call {
-	return &MockShortCodeCreatePasswordResetDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsExportDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsExportDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsExportDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreatePasswordResetDao_Exec_Call {
+func (_c *MockCredentialsExportDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsExportDaoAuditEventInsert_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsSelectByEmailRequest
+		var arg1 *dao.AuditEventInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
 		}
 		run(
 			arg0,
@@ -2439,23 +2461,23 @@ func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreatePasswordResetDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsExportDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsExportDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreatePasswordResetDao_Exec_Call {
+func (_c *MockCredentialsExportDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsExportDaoAuditEventInsert_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreatePasswordResetSmtp creates a new instance of MockShortCodeCreatePasswordResetSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsGetDao creates a new instance of MockCredentialsGetDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreatePasswordResetSmtp(t interface {
+func NewMockCredentialsGetDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreatePasswordResetSmtp {
-	mock := &MockShortCodeCreatePasswordResetSmtp{}
+}) *MockCredentialsGetDao {
+	mock := &MockCredentialsGetDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2463,139 +2485,9806 @@ func NewMockShortCodeCreatePasswordResetSmtp(t interface {
 	return mock
 }
 
-// MockShortCodeCreatePasswordResetSmtp is an autogenerated mock type for the ShortCodeCreatePasswordResetSmtp type
-type MockShortCodeCreatePasswordResetSmtp struct {
+// MockCredentialsGetDao is an autogenerated mock type for the CredentialsGetDao type
+type MockCredentialsGetDao struct {
 	mock.Mock
 }
 
-type MockShortCodeCreatePasswordResetSmtp_Expecter struct {
+type MockCredentialsGetDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreatePasswordResetSmtp) EXPECT() *MockShortCodeCreatePasswordResetSmtp_Expecter {
-	return &MockShortCodeCreatePasswordResetSmtp_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsGetDao) EXPECT() *MockCredentialsGetDao_Expecter {
+	return &MockCredentialsGetDao_Expecter{mock: &_m.Mock}
 }
 
-// Ping provides a mock function for the type MockShortCodeCreatePasswordResetSmtp
-func (_mock *MockShortCodeCreatePasswordResetSmtp) Ping() error {
-	ret := _mock.Called()
+// Exec provides a mock function for the type MockCredentialsGetDao
+func (_mock *MockCredentialsGetDao) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Ping")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockShortCodeCreatePasswordResetSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
-type MockShortCodeCreatePasswordResetSmtp_Ping_Call struct {
+// MockCredentialsGetDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGetDao_Exec_Call struct {
 	*mock.Call
 }
 
-// Ping is a helper method to define mock.On call
-func (_e *MockShortCodeCreatePasswordResetSmtp_Expecter) Ping() *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
-	return &MockShortCodeCreatePasswordResetSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsGetDao_Expecter) Exec(ctx any, request any) *MockCredentialsGetDao_Exec_Call {
+	return &MockCredentialsGetDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreatePasswordResetSmtp_Ping_Call) Run(run func()) *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
+func (_c *MockCredentialsGetDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsGetDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetSmtp_Ping_Call) Return(err error) *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
-	_c.Call.Return(err)
+func (_c *MockCredentialsGetDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsGetDao_Exec_Call {
+	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
+func (_c *MockCredentialsGetDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsGetDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SendMail provides a mock function for the type MockShortCodeCreatePasswordResetSmtp
-func (_mock *MockShortCodeCreatePasswordResetSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
-	ret := _mock.Called(to, t, tName, data)
+// NewMockCredentialsGetDaoAuditEventInsert creates a new instance of MockCredentialsGetDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGetDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGetDaoAuditEventInsert {
+	mock := &MockCredentialsGetDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGetDaoAuditEventInsert is an autogenerated mock type for the CredentialsGetDaoAuditEventInsert type
+type MockCredentialsGetDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsGetDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGetDaoAuditEventInsert) EXPECT() *MockCredentialsGetDaoAuditEventInsert_Expecter {
+	return &MockCredentialsGetDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGetDaoAuditEventInsert
+func (_mock *MockCredentialsGetDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMail")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
-		r0 = returnFunc(to, t, tName, data)
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockShortCodeCreatePasswordResetSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
-type MockShortCodeCreatePasswordResetSmtp_SendMail_Call struct {
+// MockCredentialsGetDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGetDaoAuditEventInsert_Exec_Call struct {
 	*mock.Call
 }
 
-// SendMail is a helper method to define mock.On call
-//   - to smtp.MailUsers
-//   - t *template.Template
-//   - tName string
-//   - data any
-func (_e *MockShortCodeCreatePasswordResetSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
-	return &MockShortCodeCreatePasswordResetSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsGetDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsGetDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsGetDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreatePasswordResetSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
+func (_c *MockCredentialsGetDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsGetDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGetDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsGetDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsGetDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsGetDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGetBatchDao creates a new instance of MockCredentialsGetBatchDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGetBatchDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGetBatchDao {
+	mock := &MockCredentialsGetBatchDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGetBatchDao is an autogenerated mock type for the CredentialsGetBatchDao type
+type MockCredentialsGetBatchDao struct {
+	mock.Mock
+}
+
+type MockCredentialsGetBatchDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGetBatchDao) EXPECT() *MockCredentialsGetBatchDao_Expecter {
+	return &MockCredentialsGetBatchDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGetBatchDao
+func (_mock *MockCredentialsGetBatchDao) Exec(ctx context.Context, request *dao.CredentialsSelectBatchRequest) ([]*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectBatchRequest) ([]*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectBatchRequest) []*dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectBatchRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGetBatchDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGetBatchDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectBatchRequest
+func (_e *MockCredentialsGetBatchDao_Expecter) Exec(ctx any, request any) *MockCredentialsGetBatchDao_Exec_Call {
+	return &MockCredentialsGetBatchDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGetBatchDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectBatchRequest)) *MockCredentialsGetBatchDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectBatchRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectBatchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGetBatchDao_Exec_Call) Return(credentialss []*dao.Credentials, err error) *MockCredentialsGetBatchDao_Exec_Call {
+	_c.Call.Return(credentialss, err)
+	return _c
+}
+
+func (_c *MockCredentialsGetBatchDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectBatchRequest) ([]*dao.Credentials, error)) *MockCredentialsGetBatchDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGetBatchDaoAuditEventInsert creates a new instance of MockCredentialsGetBatchDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGetBatchDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGetBatchDaoAuditEventInsert {
+	mock := &MockCredentialsGetBatchDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGetBatchDaoAuditEventInsert is an autogenerated mock type for the CredentialsGetBatchDaoAuditEventInsert type
+type MockCredentialsGetBatchDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsGetBatchDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGetBatchDaoAuditEventInsert) EXPECT() *MockCredentialsGetBatchDaoAuditEventInsert_Expecter {
+	return &MockCredentialsGetBatchDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGetBatchDaoAuditEventInsert
+func (_mock *MockCredentialsGetBatchDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsGetBatchDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsGetBatchDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGrantRoleDao creates a new instance of MockCredentialsGrantRoleDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGrantRoleDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGrantRoleDao {
+	mock := &MockCredentialsGrantRoleDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGrantRoleDao is an autogenerated mock type for the CredentialsGrantRoleDao type
+type MockCredentialsGrantRoleDao struct {
+	mock.Mock
+}
+
+type MockCredentialsGrantRoleDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGrantRoleDao) EXPECT() *MockCredentialsGrantRoleDao_Expecter {
+	return &MockCredentialsGrantRoleDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGrantRoleDao
+func (_mock *MockCredentialsGrantRoleDao) Exec(ctx context.Context, request *dao.CredentialRoleGrantInsertRequest) (*dao.CredentialRoleGrant, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.CredentialRoleGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialRoleGrantInsertRequest) (*dao.CredentialRoleGrant, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialRoleGrantInsertRequest) *dao.CredentialRoleGrant); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CredentialRoleGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialRoleGrantInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGrantRoleDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialRoleGrantInsertRequest
+func (_e *MockCredentialsGrantRoleDao_Expecter) Exec(ctx any, request any) *MockCredentialsGrantRoleDao_Exec_Call {
+	return &MockCredentialsGrantRoleDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGrantRoleDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialRoleGrantInsertRequest)) *MockCredentialsGrantRoleDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialRoleGrantInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialRoleGrantInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleDao_Exec_Call) Return(credentialRoleGrant *dao.CredentialRoleGrant, err error) *MockCredentialsGrantRoleDao_Exec_Call {
+	_c.Call.Return(credentialRoleGrant, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialRoleGrantInsertRequest) (*dao.CredentialRoleGrant, error)) *MockCredentialsGrantRoleDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGrantRoleDaoCredentialsSelect creates a new instance of MockCredentialsGrantRoleDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGrantRoleDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGrantRoleDaoCredentialsSelect {
+	mock := &MockCredentialsGrantRoleDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGrantRoleDaoCredentialsSelect is an autogenerated mock type for the CredentialsGrantRoleDaoCredentialsSelect type
+type MockCredentialsGrantRoleDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockCredentialsGrantRoleDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGrantRoleDaoCredentialsSelect) EXPECT() *MockCredentialsGrantRoleDaoCredentialsSelect_Expecter {
+	return &MockCredentialsGrantRoleDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGrantRoleDaoCredentialsSelect
+func (_mock *MockCredentialsGrantRoleDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsGrantRoleDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsGrantRoleDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGrantRoleDaoAuditEventInsert creates a new instance of MockCredentialsGrantRoleDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGrantRoleDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGrantRoleDaoAuditEventInsert {
+	mock := &MockCredentialsGrantRoleDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGrantRoleDaoAuditEventInsert is an autogenerated mock type for the CredentialsGrantRoleDaoAuditEventInsert type
+type MockCredentialsGrantRoleDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsGrantRoleDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGrantRoleDaoAuditEventInsert) EXPECT() *MockCredentialsGrantRoleDaoAuditEventInsert_Expecter {
+	return &MockCredentialsGrantRoleDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGrantRoleDaoAuditEventInsert
+func (_mock *MockCredentialsGrantRoleDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsGrantRoleDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsGrantRoleDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGrantRoleRoles creates a new instance of MockCredentialsGrantRoleRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGrantRoleRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGrantRoleRoles {
+	mock := &MockCredentialsGrantRoleRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGrantRoleRoles is an autogenerated mock type for the CredentialsGrantRoleRoles type
+type MockCredentialsGrantRoleRoles struct {
+	mock.Mock
+}
+
+type MockCredentialsGrantRoleRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGrantRoleRoles) EXPECT() *MockCredentialsGrantRoleRoles_Expecter {
+	return &MockCredentialsGrantRoleRoles_Expecter{mock: &_m.Mock}
+}
+
+// Priority provides a mock function for the type MockCredentialsGrantRoleRoles
+func (_mock *MockCredentialsGrantRoleRoles) Priority(role string) (int, error) {
+	ret := _mock.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Priority")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(role)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleRoles_Priority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Priority'
+type MockCredentialsGrantRoleRoles_Priority_Call struct {
+	*mock.Call
+}
+
+// Priority is a helper method to define mock.On call
+//   - role string
+func (_e *MockCredentialsGrantRoleRoles_Expecter) Priority(role any) *MockCredentialsGrantRoleRoles_Priority_Call {
+	return &MockCredentialsGrantRoleRoles_Priority_Call{Call: _e.mock.On("Priority", role)}
+}
+
+func (_c *MockCredentialsGrantRoleRoles_Priority_Call) Run(run func(role string)) *MockCredentialsGrantRoleRoles_Priority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleRoles_Priority_Call) Return(n int, err error) *MockCredentialsGrantRoleRoles_Priority_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleRoles_Priority_Call) RunAndReturn(run func(role string) (int, error)) *MockCredentialsGrantRoleRoles_Priority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rank provides a mock function for the type MockCredentialsGrantRoleRoles
+func (_mock *MockCredentialsGrantRoleRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockCredentialsGrantRoleRoles_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockCredentialsGrantRoleRoles_Expecter) Rank(roles any) *MockCredentialsGrantRoleRoles_Rank_Call {
+	return &MockCredentialsGrantRoleRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *MockCredentialsGrantRoleRoles_Rank_Call) Run(run func(roles []string)) *MockCredentialsGrantRoleRoles_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleRoles_Rank_Call) Return(n int, err error) *MockCredentialsGrantRoleRoles_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockCredentialsGrantRoleRoles_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGrantRoleSweepDao creates a new instance of MockCredentialsGrantRoleSweepDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGrantRoleSweepDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGrantRoleSweepDao {
+	mock := &MockCredentialsGrantRoleSweepDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGrantRoleSweepDao is an autogenerated mock type for the CredentialsGrantRoleSweepDao type
+type MockCredentialsGrantRoleSweepDao struct {
+	mock.Mock
+}
+
+type MockCredentialsGrantRoleSweepDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGrantRoleSweepDao) EXPECT() *MockCredentialsGrantRoleSweepDao_Expecter {
+	return &MockCredentialsGrantRoleSweepDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGrantRoleSweepDao
+func (_mock *MockCredentialsGrantRoleSweepDao) Exec(ctx context.Context, request *dao.CredentialRoleGrantDeleteExpiredRequest) ([]*dao.CredentialRoleGrant, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.CredentialRoleGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialRoleGrantDeleteExpiredRequest) ([]*dao.CredentialRoleGrant, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialRoleGrantDeleteExpiredRequest) []*dao.CredentialRoleGrant); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.CredentialRoleGrant)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialRoleGrantDeleteExpiredRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleSweepDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGrantRoleSweepDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialRoleGrantDeleteExpiredRequest
+func (_e *MockCredentialsGrantRoleSweepDao_Expecter) Exec(ctx any, request any) *MockCredentialsGrantRoleSweepDao_Exec_Call {
+	return &MockCredentialsGrantRoleSweepDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGrantRoleSweepDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialRoleGrantDeleteExpiredRequest)) *MockCredentialsGrantRoleSweepDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialRoleGrantDeleteExpiredRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialRoleGrantDeleteExpiredRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleSweepDao_Exec_Call) Return(credentialRoleGrants []*dao.CredentialRoleGrant, err error) *MockCredentialsGrantRoleSweepDao_Exec_Call {
+	_c.Call.Return(credentialRoleGrants, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleSweepDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialRoleGrantDeleteExpiredRequest) ([]*dao.CredentialRoleGrant, error)) *MockCredentialsGrantRoleSweepDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsGrantRoleSweepDaoAuditEventInsert creates a new instance of MockCredentialsGrantRoleSweepDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsGrantRoleSweepDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsGrantRoleSweepDaoAuditEventInsert {
+	mock := &MockCredentialsGrantRoleSweepDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsGrantRoleSweepDaoAuditEventInsert is an autogenerated mock type for the CredentialsGrantRoleSweepDaoAuditEventInsert type
+type MockCredentialsGrantRoleSweepDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsGrantRoleSweepDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsGrantRoleSweepDaoAuditEventInsert) EXPECT() *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Expecter {
+	return &MockCredentialsGrantRoleSweepDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsGrantRoleSweepDaoAuditEventInsert
+func (_mock *MockCredentialsGrantRoleSweepDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsGrantRoleSweepDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsListDao creates a new instance of MockCredentialsListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsListDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsListDao {
+	mock := &MockCredentialsListDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsListDao is an autogenerated mock type for the CredentialsListDao type
+type MockCredentialsListDao struct {
+	mock.Mock
+}
+
+type MockCredentialsListDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsListDao) EXPECT() *MockCredentialsListDao_Expecter {
+	return &MockCredentialsListDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsListDao
+func (_mock *MockCredentialsListDao) Exec(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsListRequest) ([]*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsListRequest) []*dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsListDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsListRequest
+func (_e *MockCredentialsListDao_Expecter) Exec(ctx any, request any) *MockCredentialsListDao_Exec_Call {
+	return &MockCredentialsListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsListRequest)) *MockCredentialsListDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsListDao_Exec_Call) Return(credentialss []*dao.Credentials, err error) *MockCredentialsListDao_Exec_Call {
+	_c.Call.Return(credentialss, err)
+	return _c
+}
+
+func (_c *MockCredentialsListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsListRequest) ([]*dao.Credentials, error)) *MockCredentialsListDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsListDaoCount creates a new instance of MockCredentialsListDaoCount. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsListDaoCount(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsListDaoCount {
+	mock := &MockCredentialsListDaoCount{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsListDaoCount is an autogenerated mock type for the CredentialsListDaoCount type
+type MockCredentialsListDaoCount struct {
+	mock.Mock
+}
+
+type MockCredentialsListDaoCount_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsListDaoCount) EXPECT() *MockCredentialsListDaoCount_Expecter {
+	return &MockCredentialsListDaoCount_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsListDaoCount
+func (_mock *MockCredentialsListDaoCount) Exec(ctx context.Context, request *dao.CredentialsCountRequest) (int, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsCountRequest) (int, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsCountRequest) int); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsCountRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsListDaoCount_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsListDaoCount_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsCountRequest
+func (_e *MockCredentialsListDaoCount_Expecter) Exec(ctx any, request any) *MockCredentialsListDaoCount_Exec_Call {
+	return &MockCredentialsListDaoCount_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsListDaoCount_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsCountRequest)) *MockCredentialsListDaoCount_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsCountRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsCountRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsListDaoCount_Exec_Call) Return(n int, err error) *MockCredentialsListDaoCount_Exec_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsListDaoCount_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsCountRequest) (int, error)) *MockCredentialsListDaoCount_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsListDaoAuditEventInsert creates a new instance of MockCredentialsListDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsListDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsListDaoAuditEventInsert {
+	mock := &MockCredentialsListDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsListDaoAuditEventInsert is an autogenerated mock type for the CredentialsListDaoAuditEventInsert type
+type MockCredentialsListDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsListDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsListDaoAuditEventInsert) EXPECT() *MockCredentialsListDaoAuditEventInsert_Expecter {
+	return &MockCredentialsListDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsListDaoAuditEventInsert
+func (_mock *MockCredentialsListDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsListDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsListDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsListDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsListDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsListDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsListDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsListDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsListDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsMergeDao creates a new instance of MockCredentialsMergeDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsMergeDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsMergeDao {
+	mock := &MockCredentialsMergeDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsMergeDao is an autogenerated mock type for the CredentialsMergeDao type
+type MockCredentialsMergeDao struct {
+	mock.Mock
+}
+
+type MockCredentialsMergeDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsMergeDao) EXPECT() *MockCredentialsMergeDao_Expecter {
+	return &MockCredentialsMergeDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsMergeDao
+func (_mock *MockCredentialsMergeDao) Exec(ctx context.Context, request *dao.CredentialsMergeRequest) (*dao.CredentialsRedirect, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.CredentialsRedirect
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsMergeRequest) (*dao.CredentialsRedirect, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsMergeRequest) *dao.CredentialsRedirect); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CredentialsRedirect)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsMergeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsMergeDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsMergeDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsMergeRequest
+func (_e *MockCredentialsMergeDao_Expecter) Exec(ctx any, request any) *MockCredentialsMergeDao_Exec_Call {
+	return &MockCredentialsMergeDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsMergeDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsMergeRequest)) *MockCredentialsMergeDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsMergeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsMergeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeDao_Exec_Call) Return(credentialsRedirect *dao.CredentialsRedirect, err error) *MockCredentialsMergeDao_Exec_Call {
+	_c.Call.Return(credentialsRedirect, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsMergeRequest) (*dao.CredentialsRedirect, error)) *MockCredentialsMergeDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsMergeDaoCredentialsSelect creates a new instance of MockCredentialsMergeDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsMergeDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsMergeDaoCredentialsSelect {
+	mock := &MockCredentialsMergeDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsMergeDaoCredentialsSelect is an autogenerated mock type for the CredentialsMergeDaoCredentialsSelect type
+type MockCredentialsMergeDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockCredentialsMergeDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsMergeDaoCredentialsSelect) EXPECT() *MockCredentialsMergeDaoCredentialsSelect_Expecter {
+	return &MockCredentialsMergeDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsMergeDaoCredentialsSelect
+func (_mock *MockCredentialsMergeDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsMergeDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsMergeDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsMergeDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsMergeDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsMergeDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsMergeDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsMergeDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsMergeDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsMergeDaoCredentialsUpdateRole creates a new instance of MockCredentialsMergeDaoCredentialsUpdateRole. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsMergeDaoCredentialsUpdateRole(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsMergeDaoCredentialsUpdateRole {
+	mock := &MockCredentialsMergeDaoCredentialsUpdateRole{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsMergeDaoCredentialsUpdateRole is an autogenerated mock type for the CredentialsMergeDaoCredentialsUpdateRole type
+type MockCredentialsMergeDaoCredentialsUpdateRole struct {
+	mock.Mock
+}
+
+type MockCredentialsMergeDaoCredentialsUpdateRole_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsMergeDaoCredentialsUpdateRole) EXPECT() *MockCredentialsMergeDaoCredentialsUpdateRole_Expecter {
+	return &MockCredentialsMergeDaoCredentialsUpdateRole_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsMergeDaoCredentialsUpdateRole
+func (_mock *MockCredentialsMergeDaoCredentialsUpdateRole) Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateRoleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateRoleRequest
+func (_e *MockCredentialsMergeDaoCredentialsUpdateRole_Expecter) Exec(ctx any, request any) *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call {
+	return &MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest)) *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdateRoleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdateRoleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)) *MockCredentialsMergeDaoCredentialsUpdateRole_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsMergeDaoAuditEventInsert creates a new instance of MockCredentialsMergeDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsMergeDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsMergeDaoAuditEventInsert {
+	mock := &MockCredentialsMergeDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsMergeDaoAuditEventInsert is an autogenerated mock type for the CredentialsMergeDaoAuditEventInsert type
+type MockCredentialsMergeDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsMergeDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsMergeDaoAuditEventInsert) EXPECT() *MockCredentialsMergeDaoAuditEventInsert_Expecter {
+	return &MockCredentialsMergeDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsMergeDaoAuditEventInsert
+func (_mock *MockCredentialsMergeDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsMergeDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsMergeDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsMergeDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsMergeDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsMergeDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsMergeDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsMergeDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsMergeDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsMergeDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsMergeDaoCredentialsEventInsert creates a new instance of MockCredentialsMergeDaoCredentialsEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsMergeDaoCredentialsEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsMergeDaoCredentialsEventInsert {
+	mock := &MockCredentialsMergeDaoCredentialsEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsMergeDaoCredentialsEventInsert is an autogenerated mock type for the CredentialsMergeDaoCredentialsEventInsert type
+type MockCredentialsMergeDaoCredentialsEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsMergeDaoCredentialsEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsMergeDaoCredentialsEventInsert) EXPECT() *MockCredentialsMergeDaoCredentialsEventInsert_Expecter {
+	return &MockCredentialsMergeDaoCredentialsEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsMergeDaoCredentialsEventInsert
+func (_mock *MockCredentialsMergeDaoCredentialsEventInsert) Exec(ctx context.Context, request *dao.CredentialsEventInsertRequest) (*dao.CredentialsEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.CredentialsEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsEventInsertRequest) (*dao.CredentialsEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsEventInsertRequest) *dao.CredentialsEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CredentialsEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsEventInsertRequest
+func (_e *MockCredentialsMergeDaoCredentialsEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call {
+	return &MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsEventInsertRequest)) *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call) Return(credentialsEvent *dao.CredentialsEvent, err error) *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call {
+	_c.Call.Return(credentialsEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsEventInsertRequest) (*dao.CredentialsEvent, error)) *MockCredentialsMergeDaoCredentialsEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsMergeRoles creates a new instance of MockCredentialsMergeRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsMergeRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsMergeRoles {
+	mock := &MockCredentialsMergeRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsMergeRoles is an autogenerated mock type for the CredentialsMergeRoles type
+type MockCredentialsMergeRoles struct {
+	mock.Mock
+}
+
+type MockCredentialsMergeRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsMergeRoles) EXPECT() *MockCredentialsMergeRoles_Expecter {
+	return &MockCredentialsMergeRoles_Expecter{mock: &_m.Mock}
+}
+
+// Rank provides a mock function for the type MockCredentialsMergeRoles
+func (_mock *MockCredentialsMergeRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsMergeRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockCredentialsMergeRoles_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockCredentialsMergeRoles_Expecter) Rank(roles any) *MockCredentialsMergeRoles_Rank_Call {
+	return &MockCredentialsMergeRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *MockCredentialsMergeRoles_Rank_Call) Run(run func(roles []string)) *MockCredentialsMergeRoles_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeRoles_Rank_Call) Return(n int, err error) *MockCredentialsMergeRoles_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockCredentialsMergeRoles_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsPolicyTargetGetDao creates a new instance of MockCredentialsPolicyTargetGetDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsPolicyTargetGetDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsPolicyTargetGetDao {
+	mock := &MockCredentialsPolicyTargetGetDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsPolicyTargetGetDao is an autogenerated mock type for the CredentialsPolicyTargetGetDao type
+type MockCredentialsPolicyTargetGetDao struct {
+	mock.Mock
+}
+
+type MockCredentialsPolicyTargetGetDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsPolicyTargetGetDao) EXPECT() *MockCredentialsPolicyTargetGetDao_Expecter {
+	return &MockCredentialsPolicyTargetGetDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsPolicyTargetGetDao
+func (_mock *MockCredentialsPolicyTargetGetDao) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsPolicyTargetGetDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsPolicyTargetGetDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsPolicyTargetGetDao_Expecter) Exec(ctx any, request any) *MockCredentialsPolicyTargetGetDao_Exec_Call {
+	return &MockCredentialsPolicyTargetGetDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsPolicyTargetGetDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsPolicyTargetGetDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsPolicyTargetGetDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsPolicyTargetGetDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsPolicyTargetGetDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsPolicyTargetGetDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsPolicyTargetGetRoles creates a new instance of MockCredentialsPolicyTargetGetRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsPolicyTargetGetRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsPolicyTargetGetRoles {
+	mock := &MockCredentialsPolicyTargetGetRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsPolicyTargetGetRoles is an autogenerated mock type for the CredentialsPolicyTargetGetRoles type
+type MockCredentialsPolicyTargetGetRoles struct {
+	mock.Mock
+}
+
+type MockCredentialsPolicyTargetGetRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsPolicyTargetGetRoles) EXPECT() *MockCredentialsPolicyTargetGetRoles_Expecter {
+	return &MockCredentialsPolicyTargetGetRoles_Expecter{mock: &_m.Mock}
+}
+
+// Rank provides a mock function for the type MockCredentialsPolicyTargetGetRoles
+func (_mock *MockCredentialsPolicyTargetGetRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsPolicyTargetGetRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockCredentialsPolicyTargetGetRoles_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockCredentialsPolicyTargetGetRoles_Expecter) Rank(roles any) *MockCredentialsPolicyTargetGetRoles_Rank_Call {
+	return &MockCredentialsPolicyTargetGetRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *MockCredentialsPolicyTargetGetRoles_Rank_Call) Run(run func(roles []string)) *MockCredentialsPolicyTargetGetRoles_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsPolicyTargetGetRoles_Rank_Call) Return(n int, err error) *MockCredentialsPolicyTargetGetRoles_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsPolicyTargetGetRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockCredentialsPolicyTargetGetRoles_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsRedirectGetDao creates a new instance of MockCredentialsRedirectGetDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsRedirectGetDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsRedirectGetDao {
+	mock := &MockCredentialsRedirectGetDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsRedirectGetDao is an autogenerated mock type for the CredentialsRedirectGetDao type
+type MockCredentialsRedirectGetDao struct {
+	mock.Mock
+}
+
+type MockCredentialsRedirectGetDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsRedirectGetDao) EXPECT() *MockCredentialsRedirectGetDao_Expecter {
+	return &MockCredentialsRedirectGetDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsRedirectGetDao
+func (_mock *MockCredentialsRedirectGetDao) Exec(ctx context.Context, request *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.CredentialsRedirect
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsRedirectSelectRequest) *dao.CredentialsRedirect); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CredentialsRedirect)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsRedirectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsRedirectGetDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsRedirectGetDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsRedirectSelectRequest
+func (_e *MockCredentialsRedirectGetDao_Expecter) Exec(ctx any, request any) *MockCredentialsRedirectGetDao_Exec_Call {
+	return &MockCredentialsRedirectGetDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsRedirectGetDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsRedirectSelectRequest)) *MockCredentialsRedirectGetDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsRedirectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsRedirectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsRedirectGetDao_Exec_Call) Return(credentialsRedirect *dao.CredentialsRedirect, err error) *MockCredentialsRedirectGetDao_Exec_Call {
+	_c.Call.Return(credentialsRedirect, err)
+	return _c
+}
+
+func (_c *MockCredentialsRedirectGetDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error)) *MockCredentialsRedirectGetDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateEmailDao creates a new instance of MockCredentialsUpdateEmailDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateEmailDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateEmailDao {
+	mock := &MockCredentialsUpdateEmailDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateEmailDao is an autogenerated mock type for the CredentialsUpdateEmailDao type
+type MockCredentialsUpdateEmailDao struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateEmailDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateEmailDao) EXPECT() *MockCredentialsUpdateEmailDao_Expecter {
+	return &MockCredentialsUpdateEmailDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateEmailDao
+func (_mock *MockCredentialsUpdateEmailDao) Exec(ctx context.Context, request *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateEmailDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateEmailDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateEmailRequest
+func (_e *MockCredentialsUpdateEmailDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailDao_Exec_Call {
+	return &MockCredentialsUpdateEmailDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateEmailDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateEmailRequest)) *MockCredentialsUpdateEmailDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdateEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdateEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateEmailDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateEmailRequest) (*dao.Credentials, error)) *MockCredentialsUpdateEmailDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateEmailDaoCredentialsSelect creates a new instance of MockCredentialsUpdateEmailDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateEmailDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateEmailDaoCredentialsSelect {
+	mock := &MockCredentialsUpdateEmailDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateEmailDaoCredentialsSelect is an autogenerated mock type for the CredentialsUpdateEmailDaoCredentialsSelect type
+type MockCredentialsUpdateEmailDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateEmailDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateEmailDaoCredentialsSelect) EXPECT() *MockCredentialsUpdateEmailDaoCredentialsSelect_Expecter {
+	return &MockCredentialsUpdateEmailDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateEmailDaoCredentialsSelect
+func (_mock *MockCredentialsUpdateEmailDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsUpdateEmailDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsUpdateEmailDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateEmailServiceShortCodeConsume creates a new instance of MockCredentialsUpdateEmailServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateEmailServiceShortCodeConsume(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateEmailServiceShortCodeConsume {
+	mock := &MockCredentialsUpdateEmailServiceShortCodeConsume{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateEmailServiceShortCodeConsume is an autogenerated mock type for the CredentialsUpdateEmailServiceShortCodeConsume type
+type MockCredentialsUpdateEmailServiceShortCodeConsume struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateEmailServiceShortCodeConsume) EXPECT() *MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter {
+	return &MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateEmailServiceShortCodeConsume
+func (_mock *MockCredentialsUpdateEmailServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeConsumeRequest
+func (_e *MockCredentialsUpdateEmailServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
+	return &MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeConsumeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsUpdateEmailServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateEmailSmtp creates a new instance of MockCredentialsUpdateEmailSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateEmailSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateEmailSmtp {
+	mock := &MockCredentialsUpdateEmailSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateEmailSmtp is an autogenerated mock type for the CredentialsUpdateEmailSmtp type
+type MockCredentialsUpdateEmailSmtp struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateEmailSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateEmailSmtp) EXPECT() *MockCredentialsUpdateEmailSmtp_Expecter {
+	return &MockCredentialsUpdateEmailSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockCredentialsUpdateEmailSmtp
+func (_mock *MockCredentialsUpdateEmailSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCredentialsUpdateEmailSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockCredentialsUpdateEmailSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockCredentialsUpdateEmailSmtp_Expecter) Ping() *MockCredentialsUpdateEmailSmtp_Ping_Call {
+	return &MockCredentialsUpdateEmailSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockCredentialsUpdateEmailSmtp_Ping_Call) Run(run func()) *MockCredentialsUpdateEmailSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailSmtp_Ping_Call) Return(err error) *MockCredentialsUpdateEmailSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailSmtp_Ping_Call) RunAndReturn(run func() error) *MockCredentialsUpdateEmailSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockCredentialsUpdateEmailSmtp
+func (_mock *MockCredentialsUpdateEmailSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCredentialsUpdateEmailSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockCredentialsUpdateEmailSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockCredentialsUpdateEmailSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockCredentialsUpdateEmailSmtp_SendMail_Call {
+	return &MockCredentialsUpdateEmailSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockCredentialsUpdateEmailSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockCredentialsUpdateEmailSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailSmtp_SendMail_Call) Return(err error) *MockCredentialsUpdateEmailSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockCredentialsUpdateEmailSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateLocaleDao creates a new instance of MockCredentialsUpdateLocaleDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateLocaleDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateLocaleDao {
+	mock := &MockCredentialsUpdateLocaleDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateLocaleDao is an autogenerated mock type for the CredentialsUpdateLocaleDao type
+type MockCredentialsUpdateLocaleDao struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateLocaleDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateLocaleDao) EXPECT() *MockCredentialsUpdateLocaleDao_Expecter {
+	return &MockCredentialsUpdateLocaleDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateLocaleDao
+func (_mock *MockCredentialsUpdateLocaleDao) Exec(ctx context.Context, request *dao.CredentialsUpdateLocaleRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateLocaleRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateLocaleRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateLocaleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateLocaleDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateLocaleDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateLocaleRequest
+func (_e *MockCredentialsUpdateLocaleDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateLocaleDao_Exec_Call {
+	return &MockCredentialsUpdateLocaleDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateLocaleDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateLocaleRequest)) *MockCredentialsUpdateLocaleDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdateLocaleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdateLocaleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateLocaleDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateLocaleDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateLocaleDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateLocaleRequest) (*dao.Credentials, error)) *MockCredentialsUpdateLocaleDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateNoticesDao creates a new instance of MockCredentialsUpdateNoticesDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateNoticesDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateNoticesDao {
+	mock := &MockCredentialsUpdateNoticesDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateNoticesDao is an autogenerated mock type for the CredentialsUpdateNoticesDao type
+type MockCredentialsUpdateNoticesDao struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateNoticesDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateNoticesDao) EXPECT() *MockCredentialsUpdateNoticesDao_Expecter {
+	return &MockCredentialsUpdateNoticesDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateNoticesDao
+func (_mock *MockCredentialsUpdateNoticesDao) Exec(ctx context.Context, request *dao.CredentialsUpdateNoticesRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateNoticesRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateNoticesRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateNoticesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateNoticesDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateNoticesDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateNoticesRequest
+func (_e *MockCredentialsUpdateNoticesDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateNoticesDao_Exec_Call {
+	return &MockCredentialsUpdateNoticesDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateNoticesDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateNoticesRequest)) *MockCredentialsUpdateNoticesDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdateNoticesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdateNoticesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateNoticesDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateNoticesDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateNoticesDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateNoticesRequest) (*dao.Credentials, error)) *MockCredentialsUpdateNoticesDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdatePasswordDao creates a new instance of MockCredentialsUpdatePasswordDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdatePasswordDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdatePasswordDao {
+	mock := &MockCredentialsUpdatePasswordDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdatePasswordDao is an autogenerated mock type for the CredentialsUpdatePasswordDao type
+type MockCredentialsUpdatePasswordDao struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdatePasswordDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdatePasswordDao) EXPECT() *MockCredentialsUpdatePasswordDao_Expecter {
+	return &MockCredentialsUpdatePasswordDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdatePasswordDao
+func (_mock *MockCredentialsUpdatePasswordDao) Exec(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdatePasswordRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdatePasswordDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdatePasswordDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdatePasswordRequest
+func (_e *MockCredentialsUpdatePasswordDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordDao_Exec_Call {
+	return &MockCredentialsUpdatePasswordDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdatePasswordDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest)) *MockCredentialsUpdatePasswordDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdatePasswordRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdatePasswordRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdatePasswordDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdatePasswordRequest) (*dao.Credentials, error)) *MockCredentialsUpdatePasswordDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdatePasswordDaoCredentialsSelect creates a new instance of MockCredentialsUpdatePasswordDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdatePasswordDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdatePasswordDaoCredentialsSelect {
+	mock := &MockCredentialsUpdatePasswordDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdatePasswordDaoCredentialsSelect is an autogenerated mock type for the CredentialsUpdatePasswordDaoCredentialsSelect type
+type MockCredentialsUpdatePasswordDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdatePasswordDaoCredentialsSelect) EXPECT() *MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter {
+	return &MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdatePasswordDaoCredentialsSelect
+func (_mock *MockCredentialsUpdatePasswordDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsUpdatePasswordDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsUpdatePasswordDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdatePasswordServiceShortCodeConsume creates a new instance of MockCredentialsUpdatePasswordServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdatePasswordServiceShortCodeConsume(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdatePasswordServiceShortCodeConsume {
+	mock := &MockCredentialsUpdatePasswordServiceShortCodeConsume{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdatePasswordServiceShortCodeConsume is an autogenerated mock type for the CredentialsUpdatePasswordServiceShortCodeConsume type
+type MockCredentialsUpdatePasswordServiceShortCodeConsume struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdatePasswordServiceShortCodeConsume) EXPECT() *MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter {
+	return &MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdatePasswordServiceShortCodeConsume
+func (_mock *MockCredentialsUpdatePasswordServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeConsumeRequest
+func (_e *MockCredentialsUpdatePasswordServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
+	return &MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeConsumeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsUpdatePasswordServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdatePasswordSmtp creates a new instance of MockCredentialsUpdatePasswordSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdatePasswordSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdatePasswordSmtp {
+	mock := &MockCredentialsUpdatePasswordSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdatePasswordSmtp is an autogenerated mock type for the CredentialsUpdatePasswordSmtp type
+type MockCredentialsUpdatePasswordSmtp struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdatePasswordSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdatePasswordSmtp) EXPECT() *MockCredentialsUpdatePasswordSmtp_Expecter {
+	return &MockCredentialsUpdatePasswordSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockCredentialsUpdatePasswordSmtp
+func (_mock *MockCredentialsUpdatePasswordSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCredentialsUpdatePasswordSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockCredentialsUpdatePasswordSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockCredentialsUpdatePasswordSmtp_Expecter) Ping() *MockCredentialsUpdatePasswordSmtp_Ping_Call {
+	return &MockCredentialsUpdatePasswordSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockCredentialsUpdatePasswordSmtp_Ping_Call) Run(run func()) *MockCredentialsUpdatePasswordSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordSmtp_Ping_Call) Return(err error) *MockCredentialsUpdatePasswordSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordSmtp_Ping_Call) RunAndReturn(run func() error) *MockCredentialsUpdatePasswordSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockCredentialsUpdatePasswordSmtp
+func (_mock *MockCredentialsUpdatePasswordSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCredentialsUpdatePasswordSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockCredentialsUpdatePasswordSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockCredentialsUpdatePasswordSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockCredentialsUpdatePasswordSmtp_SendMail_Call {
+	return &MockCredentialsUpdatePasswordSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockCredentialsUpdatePasswordSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockCredentialsUpdatePasswordSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordSmtp_SendMail_Call) Return(err error) *MockCredentialsUpdatePasswordSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockCredentialsUpdatePasswordSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateRoleDao creates a new instance of MockCredentialsUpdateRoleDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateRoleDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateRoleDao {
+	mock := &MockCredentialsUpdateRoleDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateRoleDao is an autogenerated mock type for the CredentialsUpdateRoleDao type
+type MockCredentialsUpdateRoleDao struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateRoleDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateRoleDao) EXPECT() *MockCredentialsUpdateRoleDao_Expecter {
+	return &MockCredentialsUpdateRoleDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateRoleDao
+func (_mock *MockCredentialsUpdateRoleDao) Exec(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateRoleRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateRoleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateRoleDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateRoleDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateRoleRequest
+func (_e *MockCredentialsUpdateRoleDao_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleDao_Exec_Call {
+	return &MockCredentialsUpdateRoleDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateRoleDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest)) *MockCredentialsUpdateRoleDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdateRoleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdateRoleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateRoleDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateRoleRequest) (*dao.Credentials, error)) *MockCredentialsUpdateRoleDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateRoleDaoCredentialsSelect creates a new instance of MockCredentialsUpdateRoleDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateRoleDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateRoleDaoCredentialsSelect {
+	mock := &MockCredentialsUpdateRoleDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateRoleDaoCredentialsSelect is an autogenerated mock type for the CredentialsUpdateRoleDaoCredentialsSelect type
+type MockCredentialsUpdateRoleDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateRoleDaoCredentialsSelect) EXPECT() *MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter {
+	return &MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateRoleDaoCredentialsSelect
+func (_mock *MockCredentialsUpdateRoleDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsUpdateRoleDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsUpdateRoleDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateRoleDaoAuditEventInsert creates a new instance of MockCredentialsUpdateRoleDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateRoleDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateRoleDaoAuditEventInsert {
+	mock := &MockCredentialsUpdateRoleDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateRoleDaoAuditEventInsert is an autogenerated mock type for the CredentialsUpdateRoleDaoAuditEventInsert type
+type MockCredentialsUpdateRoleDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateRoleDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateRoleDaoAuditEventInsert) EXPECT() *MockCredentialsUpdateRoleDaoAuditEventInsert_Expecter {
+	return &MockCredentialsUpdateRoleDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateRoleDaoAuditEventInsert
+func (_mock *MockCredentialsUpdateRoleDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockCredentialsUpdateRoleDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call {
+	return &MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockCredentialsUpdateRoleDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateRoleRoles creates a new instance of MockCredentialsUpdateRoleRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateRoleRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateRoleRoles {
+	mock := &MockCredentialsUpdateRoleRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateRoleRoles is an autogenerated mock type for the CredentialsUpdateRoleRoles type
+type MockCredentialsUpdateRoleRoles struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateRoleRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateRoleRoles) EXPECT() *MockCredentialsUpdateRoleRoles_Expecter {
+	return &MockCredentialsUpdateRoleRoles_Expecter{mock: &_m.Mock}
+}
+
+// Priority provides a mock function for the type MockCredentialsUpdateRoleRoles
+func (_mock *MockCredentialsUpdateRoleRoles) Priority(role string) (int, error) {
+	ret := _mock.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Priority")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(role)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateRoleRoles_Priority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Priority'
+type MockCredentialsUpdateRoleRoles_Priority_Call struct {
+	*mock.Call
+}
+
+// Priority is a helper method to define mock.On call
+//   - role string
+func (_e *MockCredentialsUpdateRoleRoles_Expecter) Priority(role any) *MockCredentialsUpdateRoleRoles_Priority_Call {
+	return &MockCredentialsUpdateRoleRoles_Priority_Call{Call: _e.mock.On("Priority", role)}
+}
+
+func (_c *MockCredentialsUpdateRoleRoles_Priority_Call) Run(run func(role string)) *MockCredentialsUpdateRoleRoles_Priority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleRoles_Priority_Call) Return(n int, err error) *MockCredentialsUpdateRoleRoles_Priority_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleRoles_Priority_Call) RunAndReturn(run func(role string) (int, error)) *MockCredentialsUpdateRoleRoles_Priority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rank provides a mock function for the type MockCredentialsUpdateRoleRoles
+func (_mock *MockCredentialsUpdateRoleRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateRoleRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockCredentialsUpdateRoleRoles_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockCredentialsUpdateRoleRoles_Expecter) Rank(roles any) *MockCredentialsUpdateRoleRoles_Rank_Call {
+	return &MockCredentialsUpdateRoleRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *MockCredentialsUpdateRoleRoles_Rank_Call) Run(run func(roles []string)) *MockCredentialsUpdateRoleRoles_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleRoles_Rank_Call) Return(n int, err error) *MockCredentialsUpdateRoleRoles_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockCredentialsUpdateRoleRoles_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsVerifyEmailDao creates a new instance of MockCredentialsVerifyEmailDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsVerifyEmailDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsVerifyEmailDao {
+	mock := &MockCredentialsVerifyEmailDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsVerifyEmailDao is an autogenerated mock type for the CredentialsVerifyEmailDao type
+type MockCredentialsVerifyEmailDao struct {
+	mock.Mock
+}
+
+type MockCredentialsVerifyEmailDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsVerifyEmailDao) EXPECT() *MockCredentialsVerifyEmailDao_Expecter {
+	return &MockCredentialsVerifyEmailDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsVerifyEmailDao
+func (_mock *MockCredentialsVerifyEmailDao) Exec(ctx context.Context, request *dao.CredentialsUpdateEmailVerifiedRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailVerifiedRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateEmailVerifiedRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateEmailVerifiedRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsVerifyEmailDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsVerifyEmailDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateEmailVerifiedRequest
+func (_e *MockCredentialsVerifyEmailDao_Expecter) Exec(ctx any, request any) *MockCredentialsVerifyEmailDao_Exec_Call {
+	return &MockCredentialsVerifyEmailDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsVerifyEmailDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateEmailVerifiedRequest)) *MockCredentialsVerifyEmailDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsUpdateEmailVerifiedRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsUpdateEmailVerifiedRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsVerifyEmailDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateEmailVerifiedRequest) (*dao.Credentials, error)) *MockCredentialsVerifyEmailDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsVerifyEmailDaoCredentialsSelect creates a new instance of MockCredentialsVerifyEmailDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsVerifyEmailDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsVerifyEmailDaoCredentialsSelect {
+	mock := &MockCredentialsVerifyEmailDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsVerifyEmailDaoCredentialsSelect is an autogenerated mock type for the CredentialsVerifyEmailDaoCredentialsSelect type
+type MockCredentialsVerifyEmailDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockCredentialsVerifyEmailDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsVerifyEmailDaoCredentialsSelect) EXPECT() *MockCredentialsVerifyEmailDaoCredentialsSelect_Expecter {
+	return &MockCredentialsVerifyEmailDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsVerifyEmailDaoCredentialsSelect
+func (_mock *MockCredentialsVerifyEmailDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockCredentialsVerifyEmailDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call {
+	return &MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockCredentialsVerifyEmailDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsVerifyEmailServiceShortCodeConsume creates a new instance of MockCredentialsVerifyEmailServiceShortCodeConsume. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsVerifyEmailServiceShortCodeConsume(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsVerifyEmailServiceShortCodeConsume {
+	mock := &MockCredentialsVerifyEmailServiceShortCodeConsume{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsVerifyEmailServiceShortCodeConsume is an autogenerated mock type for the CredentialsVerifyEmailServiceShortCodeConsume type
+type MockCredentialsVerifyEmailServiceShortCodeConsume struct {
+	mock.Mock
+}
+
+type MockCredentialsVerifyEmailServiceShortCodeConsume_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsVerifyEmailServiceShortCodeConsume) EXPECT() *MockCredentialsVerifyEmailServiceShortCodeConsume_Expecter {
+	return &MockCredentialsVerifyEmailServiceShortCodeConsume_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsVerifyEmailServiceShortCodeConsume
+func (_mock *MockCredentialsVerifyEmailServiceShortCodeConsume) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeConsumeRequest
+func (_e *MockCredentialsVerifyEmailServiceShortCodeConsume_Expecter) Exec(ctx any, request any) *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call {
+	return &MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeConsumeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *MockCredentialsVerifyEmailServiceShortCodeConsume_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockloginEventRecorder creates a new instance of mockloginEventRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockloginEventRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockloginEventRecorder {
+	mock := &mockloginEventRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockloginEventRecorder is an autogenerated mock type for the loginEventRecorder type
+type mockloginEventRecorder struct {
+	mock.Mock
+}
+
+type mockloginEventRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockloginEventRecorder) EXPECT() *mockloginEventRecorder_Expecter {
+	return &mockloginEventRecorder_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type mockloginEventRecorder
+func (_mock *mockloginEventRecorder) Exec(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.LoginEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventInsertRequest) *dao.LoginEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoginEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.LoginEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockloginEventRecorder_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type mockloginEventRecorder_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.LoginEventInsertRequest
+func (_e *mockloginEventRecorder_Expecter) Exec(ctx any, request any) *mockloginEventRecorder_Exec_Call {
+	return &mockloginEventRecorder_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *mockloginEventRecorder_Exec_Call) Run(run func(ctx context.Context, request *dao.LoginEventInsertRequest)) *mockloginEventRecorder_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.LoginEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.LoginEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockloginEventRecorder_Exec_Call) Return(loginEvent *dao.LoginEvent, err error) *mockloginEventRecorder_Exec_Call {
+	_c.Call.Return(loginEvent, err)
+	return _c
+}
+
+func (_c *mockloginEventRecorder_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)) *mockloginEventRecorder_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginEventListDao creates a new instance of MockLoginEventListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginEventListDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginEventListDao {
+	mock := &MockLoginEventListDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginEventListDao is an autogenerated mock type for the LoginEventListDao type
+type MockLoginEventListDao struct {
+	mock.Mock
+}
+
+type MockLoginEventListDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginEventListDao) EXPECT() *MockLoginEventListDao_Expecter {
+	return &MockLoginEventListDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockLoginEventListDao
+func (_mock *MockLoginEventListDao) Exec(ctx context.Context, request *dao.LoginEventListRequest) ([]*dao.LoginEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.LoginEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventListRequest) ([]*dao.LoginEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventListRequest) []*dao.LoginEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.LoginEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.LoginEventListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginEventListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockLoginEventListDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.LoginEventListRequest
+func (_e *MockLoginEventListDao_Expecter) Exec(ctx any, request any) *MockLoginEventListDao_Exec_Call {
+	return &MockLoginEventListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockLoginEventListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.LoginEventListRequest)) *MockLoginEventListDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.LoginEventListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.LoginEventListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginEventListDao_Exec_Call) Return(loginEvents []*dao.LoginEvent, err error) *MockLoginEventListDao_Exec_Call {
+	_c.Call.Return(loginEvents, err)
+	return _c
+}
+
+func (_c *MockLoginEventListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.LoginEventListRequest) ([]*dao.LoginEvent, error)) *MockLoginEventListDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoginEventListDaoAuditEventInsert creates a new instance of MockLoginEventListDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoginEventListDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoginEventListDaoAuditEventInsert {
+	mock := &MockLoginEventListDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLoginEventListDaoAuditEventInsert is an autogenerated mock type for the LoginEventListDaoAuditEventInsert type
+type MockLoginEventListDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockLoginEventListDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoginEventListDaoAuditEventInsert) EXPECT() *MockLoginEventListDaoAuditEventInsert_Expecter {
+	return &MockLoginEventListDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockLoginEventListDaoAuditEventInsert
+func (_mock *MockLoginEventListDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLoginEventListDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockLoginEventListDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockLoginEventListDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockLoginEventListDaoAuditEventInsert_Exec_Call {
+	return &MockLoginEventListDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockLoginEventListDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockLoginEventListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLoginEventListDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockLoginEventListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockLoginEventListDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockLoginEventListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPermissionsCheckServiceVerifyClaims creates a new instance of MockPermissionsCheckServiceVerifyClaims. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPermissionsCheckServiceVerifyClaims(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPermissionsCheckServiceVerifyClaims {
+	mock := &MockPermissionsCheckServiceVerifyClaims{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPermissionsCheckServiceVerifyClaims is an autogenerated mock type for the PermissionsCheckServiceVerifyClaims type
+type MockPermissionsCheckServiceVerifyClaims struct {
+	mock.Mock
+}
+
+type MockPermissionsCheckServiceVerifyClaims_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPermissionsCheckServiceVerifyClaims) EXPECT() *MockPermissionsCheckServiceVerifyClaims_Expecter {
+	return &MockPermissionsCheckServiceVerifyClaims_Expecter{mock: &_m.Mock}
+}
+
+// VerifyClaims provides a mock function for the type MockPermissionsCheckServiceVerifyClaims
+func (_mock *MockPermissionsCheckServiceVerifyClaims) VerifyClaims(ctx context.Context, req *servicejsonkeys.VerifyClaimsRequest) (*core.AccessTokenClaims, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyClaims")
+	}
+
+	var r0 *core.AccessTokenClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.VerifyClaimsRequest) (*core.AccessTokenClaims, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.VerifyClaimsRequest) *core.AccessTokenClaims); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.AccessTokenClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.VerifyClaimsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyClaims'
+type MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call struct {
+	*mock.Call
+}
+
+// VerifyClaims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.VerifyClaimsRequest
+func (_e *MockPermissionsCheckServiceVerifyClaims_Expecter) VerifyClaims(ctx any, req any) *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call {
+	return &MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call{Call: _e.mock.On("VerifyClaims", ctx, req)}
+}
+
+func (_c *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call) Run(run func(ctx context.Context, req *servicejsonkeys.VerifyClaimsRequest)) *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.VerifyClaimsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.VerifyClaimsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call) Return(accessTokenClaims *core.AccessTokenClaims, err error) *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call {
+	_c.Call.Return(accessTokenClaims, err)
+	return _c
+}
+
+func (_c *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.VerifyClaimsRequest) (*core.AccessTokenClaims, error)) *MockPermissionsCheckServiceVerifyClaims_VerifyClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPermissionsCheckDaoCredentialsSelect creates a new instance of MockPermissionsCheckDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPermissionsCheckDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPermissionsCheckDaoCredentialsSelect {
+	mock := &MockPermissionsCheckDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPermissionsCheckDaoCredentialsSelect is an autogenerated mock type for the PermissionsCheckDaoCredentialsSelect type
+type MockPermissionsCheckDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockPermissionsCheckDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPermissionsCheckDaoCredentialsSelect) EXPECT() *MockPermissionsCheckDaoCredentialsSelect_Expecter {
+	return &MockPermissionsCheckDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockPermissionsCheckDaoCredentialsSelect
+func (_mock *MockPermissionsCheckDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPermissionsCheckDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockPermissionsCheckDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockPermissionsCheckDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockPermissionsCheckDaoCredentialsSelect_Exec_Call {
+	return &MockPermissionsCheckDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockPermissionsCheckDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockPermissionsCheckDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPermissionsCheckDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockPermissionsCheckDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockPermissionsCheckDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockPermissionsCheckDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPermissionsCheckRoles creates a new instance of MockPermissionsCheckRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPermissionsCheckRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPermissionsCheckRoles {
+	mock := &MockPermissionsCheckRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPermissionsCheckRoles is an autogenerated mock type for the PermissionsCheckRoles type
+type MockPermissionsCheckRoles struct {
+	mock.Mock
+}
+
+type MockPermissionsCheckRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPermissionsCheckRoles) EXPECT() *MockPermissionsCheckRoles_Expecter {
+	return &MockPermissionsCheckRoles_Expecter{mock: &_m.Mock}
+}
+
+// PermissionsByRole provides a mock function for the type MockPermissionsCheckRoles
+func (_mock *MockPermissionsCheckRoles) PermissionsByRole() map[string][]string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermissionsByRole")
+	}
+
+	var r0 map[string][]string
+	if returnFunc, ok := ret.Get(0).(func() map[string][]string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	return r0
+}
+
+// MockPermissionsCheckRoles_PermissionsByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermissionsByRole'
+type MockPermissionsCheckRoles_PermissionsByRole_Call struct {
+	*mock.Call
+}
+
+// PermissionsByRole is a helper method to define mock.On call
+func (_e *MockPermissionsCheckRoles_Expecter) PermissionsByRole() *MockPermissionsCheckRoles_PermissionsByRole_Call {
+	return &MockPermissionsCheckRoles_PermissionsByRole_Call{Call: _e.mock.On("PermissionsByRole")}
+}
+
+func (_c *MockPermissionsCheckRoles_PermissionsByRole_Call) Run(run func()) *MockPermissionsCheckRoles_PermissionsByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPermissionsCheckRoles_PermissionsByRole_Call) Return(stringToStrings map[string][]string) *MockPermissionsCheckRoles_PermissionsByRole_Call {
+	_c.Call.Return(stringToStrings)
+	return _c
+}
+
+func (_c *MockPermissionsCheckRoles_PermissionsByRole_Call) RunAndReturn(run func() map[string][]string) *MockPermissionsCheckRoles_PermissionsByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPermissionsRolesRegistry creates a new instance of MockPermissionsRolesRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPermissionsRolesRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPermissionsRolesRegistry {
+	mock := &MockPermissionsRolesRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPermissionsRolesRegistry is an autogenerated mock type for the PermissionsRolesRegistry type
+type MockPermissionsRolesRegistry struct {
+	mock.Mock
+}
+
+type MockPermissionsRolesRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPermissionsRolesRegistry) EXPECT() *MockPermissionsRolesRegistry_Expecter {
+	return &MockPermissionsRolesRegistry_Expecter{mock: &_m.Mock}
+}
+
+// PermissionsByRole provides a mock function for the type MockPermissionsRolesRegistry
+func (_mock *MockPermissionsRolesRegistry) PermissionsByRole() map[string][]string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermissionsByRole")
+	}
+
+	var r0 map[string][]string
+	if returnFunc, ok := ret.Get(0).(func() map[string][]string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	return r0
+}
+
+// MockPermissionsRolesRegistry_PermissionsByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermissionsByRole'
+type MockPermissionsRolesRegistry_PermissionsByRole_Call struct {
+	*mock.Call
+}
+
+// PermissionsByRole is a helper method to define mock.On call
+func (_e *MockPermissionsRolesRegistry_Expecter) PermissionsByRole() *MockPermissionsRolesRegistry_PermissionsByRole_Call {
+	return &MockPermissionsRolesRegistry_PermissionsByRole_Call{Call: _e.mock.On("PermissionsByRole")}
+}
+
+func (_c *MockPermissionsRolesRegistry_PermissionsByRole_Call) Run(run func()) *MockPermissionsRolesRegistry_PermissionsByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPermissionsRolesRegistry_PermissionsByRole_Call) Return(stringToStrings map[string][]string) *MockPermissionsRolesRegistry_PermissionsByRole_Call {
+	_c.Call.Return(stringToStrings)
+	return _c
+}
+
+func (_c *MockPermissionsRolesRegistry_PermissionsByRole_Call) RunAndReturn(run func() map[string][]string) *MockPermissionsRolesRegistry_PermissionsByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockroleRanker creates a new instance of mockroleRanker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockroleRanker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockroleRanker {
+	mock := &mockroleRanker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockroleRanker is an autogenerated mock type for the roleRanker type
+type mockroleRanker struct {
+	mock.Mock
+}
+
+type mockroleRanker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockroleRanker) EXPECT() *mockroleRanker_Expecter {
+	return &mockroleRanker_Expecter{mock: &_m.Mock}
+}
+
+// Priority provides a mock function for the type mockroleRanker
+func (_mock *mockroleRanker) Priority(role string) (int, error) {
+	ret := _mock.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Priority")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(role)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockroleRanker_Priority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Priority'
+type mockroleRanker_Priority_Call struct {
+	*mock.Call
+}
+
+// Priority is a helper method to define mock.On call
+//   - role string
+func (_e *mockroleRanker_Expecter) Priority(role any) *mockroleRanker_Priority_Call {
+	return &mockroleRanker_Priority_Call{Call: _e.mock.On("Priority", role)}
+}
+
+func (_c *mockroleRanker_Priority_Call) Run(run func(role string)) *mockroleRanker_Priority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *mockroleRanker_Priority_Call) Return(n int, err error) *mockroleRanker_Priority_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *mockroleRanker_Priority_Call) RunAndReturn(run func(role string) (int, error)) *mockroleRanker_Priority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rank provides a mock function for the type mockroleRanker
+func (_mock *mockroleRanker) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockroleRanker_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type mockroleRanker_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *mockroleRanker_Expecter) Rank(roles any) *mockroleRanker_Rank_Call {
+	return &mockroleRanker_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *mockroleRanker_Rank_Call) Run(run func(roles []string)) *mockroleRanker_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *mockroleRanker_Rank_Call) Return(n int, err error) *mockroleRanker_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *mockroleRanker_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *mockroleRanker_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockroleRegistryLoader creates a new instance of mockroleRegistryLoader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockroleRegistryLoader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockroleRegistryLoader {
+	mock := &mockroleRegistryLoader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockroleRegistryLoader is an autogenerated mock type for the roleRegistryLoader type
+type mockroleRegistryLoader struct {
+	mock.Mock
+}
+
+type mockroleRegistryLoader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockroleRegistryLoader) EXPECT() *mockroleRegistryLoader_Expecter {
+	return &mockroleRegistryLoader_Expecter{mock: &_m.Mock}
+}
+
+// Load provides a mock function for the type mockroleRegistryLoader
+func (_mock *mockroleRegistryLoader) Load(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockroleRegistryLoader_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type mockroleRegistryLoader_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockroleRegistryLoader_Expecter) Load(ctx any) *mockroleRegistryLoader_Load_Call {
+	return &mockroleRegistryLoader_Load_Call{Call: _e.mock.On("Load", ctx)}
+}
+
+func (_c *mockroleRegistryLoader_Load_Call) Run(run func(ctx context.Context)) *mockroleRegistryLoader_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *mockroleRegistryLoader_Load_Call) Return(err error) *mockroleRegistryLoader_Load_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockroleRegistryLoader_Load_Call) RunAndReturn(run func(ctx context.Context) error) *mockroleRegistryLoader_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleCreateDao creates a new instance of MockRoleCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleCreateDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleCreateDao {
+	mock := &MockRoleCreateDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleCreateDao is an autogenerated mock type for the RoleCreateDao type
+type MockRoleCreateDao struct {
+	mock.Mock
+}
+
+type MockRoleCreateDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleCreateDao) EXPECT() *MockRoleCreateDao_Expecter {
+	return &MockRoleCreateDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleCreateDao
+func (_mock *MockRoleCreateDao) Exec(ctx context.Context, request *dao.RoleInsertRequest) (*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleInsertRequest) (*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleInsertRequest) *dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleCreateDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleInsertRequest
+func (_e *MockRoleCreateDao_Expecter) Exec(ctx any, request any) *MockRoleCreateDao_Exec_Call {
+	return &MockRoleCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleInsertRequest)) *MockRoleCreateDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleCreateDao_Exec_Call) Return(role *dao.Role, err error) *MockRoleCreateDao_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleInsertRequest) (*dao.Role, error)) *MockRoleCreateDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleCreateDaoRoleList creates a new instance of MockRoleCreateDaoRoleList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleCreateDaoRoleList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleCreateDaoRoleList {
+	mock := &MockRoleCreateDaoRoleList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleCreateDaoRoleList is an autogenerated mock type for the RoleCreateDaoRoleList type
+type MockRoleCreateDaoRoleList struct {
+	mock.Mock
+}
+
+type MockRoleCreateDaoRoleList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleCreateDaoRoleList) EXPECT() *MockRoleCreateDaoRoleList_Expecter {
+	return &MockRoleCreateDaoRoleList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleCreateDaoRoleList
+func (_mock *MockRoleCreateDaoRoleList) Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) ([]*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) []*dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleCreateDaoRoleList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleCreateDaoRoleList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleListRequest
+func (_e *MockRoleCreateDaoRoleList_Expecter) Exec(ctx any, request any) *MockRoleCreateDaoRoleList_Exec_Call {
+	return &MockRoleCreateDaoRoleList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleCreateDaoRoleList_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleListRequest)) *MockRoleCreateDaoRoleList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleCreateDaoRoleList_Exec_Call) Return(roles []*dao.Role, err error) *MockRoleCreateDaoRoleList_Exec_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRoleCreateDaoRoleList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)) *MockRoleCreateDaoRoleList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleCreateDaoAuditEventInsert creates a new instance of MockRoleCreateDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleCreateDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleCreateDaoAuditEventInsert {
+	mock := &MockRoleCreateDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleCreateDaoAuditEventInsert is an autogenerated mock type for the RoleCreateDaoAuditEventInsert type
+type MockRoleCreateDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockRoleCreateDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleCreateDaoAuditEventInsert) EXPECT() *MockRoleCreateDaoAuditEventInsert_Expecter {
+	return &MockRoleCreateDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleCreateDaoAuditEventInsert
+func (_mock *MockRoleCreateDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleCreateDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleCreateDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockRoleCreateDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockRoleCreateDaoAuditEventInsert_Exec_Call {
+	return &MockRoleCreateDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleCreateDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockRoleCreateDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleCreateDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockRoleCreateDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockRoleCreateDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockRoleCreateDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleCreateRegistry creates a new instance of MockRoleCreateRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleCreateRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleCreateRegistry {
+	mock := &MockRoleCreateRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleCreateRegistry is an autogenerated mock type for the RoleCreateRegistry type
+type MockRoleCreateRegistry struct {
+	mock.Mock
+}
+
+type MockRoleCreateRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleCreateRegistry) EXPECT() *MockRoleCreateRegistry_Expecter {
+	return &MockRoleCreateRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Load provides a mock function for the type MockRoleCreateRegistry
+func (_mock *MockRoleCreateRegistry) Load(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoleCreateRegistry_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type MockRoleCreateRegistry_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRoleCreateRegistry_Expecter) Load(ctx any) *MockRoleCreateRegistry_Load_Call {
+	return &MockRoleCreateRegistry_Load_Call{Call: _e.mock.On("Load", ctx)}
+}
+
+func (_c *MockRoleCreateRegistry_Load_Call) Run(run func(ctx context.Context)) *MockRoleCreateRegistry_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleCreateRegistry_Load_Call) Return(err error) *MockRoleCreateRegistry_Load_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoleCreateRegistry_Load_Call) RunAndReturn(run func(ctx context.Context) error) *MockRoleCreateRegistry_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleDeleteDao creates a new instance of MockRoleDeleteDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleDeleteDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleDeleteDao {
+	mock := &MockRoleDeleteDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleDeleteDao is an autogenerated mock type for the RoleDeleteDao type
+type MockRoleDeleteDao struct {
+	mock.Mock
+}
+
+type MockRoleDeleteDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleDeleteDao) EXPECT() *MockRoleDeleteDao_Expecter {
+	return &MockRoleDeleteDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleDeleteDao
+func (_mock *MockRoleDeleteDao) Exec(ctx context.Context, request *dao.RoleDeleteRequest) (*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleDeleteRequest) (*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleDeleteRequest) *dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleDeleteDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleDeleteDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleDeleteRequest
+func (_e *MockRoleDeleteDao_Expecter) Exec(ctx any, request any) *MockRoleDeleteDao_Exec_Call {
+	return &MockRoleDeleteDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleDeleteDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleDeleteRequest)) *MockRoleDeleteDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleDeleteDao_Exec_Call) Return(role *dao.Role, err error) *MockRoleDeleteDao_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleDeleteDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleDeleteRequest) (*dao.Role, error)) *MockRoleDeleteDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleDeleteDaoAuditEventInsert creates a new instance of MockRoleDeleteDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleDeleteDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleDeleteDaoAuditEventInsert {
+	mock := &MockRoleDeleteDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleDeleteDaoAuditEventInsert is an autogenerated mock type for the RoleDeleteDaoAuditEventInsert type
+type MockRoleDeleteDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockRoleDeleteDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleDeleteDaoAuditEventInsert) EXPECT() *MockRoleDeleteDaoAuditEventInsert_Expecter {
+	return &MockRoleDeleteDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleDeleteDaoAuditEventInsert
+func (_mock *MockRoleDeleteDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleDeleteDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleDeleteDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockRoleDeleteDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockRoleDeleteDaoAuditEventInsert_Exec_Call {
+	return &MockRoleDeleteDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleDeleteDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockRoleDeleteDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleDeleteDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockRoleDeleteDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockRoleDeleteDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockRoleDeleteDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleDeleteRegistry creates a new instance of MockRoleDeleteRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleDeleteRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleDeleteRegistry {
+	mock := &MockRoleDeleteRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleDeleteRegistry is an autogenerated mock type for the RoleDeleteRegistry type
+type MockRoleDeleteRegistry struct {
+	mock.Mock
+}
+
+type MockRoleDeleteRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleDeleteRegistry) EXPECT() *MockRoleDeleteRegistry_Expecter {
+	return &MockRoleDeleteRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Load provides a mock function for the type MockRoleDeleteRegistry
+func (_mock *MockRoleDeleteRegistry) Load(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoleDeleteRegistry_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type MockRoleDeleteRegistry_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRoleDeleteRegistry_Expecter) Load(ctx any) *MockRoleDeleteRegistry_Load_Call {
+	return &MockRoleDeleteRegistry_Load_Call{Call: _e.mock.On("Load", ctx)}
+}
+
+func (_c *MockRoleDeleteRegistry_Load_Call) Run(run func(ctx context.Context)) *MockRoleDeleteRegistry_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleDeleteRegistry_Load_Call) Return(err error) *MockRoleDeleteRegistry_Load_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoleDeleteRegistry_Load_Call) RunAndReturn(run func(ctx context.Context) error) *MockRoleDeleteRegistry_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleListDao creates a new instance of MockRoleListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleListDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleListDao {
+	mock := &MockRoleListDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleListDao is an autogenerated mock type for the RoleListDao type
+type MockRoleListDao struct {
+	mock.Mock
+}
+
+type MockRoleListDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleListDao) EXPECT() *MockRoleListDao_Expecter {
+	return &MockRoleListDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleListDao
+func (_mock *MockRoleListDao) Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) ([]*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) []*dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleListDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleListRequest
+func (_e *MockRoleListDao_Expecter) Exec(ctx any, request any) *MockRoleListDao_Exec_Call {
+	return &MockRoleListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleListRequest)) *MockRoleListDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleListDao_Exec_Call) Return(roles []*dao.Role, err error) *MockRoleListDao_Exec_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRoleListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)) *MockRoleListDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleRegistryDao creates a new instance of MockRoleRegistryDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleRegistryDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleRegistryDao {
+	mock := &MockRoleRegistryDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleRegistryDao is an autogenerated mock type for the RoleRegistryDao type
+type MockRoleRegistryDao struct {
+	mock.Mock
+}
+
+type MockRoleRegistryDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleRegistryDao) EXPECT() *MockRoleRegistryDao_Expecter {
+	return &MockRoleRegistryDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleRegistryDao
+func (_mock *MockRoleRegistryDao) Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) ([]*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) []*dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleRegistryDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleRegistryDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleListRequest
+func (_e *MockRoleRegistryDao_Expecter) Exec(ctx any, request any) *MockRoleRegistryDao_Exec_Call {
+	return &MockRoleRegistryDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleRegistryDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleListRequest)) *MockRoleRegistryDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleRegistryDao_Exec_Call) Return(roles []*dao.Role, err error) *MockRoleRegistryDao_Exec_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRoleRegistryDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)) *MockRoleRegistryDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleSeedDaoRoleList creates a new instance of MockRoleSeedDaoRoleList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleSeedDaoRoleList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleSeedDaoRoleList {
+	mock := &MockRoleSeedDaoRoleList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleSeedDaoRoleList is an autogenerated mock type for the RoleSeedDaoRoleList type
+type MockRoleSeedDaoRoleList struct {
+	mock.Mock
+}
+
+type MockRoleSeedDaoRoleList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleSeedDaoRoleList) EXPECT() *MockRoleSeedDaoRoleList_Expecter {
+	return &MockRoleSeedDaoRoleList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleSeedDaoRoleList
+func (_mock *MockRoleSeedDaoRoleList) Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) ([]*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) []*dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleSeedDaoRoleList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleSeedDaoRoleList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleListRequest
+func (_e *MockRoleSeedDaoRoleList_Expecter) Exec(ctx any, request any) *MockRoleSeedDaoRoleList_Exec_Call {
+	return &MockRoleSeedDaoRoleList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleSeedDaoRoleList_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleListRequest)) *MockRoleSeedDaoRoleList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleSeedDaoRoleList_Exec_Call) Return(roles []*dao.Role, err error) *MockRoleSeedDaoRoleList_Exec_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRoleSeedDaoRoleList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)) *MockRoleSeedDaoRoleList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleSeedDaoRoleInsert creates a new instance of MockRoleSeedDaoRoleInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleSeedDaoRoleInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleSeedDaoRoleInsert {
+	mock := &MockRoleSeedDaoRoleInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleSeedDaoRoleInsert is an autogenerated mock type for the RoleSeedDaoRoleInsert type
+type MockRoleSeedDaoRoleInsert struct {
+	mock.Mock
+}
+
+type MockRoleSeedDaoRoleInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleSeedDaoRoleInsert) EXPECT() *MockRoleSeedDaoRoleInsert_Expecter {
+	return &MockRoleSeedDaoRoleInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleSeedDaoRoleInsert
+func (_mock *MockRoleSeedDaoRoleInsert) Exec(ctx context.Context, request *dao.RoleInsertRequest) (*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleInsertRequest) (*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleInsertRequest) *dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleSeedDaoRoleInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleSeedDaoRoleInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleInsertRequest
+func (_e *MockRoleSeedDaoRoleInsert_Expecter) Exec(ctx any, request any) *MockRoleSeedDaoRoleInsert_Exec_Call {
+	return &MockRoleSeedDaoRoleInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleSeedDaoRoleInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleInsertRequest)) *MockRoleSeedDaoRoleInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleSeedDaoRoleInsert_Exec_Call) Return(role *dao.Role, err error) *MockRoleSeedDaoRoleInsert_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleSeedDaoRoleInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleInsertRequest) (*dao.Role, error)) *MockRoleSeedDaoRoleInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleSeedDaoAuditEventInsert creates a new instance of MockRoleSeedDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleSeedDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleSeedDaoAuditEventInsert {
+	mock := &MockRoleSeedDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleSeedDaoAuditEventInsert is an autogenerated mock type for the RoleSeedDaoAuditEventInsert type
+type MockRoleSeedDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockRoleSeedDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleSeedDaoAuditEventInsert) EXPECT() *MockRoleSeedDaoAuditEventInsert_Expecter {
+	return &MockRoleSeedDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleSeedDaoAuditEventInsert
+func (_mock *MockRoleSeedDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleSeedDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleSeedDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockRoleSeedDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockRoleSeedDaoAuditEventInsert_Exec_Call {
+	return &MockRoleSeedDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleSeedDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockRoleSeedDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleSeedDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockRoleSeedDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockRoleSeedDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockRoleSeedDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleUpdateDao creates a new instance of MockRoleUpdateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleUpdateDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleUpdateDao {
+	mock := &MockRoleUpdateDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleUpdateDao is an autogenerated mock type for the RoleUpdateDao type
+type MockRoleUpdateDao struct {
+	mock.Mock
+}
+
+type MockRoleUpdateDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleUpdateDao) EXPECT() *MockRoleUpdateDao_Expecter {
+	return &MockRoleUpdateDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleUpdateDao
+func (_mock *MockRoleUpdateDao) Exec(ctx context.Context, request *dao.RoleUpdateRequest) (*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleUpdateRequest) (*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleUpdateRequest) *dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleUpdateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleUpdateDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleUpdateRequest
+func (_e *MockRoleUpdateDao_Expecter) Exec(ctx any, request any) *MockRoleUpdateDao_Exec_Call {
+	return &MockRoleUpdateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleUpdateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleUpdateRequest)) *MockRoleUpdateDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleUpdateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleUpdateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleUpdateDao_Exec_Call) Return(role *dao.Role, err error) *MockRoleUpdateDao_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleUpdateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleUpdateRequest) (*dao.Role, error)) *MockRoleUpdateDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleUpdateDaoRoleList creates a new instance of MockRoleUpdateDaoRoleList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleUpdateDaoRoleList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleUpdateDaoRoleList {
+	mock := &MockRoleUpdateDaoRoleList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleUpdateDaoRoleList is an autogenerated mock type for the RoleUpdateDaoRoleList type
+type MockRoleUpdateDaoRoleList struct {
+	mock.Mock
+}
+
+type MockRoleUpdateDaoRoleList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleUpdateDaoRoleList) EXPECT() *MockRoleUpdateDaoRoleList_Expecter {
+	return &MockRoleUpdateDaoRoleList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleUpdateDaoRoleList
+func (_mock *MockRoleUpdateDaoRoleList) Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) ([]*dao.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.RoleListRequest) []*dao.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.RoleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleUpdateDaoRoleList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleUpdateDaoRoleList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.RoleListRequest
+func (_e *MockRoleUpdateDaoRoleList_Expecter) Exec(ctx any, request any) *MockRoleUpdateDaoRoleList_Exec_Call {
+	return &MockRoleUpdateDaoRoleList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleUpdateDaoRoleList_Exec_Call) Run(run func(ctx context.Context, request *dao.RoleListRequest)) *MockRoleUpdateDaoRoleList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.RoleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.RoleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleUpdateDaoRoleList_Exec_Call) Return(roles []*dao.Role, err error) *MockRoleUpdateDaoRoleList_Exec_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRoleUpdateDaoRoleList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)) *MockRoleUpdateDaoRoleList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleUpdateDaoAuditEventInsert creates a new instance of MockRoleUpdateDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleUpdateDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleUpdateDaoAuditEventInsert {
+	mock := &MockRoleUpdateDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleUpdateDaoAuditEventInsert is an autogenerated mock type for the RoleUpdateDaoAuditEventInsert type
+type MockRoleUpdateDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockRoleUpdateDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleUpdateDaoAuditEventInsert) EXPECT() *MockRoleUpdateDaoAuditEventInsert_Expecter {
+	return &MockRoleUpdateDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleUpdateDaoAuditEventInsert
+func (_mock *MockRoleUpdateDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleUpdateDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleUpdateDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockRoleUpdateDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockRoleUpdateDaoAuditEventInsert_Exec_Call {
+	return &MockRoleUpdateDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleUpdateDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockRoleUpdateDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleUpdateDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockRoleUpdateDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockRoleUpdateDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockRoleUpdateDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleUpdateRegistry creates a new instance of MockRoleUpdateRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleUpdateRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleUpdateRegistry {
+	mock := &MockRoleUpdateRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleUpdateRegistry is an autogenerated mock type for the RoleUpdateRegistry type
+type MockRoleUpdateRegistry struct {
+	mock.Mock
+}
+
+type MockRoleUpdateRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleUpdateRegistry) EXPECT() *MockRoleUpdateRegistry_Expecter {
+	return &MockRoleUpdateRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Load provides a mock function for the type MockRoleUpdateRegistry
+func (_mock *MockRoleUpdateRegistry) Load(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoleUpdateRegistry_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type MockRoleUpdateRegistry_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRoleUpdateRegistry_Expecter) Load(ctx any) *MockRoleUpdateRegistry_Load_Call {
+	return &MockRoleUpdateRegistry_Load_Call{Call: _e.mock.On("Load", ctx)}
+}
+
+func (_c *MockRoleUpdateRegistry_Load_Call) Run(run func(ctx context.Context)) *MockRoleUpdateRegistry_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleUpdateRegistry_Load_Call) Return(err error) *MockRoleUpdateRegistry_Load_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoleUpdateRegistry_Load_Call) RunAndReturn(run func(ctx context.Context) error) *MockRoleUpdateRegistry_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeConsumeDaoSelect creates a new instance of MockShortCodeConsumeDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeConsumeDaoSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeConsumeDaoSelect {
+	mock := &MockShortCodeConsumeDaoSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeConsumeDaoSelect is an autogenerated mock type for the ShortCodeConsumeDaoSelect type
+type MockShortCodeConsumeDaoSelect struct {
+	mock.Mock
+}
+
+type MockShortCodeConsumeDaoSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeConsumeDaoSelect) EXPECT() *MockShortCodeConsumeDaoSelect_Expecter {
+	return &MockShortCodeConsumeDaoSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeConsumeDaoSelect
+func (_mock *MockShortCodeConsumeDaoSelect) Exec(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeConsumeDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeConsumeDaoSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeSelectRequest
+func (_e *MockShortCodeConsumeDaoSelect_Expecter) Exec(ctx any, request any) *MockShortCodeConsumeDaoSelect_Exec_Call {
+	return &MockShortCodeConsumeDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeSelectRequest)) *MockShortCodeConsumeDaoSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeConsumeDaoSelect_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeConsumeDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)) *MockShortCodeConsumeDaoSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeConsumeDaoDelete creates a new instance of MockShortCodeConsumeDaoDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeConsumeDaoDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeConsumeDaoDelete {
+	mock := &MockShortCodeConsumeDaoDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeConsumeDaoDelete is an autogenerated mock type for the ShortCodeConsumeDaoDelete type
+type MockShortCodeConsumeDaoDelete struct {
+	mock.Mock
+}
+
+type MockShortCodeConsumeDaoDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeConsumeDaoDelete) EXPECT() *MockShortCodeConsumeDaoDelete_Expecter {
+	return &MockShortCodeConsumeDaoDelete_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeConsumeDaoDelete
+func (_mock *MockShortCodeConsumeDaoDelete) Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeConsumeDaoDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeConsumeDaoDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeDeleteRequest
+func (_e *MockShortCodeConsumeDaoDelete_Expecter) Exec(ctx any, request any) *MockShortCodeConsumeDaoDelete_Exec_Call {
+	return &MockShortCodeConsumeDaoDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest)) *MockShortCodeConsumeDaoDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeConsumeDaoDelete_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeConsumeDaoDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)) *MockShortCodeConsumeDaoDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeConsumeDaoIncrementAttempts creates a new instance of MockShortCodeConsumeDaoIncrementAttempts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeConsumeDaoIncrementAttempts(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeConsumeDaoIncrementAttempts {
+	mock := &MockShortCodeConsumeDaoIncrementAttempts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeConsumeDaoIncrementAttempts is an autogenerated mock type for the ShortCodeConsumeDaoIncrementAttempts type
+type MockShortCodeConsumeDaoIncrementAttempts struct {
+	mock.Mock
+}
+
+type MockShortCodeConsumeDaoIncrementAttempts_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeConsumeDaoIncrementAttempts) EXPECT() *MockShortCodeConsumeDaoIncrementAttempts_Expecter {
+	return &MockShortCodeConsumeDaoIncrementAttempts_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeConsumeDaoIncrementAttempts
+func (_mock *MockShortCodeConsumeDaoIncrementAttempts) Exec(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeIncrementAttemptsRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeIncrementAttemptsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeConsumeDaoIncrementAttempts_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeConsumeDaoIncrementAttempts_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeIncrementAttemptsRequest
+func (_e *MockShortCodeConsumeDaoIncrementAttempts_Expecter) Exec(ctx any, request any) *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call {
+	return &MockShortCodeConsumeDaoIncrementAttempts_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest)) *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeIncrementAttemptsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeIncrementAttemptsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error)) *MockShortCodeConsumeDaoIncrementAttempts_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockshortCodeConsumer creates a new instance of mockshortCodeConsumer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockshortCodeConsumer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockshortCodeConsumer {
+	mock := &mockshortCodeConsumer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockshortCodeConsumer is an autogenerated mock type for the shortCodeConsumer type
+type mockshortCodeConsumer struct {
+	mock.Mock
+}
+
+type mockshortCodeConsumer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockshortCodeConsumer) EXPECT() *mockshortCodeConsumer_Expecter {
+	return &mockshortCodeConsumer_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type mockshortCodeConsumer
+func (_mock *mockshortCodeConsumer) Exec(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeConsumeRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeConsumeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mockshortCodeConsumer_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type mockshortCodeConsumer_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeConsumeRequest
+func (_e *mockshortCodeConsumer_Expecter) Exec(ctx any, request any) *mockshortCodeConsumer_Exec_Call {
+	return &mockshortCodeConsumer_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *mockshortCodeConsumer_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeConsumeRequest)) *mockshortCodeConsumer_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeConsumeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeConsumeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockshortCodeConsumer_Exec_Call) Return(shortCode *core.ShortCode, err error) *mockshortCodeConsumer_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *mockshortCodeConsumer_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeConsumeRequest) (*core.ShortCode, error)) *mockshortCodeConsumer_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateDao creates a new instance of MockShortCodeCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateDao {
+	mock := &MockShortCodeCreateDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateDao is an autogenerated mock type for the ShortCodeCreateDao type
+type MockShortCodeCreateDao struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateDao) EXPECT() *MockShortCodeCreateDao_Expecter {
+	return &MockShortCodeCreateDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateDao
+func (_mock *MockShortCodeCreateDao) Exec(ctx context.Context, request *dao.ShortCodeInsertRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeInsertRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeInsertRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeInsertRequest
+func (_e *MockShortCodeCreateDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateDao_Exec_Call {
+	return &MockShortCodeCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeInsertRequest)) *MockShortCodeCreateDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateDao_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeCreateDao_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeInsertRequest) (*dao.ShortCode, error)) *MockShortCodeCreateDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateDaoSelect creates a new instance of MockShortCodeCreateDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateDaoSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateDaoSelect {
+	mock := &MockShortCodeCreateDaoSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateDaoSelect is an autogenerated mock type for the ShortCodeCreateDaoSelect type
+type MockShortCodeCreateDaoSelect struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateDaoSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateDaoSelect) EXPECT() *MockShortCodeCreateDaoSelect_Expecter {
+	return &MockShortCodeCreateDaoSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateDaoSelect
+func (_mock *MockShortCodeCreateDaoSelect) Exec(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateDaoSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeSelectRequest
+func (_e *MockShortCodeCreateDaoSelect_Expecter) Exec(ctx any, request any) *MockShortCodeCreateDaoSelect_Exec_Call {
+	return &MockShortCodeCreateDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeSelectRequest)) *MockShortCodeCreateDaoSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateDaoSelect_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeCreateDaoSelect_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)) *MockShortCodeCreateDaoSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailUpdateService creates a new instance of MockShortCodeCreateEmailUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailUpdateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailUpdateService {
+	mock := &MockShortCodeCreateEmailUpdateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailUpdateService is an autogenerated mock type for the ShortCodeCreateEmailUpdateService type
+type MockShortCodeCreateEmailUpdateService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailUpdateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailUpdateService) EXPECT() *MockShortCodeCreateEmailUpdateService_Expecter {
+	return &MockShortCodeCreateEmailUpdateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateService
+func (_mock *MockShortCodeCreateEmailUpdateService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailUpdateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateRequest
+func (_e *MockShortCodeCreateEmailUpdateService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	return &MockShortCodeCreateEmailUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailUpdateDao creates a new instance of MockShortCodeCreateEmailUpdateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailUpdateDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailUpdateDao {
+	mock := &MockShortCodeCreateEmailUpdateDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailUpdateDao is an autogenerated mock type for the ShortCodeCreateEmailUpdateDao type
+type MockShortCodeCreateEmailUpdateDao struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailUpdateDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailUpdateDao) EXPECT() *MockShortCodeCreateEmailUpdateDao_Expecter {
+	return &MockShortCodeCreateEmailUpdateDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateDao
+func (_mock *MockShortCodeCreateEmailUpdateDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailUpdateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailUpdateDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectByEmailRequest
+func (_e *MockShortCodeCreateEmailUpdateDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+	return &MockShortCodeCreateEmailUpdateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectByEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreateEmailUpdateDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailUpdateDaoCredentialsSelect creates a new instance of MockShortCodeCreateEmailUpdateDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailUpdateDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailUpdateDaoCredentialsSelect {
+	mock := &MockShortCodeCreateEmailUpdateDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailUpdateDaoCredentialsSelect is an autogenerated mock type for the ShortCodeCreateEmailUpdateDaoCredentialsSelect type
+type MockShortCodeCreateEmailUpdateDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailUpdateDaoCredentialsSelect) EXPECT() *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Expecter {
+	return &MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateDaoCredentialsSelect
+func (_mock *MockShortCodeCreateEmailUpdateDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call {
+	return &MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockShortCodeCreateEmailUpdateDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailUpdateSmtp creates a new instance of MockShortCodeCreateEmailUpdateSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailUpdateSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailUpdateSmtp {
+	mock := &MockShortCodeCreateEmailUpdateSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailUpdateSmtp is an autogenerated mock type for the ShortCodeCreateEmailUpdateSmtp type
+type MockShortCodeCreateEmailUpdateSmtp struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailUpdateSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailUpdateSmtp) EXPECT() *MockShortCodeCreateEmailUpdateSmtp_Expecter {
+	return &MockShortCodeCreateEmailUpdateSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockShortCodeCreateEmailUpdateSmtp
+func (_mock *MockShortCodeCreateEmailUpdateSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateEmailUpdateSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockShortCodeCreateEmailUpdateSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockShortCodeCreateEmailUpdateSmtp_Expecter) Ping() *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
+	return &MockShortCodeCreateEmailUpdateSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockShortCodeCreateEmailUpdateSmtp_Ping_Call) Run(run func()) *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateSmtp_Ping_Call) Return(err error) *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreateEmailUpdateSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockShortCodeCreateEmailUpdateSmtp
+func (_mock *MockShortCodeCreateEmailUpdateSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateEmailUpdateSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockShortCodeCreateEmailUpdateSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockShortCodeCreateEmailUpdateSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
+	return &MockShortCodeCreateEmailUpdateSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call) Return(err error) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreateEmailUpdateSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailVerificationService creates a new instance of MockShortCodeCreateEmailVerificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailVerificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailVerificationService {
+	mock := &MockShortCodeCreateEmailVerificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailVerificationService is an autogenerated mock type for the ShortCodeCreateEmailVerificationService type
+type MockShortCodeCreateEmailVerificationService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailVerificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailVerificationService) EXPECT() *MockShortCodeCreateEmailVerificationService_Expecter {
+	return &MockShortCodeCreateEmailVerificationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailVerificationService
+func (_mock *MockShortCodeCreateEmailVerificationService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailVerificationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailVerificationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateRequest
+func (_e *MockShortCodeCreateEmailVerificationService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	return &MockShortCodeCreateEmailVerificationService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailVerificationService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailVerificationDao creates a new instance of MockShortCodeCreateEmailVerificationDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailVerificationDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailVerificationDao {
+	mock := &MockShortCodeCreateEmailVerificationDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailVerificationDao is an autogenerated mock type for the ShortCodeCreateEmailVerificationDao type
+type MockShortCodeCreateEmailVerificationDao struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailVerificationDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailVerificationDao) EXPECT() *MockShortCodeCreateEmailVerificationDao_Expecter {
+	return &MockShortCodeCreateEmailVerificationDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailVerificationDao
+func (_mock *MockShortCodeCreateEmailVerificationDao) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailVerificationDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailVerificationDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockShortCodeCreateEmailVerificationDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailVerificationDao_Exec_Call {
+	return &MockShortCodeCreateEmailVerificationDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailVerificationDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockShortCodeCreateEmailVerificationDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateEmailVerificationDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockShortCodeCreateEmailVerificationDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailVerificationSmtp creates a new instance of MockShortCodeCreateEmailVerificationSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailVerificationSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailVerificationSmtp {
+	mock := &MockShortCodeCreateEmailVerificationSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailVerificationSmtp is an autogenerated mock type for the ShortCodeCreateEmailVerificationSmtp type
+type MockShortCodeCreateEmailVerificationSmtp struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailVerificationSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailVerificationSmtp) EXPECT() *MockShortCodeCreateEmailVerificationSmtp_Expecter {
+	return &MockShortCodeCreateEmailVerificationSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockShortCodeCreateEmailVerificationSmtp
+func (_mock *MockShortCodeCreateEmailVerificationSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateEmailVerificationSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockShortCodeCreateEmailVerificationSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockShortCodeCreateEmailVerificationSmtp_Expecter) Ping() *MockShortCodeCreateEmailVerificationSmtp_Ping_Call {
+	return &MockShortCodeCreateEmailVerificationSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockShortCodeCreateEmailVerificationSmtp_Ping_Call) Run(run func()) *MockShortCodeCreateEmailVerificationSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationSmtp_Ping_Call) Return(err error) *MockShortCodeCreateEmailVerificationSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreateEmailVerificationSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockShortCodeCreateEmailVerificationSmtp
+func (_mock *MockShortCodeCreateEmailVerificationSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateEmailVerificationSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockShortCodeCreateEmailVerificationSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockShortCodeCreateEmailVerificationSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call {
+	return &MockShortCodeCreateEmailVerificationSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call) Return(err error) *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreateEmailVerificationSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateInviteService creates a new instance of MockShortCodeCreateInviteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateInviteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateInviteService {
+	mock := &MockShortCodeCreateInviteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateInviteService is an autogenerated mock type for the ShortCodeCreateInviteService type
+type MockShortCodeCreateInviteService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateInviteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateInviteService) EXPECT() *MockShortCodeCreateInviteService_Expecter {
+	return &MockShortCodeCreateInviteService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateInviteService
+func (_mock *MockShortCodeCreateInviteService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateInviteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateInviteService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateRequest
+func (_e *MockShortCodeCreateInviteService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateInviteService_Exec_Call {
+	return &MockShortCodeCreateInviteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateInviteService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreateInviteService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateInviteService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreateInviteService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateInviteDao creates a new instance of MockShortCodeCreateInviteDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateInviteDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateInviteDao {
+	mock := &MockShortCodeCreateInviteDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateInviteDao is an autogenerated mock type for the ShortCodeCreateInviteDao type
+type MockShortCodeCreateInviteDao struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateInviteDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateInviteDao) EXPECT() *MockShortCodeCreateInviteDao_Expecter {
+	return &MockShortCodeCreateInviteDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateInviteDao
+func (_mock *MockShortCodeCreateInviteDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateInviteDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateInviteDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectByEmailRequest
+func (_e *MockShortCodeCreateInviteDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateInviteDao_Exec_Call {
+	return &MockShortCodeCreateInviteDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateInviteDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreateInviteDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectByEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateInviteDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreateInviteDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateInviteDaoCredentialsSelect creates a new instance of MockShortCodeCreateInviteDaoCredentialsSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateInviteDaoCredentialsSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateInviteDaoCredentialsSelect {
+	mock := &MockShortCodeCreateInviteDaoCredentialsSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateInviteDaoCredentialsSelect is an autogenerated mock type for the ShortCodeCreateInviteDaoCredentialsSelect type
+type MockShortCodeCreateInviteDaoCredentialsSelect struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateInviteDaoCredentialsSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateInviteDaoCredentialsSelect) EXPECT() *MockShortCodeCreateInviteDaoCredentialsSelect_Expecter {
+	return &MockShortCodeCreateInviteDaoCredentialsSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateInviteDaoCredentialsSelect
+func (_mock *MockShortCodeCreateInviteDaoCredentialsSelect) Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectRequest
+func (_e *MockShortCodeCreateInviteDaoCredentialsSelect_Expecter) Exec(ctx any, request any) *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call {
+	return &MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectRequest)) *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)) *MockShortCodeCreateInviteDaoCredentialsSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateInviteRoles creates a new instance of MockShortCodeCreateInviteRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateInviteRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateInviteRoles {
+	mock := &MockShortCodeCreateInviteRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateInviteRoles is an autogenerated mock type for the ShortCodeCreateInviteRoles type
+type MockShortCodeCreateInviteRoles struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateInviteRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateInviteRoles) EXPECT() *MockShortCodeCreateInviteRoles_Expecter {
+	return &MockShortCodeCreateInviteRoles_Expecter{mock: &_m.Mock}
+}
+
+// Priority provides a mock function for the type MockShortCodeCreateInviteRoles
+func (_mock *MockShortCodeCreateInviteRoles) Priority(role string) (int, error) {
+	ret := _mock.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Priority")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(role)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateInviteRoles_Priority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Priority'
+type MockShortCodeCreateInviteRoles_Priority_Call struct {
+	*mock.Call
+}
+
+// Priority is a helper method to define mock.On call
+//   - role string
+func (_e *MockShortCodeCreateInviteRoles_Expecter) Priority(role any) *MockShortCodeCreateInviteRoles_Priority_Call {
+	return &MockShortCodeCreateInviteRoles_Priority_Call{Call: _e.mock.On("Priority", role)}
+}
+
+func (_c *MockShortCodeCreateInviteRoles_Priority_Call) Run(run func(role string)) *MockShortCodeCreateInviteRoles_Priority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteRoles_Priority_Call) Return(n int, err error) *MockShortCodeCreateInviteRoles_Priority_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteRoles_Priority_Call) RunAndReturn(run func(role string) (int, error)) *MockShortCodeCreateInviteRoles_Priority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rank provides a mock function for the type MockShortCodeCreateInviteRoles
+func (_mock *MockShortCodeCreateInviteRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateInviteRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockShortCodeCreateInviteRoles_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockShortCodeCreateInviteRoles_Expecter) Rank(roles any) *MockShortCodeCreateInviteRoles_Rank_Call {
+	return &MockShortCodeCreateInviteRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *MockShortCodeCreateInviteRoles_Rank_Call) Run(run func(roles []string)) *MockShortCodeCreateInviteRoles_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteRoles_Rank_Call) Return(n int, err error) *MockShortCodeCreateInviteRoles_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockShortCodeCreateInviteRoles_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateInviteSmtp creates a new instance of MockShortCodeCreateInviteSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateInviteSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateInviteSmtp {
+	mock := &MockShortCodeCreateInviteSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateInviteSmtp is an autogenerated mock type for the ShortCodeCreateInviteSmtp type
+type MockShortCodeCreateInviteSmtp struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateInviteSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateInviteSmtp) EXPECT() *MockShortCodeCreateInviteSmtp_Expecter {
+	return &MockShortCodeCreateInviteSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockShortCodeCreateInviteSmtp
+func (_mock *MockShortCodeCreateInviteSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateInviteSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockShortCodeCreateInviteSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockShortCodeCreateInviteSmtp_Expecter) Ping() *MockShortCodeCreateInviteSmtp_Ping_Call {
+	return &MockShortCodeCreateInviteSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockShortCodeCreateInviteSmtp_Ping_Call) Run(run func()) *MockShortCodeCreateInviteSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteSmtp_Ping_Call) Return(err error) *MockShortCodeCreateInviteSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreateInviteSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockShortCodeCreateInviteSmtp
+func (_mock *MockShortCodeCreateInviteSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateInviteSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockShortCodeCreateInviteSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockShortCodeCreateInviteSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreateInviteSmtp_SendMail_Call {
+	return &MockShortCodeCreateInviteSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockShortCodeCreateInviteSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreateInviteSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteSmtp_SendMail_Call) Return(err error) *MockShortCodeCreateInviteSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreateInviteSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreatePasswordResetService creates a new instance of MockShortCodeCreatePasswordResetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreatePasswordResetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreatePasswordResetService {
+	mock := &MockShortCodeCreatePasswordResetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreatePasswordResetService is an autogenerated mock type for the ShortCodeCreatePasswordResetService type
+type MockShortCodeCreatePasswordResetService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreatePasswordResetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreatePasswordResetService) EXPECT() *MockShortCodeCreatePasswordResetService_Expecter {
+	return &MockShortCodeCreatePasswordResetService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreatePasswordResetService
+func (_mock *MockShortCodeCreatePasswordResetService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreatePasswordResetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreatePasswordResetService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateRequest
+func (_e *MockShortCodeCreatePasswordResetService_Expecter) Exec(ctx any, request any) *MockShortCodeCreatePasswordResetService_Exec_Call {
+	return &MockShortCodeCreatePasswordResetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreatePasswordResetService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreatePasswordResetService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreatePasswordResetService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreatePasswordResetDao creates a new instance of MockShortCodeCreatePasswordResetDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreatePasswordResetDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreatePasswordResetDao {
+	mock := &MockShortCodeCreatePasswordResetDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreatePasswordResetDao is an autogenerated mock type for the ShortCodeCreatePasswordResetDao type
+type MockShortCodeCreatePasswordResetDao struct {
+	mock.Mock
+}
+
+type MockShortCodeCreatePasswordResetDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreatePasswordResetDao) EXPECT() *MockShortCodeCreatePasswordResetDao_Expecter {
+	return &MockShortCodeCreatePasswordResetDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreatePasswordResetDao
+func (_mock *MockShortCodeCreatePasswordResetDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreatePasswordResetDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreatePasswordResetDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectByEmailRequest
+func (_e *MockShortCodeCreatePasswordResetDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreatePasswordResetDao_Exec_Call {
+	return &MockShortCodeCreatePasswordResetDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreatePasswordResetDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectByEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreatePasswordResetDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreatePasswordResetDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreatePasswordResetSmtp creates a new instance of MockShortCodeCreatePasswordResetSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreatePasswordResetSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreatePasswordResetSmtp {
+	mock := &MockShortCodeCreatePasswordResetSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreatePasswordResetSmtp is an autogenerated mock type for the ShortCodeCreatePasswordResetSmtp type
+type MockShortCodeCreatePasswordResetSmtp struct {
+	mock.Mock
+}
+
+type MockShortCodeCreatePasswordResetSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreatePasswordResetSmtp) EXPECT() *MockShortCodeCreatePasswordResetSmtp_Expecter {
+	return &MockShortCodeCreatePasswordResetSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockShortCodeCreatePasswordResetSmtp
+func (_mock *MockShortCodeCreatePasswordResetSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreatePasswordResetSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockShortCodeCreatePasswordResetSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockShortCodeCreatePasswordResetSmtp_Expecter) Ping() *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
+	return &MockShortCodeCreatePasswordResetSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockShortCodeCreatePasswordResetSmtp_Ping_Call) Run(run func()) *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetSmtp_Ping_Call) Return(err error) *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreatePasswordResetSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockShortCodeCreatePasswordResetSmtp
+func (_mock *MockShortCodeCreatePasswordResetSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreatePasswordResetSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockShortCodeCreatePasswordResetSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockShortCodeCreatePasswordResetSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
+	return &MockShortCodeCreatePasswordResetSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockShortCodeCreatePasswordResetSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetSmtp_SendMail_Call) Return(err error) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreatePasswordResetSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateRegisterService creates a new instance of MockShortCodeCreateRegisterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateRegisterService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateRegisterService {
+	mock := &MockShortCodeCreateRegisterService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateRegisterService is an autogenerated mock type for the ShortCodeCreateRegisterService type
+type MockShortCodeCreateRegisterService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateRegisterService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateRegisterService) EXPECT() *MockShortCodeCreateRegisterService_Expecter {
+	return &MockShortCodeCreateRegisterService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateRegisterService
+func (_mock *MockShortCodeCreateRegisterService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateRegisterService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateRegisterService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateRequest
+func (_e *MockShortCodeCreateRegisterService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateRegisterService_Exec_Call {
+	return &MockShortCodeCreateRegisterService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateRegisterService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreateRegisterService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateRegisterService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreateRegisterService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateRegisterDao creates a new instance of MockShortCodeCreateRegisterDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateRegisterDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateRegisterDao {
+	mock := &MockShortCodeCreateRegisterDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateRegisterDao is an autogenerated mock type for the ShortCodeCreateRegisterDao type
+type MockShortCodeCreateRegisterDao struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateRegisterDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateRegisterDao) EXPECT() *MockShortCodeCreateRegisterDao_Expecter {
+	return &MockShortCodeCreateRegisterDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateRegisterDao
+func (_mock *MockShortCodeCreateRegisterDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateRegisterDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateRegisterDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsSelectByEmailRequest
+func (_e *MockShortCodeCreateRegisterDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateRegisterDao_Exec_Call {
+	return &MockShortCodeCreateRegisterDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateRegisterDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreateRegisterDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsSelectByEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateRegisterDao_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreateRegisterDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateRegisterSmtp creates a new instance of MockShortCodeCreateRegisterSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateRegisterSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateRegisterSmtp {
+	mock := &MockShortCodeCreateRegisterSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateRegisterSmtp is an autogenerated mock type for the ShortCodeCreateRegisterSmtp type
+type MockShortCodeCreateRegisterSmtp struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateRegisterSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateRegisterSmtp) EXPECT() *MockShortCodeCreateRegisterSmtp_Expecter {
+	return &MockShortCodeCreateRegisterSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockShortCodeCreateRegisterSmtp
+func (_mock *MockShortCodeCreateRegisterSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateRegisterSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockShortCodeCreateRegisterSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockShortCodeCreateRegisterSmtp_Expecter) Ping() *MockShortCodeCreateRegisterSmtp_Ping_Call {
+	return &MockShortCodeCreateRegisterSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockShortCodeCreateRegisterSmtp_Ping_Call) Run(run func()) *MockShortCodeCreateRegisterSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterSmtp_Ping_Call) Return(err error) *MockShortCodeCreateRegisterSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreateRegisterSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockShortCodeCreateRegisterSmtp
+func (_mock *MockShortCodeCreateRegisterSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockShortCodeCreateRegisterSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockShortCodeCreateRegisterSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockShortCodeCreateRegisterSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
+	return &MockShortCodeCreateRegisterSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockShortCodeCreateRegisterSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 smtp.MailUsers
 		if args[0] != nil {
-			arg0 = args[0].(smtp.MailUsers)
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterSmtp_SendMail_Call) Return(err error) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateRegisterSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeJanitorDaoLock creates a new instance of MockShortCodeJanitorDaoLock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeJanitorDaoLock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeJanitorDaoLock {
+	mock := &MockShortCodeJanitorDaoLock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeJanitorDaoLock is an autogenerated mock type for the ShortCodeJanitorDaoLock type
+type MockShortCodeJanitorDaoLock struct {
+	mock.Mock
+}
+
+type MockShortCodeJanitorDaoLock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeJanitorDaoLock) EXPECT() *MockShortCodeJanitorDaoLock_Expecter {
+	return &MockShortCodeJanitorDaoLock_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeJanitorDaoLock
+func (_mock *MockShortCodeJanitorDaoLock) Exec(ctx context.Context, callback func(ctx context.Context) error) (bool, error) {
+	ret := _mock.Called(ctx, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) (bool, error)); ok {
+		return returnFunc(ctx, callback)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) bool); ok {
+		r0 = returnFunc(ctx, callback)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r1 = returnFunc(ctx, callback)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeJanitorDaoLock_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeJanitorDaoLock_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callback func(ctx context.Context) error
+func (_e *MockShortCodeJanitorDaoLock_Expecter) Exec(ctx any, callback any) *MockShortCodeJanitorDaoLock_Exec_Call {
+	return &MockShortCodeJanitorDaoLock_Exec_Call{Call: _e.mock.On("Exec", ctx, callback)}
+}
+
+func (_c *MockShortCodeJanitorDaoLock_Exec_Call) Run(run func(ctx context.Context, callback func(ctx context.Context) error)) *MockShortCodeJanitorDaoLock_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeJanitorDaoLock_Exec_Call) Return(b bool, err error) *MockShortCodeJanitorDaoLock_Exec_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockShortCodeJanitorDaoLock_Exec_Call) RunAndReturn(run func(ctx context.Context, callback func(ctx context.Context) error) (bool, error)) *MockShortCodeJanitorDaoLock_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeJanitorDaoExpire creates a new instance of MockShortCodeJanitorDaoExpire. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeJanitorDaoExpire(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeJanitorDaoExpire {
+	mock := &MockShortCodeJanitorDaoExpire{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeJanitorDaoExpire is an autogenerated mock type for the ShortCodeJanitorDaoExpire type
+type MockShortCodeJanitorDaoExpire struct {
+	mock.Mock
+}
+
+type MockShortCodeJanitorDaoExpire_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeJanitorDaoExpire) EXPECT() *MockShortCodeJanitorDaoExpire_Expecter {
+	return &MockShortCodeJanitorDaoExpire_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeJanitorDaoExpire
+func (_mock *MockShortCodeJanitorDaoExpire) Exec(ctx context.Context, request *dao.ShortCodeExpireRequest) (int, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeExpireRequest) (int, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeExpireRequest) int); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeExpireRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeJanitorDaoExpire_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeJanitorDaoExpire_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeExpireRequest
+func (_e *MockShortCodeJanitorDaoExpire_Expecter) Exec(ctx any, request any) *MockShortCodeJanitorDaoExpire_Exec_Call {
+	return &MockShortCodeJanitorDaoExpire_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeJanitorDaoExpire_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeExpireRequest)) *MockShortCodeJanitorDaoExpire_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeExpireRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeExpireRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeJanitorDaoExpire_Exec_Call) Return(n int, err error) *MockShortCodeJanitorDaoExpire_Exec_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockShortCodeJanitorDaoExpire_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeExpireRequest) (int, error)) *MockShortCodeJanitorDaoExpire_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeJanitorDaoPurge creates a new instance of MockShortCodeJanitorDaoPurge. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeJanitorDaoPurge(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeJanitorDaoPurge {
+	mock := &MockShortCodeJanitorDaoPurge{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeJanitorDaoPurge is an autogenerated mock type for the ShortCodeJanitorDaoPurge type
+type MockShortCodeJanitorDaoPurge struct {
+	mock.Mock
+}
+
+type MockShortCodeJanitorDaoPurge_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeJanitorDaoPurge) EXPECT() *MockShortCodeJanitorDaoPurge_Expecter {
+	return &MockShortCodeJanitorDaoPurge_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeJanitorDaoPurge
+func (_mock *MockShortCodeJanitorDaoPurge) Exec(ctx context.Context, request *dao.ShortCodePurgeRequest) (int, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodePurgeRequest) (int, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodePurgeRequest) int); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodePurgeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeJanitorDaoPurge_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeJanitorDaoPurge_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodePurgeRequest
+func (_e *MockShortCodeJanitorDaoPurge_Expecter) Exec(ctx any, request any) *MockShortCodeJanitorDaoPurge_Exec_Call {
+	return &MockShortCodeJanitorDaoPurge_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeJanitorDaoPurge_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodePurgeRequest)) *MockShortCodeJanitorDaoPurge_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodePurgeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodePurgeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeJanitorDaoPurge_Exec_Call) Return(n int, err error) *MockShortCodeJanitorDaoPurge_Exec_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockShortCodeJanitorDaoPurge_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodePurgeRequest) (int, error)) *MockShortCodeJanitorDaoPurge_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeListDao creates a new instance of MockShortCodeListDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeListDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeListDao {
+	mock := &MockShortCodeListDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeListDao is an autogenerated mock type for the ShortCodeListDao type
+type MockShortCodeListDao struct {
+	mock.Mock
+}
+
+type MockShortCodeListDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeListDao) EXPECT() *MockShortCodeListDao_Expecter {
+	return &MockShortCodeListDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeListDao
+func (_mock *MockShortCodeListDao) Exec(ctx context.Context, request *dao.ShortCodeListRequest) ([]*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeListRequest) ([]*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeListRequest) []*dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeListDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeListDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeListRequest
+func (_e *MockShortCodeListDao_Expecter) Exec(ctx any, request any) *MockShortCodeListDao_Exec_Call {
+	return &MockShortCodeListDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeListDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeListRequest)) *MockShortCodeListDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeListDao_Exec_Call) Return(shortCodes []*dao.ShortCode, err error) *MockShortCodeListDao_Exec_Call {
+	_c.Call.Return(shortCodes, err)
+	return _c
+}
+
+func (_c *MockShortCodeListDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeListRequest) ([]*dao.ShortCode, error)) *MockShortCodeListDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeListDaoAuditEventInsert creates a new instance of MockShortCodeListDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeListDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeListDaoAuditEventInsert {
+	mock := &MockShortCodeListDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeListDaoAuditEventInsert is an autogenerated mock type for the ShortCodeListDaoAuditEventInsert type
+type MockShortCodeListDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockShortCodeListDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeListDaoAuditEventInsert) EXPECT() *MockShortCodeListDaoAuditEventInsert_Expecter {
+	return &MockShortCodeListDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeListDaoAuditEventInsert
+func (_mock *MockShortCodeListDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeListDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeListDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockShortCodeListDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockShortCodeListDaoAuditEventInsert_Exec_Call {
+	return &MockShortCodeListDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeListDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockShortCodeListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeListDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockShortCodeListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockShortCodeListDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockShortCodeListDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeRevokeDao creates a new instance of MockShortCodeRevokeDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeRevokeDao(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeRevokeDao {
+	mock := &MockShortCodeRevokeDao{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeRevokeDao is an autogenerated mock type for the ShortCodeRevokeDao type
+type MockShortCodeRevokeDao struct {
+	mock.Mock
+}
+
+type MockShortCodeRevokeDao_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeRevokeDao) EXPECT() *MockShortCodeRevokeDao_Expecter {
+	return &MockShortCodeRevokeDao_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeRevokeDao
+func (_mock *MockShortCodeRevokeDao) Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeRevokeDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeRevokeDao_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeDeleteRequest
+func (_e *MockShortCodeRevokeDao_Expecter) Exec(ctx any, request any) *MockShortCodeRevokeDao_Exec_Call {
+	return &MockShortCodeRevokeDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeRevokeDao_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest)) *MockShortCodeRevokeDao_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeRevokeDao_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeRevokeDao_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeRevokeDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)) *MockShortCodeRevokeDao_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeRevokeDaoAuditEventInsert creates a new instance of MockShortCodeRevokeDaoAuditEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeRevokeDaoAuditEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeRevokeDaoAuditEventInsert {
+	mock := &MockShortCodeRevokeDaoAuditEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeRevokeDaoAuditEventInsert is an autogenerated mock type for the ShortCodeRevokeDaoAuditEventInsert type
+type MockShortCodeRevokeDaoAuditEventInsert struct {
+	mock.Mock
+}
+
+type MockShortCodeRevokeDaoAuditEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeRevokeDaoAuditEventInsert) EXPECT() *MockShortCodeRevokeDaoAuditEventInsert_Expecter {
+	return &MockShortCodeRevokeDaoAuditEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeRevokeDaoAuditEventInsert
+func (_mock *MockShortCodeRevokeDaoAuditEventInsert) Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.AuditEventInsertRequest) *dao.AuditEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.AuditEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeRevokeDaoAuditEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeRevokeDaoAuditEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.AuditEventInsertRequest
+func (_e *MockShortCodeRevokeDaoAuditEventInsert_Expecter) Exec(ctx any, request any) *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call {
+	return &MockShortCodeRevokeDaoAuditEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.AuditEventInsertRequest)) *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.AuditEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.AuditEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call) Return(auditEvent *dao.AuditEvent, err error) *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)) *MockShortCodeRevokeDaoAuditEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeVerifyDaoSelect creates a new instance of MockShortCodeVerifyDaoSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeVerifyDaoSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeVerifyDaoSelect {
+	mock := &MockShortCodeVerifyDaoSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeVerifyDaoSelect is an autogenerated mock type for the ShortCodeVerifyDaoSelect type
+type MockShortCodeVerifyDaoSelect struct {
+	mock.Mock
+}
+
+type MockShortCodeVerifyDaoSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeVerifyDaoSelect) EXPECT() *MockShortCodeVerifyDaoSelect_Expecter {
+	return &MockShortCodeVerifyDaoSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeVerifyDaoSelect
+func (_mock *MockShortCodeVerifyDaoSelect) Exec(ctx context.Context, request *dao.ShortCodeSelectLatestRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectLatestRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeSelectLatestRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeSelectLatestRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeVerifyDaoSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeVerifyDaoSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeSelectLatestRequest
+func (_e *MockShortCodeVerifyDaoSelect_Expecter) Exec(ctx any, request any) *MockShortCodeVerifyDaoSelect_Exec_Call {
+	return &MockShortCodeVerifyDaoSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeVerifyDaoSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeSelectLatestRequest)) *MockShortCodeVerifyDaoSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *template.Template
+		var arg1 *dao.ShortCodeSelectLatestRequest
 		if args[1] != nil {
-			arg1 = args[1].(*template.Template)
+			arg1 = args[1].(*dao.ShortCodeSelectLatestRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeVerifyDaoSelect_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeVerifyDaoSelect_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeVerifyDaoSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeSelectLatestRequest) (*dao.ShortCode, error)) *MockShortCodeVerifyDaoSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeVerifyDaoDelete creates a new instance of MockShortCodeVerifyDaoDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeVerifyDaoDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeVerifyDaoDelete {
+	mock := &MockShortCodeVerifyDaoDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeVerifyDaoDelete is an autogenerated mock type for the ShortCodeVerifyDaoDelete type
+type MockShortCodeVerifyDaoDelete struct {
+	mock.Mock
+}
+
+type MockShortCodeVerifyDaoDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeVerifyDaoDelete) EXPECT() *MockShortCodeVerifyDaoDelete_Expecter {
+	return &MockShortCodeVerifyDaoDelete_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeVerifyDaoDelete
+func (_mock *MockShortCodeVerifyDaoDelete) Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeDeleteRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeVerifyDaoDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeVerifyDaoDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeDeleteRequest
+func (_e *MockShortCodeVerifyDaoDelete_Expecter) Exec(ctx any, request any) *MockShortCodeVerifyDaoDelete_Exec_Call {
+	return &MockShortCodeVerifyDaoDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeVerifyDaoDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest)) *MockShortCodeVerifyDaoDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeVerifyDaoDelete_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeVerifyDaoDelete_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeVerifyDaoDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)) *MockShortCodeVerifyDaoDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeVerifyDaoIncrementAttempts creates a new instance of MockShortCodeVerifyDaoIncrementAttempts. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeVerifyDaoIncrementAttempts(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeVerifyDaoIncrementAttempts {
+	mock := &MockShortCodeVerifyDaoIncrementAttempts{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeVerifyDaoIncrementAttempts is an autogenerated mock type for the ShortCodeVerifyDaoIncrementAttempts type
+type MockShortCodeVerifyDaoIncrementAttempts struct {
+	mock.Mock
+}
+
+type MockShortCodeVerifyDaoIncrementAttempts_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeVerifyDaoIncrementAttempts) EXPECT() *MockShortCodeVerifyDaoIncrementAttempts_Expecter {
+	return &MockShortCodeVerifyDaoIncrementAttempts_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeVerifyDaoIncrementAttempts
+func (_mock *MockShortCodeVerifyDaoIncrementAttempts) Exec(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ShortCodeIncrementAttemptsRequest) *dao.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ShortCodeIncrementAttemptsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeVerifyDaoIncrementAttempts_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeVerifyDaoIncrementAttempts_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ShortCodeIncrementAttemptsRequest
+func (_e *MockShortCodeVerifyDaoIncrementAttempts_Expecter) Exec(ctx any, request any) *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call {
+	return &MockShortCodeVerifyDaoIncrementAttempts_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call) Run(run func(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest)) *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ShortCodeIncrementAttemptsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ShortCodeIncrementAttemptsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call) Return(shortCode *dao.ShortCode, err error) *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error)) *MockShortCodeVerifyDaoIncrementAttempts_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMocktokenPairSigner creates a new instance of mocktokenPairSigner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMocktokenPairSigner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocktokenPairSigner {
+	mock := &mocktokenPairSigner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mocktokenPairSigner is an autogenerated mock type for the tokenPairSigner type
+type mocktokenPairSigner struct {
+	mock.Mock
+}
+
+type mocktokenPairSigner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mocktokenPairSigner) EXPECT() *mocktokenPairSigner_Expecter {
+	return &mocktokenPairSigner_Expecter{mock: &_m.Mock}
+}
+
+// ClaimsSign provides a mock function for the type mocktokenPairSigner
+func (_mock *mocktokenPairSigner) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimsSign")
+	}
+
+	var r0 *servicejsonkeys.ClaimsSignResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// mocktokenPairSigner_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
+type mocktokenPairSigner_ClaimsSign_Call struct {
+	*mock.Call
+}
+
+// ClaimsSign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.ClaimsSignRequest
+//   - opts ...grpc.CallOption
+func (_e *mocktokenPairSigner_Expecter) ClaimsSign(ctx any, req any, opts ...any) *mocktokenPairSigner_ClaimsSign_Call {
+	return &mocktokenPairSigner_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *mocktokenPairSigner_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *mocktokenPairSigner_ClaimsSign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+		var arg1 *servicejsonkeys.ClaimsSignRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
 		}
-		var arg3 any
-		if args[3] != nil {
-			arg3 = args[3].(any)
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
 		}
+		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
+			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetSmtp_SendMail_Call) Return(err error) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
-	_c.Call.Return(err)
+func (_c *mocktokenPairSigner_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *mocktokenPairSigner_ClaimsSign_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreatePasswordResetSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreatePasswordResetSmtp_SendMail_Call {
+func (_c *mocktokenPairSigner_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *mocktokenPairSigner_ClaimsSign_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateRegisterService creates a new instance of MockShortCodeCreateRegisterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockTokenCreateDao creates a new instance of MockTokenCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateRegisterService(t interface {
+func NewMockTokenCreateDao(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateRegisterService {
-	mock := &MockShortCodeCreateRegisterService{}
+}) *MockTokenCreateDao {
+	mock := &MockTokenCreateDao{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2603,40 +12292,40 @@ func NewMockShortCodeCreateRegisterService(t interface {
 	return mock
 }
 
-// MockShortCodeCreateRegisterService is an autogenerated mock type for the ShortCodeCreateRegisterService type
-type MockShortCodeCreateRegisterService struct {
+// MockTokenCreateDao is an autogenerated mock type for the TokenCreateDao type
+type MockTokenCreateDao struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateRegisterService_Expecter struct {
+type MockTokenCreateDao_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateRegisterService) EXPECT() *MockShortCodeCreateRegisterService_Expecter {
-	return &MockShortCodeCreateRegisterService_Expecter{mock: &_m.Mock}
+func (_m *MockTokenCreateDao) EXPECT() *MockTokenCreateDao_Expecter {
+	return &MockTokenCreateDao_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreateRegisterService
-func (_mock *MockShortCodeCreateRegisterService) Exec(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockTokenCreateDao
+func (_mock *MockTokenCreateDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 *dao.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).(*dao.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2644,27 +12333,27 @@ func (_mock *MockShortCodeCreateRegisterService) Exec(ctx context.Context, reque
 	return r0, r1
 }
 
-// MockShortCodeCreateRegisterService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreateRegisterService_Exec_Call struct {
+// MockTokenCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenCreateDao_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeCreateRequest
-func (_e *MockShortCodeCreateRegisterService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateRegisterService_Exec_Call {
-	return &MockShortCodeCreateRegisterService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.CredentialsSelectByEmailRequest
+func (_e *MockTokenCreateDao_Expecter) Exec(ctx any, request any) *MockTokenCreateDao_Exec_Call {
+	return &MockTokenCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateRegisterService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateRequest)) *MockShortCodeCreateRegisterService_Exec_Call {
+func (_c *MockTokenCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockTokenCreateDao_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeCreateRequest
+		var arg1 *dao.CredentialsSelectByEmailRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeCreateRequest)
+			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
 		}
 		run(
 			arg0,
@@ -2674,23 +12363,23 @@ func (_c *MockShortCodeCreateRegisterService_Exec_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockShortCodeCreateRegisterService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateRegisterService_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockTokenCreateDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockTokenCreateDao_Exec_Call {
+	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateRegisterService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateRequest) (*core.ShortCode, error)) *MockShortCodeCreateRegisterService_Exec_Call {
+func (_c *MockTokenCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockTokenCreateDao_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateRegisterDao creates a new instance of MockShortCodeCreateRegisterDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockTokenCreateDaoLoginEventInsert creates a new instance of MockTokenCreateDaoLoginEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateRegisterDao(t interface {
+func NewMockTokenCreateDaoLoginEventInsert(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateRegisterDao {
-	mock := &MockShortCodeCreateRegisterDao{}
+}) *MockTokenCreateDaoLoginEventInsert {
+	mock := &MockTokenCreateDaoLoginEventInsert{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2698,40 +12387,40 @@ func NewMockShortCodeCreateRegisterDao(t interface {
 	return mock
 }
 
-// MockShortCodeCreateRegisterDao is an autogenerated mock type for the ShortCodeCreateRegisterDao type
-type MockShortCodeCreateRegisterDao struct {
+// MockTokenCreateDaoLoginEventInsert is an autogenerated mock type for the TokenCreateDaoLoginEventInsert type
+type MockTokenCreateDaoLoginEventInsert struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateRegisterDao_Expecter struct {
+type MockTokenCreateDaoLoginEventInsert_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateRegisterDao) EXPECT() *MockShortCodeCreateRegisterDao_Expecter {
-	return &MockShortCodeCreateRegisterDao_Expecter{mock: &_m.Mock}
+func (_m *MockTokenCreateDaoLoginEventInsert) EXPECT() *MockTokenCreateDaoLoginEventInsert_Expecter {
+	return &MockTokenCreateDaoLoginEventInsert_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreateRegisterDao
-func (_mock *MockShortCodeCreateRegisterDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
+// Exec provides a mock function for the type MockTokenCreateDaoLoginEventInsert
+func (_mock *MockTokenCreateDaoLoginEventInsert) Exec(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Credentials
+	var r0 *dao.LoginEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventInsertRequest) *dao.LoginEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
+			r0 = ret.Get(0).(*dao.LoginEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.LoginEventInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2739,27 +12428,27 @@ func (_mock *MockShortCodeCreateRegisterDao) Exec(ctx context.Context, request *
 	return r0, r1
 }
 
-// MockShortCodeCreateRegisterDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreateRegisterDao_Exec_Call struct {
+// MockTokenCreateDaoLoginEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenCreateDaoLoginEventInsert_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.CredentialsSelectByEmailRequest
-func (_e *MockShortCodeCreateRegisterDao_Expecter) Exec(ctx any, request any) *MockShortCodeCreateRegisterDao_Exec_Call {
-	return &MockShortCodeCreateRegisterDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.LoginEventInsertRequest
+func (_e *MockTokenCreateDaoLoginEventInsert_Expecter) Exec(ctx any, request any) *MockTokenCreateDaoLoginEventInsert_Exec_Call {
+	return &MockTokenCreateDaoLoginEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateRegisterDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockShortCodeCreateRegisterDao_Exec_Call {
+func (_c *MockTokenCreateDaoLoginEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.LoginEventInsertRequest)) *MockTokenCreateDaoLoginEventInsert_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.CredentialsSelectByEmailRequest
+		var arg1 *dao.LoginEventInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+			arg1 = args[1].(*dao.LoginEventInsertRequest)
 		}
 		run(
 			arg0,
@@ -2769,23 +12458,23 @@ func (_c *MockShortCodeCreateRegisterDao_Exec_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockShortCodeCreateRegisterDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockShortCodeCreateRegisterDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockTokenCreateDaoLoginEventInsert_Exec_Call) Return(loginEvent *dao.LoginEvent, err error) *MockTokenCreateDaoLoginEventInsert_Exec_Call {
+	_c.Call.Return(loginEvent, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateRegisterDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockShortCodeCreateRegisterDao_Exec_Call {
+func (_c *MockTokenCreateDaoLoginEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)) *MockTokenCreateDaoLoginEventInsert_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateRegisterSmtp creates a new instance of MockShortCodeCreateRegisterSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockTokenCreateDaoUpdateLastLogin creates a new instance of MockTokenCreateDaoUpdateLastLogin. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateRegisterSmtp(t interface {
+func NewMockTokenCreateDaoUpdateLastLogin(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateRegisterSmtp {
-	mock := &MockShortCodeCreateRegisterSmtp{}
+}) *MockTokenCreateDaoUpdateLastLogin {
+	mock := &MockTokenCreateDaoUpdateLastLogin{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2793,139 +12482,94 @@ func NewMockShortCodeCreateRegisterSmtp(t interface {
 	return mock
 }
 
-// MockShortCodeCreateRegisterSmtp is an autogenerated mock type for the ShortCodeCreateRegisterSmtp type
-type MockShortCodeCreateRegisterSmtp struct {
+// MockTokenCreateDaoUpdateLastLogin is an autogenerated mock type for the TokenCreateDaoUpdateLastLogin type
+type MockTokenCreateDaoUpdateLastLogin struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateRegisterSmtp_Expecter struct {
+type MockTokenCreateDaoUpdateLastLogin_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateRegisterSmtp) EXPECT() *MockShortCodeCreateRegisterSmtp_Expecter {
-	return &MockShortCodeCreateRegisterSmtp_Expecter{mock: &_m.Mock}
+func (_m *MockTokenCreateDaoUpdateLastLogin) EXPECT() *MockTokenCreateDaoUpdateLastLogin_Expecter {
+	return &MockTokenCreateDaoUpdateLastLogin_Expecter{mock: &_m.Mock}
 }
 
-// Ping provides a mock function for the type MockShortCodeCreateRegisterSmtp
-func (_mock *MockShortCodeCreateRegisterSmtp) Ping() error {
-	ret := _mock.Called()
+// Exec provides a mock function for the type MockTokenCreateDaoUpdateLastLogin
+func (_mock *MockTokenCreateDaoUpdateLastLogin) Exec(ctx context.Context, request *dao.CredentialsUpdateLastLoginRequest) (*dao.Credentials, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Ping")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Error(0)
+	var r0 *dao.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateLastLoginRequest) (*dao.Credentials, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	return r0
-}
-
-// MockShortCodeCreateRegisterSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
-type MockShortCodeCreateRegisterSmtp_Ping_Call struct {
-	*mock.Call
-}
-
-// Ping is a helper method to define mock.On call
-func (_e *MockShortCodeCreateRegisterSmtp_Expecter) Ping() *MockShortCodeCreateRegisterSmtp_Ping_Call {
-	return &MockShortCodeCreateRegisterSmtp_Ping_Call{Call: _e.mock.On("Ping")}
-}
-
-func (_c *MockShortCodeCreateRegisterSmtp_Ping_Call) Run(run func()) *MockShortCodeCreateRegisterSmtp_Ping_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *MockShortCodeCreateRegisterSmtp_Ping_Call) Return(err error) *MockShortCodeCreateRegisterSmtp_Ping_Call {
-	_c.Call.Return(err)
-	return _c
-}
-
-func (_c *MockShortCodeCreateRegisterSmtp_Ping_Call) RunAndReturn(run func() error) *MockShortCodeCreateRegisterSmtp_Ping_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// SendMail provides a mock function for the type MockShortCodeCreateRegisterSmtp
-func (_mock *MockShortCodeCreateRegisterSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
-	ret := _mock.Called(to, t, tName, data)
-
-	if len(ret) == 0 {
-		panic("no return value specified for SendMail")
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsUpdateLastLoginRequest) *dao.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Credentials)
+		}
 	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
-		r0 = returnFunc(to, t, tName, data)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsUpdateLastLoginRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
-// MockShortCodeCreateRegisterSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
-type MockShortCodeCreateRegisterSmtp_SendMail_Call struct {
+// MockTokenCreateDaoUpdateLastLogin_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenCreateDaoUpdateLastLogin_Exec_Call struct {
 	*mock.Call
 }
 
-// SendMail is a helper method to define mock.On call
-//   - to smtp.MailUsers
-//   - t *template.Template
-//   - tName string
-//   - data any
-func (_e *MockShortCodeCreateRegisterSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
-	return &MockShortCodeCreateRegisterSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsUpdateLastLoginRequest
+func (_e *MockTokenCreateDaoUpdateLastLogin_Expecter) Exec(ctx any, request any) *MockTokenCreateDaoUpdateLastLogin_Exec_Call {
+	return &MockTokenCreateDaoUpdateLastLogin_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateRegisterSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
+func (_c *MockTokenCreateDaoUpdateLastLogin_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsUpdateLastLoginRequest)) *MockTokenCreateDaoUpdateLastLogin_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 smtp.MailUsers
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(smtp.MailUsers)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *template.Template
+		var arg1 *dao.CredentialsUpdateLastLoginRequest
 		if args[1] != nil {
-			arg1 = args[1].(*template.Template)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 any
-		if args[3] != nil {
-			arg3 = args[3].(any)
+			arg1 = args[1].(*dao.CredentialsUpdateLastLoginRequest)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockShortCodeCreateRegisterSmtp_SendMail_Call) Return(err error) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
-	_c.Call.Return(err)
+func (_c *MockTokenCreateDaoUpdateLastLogin_Exec_Call) Return(credentials *dao.Credentials, err error) *MockTokenCreateDaoUpdateLastLogin_Exec_Call {
+	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateRegisterSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockShortCodeCreateRegisterSmtp_SendMail_Call {
+func (_c *MockTokenCreateDaoUpdateLastLogin_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsUpdateLastLoginRequest) (*dao.Credentials, error)) *MockTokenCreateDaoUpdateLastLogin_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// newMocktokenPairSigner creates a new instance of mocktokenPairSigner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockTokenCreateDaoLoginEventDeviceExists creates a new instance of MockTokenCreateDaoLoginEventDeviceExists. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func newMocktokenPairSigner(t interface {
+func NewMockTokenCreateDaoLoginEventDeviceExists(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *mocktokenPairSigner {
-	mock := &mocktokenPairSigner{}
+}) *MockTokenCreateDaoLoginEventDeviceExists {
+	mock := &MockTokenCreateDaoLoginEventDeviceExists{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2933,109 +12577,92 @@ func newMocktokenPairSigner(t interface {
 	return mock
 }
 
-// mocktokenPairSigner is an autogenerated mock type for the tokenPairSigner type
-type mocktokenPairSigner struct {
+// MockTokenCreateDaoLoginEventDeviceExists is an autogenerated mock type for the TokenCreateDaoLoginEventDeviceExists type
+type MockTokenCreateDaoLoginEventDeviceExists struct {
 	mock.Mock
 }
 
-type mocktokenPairSigner_Expecter struct {
+type MockTokenCreateDaoLoginEventDeviceExists_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *mocktokenPairSigner) EXPECT() *mocktokenPairSigner_Expecter {
-	return &mocktokenPairSigner_Expecter{mock: &_m.Mock}
+func (_m *MockTokenCreateDaoLoginEventDeviceExists) EXPECT() *MockTokenCreateDaoLoginEventDeviceExists_Expecter {
+	return &MockTokenCreateDaoLoginEventDeviceExists_Expecter{mock: &_m.Mock}
 }
 
-// ClaimsSign provides a mock function for the type mocktokenPairSigner
-func (_mock *mocktokenPairSigner) ClaimsSign(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error) {
-	var tmpRet mock.Arguments
-	if len(opts) > 0 {
-		tmpRet = _mock.Called(ctx, req, opts)
-	} else {
-		tmpRet = _mock.Called(ctx, req)
-	}
-	ret := tmpRet
+// Exec provides a mock function for the type MockTokenCreateDaoLoginEventDeviceExists
+func (_mock *MockTokenCreateDaoLoginEventDeviceExists) Exec(ctx context.Context, request *dao.LoginEventDeviceExistsRequest) (bool, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClaimsSign")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 *servicejsonkeys.ClaimsSignResponse
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)); ok {
-		return returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventDeviceExistsRequest) (bool, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) *servicejsonkeys.ClaimsSignResponse); ok {
-		r0 = returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventDeviceExistsRequest) bool); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.ClaimsSignResponse)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.ClaimsSignRequest, ...grpc.CallOption) error); ok {
-		r1 = returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.LoginEventDeviceExistsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// mocktokenPairSigner_ClaimsSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimsSign'
-type mocktokenPairSigner_ClaimsSign_Call struct {
+// MockTokenCreateDaoLoginEventDeviceExists_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenCreateDaoLoginEventDeviceExists_Exec_Call struct {
 	*mock.Call
 }
 
-// ClaimsSign is a helper method to define mock.On call
-//   - ctx context.Context
-//   - req *servicejsonkeys.ClaimsSignRequest
-//   - opts ...grpc.CallOption
-func (_e *mocktokenPairSigner_Expecter) ClaimsSign(ctx any, req any, opts ...any) *mocktokenPairSigner_ClaimsSign_Call {
-	return &mocktokenPairSigner_ClaimsSign_Call{Call: _e.mock.On("ClaimsSign",
-		append([]any{ctx, req}, opts...)...)}
-}
-
-func (_c *mocktokenPairSigner_ClaimsSign_Call) Run(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption)) *mocktokenPairSigner_ClaimsSign_Call {
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.LoginEventDeviceExistsRequest
+func (_e *MockTokenCreateDaoLoginEventDeviceExists_Expecter) Exec(ctx any, request any) *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call {
+	return &MockTokenCreateDaoLoginEventDeviceExists_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call) Run(run func(ctx context.Context, request *dao.LoginEventDeviceExistsRequest)) *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.ClaimsSignRequest
+		var arg1 *dao.LoginEventDeviceExistsRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.ClaimsSignRequest)
-		}
-		var arg2 []grpc.CallOption
-		var variadicArgs []grpc.CallOption
-		if len(args) > 2 {
-			variadicArgs = args[2].([]grpc.CallOption)
+			arg1 = args[1].(*dao.LoginEventDeviceExistsRequest)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *mocktokenPairSigner_ClaimsSign_Call) Return(v *servicejsonkeys.ClaimsSignResponse, err error) *mocktokenPairSigner_ClaimsSign_Call {
-	_c.Call.Return(v, err)
+func (_c *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call) Return(b bool, err error) *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *mocktokenPairSigner_ClaimsSign_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.ClaimsSignRequest, opts ...grpc.CallOption) (*servicejsonkeys.ClaimsSignResponse, error)) *mocktokenPairSigner_ClaimsSign_Call {
+func (_c *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.LoginEventDeviceExistsRequest) (bool, error)) *MockTokenCreateDaoLoginEventDeviceExists_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockTokenCreateDao creates a new instance of MockTokenCreateDao. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockTokenCreateSmtp creates a new instance of MockTokenCreateSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockTokenCreateDao(t interface {
+func NewMockTokenCreateSmtp(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockTokenCreateDao {
-	mock := &MockTokenCreateDao{}
+}) *MockTokenCreateSmtp {
+	mock := &MockTokenCreateSmtp{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -3043,83 +12670,128 @@ func NewMockTokenCreateDao(t interface {
 	return mock
 }
 
-// MockTokenCreateDao is an autogenerated mock type for the TokenCreateDao type
-type MockTokenCreateDao struct {
+// MockTokenCreateSmtp is an autogenerated mock type for the TokenCreateSmtp type
+type MockTokenCreateSmtp struct {
 	mock.Mock
 }
 
-type MockTokenCreateDao_Expecter struct {
+type MockTokenCreateSmtp_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockTokenCreateDao) EXPECT() *MockTokenCreateDao_Expecter {
-	return &MockTokenCreateDao_Expecter{mock: &_m.Mock}
+func (_m *MockTokenCreateSmtp) EXPECT() *MockTokenCreateSmtp_Expecter {
+	return &MockTokenCreateSmtp_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockTokenCreateDao
-func (_mock *MockTokenCreateDao) Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error) {
-	ret := _mock.Called(ctx, request)
+// Ping provides a mock function for the type MockTokenCreateSmtp
+func (_mock *MockTokenCreateSmtp) Ping() error {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Exec")
+		panic("no return value specified for Ping")
 	}
 
-	var r0 *dao.Credentials
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)); ok {
-		return returnFunc(ctx, request)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsSelectByEmailRequest) *dao.Credentials); ok {
-		r0 = returnFunc(ctx, request)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Credentials)
-		}
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsSelectByEmailRequest) error); ok {
-		r1 = returnFunc(ctx, request)
+	return r0
+}
+
+// MockTokenCreateSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockTokenCreateSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockTokenCreateSmtp_Expecter) Ping() *MockTokenCreateSmtp_Ping_Call {
+	return &MockTokenCreateSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockTokenCreateSmtp_Ping_Call) Run(run func()) *MockTokenCreateSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTokenCreateSmtp_Ping_Call) Return(err error) *MockTokenCreateSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTokenCreateSmtp_Ping_Call) RunAndReturn(run func() error) *MockTokenCreateSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockTokenCreateSmtp
+func (_mock *MockTokenCreateSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockTokenCreateDao_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockTokenCreateDao_Exec_Call struct {
+// MockTokenCreateSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockTokenCreateSmtp_SendMail_Call struct {
 	*mock.Call
 }
 
-// Exec is a helper method to define mock.On call
-//   - ctx context.Context
-//   - request *dao.CredentialsSelectByEmailRequest
-func (_e *MockTokenCreateDao_Expecter) Exec(ctx any, request any) *MockTokenCreateDao_Exec_Call {
-	return &MockTokenCreateDao_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockTokenCreateSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockTokenCreateSmtp_SendMail_Call {
+	return &MockTokenCreateSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
 }
 
-func (_c *MockTokenCreateDao_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest)) *MockTokenCreateDao_Exec_Call {
+func (_c *MockTokenCreateSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockTokenCreateSmtp_SendMail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 smtp.MailUsers
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
+			arg0 = args[0].(smtp.MailUsers)
 		}
-		var arg1 *dao.CredentialsSelectByEmailRequest
+		var arg1 *template.Template
 		if args[1] != nil {
-			arg1 = args[1].(*dao.CredentialsSelectByEmailRequest)
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockTokenCreateDao_Exec_Call) Return(credentials *dao.Credentials, err error) *MockTokenCreateDao_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockTokenCreateSmtp_SendMail_Call) Return(err error) *MockTokenCreateSmtp_SendMail_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockTokenCreateDao_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)) *MockTokenCreateDao_Exec_Call {
+func (_c *MockTokenCreateSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockTokenCreateSmtp_SendMail_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3439,6 +13111,196 @@ func (_c *MockTokenRefreshDao_Exec_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// NewMockTokenRefreshDaoLoginEventInsert creates a new instance of MockTokenRefreshDaoLoginEventInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRefreshDaoLoginEventInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRefreshDaoLoginEventInsert {
+	mock := &MockTokenRefreshDaoLoginEventInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenRefreshDaoLoginEventInsert is an autogenerated mock type for the TokenRefreshDaoLoginEventInsert type
+type MockTokenRefreshDaoLoginEventInsert struct {
+	mock.Mock
+}
+
+type MockTokenRefreshDaoLoginEventInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRefreshDaoLoginEventInsert) EXPECT() *MockTokenRefreshDaoLoginEventInsert_Expecter {
+	return &MockTokenRefreshDaoLoginEventInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockTokenRefreshDaoLoginEventInsert
+func (_mock *MockTokenRefreshDaoLoginEventInsert) Exec(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.LoginEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.LoginEventInsertRequest) *dao.LoginEvent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.LoginEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.LoginEventInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRefreshDaoLoginEventInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenRefreshDaoLoginEventInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.LoginEventInsertRequest
+func (_e *MockTokenRefreshDaoLoginEventInsert_Expecter) Exec(ctx any, request any) *MockTokenRefreshDaoLoginEventInsert_Exec_Call {
+	return &MockTokenRefreshDaoLoginEventInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockTokenRefreshDaoLoginEventInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.LoginEventInsertRequest)) *MockTokenRefreshDaoLoginEventInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.LoginEventInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.LoginEventInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenRefreshDaoLoginEventInsert_Exec_Call) Return(loginEvent *dao.LoginEvent, err error) *MockTokenRefreshDaoLoginEventInsert_Exec_Call {
+	_c.Call.Return(loginEvent, err)
+	return _c
+}
+
+func (_c *MockTokenRefreshDaoLoginEventInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)) *MockTokenRefreshDaoLoginEventInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenRefreshDaoCredentialsRedirectSelect creates a new instance of MockTokenRefreshDaoCredentialsRedirectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenRefreshDaoCredentialsRedirectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenRefreshDaoCredentialsRedirectSelect {
+	mock := &MockTokenRefreshDaoCredentialsRedirectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTokenRefreshDaoCredentialsRedirectSelect is an autogenerated mock type for the TokenRefreshDaoCredentialsRedirectSelect type
+type MockTokenRefreshDaoCredentialsRedirectSelect struct {
+	mock.Mock
+}
+
+type MockTokenRefreshDaoCredentialsRedirectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenRefreshDaoCredentialsRedirectSelect) EXPECT() *MockTokenRefreshDaoCredentialsRedirectSelect_Expecter {
+	return &MockTokenRefreshDaoCredentialsRedirectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockTokenRefreshDaoCredentialsRedirectSelect
+func (_mock *MockTokenRefreshDaoCredentialsRedirectSelect) Exec(ctx context.Context, request *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.CredentialsRedirect
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.CredentialsRedirectSelectRequest) *dao.CredentialsRedirect); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.CredentialsRedirect)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.CredentialsRedirectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.CredentialsRedirectSelectRequest
+func (_e *MockTokenRefreshDaoCredentialsRedirectSelect_Expecter) Exec(ctx any, request any) *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call {
+	return &MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.CredentialsRedirectSelectRequest)) *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.CredentialsRedirectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.CredentialsRedirectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call) Return(credentialsRedirect *dao.CredentialsRedirect, err error) *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call {
+	_c.Call.Return(credentialsRedirect, err)
+	return _c
+}
+
+func (_c *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error)) *MockTokenRefreshDaoCredentialsRedirectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTokenRefreshServiceSignClaims creates a new instance of MockTokenRefreshServiceSignClaims. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTokenRefreshServiceSignClaims(t interface {
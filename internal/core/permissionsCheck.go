@@ -0,0 +1,198 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel/service-json-keys/v2/pkg/go"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// PermissionCheckUser is the permission needed to check the permissions of another user by ID.
+const PermissionCheckUser = "permissions:check:user"
+
+var (
+	// ErrPermissionsCheckInvalidToken is returned by [PermissionsCheck.Exec] when the access token
+	// to check is invalid, expired, or carries an expired role grant.
+	ErrPermissionsCheckInvalidToken = errors.New("invalid access token")
+	// ErrPermissionsCheckForbidden is returned by [PermissionsCheck.Exec] when the caller checks
+	// another user by ID without the [PermissionCheckUser] permission.
+	ErrPermissionsCheckForbidden = errors.New("cannot check the permissions of another user")
+)
+
+// PermissionsCheckServiceVerifyClaims verifies the access token to check and decodes its claims.
+//
+// nosemgrep: agora-dep-interface-method-must-be-exec
+type PermissionsCheckServiceVerifyClaims interface {
+	VerifyClaims(ctx context.Context, req *servicejsonkeys.VerifyClaimsRequest) (*AccessTokenClaims, error)
+}
+
+type PermissionsCheckDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+// PermissionsCheckRoles resolves roles to the permissions they grant; satisfied by [RoleRegistry].
+type PermissionsCheckRoles interface {
+	PermissionsByRole() map[string][]string
+}
+
+type PermissionsCheckRequest struct {
+	// AccessToken is the token whose permissions are checked. Exclusive with UserID.
+	AccessToken string `validate:"excluded_with=UserID"`
+	// UserID is the user whose permissions are checked. Exclusive with AccessToken.
+	UserID *uuid.UUID
+	// Permissions are the permissions to check.
+	Permissions []string `validate:"required,min=1,max=64,unique,dive,permission"`
+
+	// CurrentUserID and CurrentRoles identify the caller. Its own permissions are checked when
+	// neither AccessToken nor UserID is set.
+	CurrentUserID *uuid.UUID
+	CurrentRoles  []string
+}
+
+// PermissionsCheckResult tells, for every checked permission, whether it is granted.
+type PermissionsCheckResult struct {
+	// UserID is the user whose permissions were checked. Nil for an anonymous token.
+	UserID *uuid.UUID
+	// Roles are the roles the permissions were resolved from, temporary grants included.
+	Roles       []string
+	Permissions map[string]bool
+}
+
+// PermissionsCheck tells whether a user is granted a set of permissions, so services can gate
+// actions without resolving roles themselves. The user is the bearer of an access token, a user
+// looked up by ID, or the caller itself.
+//
+// A token is checked against its claims, like the auth middleware would: its roles are the ones it
+// was issued with. A user looked up by ID is checked against its current roles, temporary grants
+// included. Checking another user by ID requires the [PermissionCheckUser] permission.
+type PermissionsCheck struct {
+	serviceVerifyClaims  PermissionsCheckServiceVerifyClaims
+	daoCredentialsSelect PermissionsCheckDaoCredentialsSelect
+	roles                PermissionsCheckRoles
+}
+
+func NewPermissionsCheck(
+	serviceVerifyClaims PermissionsCheckServiceVerifyClaims,
+	daoCredentialsSelect PermissionsCheckDaoCredentialsSelect,
+	roles PermissionsCheckRoles,
+) *PermissionsCheck {
+	return &PermissionsCheck{
+		serviceVerifyClaims:  serviceVerifyClaims,
+		daoCredentialsSelect: daoCredentialsSelect,
+		roles:                roles,
+	}
+}
+
+func (service *PermissionsCheck) Exec(
+	ctx context.Context, request *PermissionsCheckRequest,
+) (*PermissionsCheckResult, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.PermissionsCheck")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.StringSlice("permissions", request.Permissions),
+		attribute.Bool("request.byToken", request.AccessToken != ""),
+		attribute.Bool("request.byUserID", request.UserID != nil),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	permissionsByRole := service.roles.PermissionsByRole()
+
+	var result *PermissionsCheckResult
+
+	switch {
+	case request.AccessToken != "":
+		result, err = service.checkToken(ctx, request.AccessToken)
+	case request.UserID != nil:
+		result, err = service.checkUser(ctx, permissionsByRole, request)
+	default:
+		result = &PermissionsCheckResult{UserID: request.CurrentUserID, Roles: request.CurrentRoles}
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	granted := grantedPermissions(ctx, permissionsByRole, result.Roles)
+
+	result.Permissions = lo.SliceToMap(request.Permissions, func(permission string) (string, bool) {
+		return permission, granted[permission]
+	})
+
+	return otel.ReportSuccess(span, result), nil
+}
+
+func (service *PermissionsCheck) checkToken(ctx context.Context, accessToken string) (*PermissionsCheckResult, error) {
+	claims, err := service.serviceVerifyClaims.VerifyClaims(ctx, &servicejsonkeys.VerifyClaimsRequest{
+		Usage:       servicejsonkeys.KeyUsageAuth,
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return nil, errors.Join(err, ErrPermissionsCheckInvalidToken)
+	}
+
+	// Mirrors middlewares.Auth: the token is refused once a role grant it carries expired.
+	if claims.GrantExpiresAt != nil && !time.Now().Before(*claims.GrantExpiresAt) {
+		return nil, fmt.Errorf("%w: role grant expired", ErrPermissionsCheckInvalidToken)
+	}
+
+	return &PermissionsCheckResult{UserID: claims.UserID, Roles: claims.Roles}, nil
+}
+
+func (service *PermissionsCheck) checkUser(
+	ctx context.Context, permissionsByRole map[string][]string, request *PermissionsCheckRequest,
+) (*PermissionsCheckResult, error) {
+	if request.CurrentUserID == nil || *request.CurrentUserID != *request.UserID {
+		granted := grantedPermissions(ctx, permissionsByRole, request.CurrentRoles)
+		if !granted[PermissionCheckUser] {
+			return nil, ErrPermissionsCheckForbidden
+		}
+	}
+
+	credentials, err := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{ID: *request.UserID})
+	if err != nil {
+		return nil, fmt.Errorf("select credentials: %w", err)
+	}
+
+	roles := lo.Union(credentials.Roles, credentials.GrantedRoles)
+	slices.Sort(roles)
+
+	return &PermissionsCheckResult{UserID: &credentials.ID, Roles: roles}, nil
+}
+
+// grantedPermissions returns the set of permissions granted by the roles. A role that is not
+// defined grants nothing: it is logged, and the check goes on with the other roles.
+func grantedPermissions(ctx context.Context, permissionsByRole map[string][]string, roles []string) map[string]bool {
+	granted := map[string]bool{}
+
+	for _, role := range roles {
+		permissions, ok := permissionsByRole[role]
+		if !ok {
+			otel.Logger().WarnContext(ctx, fmt.Sprintf("%s: %q grants no permission", config.ErrUnknownRole, role))
+
+			continue
+		}
+
+		for _, permission := range permissions {
+			granted[permission] = true
+		}
+	}
+
+	return granted
+}
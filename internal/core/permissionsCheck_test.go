@@ -0,0 +1,287 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-json-keys/v2/pkg/go"
+
+	"github.com/a-novel-kit/jwt/v2/jws"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestPermissionsCheck(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	permissionsByRole := map[string][]string{
+		"role:user":  {"read"},
+		"role:admin": {"read", "write", core.PermissionCheckUser},
+		"role:temp":  {"debug"},
+	}
+
+	type verifyClaimsMock struct {
+		resp *core.AccessTokenClaims
+		err  error
+	}
+
+	type credentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.PermissionsCheckRequest
+
+		verifyClaimsMock      *verifyClaimsMock
+		credentialsSelectMock *credentialsSelectMock
+
+		expect    *core.PermissionsCheckResult
+		expectErr error
+	}{
+		{
+			name: "Success/Token",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+				Permissions: []string{"read", "write"},
+			},
+
+			verifyClaimsMock: &verifyClaimsMock{
+				resp: &core.AccessTokenClaims{UserID: &userID, Roles: []string{"role:user"}},
+			},
+
+			expect: &core.PermissionsCheckResult{
+				UserID:      &userID,
+				Roles:       []string{"role:user"},
+				Permissions: map[string]bool{"read": true, "write": false},
+			},
+		},
+		{
+			name: "Success/Token/GrantValid",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+				Permissions: []string{"debug"},
+			},
+
+			verifyClaimsMock: &verifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         &userID,
+					Roles:          []string{"role:temp", "role:user"},
+					GrantExpiresAt: lo.ToPtr(time.Now().Add(time.Hour)),
+				},
+			},
+
+			expect: &core.PermissionsCheckResult{
+				UserID:      &userID,
+				Roles:       []string{"role:temp", "role:user"},
+				Permissions: map[string]bool{"debug": true},
+			},
+		},
+		{
+			name: "Success/Caller",
+
+			request: &core.PermissionsCheckRequest{
+				Permissions:   []string{"read", "write"},
+				CurrentUserID: &callerID,
+				CurrentRoles:  []string{"role:admin"},
+			},
+
+			expect: &core.PermissionsCheckResult{
+				UserID:      &callerID,
+				Roles:       []string{"role:admin"},
+				Permissions: map[string]bool{"read": true, "write": true},
+			},
+		},
+		{
+			name: "Success/UserID",
+
+			request: &core.PermissionsCheckRequest{
+				UserID:        &userID,
+				Permissions:   []string{"read", "write", "debug"},
+				CurrentUserID: &callerID,
+				CurrentRoles:  []string{"role:admin"},
+			},
+
+			credentialsSelectMock: &credentialsSelectMock{
+				resp: &dao.Credentials{ID: userID, Roles: []string{"role:user"}, GrantedRoles: []string{"role:temp"}},
+			},
+
+			expect: &core.PermissionsCheckResult{
+				UserID:      &userID,
+				Roles:       []string{"role:temp", "role:user"},
+				Permissions: map[string]bool{"read": true, "write": false, "debug": true},
+			},
+		},
+		{
+			// Checking yourself by ID does not require the extra permission.
+			name: "Success/UserID/Self",
+
+			request: &core.PermissionsCheckRequest{
+				UserID:        &userID,
+				Permissions:   []string{"read"},
+				CurrentUserID: &userID,
+				CurrentRoles:  []string{"role:user"},
+			},
+
+			credentialsSelectMock: &credentialsSelectMock{
+				resp: &dao.Credentials{ID: userID, Roles: []string{"role:user"}},
+			},
+
+			expect: &core.PermissionsCheckResult{
+				UserID:      &userID,
+				Roles:       []string{"role:user"},
+				Permissions: map[string]bool{"read": true},
+			},
+		},
+		{
+			name: "UserID/Forbidden",
+
+			request: &core.PermissionsCheckRequest{
+				UserID:        &userID,
+				Permissions:   []string{"read"},
+				CurrentUserID: &callerID,
+				CurrentRoles:  []string{"role:user"},
+			},
+
+			expectErr: core.ErrPermissionsCheckForbidden,
+		},
+		{
+			name: "UserID/SelectError",
+
+			request: &core.PermissionsCheckRequest{
+				UserID:        &userID,
+				Permissions:   []string{"read"},
+				CurrentUserID: &callerID,
+				CurrentRoles:  []string{"role:admin"},
+			},
+
+			credentialsSelectMock: &credentialsSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Token/Invalid",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+				Permissions: []string{"read"},
+			},
+
+			verifyClaimsMock: &verifyClaimsMock{err: jws.ErrInvalidSignature},
+
+			expectErr: core.ErrPermissionsCheckInvalidToken,
+		},
+		{
+			name: "Token/GrantExpired",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+				Permissions: []string{"read"},
+			},
+
+			verifyClaimsMock: &verifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         &userID,
+					Roles:          []string{"role:temp", "role:user"},
+					GrantExpiresAt: lo.ToPtr(time.Now().Add(-time.Minute)),
+				},
+			},
+
+			expectErr: core.ErrPermissionsCheckInvalidToken,
+		},
+		{
+			// A role the config does not define grants nothing, but does not fail the check.
+			name: "Success/Token/UnknownRole",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+				Permissions: []string{"read", "write"},
+			},
+
+			verifyClaimsMock: &verifyClaimsMock{
+				resp: &core.AccessTokenClaims{UserID: &userID, Roles: []string{"ghost", "role:user"}},
+			},
+
+			expect: &core.PermissionsCheckResult{
+				UserID:      &userID,
+				Roles:       []string{"ghost", "role:user"},
+				Permissions: map[string]bool{"read": true, "write": false},
+			},
+		},
+		{
+			name: "TokenAndUserID",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+				UserID:      &userID,
+				Permissions: []string{"read"},
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "NoPermissions",
+
+			request: &core.PermissionsCheckRequest{
+				AccessToken: "token",
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+
+			serviceVerifyClaims := coremocks.NewMockPermissionsCheckServiceVerifyClaims(t)
+			daoCredentialsSelect := coremocks.NewMockPermissionsCheckDaoCredentialsSelect(t)
+			roles := coremocks.NewMockPermissionsCheckRoles(t)
+
+			roles.EXPECT().PermissionsByRole().Return(permissionsByRole).Maybe()
+
+			if testCase.verifyClaimsMock != nil {
+				serviceVerifyClaims.EXPECT().
+					VerifyClaims(mock.Anything, &servicejsonkeys.VerifyClaimsRequest{
+						Usage:       servicejsonkeys.KeyUsageAuth,
+						AccessToken: testCase.request.AccessToken,
+					}).
+					Return(testCase.verifyClaimsMock.resp, testCase.verifyClaimsMock.err)
+			}
+
+			if testCase.credentialsSelectMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: *testCase.request.UserID}).
+					Return(testCase.credentialsSelectMock.resp, testCase.credentialsSelectMock.err)
+			}
+
+			service := core.NewPermissionsCheck(serviceVerifyClaims, daoCredentialsSelect, roles)
+
+			resp, err := service.Exec(ctx, testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			serviceVerifyClaims.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
+		})
+	}
+}
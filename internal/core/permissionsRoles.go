@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"slices"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+)
+
+// PermissionsRolesRegistry resolves roles to the permissions they grant; satisfied by
+// [RoleRegistry].
+type PermissionsRolesRegistry interface {
+	PermissionsByRole() map[string][]string
+}
+
+type PermissionsRolesRequest struct{}
+
+// PermissionsRoles returns, for every role, the permissions it grants, inherited ones included,
+// sorted and without duplicates. It reads the definitions the service currently enforces, so a
+// client that resolves the roles of a token against it agrees with the server.
+type PermissionsRoles struct {
+	registry PermissionsRolesRegistry
+}
+
+func NewPermissionsRoles(registry PermissionsRolesRegistry) *PermissionsRoles {
+	return &PermissionsRoles{registry: registry}
+}
+
+func (service *PermissionsRoles) Exec(ctx context.Context, _ *PermissionsRolesRequest) (map[string][]string, error) {
+	_, span := otel.Tracer().Start(ctx, "service.PermissionsRoles")
+	defer span.End()
+
+	// The registry map is shared: build a new one rather than sorting it in place.
+	permissionsByRole := lo.MapValues(service.registry.PermissionsByRole(), func(permissions []string, _ string) []string {
+		resolved := lo.Uniq(permissions)
+		slices.Sort(resolved)
+
+		return resolved
+	})
+
+	span.SetAttributes(attribute.Int("roles.count", len(permissionsByRole)))
+
+	return otel.ReportSuccess(span, permissionsByRole), nil
+}
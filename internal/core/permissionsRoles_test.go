@@ -0,0 +1,33 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+)
+
+func TestPermissionsRoles(t *testing.T) {
+	t.Parallel()
+
+	registry := coremocks.NewMockPermissionsRolesRegistry(t)
+
+	shared := map[string][]string{
+		"role:user":  {"write", "read"},
+		"role:admin": {"admin", "write", "read", "read"},
+	}
+
+	registry.EXPECT().PermissionsByRole().Return(shared)
+
+	resp, err := core.NewPermissionsRoles(registry).Exec(t.Context(), &core.PermissionsRolesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"role:user":  {"read", "write"},
+		"role:admin": {"admin", "read", "write"},
+	}, resp)
+
+	// The registry map is shared with the auth middleware, and must be left untouched.
+	require.Equal(t, []string{"admin", "write", "read", "read"}, shared["role:admin"])
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+)
+
+var (
+	// ErrRegistrationClosed is returned when self-registration is disabled.
+	ErrRegistrationClosed = errors.New("registration is closed")
+	// ErrRegistrationDomainNotAllowed is returned in domain-allowlist mode, when the
+	// email domain is not in the allow list.
+	ErrRegistrationDomainNotAllowed = errors.New("email domain is not allowed to register")
+	// ErrRegistrationDomainDenied is returned when the email domain is in the deny list.
+	ErrRegistrationDomainDenied = errors.New("email domain is denied registration")
+	// ErrRegistrationDisposableEmail is returned when the email belongs to a disposable
+	// email provider, and those are blocked.
+	ErrRegistrationDisposableEmail = errors.New("disposable email addresses cannot register")
+)
+
+// checkRegistration applies the registration policy to an email address. It returns nil
+// if the address may register, or wraps one of the ErrRegistration* sentinels.
+func checkRegistration(policy config.Registration, email string) error {
+	domain := config.EmailDomain(email)
+
+	var err error
+
+	switch {
+	case policy.Mode == config.RegistrationModeClosed:
+		err = ErrRegistrationClosed
+	case config.DomainMatches(domain, policy.DeniedDomains):
+		err = ErrRegistrationDomainDenied
+	case policy.BlockDisposable && config.IsDisposableDomain(domain):
+		err = ErrRegistrationDisposableEmail
+	case policy.Mode == config.RegistrationModeDomainAllowlist && !config.DomainMatches(domain, policy.AllowedDomains):
+		err = ErrRegistrationDomainNotAllowed
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", err, domain)
+}
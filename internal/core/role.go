@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// roleNameMaxLength bounds the name of a role and of a permission.
+const roleNameMaxLength = 64
+
+// roleNameRegexp matches the names of roles and permissions: colon-separated segments, such as
+// auth:admin or credentials:role:patch.
+var roleNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+(:[a-zA-Z0-9_-]+)*$`)
+
+// permissionGrantRegexp matches the permissions a role grants: a permission name whose segments
+// may be wildcards, optionally negated, such as credentials:* or !credentials:role:patch.
+var permissionGrantRegexp = regexp.MustCompile(`^!?([a-zA-Z0-9_-]+|\*)(:([a-zA-Z0-9_-]+|\*))*$`)
+
+// Role bundles the permissions granted to the users holding it.
+type Role struct {
+	Name string
+	// Priority ranks the role in the hierarchy; a higher value outranks a lower one.
+	Priority int
+	// Permissions lists the permissions the role grants by itself. Entries may be wildcard
+	// patterns, or negations; see config.MatchPermission.
+	Permissions []string
+	// Inherits lists the roles whose permissions this role also grants, sorted by name.
+	Inherits  []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func loadRole(item *dao.Role, _ int) *Role {
+	return &Role{
+		Name:        item.Name,
+		Priority:    item.Priority,
+		Permissions: item.Permissions,
+		Inherits:    item.Inherits,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
+
+// roleRanker ranks roles against the current definitions. Service-level interfaces (e.g.
+// CredentialsUpdateRoleRoles) already match this shape; both [RoleRegistry] and
+// config.Permissions implement it.
+type roleRanker interface {
+	Priority(role string) (int, error)
+	Rank(roles []string) (int, error)
+}
+
+// rolesPermissions converts stored roles to the permissions configuration, so they resolve and
+// rank the same way as the built-in one. The known permissions are the ones this build checks.
+func rolesPermissions(roles []*dao.Role) config.Permissions {
+	permissions := config.Permissions{
+		Known: config.PermissionsConfigDefault.Known,
+		Roles: make(map[string]config.Role, len(roles)),
+	}
+
+	for _, role := range roles {
+		permissions.Roles[role.Name] = config.Role{
+			Inherits:    role.Inherits,
+			Permissions: role.Permissions,
+			Priority:    role.Priority,
+		}
+	}
+
+	return permissions
+}
+
+// checkRoles checks that a set of role definitions resolves: every inherited role is defined,
+// every wildcard pattern matches a known permission, and the inheritance has no cycle. Services
+// run it on the definitions a write would leave, before the write.
+func checkRoles(roles []*dao.Role) error {
+	_, err := rolesPermissions(roles).Resolve()
+	if err != nil {
+		return errors.Join(err, ErrInvalidRequest)
+	}
+
+	return nil
+}
+
+// roleRegistryLoader reloads the role definitions after a write. Service-level interfaces
+// (e.g. RoleCreateRegistry) already match this shape.
+type roleRegistryLoader interface {
+	Load(ctx context.Context) error
+}
+
+// reloadRoles applies a committed write to the definitions of this instance, rather than
+// waiting for the next refresh. Other instances pick it up at their own refresh. A failure is
+// logged, and never fails the committed write.
+func reloadRoles(ctx context.Context, registry roleRegistryLoader) {
+	err := registry.Load(ctx)
+	if err != nil {
+		otel.Logger().ErrorContext(ctx, fmt.Errorf("reload roles: %w", err).Error())
+	}
+}
+
+// ValidateCredentialsRole is a go-playground/validator field-level validator that
+// accepts a string only when it is a well-formed role name. Roles are managed at
+// runtime, so whether the role exists is checked by the services against the
+// current definitions. It is registered under the "role" tag at package init.
+func ValidateCredentialsRole(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	return len(val) <= roleNameMaxLength && roleNameRegexp.MatchString(val)
+}
+
+// ValidatePermission is a go-playground/validator field-level validator that
+// accepts a well-formed permission name. It is registered under the "permission"
+// tag at package init.
+func ValidatePermission(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	return len(val) <= roleNameMaxLength && roleNameRegexp.MatchString(val)
+}
+
+// ValidatePermissionGrant is a go-playground/validator field-level validator that
+// accepts a permission a role may grant: a permission name, a wildcard pattern, or
+// the negation of either. Whether a pattern matches a known permission is checked
+// when the roles resolve. It is registered under the "permissionGrant" tag at
+// package init.
+func ValidatePermissionGrant(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	return len(val) <= roleNameMaxLength && permissionGrantRegexp.MatchString(val)
+}
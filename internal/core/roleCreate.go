@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type RoleCreateDao interface {
+	Exec(ctx context.Context, request *dao.RoleInsertRequest) (*dao.Role, error)
+}
+
+type RoleCreateDaoRoleList interface {
+	Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)
+}
+
+type RoleCreateDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// RoleCreateRegistry reloads the role definitions once the role is created; satisfied by
+// [RoleRegistry].
+type RoleCreateRegistry interface {
+	Load(ctx context.Context) error
+}
+
+type RoleCreateRequest struct {
+	Name string `validate:"required,role"`
+	// Priority ranks the role in the hierarchy; a higher value outranks a lower one.
+	Priority    int      `validate:"min=0"`
+	Permissions []string `validate:"max=256,unique,dive,permissionGrant"`
+	// Inherits lists roles whose permissions the new role also grants. They must exist.
+	Inherits      []string `validate:"max=16,unique,dive,role"`
+	CurrentUserID uuid.UUID
+	// RequestID identifies the request in the audit trail.
+	RequestID string
+}
+
+// RoleCreate defines a new role. The role must not inherit an unknown role.
+//
+// The creation is recorded in the audit trail, in the transaction of the insert.
+type RoleCreate struct {
+	dao                 RoleCreateDao
+	daoRoleList         RoleCreateDaoRoleList
+	daoAuditEventInsert RoleCreateDaoAuditEventInsert
+	transactor          transaction.Transactor
+	registry            RoleCreateRegistry
+}
+
+func NewRoleCreate(
+	dao RoleCreateDao,
+	daoRoleList RoleCreateDaoRoleList,
+	daoAuditEventInsert RoleCreateDaoAuditEventInsert,
+	transactor transaction.Transactor,
+	registry RoleCreateRegistry,
+) *RoleCreate {
+	return &RoleCreate{
+		dao:                 dao,
+		daoRoleList:         daoRoleList,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+		registry:            registry,
+	}
+}
+
+func (service *RoleCreate) Exec(ctx context.Context, request *RoleCreateRequest) (*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RoleCreate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("role.name", request.Name),
+		attribute.Int("role.priority", request.Priority),
+		attribute.StringSlice("role.inherits", request.Inherits),
+		attribute.String("actor.id", request.CurrentUserID.String()),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var entity *dao.Role
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Locking serializes the writes to roles, so the definitions checked here are still
+		// the current ones on commit.
+		roles, err := service.daoRoleList.Exec(ctx, &dao.RoleListRequest{Lock: true})
+		if err != nil {
+			return fmt.Errorf("list roles: %w", err)
+		}
+
+		err = checkRoles(append(roles, &dao.Role{
+			Name:        request.Name,
+			Priority:    request.Priority,
+			Permissions: request.Permissions,
+			Inherits:    request.Inherits,
+		}))
+		if err != nil {
+			return err
+		}
+
+		entity, err = service.dao.Exec(ctx, &dao.RoleInsertRequest{
+			Name:        request.Name,
+			Priority:    request.Priority,
+			Permissions: request.Permissions,
+			Inherits:    request.Inherits,
+			Now:         time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("insert role: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			Action:    AuditActionRolesCreate,
+			After:     newAuditRoleState(entity),
+			RequestID: request.RequestID,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	reloadRoles(ctx, service.registry)
+
+	return otel.ReportSuccess(span, loadRole(entity, 0)), nil
+}
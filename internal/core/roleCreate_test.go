@@ -0,0 +1,328 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestRoleCreate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	storedRoles := []*dao.Role{
+		{Name: "test:base", Priority: 0, Permissions: []string{"base:read"}},
+		{Name: "test:derived", Priority: 1, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+	}
+
+	type roleListMock struct {
+		resp []*dao.Role
+		err  error
+	}
+
+	type roleInsertMock struct {
+		resp *dao.Role
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.RoleCreateRequest
+
+		roleListMock   *roleListMock
+		roleInsertMock *roleInsertMock
+		// auditEventInsertErr is returned by the audit trail, which records every creation.
+		auditEventInsertErr error
+		// registryLoadErr is returned by the reload that follows a successful creation.
+		registryLoadErr error
+
+		expect    *core.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Priority:      2,
+				Permissions:   []string{"new:read"},
+				Inherits:      []string{"test:derived"},
+				CurrentUserID: callerID,
+				RequestID:     "request-1",
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+			roleInsertMock: &roleInsertMock{
+				resp: &dao.Role{
+					Name:        "test:new",
+					Priority:    2,
+					Permissions: []string{"new:read"},
+					Inherits:    []string{"test:derived"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Role{
+				Name:        "test:new",
+				Priority:    2,
+				Permissions: []string{"new:read"},
+				Inherits:    []string{"test:derived"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/RegistryLoadError",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+			roleInsertMock: &roleInsertMock{
+				resp: &dao.Role{
+					Name:      "test:new",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			// The role is committed: the next refresh picks it up.
+			registryLoadErr: errFoo,
+
+			expect: &core.Role{
+				Name:      "test:new",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/UnknownInherit",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Inherits:      []string{"test:unknown"},
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Success/Patterns",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Permissions:   []string{"credentials:*", "!credentials:role:patch"},
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+			roleInsertMock: &roleInsertMock{
+				resp: &dao.Role{
+					Name:        "test:new",
+					Permissions: []string{"credentials:*", "!credentials:role:patch"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Role{
+				Name:        "test:new",
+				Permissions: []string{"credentials:*", "!credentials:role:patch"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// A pattern that matches no permission the service checks grants nothing: likely a typo.
+			name: "Error/UnmatchedPattern",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Permissions:   []string{"credential:*"},
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+
+			expectErr: config.ErrUnmatchedPermission,
+		},
+		{
+			name: "Error/InvalidPattern",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Permissions:   []string{"credentials:role*"},
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InheritsItself",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Inherits:      []string{"test:new"},
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidName",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test new",
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidPermission",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Permissions:   []string{"new:"},
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NegativePriority",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				Priority:      -1,
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:base",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock:   &roleListMock{resp: storedRoles},
+			roleInsertMock: &roleInsertMock{err: dao.ErrRoleInsertAlreadyExists},
+
+			expectErr: dao.ErrRoleInsertAlreadyExists,
+		},
+		{
+			name: "Error/RoleList",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.RoleCreateRequest{
+				Name:          "test:new",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock:        &roleListMock{resp: storedRoles},
+			roleInsertMock:      &roleInsertMock{resp: &dao.Role{Name: "test:new"}},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockRoleCreateDao(t)
+			daoRoleList := coremocks.NewMockRoleCreateDaoRoleList(t)
+			daoAuditEventInsert := coremocks.NewMockRoleCreateDaoAuditEventInsert(t)
+			registry := coremocks.NewMockRoleCreateRegistry(t)
+
+			if testCase.roleListMock != nil {
+				daoRoleList.EXPECT().
+					Exec(mock.Anything, &dao.RoleListRequest{Lock: true}).
+					Return(testCase.roleListMock.resp, testCase.roleListMock.err)
+			}
+
+			if testCase.roleInsertMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.RoleInsertRequest) bool {
+						return assert.Equal(t, testCase.request.Name, data.Name) &&
+							assert.Equal(t, testCase.request.Priority, data.Priority) &&
+							assert.Equal(t, testCase.request.Permissions, data.Permissions) &&
+							assert.Equal(t, testCase.request.Inherits, data.Inherits) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.roleInsertMock.resp, testCase.roleInsertMock.err)
+			}
+
+			if testCase.roleInsertMock != nil && testCase.roleInsertMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						var after map[string]any
+
+						return assert.Equal(t, core.AuditActionRolesCreate, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Nil(t, data.TargetID) &&
+							assert.Nil(t, data.Before) &&
+							assert.NoError(t, json.Unmarshal(data.After, &after)) &&
+							assert.Equal(t, testCase.roleInsertMock.resp.Name, after["name"]) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			if testCase.expectErr == nil {
+				registry.EXPECT().Load(mock.Anything).Return(testCase.registryLoadErr)
+			}
+
+			service := core.NewRoleCreate(
+				mockDao, daoRoleList, daoAuditEventInsert, transactiontest.NewTransactor(), registry,
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoRoleList.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+			registry.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// ErrRoleDeleteBuiltIn is returned by [RoleDelete.Exec] for the roles defined in the permissions
+// configuration. The service relies on them, and seeds them again on every deployment.
+var ErrRoleDeleteBuiltIn = errors.New("built-in roles cannot be deleted")
+
+type RoleDeleteDao interface {
+	Exec(ctx context.Context, request *dao.RoleDeleteRequest) (*dao.Role, error)
+}
+
+type RoleDeleteDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// RoleDeleteRegistry reloads the role definitions once the role is deleted; satisfied by
+// [RoleRegistry].
+type RoleDeleteRegistry interface {
+	Load(ctx context.Context) error
+}
+
+type RoleDeleteRequest struct {
+	Name          string `validate:"required,role"`
+	CurrentUserID uuid.UUID
+	// RequestID identifies the request in the audit trail.
+	RequestID string
+}
+
+// RoleDelete deletes a role. A role still held by an account, or inherited by another role,
+// cannot be deleted: revoke it first.
+//
+// The deletion is recorded in the audit trail, in the transaction of the delete.
+type RoleDelete struct {
+	dao                 RoleDeleteDao
+	daoAuditEventInsert RoleDeleteDaoAuditEventInsert
+	transactor          transaction.Transactor
+	registry            RoleDeleteRegistry
+}
+
+func NewRoleDelete(
+	dao RoleDeleteDao,
+	daoAuditEventInsert RoleDeleteDaoAuditEventInsert,
+	transactor transaction.Transactor,
+	registry RoleDeleteRegistry,
+) *RoleDelete {
+	return &RoleDelete{
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+		registry:            registry,
+	}
+}
+
+func (service *RoleDelete) Exec(ctx context.Context, request *RoleDeleteRequest) (*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RoleDelete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("role.name", request.Name),
+		attribute.String("actor.id", request.CurrentUserID.String()),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	if _, builtIn := config.PermissionsConfigDefault.Roles[request.Name]; builtIn {
+		return nil, otel.ReportError(span, fmt.Errorf("%w: %q", ErrRoleDeleteBuiltIn, request.Name))
+	}
+
+	var entity *dao.Role
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		entity, err = service.dao.Exec(ctx, &dao.RoleDeleteRequest{Name: request.Name})
+		if err != nil {
+			return fmt.Errorf("delete role: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			Action:    AuditActionRolesDelete,
+			Before:    newAuditRoleState(entity),
+			RequestID: request.RequestID,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	reloadRoles(ctx, service.registry)
+
+	return otel.ReportSuccess(span, loadRole(entity, 0)), nil
+}
@@ -0,0 +1,178 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestRoleDelete(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type roleDeleteMock struct {
+		resp *dao.Role
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.RoleDeleteRequest
+
+		roleDeleteMock *roleDeleteMock
+		// auditEventInsertErr is returned by the audit trail, which records every deletion.
+		auditEventInsertErr error
+
+		expect    *core.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.RoleDeleteRequest{
+				Name:          "test:custom",
+				CurrentUserID: callerID,
+				RequestID:     "request-1",
+			},
+
+			roleDeleteMock: &roleDeleteMock{
+				resp: &dao.Role{
+					Name:        "test:custom",
+					Priority:    1,
+					Permissions: []string{"custom:read"},
+					Inherits:    []string{config.RoleUser},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Role{
+				Name:        "test:custom",
+				Priority:    1,
+				Permissions: []string{"custom:read"},
+				Inherits:    []string{config.RoleUser},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/BuiltIn",
+
+			request: &core.RoleDeleteRequest{
+				Name:          config.RoleAdmin,
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrRoleDeleteBuiltIn,
+		},
+		{
+			name: "Error/InvalidName",
+
+			request: &core.RoleDeleteRequest{
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &core.RoleDeleteRequest{
+				Name:          "test:custom",
+				CurrentUserID: callerID,
+			},
+
+			roleDeleteMock: &roleDeleteMock{err: dao.ErrRoleDeleteNotFound},
+
+			expectErr: dao.ErrRoleDeleteNotFound,
+		},
+		{
+			name: "Error/InUse",
+
+			request: &core.RoleDeleteRequest{
+				Name:          "test:custom",
+				CurrentUserID: callerID,
+			},
+
+			roleDeleteMock: &roleDeleteMock{err: dao.ErrRoleDeleteInUse},
+
+			expectErr: dao.ErrRoleDeleteInUse,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.RoleDeleteRequest{
+				Name:          "test:custom",
+				CurrentUserID: callerID,
+			},
+
+			roleDeleteMock:      &roleDeleteMock{resp: &dao.Role{Name: "test:custom"}},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockRoleDeleteDao(t)
+			daoAuditEventInsert := coremocks.NewMockRoleDeleteDaoAuditEventInsert(t)
+			registry := coremocks.NewMockRoleDeleteRegistry(t)
+
+			if testCase.roleDeleteMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, &dao.RoleDeleteRequest{Name: testCase.request.Name}).
+					Return(testCase.roleDeleteMock.resp, testCase.roleDeleteMock.err)
+			}
+
+			if testCase.roleDeleteMock != nil && testCase.roleDeleteMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						var before map[string]any
+
+						return assert.Equal(t, core.AuditActionRolesDelete, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Nil(t, data.TargetID) &&
+							assert.NoError(t, json.Unmarshal(data.Before, &before)) &&
+							assert.Equal(t, testCase.request.Name, before["name"]) &&
+							assert.Nil(t, data.After) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			if testCase.expectErr == nil {
+				registry.EXPECT().Load(mock.Anything).Return(nil)
+			}
+
+			service := core.NewRoleDelete(mockDao, daoAuditEventInsert, transactiontest.NewTransactor(), registry)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+			registry.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type RoleListDao interface {
+	Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)
+}
+
+type RoleListRequest struct{}
+
+// RoleList returns the definitions of every role, by ascending priority, then name.
+type RoleList struct {
+	dao RoleListDao
+}
+
+func NewRoleList(dao RoleListDao) *RoleList {
+	return &RoleList{
+		dao: dao,
+	}
+}
+
+func (service *RoleList) Exec(ctx context.Context, _ *RoleListRequest) ([]*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RoleList")
+	defer span.End()
+
+	entities, err := service.dao.Exec(ctx, &dao.RoleListRequest{})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("list roles: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("response.count", len(entities)))
+
+	return otel.ReportSuccess(span, lo.Map(entities, loadRole)), nil
+}
@@ -0,0 +1,101 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestRoleList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type daoMock struct {
+		resp []*dao.Role
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		daoMock *daoMock
+
+		expect    []*core.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			daoMock: &daoMock{
+				resp: []*dao.Role{
+					{
+						Name:        "test:base",
+						Permissions: []string{"base:read"},
+						CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						Name:        "test:derived",
+						Priority:    1,
+						Permissions: []string{"derived:read"},
+						Inherits:    []string{"test:base"},
+						CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expect: []*core.Role{
+				{
+					Name:        "test:base",
+					Permissions: []string{"base:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Name:        "test:derived",
+					Priority:    1,
+					Permissions: []string{"derived:read"},
+					Inherits:    []string{"test:base"},
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error/DAO",
+
+			daoMock: &daoMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockRoleListDao(t)
+
+			mockDao.EXPECT().
+				Exec(mock.Anything, &dao.RoleListRequest{}).
+				Return(testCase.daoMock.resp, testCase.daoMock.err)
+
+			service := core.NewRoleList(mockDao)
+
+			resp, err := service.Exec(t.Context(), &core.RoleListRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+		})
+	}
+}
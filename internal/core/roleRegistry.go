@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// RoleRegistryDao lists the stored roles.
+type RoleRegistryDao interface {
+	Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)
+}
+
+type roleRegistryState struct {
+	permissions       config.Permissions
+	permissionsByRole map[string][]string
+}
+
+// RoleRegistry holds the role definitions stored in the database, resolved and ready to rank
+// accounts and gate requests. It starts empty: [RoleRegistry.Load] it before serving, and
+// [RoleRegistry.Watch] it to pick up the changes made by other instances.
+//
+// Reads never block: a reload swaps the whole state at once, so a request sees either the old
+// definitions or the new ones, never a mix.
+type RoleRegistry struct {
+	dao RoleRegistryDao
+
+	state atomic.Pointer[roleRegistryState]
+}
+
+func NewRoleRegistry(dao RoleRegistryDao) *RoleRegistry {
+	return &RoleRegistry{dao: dao}
+}
+
+// Load reads the roles from the database and replaces the current definitions. On failure, the
+// current definitions are kept.
+func (registry *RoleRegistry) Load(ctx context.Context) error {
+	ctx, span := otel.Tracer().Start(ctx, "service.RoleRegistry.Load")
+	defer span.End()
+
+	roles, err := registry.dao.Exec(ctx, &dao.RoleListRequest{})
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("list roles: %w", err))
+	}
+
+	permissions := rolesPermissions(roles)
+
+	// The writes validate the definitions, so this only fails if the tables were edited by hand.
+	permissionsByRole, err := permissions.Resolve()
+	if err != nil {
+		return otel.ReportError(span, fmt.Errorf("resolve roles: %w", err))
+	}
+
+	registry.state.Store(&roleRegistryState{
+		permissions:       permissions,
+		permissionsByRole: permissionsByRole,
+	})
+
+	span.SetAttributes(attribute.Int("roles.count", len(roles)))
+	otel.ReportSuccessNoContent(span)
+
+	return nil
+}
+
+// Watch reloads the roles every interval, until ctx is done. A failed reload is logged, and the
+// current definitions are kept until the next one succeeds.
+func (registry *RoleRegistry) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := registry.Load(ctx)
+			if err != nil {
+				otel.Logger().ErrorContext(ctx, fmt.Errorf("reload roles: %w", err).Error())
+			}
+		}
+	}
+}
+
+// Permissions returns the current role definitions.
+func (registry *RoleRegistry) Permissions() config.Permissions {
+	return registry.current().permissions
+}
+
+// PermissionsByRole maps every role to the permissions it grants, inherited ones included. The
+// map is shared: callers must not modify it.
+func (registry *RoleRegistry) PermissionsByRole() map[string][]string {
+	return registry.current().permissionsByRole
+}
+
+// Priority returns the rank of a role. See [config.Permissions.Priority].
+func (registry *RoleRegistry) Priority(role string) (int, error) {
+	return registry.current().permissions.Priority(role)
+}
+
+// Rank returns the rank of an account holding the given roles. See [config.Permissions.Rank].
+func (registry *RoleRegistry) Rank(roles []string) (int, error) {
+	return registry.current().permissions.Rank(roles)
+}
+
+func (registry *RoleRegistry) current() *roleRegistryState {
+	state := registry.state.Load()
+	if state == nil {
+		return &roleRegistryState{}
+	}
+
+	return state
+}
@@ -0,0 +1,137 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestRoleRegistry(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	roles := []*dao.Role{
+		{Name: "test:base", Priority: 0, Permissions: []string{"base:read"}},
+		{Name: "test:derived", Priority: 2, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		registry := core.NewRoleRegistry(coremocks.NewMockRoleRegistryDao(t))
+
+		// Nothing is granted until the roles are loaded.
+		require.Empty(t, registry.PermissionsByRole())
+
+		_, err := registry.Priority("test:base")
+		require.ErrorIs(t, err, config.ErrUnknownRole)
+	})
+
+	t.Run("Load", func(t *testing.T) {
+		t.Parallel()
+
+		registryDAO := coremocks.NewMockRoleRegistryDao(t)
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return(roles, nil)
+
+		registry := core.NewRoleRegistry(registryDAO)
+		require.NoError(t, registry.Load(t.Context()))
+
+		permissionsByRole := registry.PermissionsByRole()
+		require.ElementsMatch(t, []string{"base:read"}, permissionsByRole["test:base"])
+		require.ElementsMatch(t, []string{"base:read", "derived:read"}, permissionsByRole["test:derived"])
+
+		priority, err := registry.Priority("test:derived")
+		require.NoError(t, err)
+		require.Equal(t, 2, priority)
+
+		rank, err := registry.Rank([]string{"test:base", "test:derived"})
+		require.NoError(t, err)
+		require.Equal(t, 2, rank)
+
+		_, err = registry.Rank([]string{"test:unknown"})
+		require.ErrorIs(t, err, config.ErrUnknownRole)
+	})
+
+	t.Run("Patterns", func(t *testing.T) {
+		t.Parallel()
+
+		registryDAO := coremocks.NewMockRoleRegistryDao(t)
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return([]*dao.Role{
+			{Name: "test:base", Permissions: []string{"roles:*", "credentials:password:*"}},
+			{Name: "test:derived", Permissions: []string{"!roles:patch", "!credentials:*"}, Inherits: []string{"test:base"}},
+			{Name: "test:top", Permissions: []string{"credentials:password:reset"}, Inherits: []string{"test:derived"}},
+		}, nil)
+
+		registry := core.NewRoleRegistry(registryDAO)
+		require.NoError(t, registry.Load(t.Context()))
+
+		permissionsByRole := registry.PermissionsByRole()
+		require.ElementsMatch(t, []string{
+			"roles:create", "roles:delete", "roles:list", "roles:patch",
+			"credentials:password:patch", "credentials:password:reset",
+		}, permissionsByRole["test:base"])
+		// Negations override inherited permissions, and stick for the roles below unless granted again.
+		require.ElementsMatch(t, []string{"roles:create", "roles:delete", "roles:list"}, permissionsByRole["test:derived"])
+		require.ElementsMatch(t, []string{
+			"roles:create", "roles:delete", "roles:list", "credentials:password:reset",
+		}, permissionsByRole["test:top"])
+	})
+
+	t.Run("Error/UnmatchedPattern", func(t *testing.T) {
+		t.Parallel()
+
+		registryDAO := coremocks.NewMockRoleRegistryDao(t)
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return([]*dao.Role{
+			{Name: "test:base", Permissions: []string{"role:*"}},
+		}, nil)
+
+		registry := core.NewRoleRegistry(registryDAO)
+		require.ErrorIs(t, registry.Load(t.Context()), config.ErrUnmatchedPermission)
+		require.Empty(t, registry.PermissionsByRole())
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		t.Parallel()
+
+		registryDAO := coremocks.NewMockRoleRegistryDao(t)
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return(roles, nil).Once()
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return(roles[:1], nil).Once()
+
+		registry := core.NewRoleRegistry(registryDAO)
+		require.NoError(t, registry.Load(t.Context()))
+		require.Contains(t, registry.PermissionsByRole(), "test:derived")
+
+		require.NoError(t, registry.Load(t.Context()))
+		require.NotContains(t, registry.PermissionsByRole(), "test:derived")
+	})
+
+	t.Run("Error/KeepsCurrent", func(t *testing.T) {
+		t.Parallel()
+
+		registryDAO := coremocks.NewMockRoleRegistryDao(t)
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return(roles, nil).Once()
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return(nil, errFoo).Once()
+		registryDAO.EXPECT().Exec(mock.Anything, mock.Anything).Return([]*dao.Role{
+			{Name: "test:a", Inherits: []string{"test:b"}},
+			{Name: "test:b", Inherits: []string{"test:a"}},
+		}, nil).Once()
+
+		registry := core.NewRoleRegistry(registryDAO)
+		require.NoError(t, registry.Load(t.Context()))
+
+		require.ErrorIs(t, registry.Load(t.Context()), errFoo)
+		require.Contains(t, registry.PermissionsByRole(), "test:derived")
+
+		require.ErrorIs(t, registry.Load(t.Context()), lib.ErrCircularDependency)
+		require.Contains(t, registry.PermissionsByRole(), "test:derived")
+	})
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type RoleSeedDaoRoleList interface {
+	Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)
+}
+
+type RoleSeedDaoRoleInsert interface {
+	Exec(ctx context.Context, request *dao.RoleInsertRequest) (*dao.Role, error)
+}
+
+type RoleSeedDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// RoleSeedRequest carries the role definitions shipped with the service, usually
+// config.PermissionsConfigDefault.
+type RoleSeedRequest struct {
+	Permissions config.Permissions
+}
+
+// RoleSeedResult lists the roles a seed created, sorted by name.
+type RoleSeedResult struct {
+	Created []string
+}
+
+// RoleSeed creates the roles shipped with the service that are missing from the database. It is
+// idempotent, and safe to run on every deploy.
+//
+// Existing roles are left alone, whatever the shipped definitions say: once stored, a role
+// belongs to administrators, and what they removed does not come back on the next deploy. A
+// permission new endpoints require reaches the built-in roles through a migration instead.
+// Created roles are recorded in the audit trail, with no actor.
+type RoleSeed struct {
+	daoRoleList         RoleSeedDaoRoleList
+	daoRoleInsert       RoleSeedDaoRoleInsert
+	daoAuditEventInsert RoleSeedDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewRoleSeed(
+	daoRoleList RoleSeedDaoRoleList,
+	daoRoleInsert RoleSeedDaoRoleInsert,
+	daoAuditEventInsert RoleSeedDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *RoleSeed {
+	return &RoleSeed{
+		daoRoleList:         daoRoleList,
+		daoRoleInsert:       daoRoleInsert,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+func (service *RoleSeed) Exec(ctx context.Context, request *RoleSeedRequest) (*RoleSeedResult, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RoleSeed")
+	defer span.End()
+
+	// The shipped definitions must resolve on their own: a cycle there is a bug of the release,
+	// not of the stored data.
+	_, err := request.Permissions.Resolve()
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("resolve seed roles: %w", err))
+	}
+
+	result := &RoleSeedResult{}
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		roles, err := service.daoRoleList.Exec(ctx, &dao.RoleListRequest{Lock: true})
+		if err != nil {
+			return fmt.Errorf("list roles: %w", err)
+		}
+
+		stored := lo.KeyBy(roles, func(item *dao.Role) string { return item.Name })
+		seeded := make([]*dao.Role, 0, len(request.Permissions.Roles))
+
+		for name, role := range request.Permissions.Roles {
+			if _, ok := stored[name]; ok {
+				continue
+			}
+
+			seeded = append(seeded, &dao.Role{
+				Name:        name,
+				Priority:    role.Priority,
+				Permissions: role.Permissions,
+				Inherits:    role.Inherits,
+			})
+		}
+
+		// A missing role may inherit a stored one, whose own inheritance administrators changed.
+		err = checkRoles(append(roles, seeded...))
+		if err != nil {
+			return fmt.Errorf("check seeded roles: %w", err)
+		}
+
+		return service.write(ctx, stored, seeded, result)
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	slices.Sort(result.Created)
+
+	span.SetAttributes(
+		attribute.StringSlice("result.created", result.Created),
+	)
+
+	return otel.ReportSuccess(span, result), nil
+}
+
+// write stores the seeded roles. A role can only inherit roles that exist, so roles are written
+// once every role they inherit is: the definitions resolve, so each pass writes at least one.
+func (service *RoleSeed) write(
+	ctx context.Context, stored map[string]*dao.Role, seeded []*dao.Role, result *RoleSeedResult,
+) error {
+	written := lo.MapValues(stored, func(_ *dao.Role, _ string) bool { return true })
+	now := time.Now()
+
+	for len(seeded) > 0 {
+		var pending []*dao.Role
+
+		for _, role := range seeded {
+			if !lo.EveryBy(role.Inherits, func(item string) bool { return written[item] }) {
+				pending = append(pending, role)
+
+				continue
+			}
+
+			err := service.insertRole(ctx, role, now, result)
+			if err != nil {
+				return err
+			}
+
+			written[role.Name] = true
+		}
+
+		seeded = pending
+	}
+
+	return nil
+}
+
+func (service *RoleSeed) insertRole(ctx context.Context, role *dao.Role, now time.Time, result *RoleSeedResult) error {
+	entity, err := service.daoRoleInsert.Exec(ctx, &dao.RoleInsertRequest{
+		Name:        role.Name,
+		Priority:    role.Priority,
+		Permissions: role.Permissions,
+		Inherits:    role.Inherits,
+		Now:         now,
+	})
+	if err != nil {
+		return fmt.Errorf("insert role %q: %w", role.Name, err)
+	}
+
+	result.Created = append(result.Created, role.Name)
+
+	return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+		Action: AuditActionRolesCreate,
+		After:  newAuditRoleState(entity),
+	})
+}
@@ -0,0 +1,200 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestRoleSeed(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	permissions := config.Permissions{
+		Roles: map[string]config.Role{
+			"test:base":    {Priority: 0, Permissions: []string{"base:read"}},
+			"test:derived": {Priority: 1, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+			"test:top":     {Priority: 2, Permissions: []string{"top:read"}, Inherits: []string{"test:derived"}},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		permissions config.Permissions
+
+		roleListResp []*dao.Role
+		roleListErr  error
+		// expectInserts are the inserted roles, in order: a role after the roles it inherits.
+		expectInserts []*dao.RoleInsertRequest
+		insertErr     error
+
+		expect    *core.RoleSeedResult
+		expectErr error
+	}{
+		{
+			name: "Success/Empty",
+
+			permissions: permissions,
+
+			expectInserts: []*dao.RoleInsertRequest{
+				{Name: "test:base", Priority: 0, Permissions: []string{"base:read"}},
+				{Name: "test:derived", Priority: 1, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+				{Name: "test:top", Priority: 2, Permissions: []string{"top:read"}, Inherits: []string{"test:derived"}},
+			},
+
+			expect: &core.RoleSeedResult{Created: []string{"test:base", "test:derived", "test:top"}},
+		},
+		{
+			name: "Success/AddsMissing",
+
+			permissions: permissions,
+
+			roleListResp: []*dao.Role{
+				// Administrators raised its priority, and replaced its permissions: the seed leaves it
+				// alone.
+				{Name: "test:base", Priority: 5, Permissions: []string{"custom:read"}},
+				{Name: "test:derived", Priority: 1, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+				{Name: "test:custom", Priority: 1},
+			},
+
+			expectInserts: []*dao.RoleInsertRequest{
+				{Name: "test:top", Priority: 2, Permissions: []string{"top:read"}, Inherits: []string{"test:derived"}},
+			},
+
+			expect: &core.RoleSeedResult{Created: []string{"test:top"}},
+		},
+		{
+			name: "Success/UpToDate",
+
+			permissions: permissions,
+
+			roleListResp: []*dao.Role{
+				{Name: "test:base", Priority: 0, Permissions: []string{"base:read"}},
+				{Name: "test:derived", Priority: 1, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+				{Name: "test:top", Priority: 2, Permissions: []string{"top:read"}, Inherits: []string{"test:derived"}},
+			},
+
+			expect: &core.RoleSeedResult{},
+		},
+		{
+			name: "Error/StoredCycle",
+
+			permissions: permissions,
+
+			// Seeding test:derived would make it inherit test:base, which inherits test:top through
+			// test:custom.
+			roleListResp: []*dao.Role{
+				{Name: "test:base", Inherits: []string{"test:custom"}},
+				{Name: "test:custom", Inherits: []string{"test:top"}},
+				{Name: "test:top", Priority: 2, Permissions: []string{"top:read"}, Inherits: []string{"test:derived"}},
+			},
+
+			expectErr: lib.ErrCircularDependency,
+		},
+		{
+			name: "Error/SeedCycle",
+
+			permissions: config.Permissions{
+				Roles: map[string]config.Role{
+					"test:a": {Inherits: []string{"test:b"}},
+					"test:b": {Inherits: []string{"test:a"}},
+				},
+			},
+
+			expectErr: lib.ErrCircularDependency,
+		},
+		{
+			name: "Error/RoleList",
+
+			permissions: permissions,
+
+			roleListErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/RoleInsert",
+
+			permissions: permissions,
+
+			expectInserts: []*dao.RoleInsertRequest{
+				{Name: "test:base", Priority: 0, Permissions: []string{"base:read"}},
+			},
+			insertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoRoleList := coremocks.NewMockRoleSeedDaoRoleList(t)
+			daoRoleInsert := coremocks.NewMockRoleSeedDaoRoleInsert(t)
+			daoAuditEventInsert := coremocks.NewMockRoleSeedDaoAuditEventInsert(t)
+
+			_, resolveErr := testCase.permissions.Resolve()
+			if resolveErr == nil {
+				daoRoleList.EXPECT().
+					Exec(mock.Anything, &dao.RoleListRequest{Lock: true}).
+					Return(testCase.roleListResp, testCase.roleListErr)
+			}
+
+			inserted := lo.Map(testCase.roleListResp, func(item *dao.Role, _ int) string { return item.Name })
+
+			for _, insert := range testCase.expectInserts {
+				daoRoleInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.RoleInsertRequest) bool {
+						return data.Name == insert.Name
+					})).
+					RunAndReturn(func(_ context.Context, data *dao.RoleInsertRequest) (*dao.Role, error) {
+						require.Equal(t, insert.Priority, data.Priority)
+						require.Equal(t, insert.Permissions, data.Permissions)
+						require.Equal(t, insert.Inherits, data.Inherits)
+						// Every inherited role exists by the time the role is inserted.
+						require.Subset(t, inserted, data.Inherits)
+
+						inserted = append(inserted, data.Name)
+
+						return &dao.Role{Name: data.Name}, testCase.insertErr
+					})
+
+				if testCase.insertErr == nil {
+					daoAuditEventInsert.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+							return data.Action == core.AuditActionRolesCreate && data.ActorID == nil
+						})).
+						Return(&dao.AuditEvent{}, nil).
+						Once()
+				}
+			}
+
+			service := core.NewRoleSeed(
+				daoRoleList, daoRoleInsert, daoAuditEventInsert, transactiontest.NewTransactor(),
+			)
+
+			resp, err := service.Exec(t.Context(), &core.RoleSeedRequest{Permissions: testCase.permissions})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoRoleList.AssertExpectations(t)
+			daoRoleInsert.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
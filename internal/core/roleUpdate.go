@@ -0,0 +1,151 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type RoleUpdateDao interface {
+	Exec(ctx context.Context, request *dao.RoleUpdateRequest) (*dao.Role, error)
+}
+
+type RoleUpdateDaoRoleList interface {
+	Exec(ctx context.Context, request *dao.RoleListRequest) ([]*dao.Role, error)
+}
+
+type RoleUpdateDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+// RoleUpdateRegistry reloads the role definitions once the role is updated; satisfied by
+// [RoleRegistry].
+type RoleUpdateRegistry interface {
+	Load(ctx context.Context) error
+}
+
+// RoleUpdateRequest replaces the definition of a role: fields left empty are cleared, not kept.
+type RoleUpdateRequest struct {
+	Name string `validate:"required,role"`
+	// Priority ranks the role in the hierarchy; a higher value outranks a lower one.
+	Priority    int      `validate:"min=0"`
+	Permissions []string `validate:"max=256,unique,dive,permissionGrant"`
+	// Inherits lists roles whose permissions the role also grants. They must exist, and must not
+	// inherit the role back.
+	Inherits      []string `validate:"max=16,unique,dive,role"`
+	CurrentUserID uuid.UUID
+	// RequestID identifies the request in the audit trail.
+	RequestID string
+}
+
+// RoleUpdate replaces the definition of an existing role. The new definition must not inherit
+// an unknown role, nor make the inheritance circular.
+//
+// Tokens name roles, not permissions: a change applies to the tokens already issued as soon as
+// the instances reload their definitions.
+//
+// The change is recorded in the audit trail, in the transaction of the update.
+type RoleUpdate struct {
+	dao                 RoleUpdateDao
+	daoRoleList         RoleUpdateDaoRoleList
+	daoAuditEventInsert RoleUpdateDaoAuditEventInsert
+	transactor          transaction.Transactor
+	registry            RoleUpdateRegistry
+}
+
+func NewRoleUpdate(
+	dao RoleUpdateDao,
+	daoRoleList RoleUpdateDaoRoleList,
+	daoAuditEventInsert RoleUpdateDaoAuditEventInsert,
+	transactor transaction.Transactor,
+	registry RoleUpdateRegistry,
+) *RoleUpdate {
+	return &RoleUpdate{
+		dao:                 dao,
+		daoRoleList:         daoRoleList,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+		registry:            registry,
+	}
+}
+
+func (service *RoleUpdate) Exec(ctx context.Context, request *RoleUpdateRequest) (*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.RoleUpdate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("role.name", request.Name),
+		attribute.Int("role.priority", request.Priority),
+		attribute.StringSlice("role.inherits", request.Inherits),
+		attribute.String("actor.id", request.CurrentUserID.String()),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var entity *dao.Role
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Locking serializes the writes to roles, so the definitions checked here are still
+		// the current ones on commit.
+		roles, err := service.daoRoleList.Exec(ctx, &dao.RoleListRequest{Lock: true})
+		if err != nil {
+			return fmt.Errorf("list roles: %w", err)
+		}
+
+		current, found := lo.Find(roles, func(item *dao.Role) bool { return item.Name == request.Name })
+		if !found {
+			return fmt.Errorf("%w: %q", dao.ErrRoleUpdateNotFound, request.Name)
+		}
+
+		updated := &dao.Role{
+			Name:        request.Name,
+			Priority:    request.Priority,
+			Permissions: request.Permissions,
+			Inherits:    request.Inherits,
+		}
+
+		err = checkRoles(append(lo.Without(roles, current), updated))
+		if err != nil {
+			return err
+		}
+
+		entity, err = service.dao.Exec(ctx, &dao.RoleUpdateRequest{
+			Name:        request.Name,
+			Priority:    request.Priority,
+			Permissions: request.Permissions,
+			Inherits:    request.Inherits,
+			Now:         time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("update role: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			Action:    AuditActionRolesUpdate,
+			Before:    newAuditRoleState(current),
+			After:     newAuditRoleState(entity),
+			RequestID: request.RequestID,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	reloadRoles(ctx, service.registry)
+
+	return otel.ReportSuccess(span, loadRole(entity, 0)), nil
+}
@@ -0,0 +1,268 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestRoleUpdate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	storedRoles := []*dao.Role{
+		{Name: "test:base", Priority: 0, Permissions: []string{"base:read"}},
+		{Name: "test:derived", Priority: 1, Permissions: []string{"derived:read"}, Inherits: []string{"test:base"}},
+		{Name: "test:other", Priority: 1, Permissions: []string{"other:read"}},
+	}
+
+	type roleListMock struct {
+		resp []*dao.Role
+		err  error
+	}
+
+	type roleUpdateMock struct {
+		resp *dao.Role
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.RoleUpdateRequest
+
+		roleListMock   *roleListMock
+		roleUpdateMock *roleUpdateMock
+		// auditEventInsertErr is returned by the audit trail, which records every update.
+		auditEventInsertErr error
+
+		expect    *core.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:derived",
+				Priority:      2,
+				Permissions:   []string{"derived:read", "derived:write"},
+				Inherits:      []string{"test:base", "test:other"},
+				CurrentUserID: callerID,
+				RequestID:     "request-1",
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+			roleUpdateMock: &roleUpdateMock{
+				resp: &dao.Role{
+					Name:        "test:derived",
+					Priority:    2,
+					Permissions: []string{"derived:read", "derived:write"},
+					Inherits:    []string{"test:base", "test:other"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Role{
+				Name:        "test:derived",
+				Priority:    2,
+				Permissions: []string{"derived:read", "derived:write"},
+				Inherits:    []string{"test:base", "test:other"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/ClearInherits",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:derived",
+				Priority:      1,
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+			roleUpdateMock: &roleUpdateMock{
+				resp: &dao.Role{
+					Name:      "test:derived",
+					Priority:  1,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expect: &core.Role{
+				Name:      "test:derived",
+				Priority:  1,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/CircularInheritance",
+
+			// test:derived already inherits test:base.
+			request: &core.RoleUpdateRequest{
+				Name:          "test:base",
+				Inherits:      []string{"test:derived"},
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/UnknownInherit",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:derived",
+				Inherits:      []string{"test:unknown"},
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:unknown",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{resp: storedRoles},
+
+			expectErr: dao.ErrRoleUpdateNotFound,
+		},
+		{
+			name: "Error/InvalidName",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "",
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/RoleList",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:derived",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock: &roleListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/RoleUpdate",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:derived",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock:   &roleListMock{resp: storedRoles},
+			roleUpdateMock: &roleUpdateMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.RoleUpdateRequest{
+				Name:          "test:derived",
+				CurrentUserID: callerID,
+			},
+
+			roleListMock:        &roleListMock{resp: storedRoles},
+			roleUpdateMock:      &roleUpdateMock{resp: &dao.Role{Name: "test:derived"}},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDao := coremocks.NewMockRoleUpdateDao(t)
+			daoRoleList := coremocks.NewMockRoleUpdateDaoRoleList(t)
+			daoAuditEventInsert := coremocks.NewMockRoleUpdateDaoAuditEventInsert(t)
+			registry := coremocks.NewMockRoleUpdateRegistry(t)
+
+			if testCase.roleListMock != nil {
+				daoRoleList.EXPECT().
+					Exec(mock.Anything, &dao.RoleListRequest{Lock: true}).
+					Return(testCase.roleListMock.resp, testCase.roleListMock.err)
+			}
+
+			if testCase.roleUpdateMock != nil {
+				mockDao.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.RoleUpdateRequest) bool {
+						return assert.Equal(t, testCase.request.Name, data.Name) &&
+							assert.Equal(t, testCase.request.Priority, data.Priority) &&
+							assert.Equal(t, testCase.request.Permissions, data.Permissions) &&
+							assert.Equal(t, testCase.request.Inherits, data.Inherits) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second)
+					})).
+					Return(testCase.roleUpdateMock.resp, testCase.roleUpdateMock.err)
+			}
+
+			if testCase.roleUpdateMock != nil && testCase.roleUpdateMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						var before, after map[string]any
+
+						return assert.Equal(t, core.AuditActionRolesUpdate, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Nil(t, data.TargetID) &&
+							assert.NoError(t, json.Unmarshal(data.Before, &before)) &&
+							assert.NoError(t, json.Unmarshal(data.After, &after)) &&
+							assert.Equal(t, testCase.request.Name, before["name"]) &&
+							assert.Equal(t, testCase.request.Name, after["name"]) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			if testCase.expectErr == nil {
+				registry.EXPECT().Load(mock.Anything).Return(nil)
+			}
+
+			service := core.NewRoleUpdate(
+				mockDao, daoRoleList, daoAuditEventInsert, transactiontest.NewTransactor(), registry,
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			mockDao.AssertExpectations(t)
+			daoRoleList.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+			registry.AssertExpectations(t)
+		})
+	}
+}
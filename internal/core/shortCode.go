@@ -1,12 +1,25 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
 )
 
+// ErrShortCodeFormatUnknown is returned when issuing a short code for a usage configured with a
+// format missing from config.KnownShortCodeFormats.
+var ErrShortCodeFormatUnknown = errors.New("unknown short code format")
+
 // ShortCode is a one-time verification code issued for a sensitive identity
 // operation (registration, password reset, email change). Callers receive it
 // from [ShortCodeCreate]; a separate [ShortCodeConsume] call validates and
@@ -28,11 +41,68 @@ type ShortCode struct {
 	CreatedAt time.Time
 	ExpiresAt time.Time
 
-	// PlainCode is the user-facing code emailed to the target. It is populated
-	// only on the response from [ShortCodeCreate]; the database stores a hash.
+	// PlainCode is the user-facing code emailed to the target, laid out in the
+	// format of its usage. It is populated only on the response from
+	// [ShortCodeCreate]; the database stores a hash.
 	PlainCode string
 }
 
+const (
+	// ShortCodeStatusActive is the status of a code that can still be redeemed.
+	ShortCodeStatusActive = "active"
+	// ShortCodeStatusExpired is the status of a code that outlived its expiration date,
+	// whether or not the janitor deleted it since.
+	ShortCodeStatusExpired = "expired"
+	// ShortCodeStatusDeleted is the status of a code deleted before its expiration:
+	// consumed, superseded, locked or revoked. Its deletion comment tells which.
+	ShortCodeStatusDeleted = "deleted"
+)
+
+// ShortCodeMetadata is the administrative view of a short code, returned by
+// [ShortCodeList] and [ShortCodeRevoke]. Neither the code hash nor the flow data
+// are exposed.
+type ShortCodeMetadata struct {
+	ID     uuid.UUID
+	Usage  string
+	Target string
+	// Status is one of the ShortCodeStatus* constants.
+	Status string
+	// Attempts counts the failed attempts at redeeming the code.
+	Attempts int
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	DeletedAt      *time.Time
+	DeletedComment *string
+}
+
+func loadShortCodeMetadata(item *dao.ShortCode, _ int) *ShortCodeMetadata {
+	status := ShortCodeStatusActive
+
+	switch {
+	// The janitor deletes the codes that expired, with a comment of its own.
+	case lo.FromPtr(item.DeletedComment) == dao.ShortCodeDeleteExpired:
+		status = ShortCodeStatusExpired
+	case item.DeletedAt != nil:
+		status = ShortCodeStatusDeleted
+	case !item.ExpiresAt.After(time.Now()):
+		status = ShortCodeStatusExpired
+	}
+
+	return &ShortCodeMetadata{
+		ID:             item.ID,
+		Usage:          item.Usage,
+		Target:         item.Target,
+		Status:         status,
+		Attempts:       item.Attempts,
+		CreatedAt:      item.CreatedAt,
+		ExpiresAt:      item.ExpiresAt,
+		DeletedAt:      item.DeletedAt,
+		DeletedComment: item.DeletedComment,
+	}
+}
+
 const (
 	// ShortCodeUsageValidateEmail gates an email-change confirmation: the code is
 	// emailed to the prospective new address and consumed by [CredentialsUpdateEmail].
@@ -43,6 +113,14 @@ const (
 	// ShortCodeUsageRegister gates a new-account registration: the code is emailed
 	// to the prospective address and consumed by [CredentialsCreate].
 	ShortCodeUsageRegister = "register"
+	// ShortCodeUsageInvite gates an invitation: the code is emailed to the invited
+	// address by [ShortCodeCreateInvite] and consumed by [CredentialsCreateInvite].
+	// Its data is a [ShortCodeInviteData].
+	ShortCodeUsageInvite = "invite"
+	// ShortCodeUsageVerifyEmail gates the verification of an account's current address:
+	// the code is emailed to it by [ShortCodeCreateEmailVerification] and consumed by
+	// [CredentialsVerifyEmail]. Its data is the address the code was sent to.
+	ShortCodeUsageVerifyEmail = "verifyEmail"
 )
 
 // KnownShortCodeUsages enumerates every valid value for [ShortCode.Usage]. It
@@ -52,6 +130,8 @@ var KnownShortCodeUsages = []string{
 	ShortCodeUsageValidateEmail,
 	ShortCodeUsageResetPassword,
 	ShortCodeUsageRegister,
+	ShortCodeUsageInvite,
+	ShortCodeUsageVerifyEmail,
 }
 
 // ValidateShortCodeUsage is the go-playground/validator field-level validator
@@ -68,3 +148,72 @@ func ValidateShortCodeUsage(fl validator.FieldLevel) bool {
 
 	return false
 }
+
+// shortCodeGroupSize is the number of characters between two separators, in the formats that
+// group them.
+const shortCodeGroupSize = 4
+
+var (
+	// shortCodeSeparatorsReplacer strips what users may type between the characters of a code:
+	// the separators of the grouped formats, and spaces.
+	shortCodeSeparatorsReplacer = strings.NewReplacer("-", "", " ", "")
+	// shortCodeCrockfordReplacer maps the letters Crockford's base32 leaves out to the digits
+	// they are mistaken for.
+	shortCodeCrockfordReplacer = strings.NewReplacer("I", "1", "L", "1", "O", "0")
+)
+
+// generateShortCode draws a code in the format of usage, laid out as it is delivered to the user.
+// Usages that set no length draw defaultLength characters.
+func generateShortCode(usage config.ShortCodeUsage, defaultLength int) (string, error) {
+	length := lo.Ternary(usage.Length > 0, usage.Length, defaultLength)
+
+	switch usage.Format {
+	case "", config.ShortCodeFormatURLSafe:
+		return lib.NewRandomURLString(length)
+	case config.ShortCodeFormatNumeric:
+		return lib.NewRandomString(lib.NumericCharList, length)
+	case config.ShortCodeFormatCrockford:
+		code, err := lib.NewRandomString(lib.CrockfordCharList, length)
+		if err != nil {
+			return "", err
+		}
+
+		var grouped strings.Builder
+
+		// The alphabet is ASCII: byte offsets count characters.
+		for i, char := range code {
+			if i > 0 && i%shortCodeGroupSize == 0 {
+				grouped.WriteRune('-')
+			}
+
+			grouped.WriteRune(char)
+		}
+
+		return grouped.String(), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrShortCodeFormatUnknown, usage.Format)
+	}
+}
+
+// normalizeShortCode reduces a code to the form its hash covers, so the variations the format of
+// usage tolerates all match. The code is normalized once generated, and again when redeemed.
+func normalizeShortCode(usage config.ShortCodeUsage, code string) string {
+	switch usage.Format {
+	case config.ShortCodeFormatNumeric:
+		return shortCodeSeparatorsReplacer.Replace(code)
+	case config.ShortCodeFormatCrockford:
+		return shortCodeCrockfordReplacer.Replace(strings.ToUpper(shortCodeSeparatorsReplacer.Replace(code)))
+	default:
+		return lo.Ternary(usage.CaseInsensitive, strings.ToLower(code), code)
+	}
+}
+
+// shortCodeMailDuration is the validity of a short code told in its email, in hours. A resent
+// code keeps the expiry of the code it replaces: what is left of it is told instead of the TTL.
+func shortCodeMailDuration(shortCode *ShortCode, ttl time.Duration, resend bool) float64 {
+	if !resend {
+		return ttl.Hours()
+	}
+
+	return math.Ceil(shortCode.ExpiresAt.Sub(shortCode.CreatedAt).Hours())
+}
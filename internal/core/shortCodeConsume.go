@@ -9,7 +9,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/lib"
 )
@@ -23,6 +25,10 @@ var (
 	// stored code's expiry has already passed. The SQL select filters expired rows
 	// too; this check covers clock skew between the database and the service.
 	ErrShortCodeConsumeExpired = errors.New("short code expired")
+	// ErrShortCodeConsumeTooManyAttempts is returned by [ShortCodeConsume.Exec] when the
+	// submitted code is wrong, and the failed attempts reached the limit of the usage. The
+	// stored code is deleted: the user must request a new one.
+	ErrShortCodeConsumeTooManyAttempts = errors.New("too many failed attempts, request a new short code")
 )
 
 // ShortCodeConsumeDaoSelect loads the stored short code for a (Usage, Target)
@@ -37,6 +43,11 @@ type ShortCodeConsumeDaoDelete interface {
 	Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)
 }
 
+// ShortCodeConsumeDaoIncrementAttempts counts a failed attempt at redeeming a short code.
+type ShortCodeConsumeDaoIncrementAttempts interface {
+	Exec(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error)
+}
+
 // ShortCodeConsumeRequest identifies the code to redeem: the flow it was issued
 // for, the subject it was bound to, and the plaintext code the user supplied.
 type ShortCodeConsumeRequest struct {
@@ -48,26 +59,40 @@ type ShortCodeConsumeRequest struct {
 // ShortCodeConsume verifies a user-submitted short code against the stored hash
 // and, on success, retires it so it cannot be redeemed twice. It is the
 // counterpart to [ShortCodeCreate].
+//
+// Every failed attempt is counted on the stored code, which is deleted once the
+// count reaches the MaxAttempts of its usage: a code cannot be guessed by brute
+// force while it is valid. Callers redeeming a code within a transaction must
+// commit the failed attempts; see [consumeShortCodeWithinTx].
 type ShortCodeConsume struct {
-	daoSelect ShortCodeConsumeDaoSelect
-	daoDelete ShortCodeConsumeDaoDelete
+	daoSelect            ShortCodeConsumeDaoSelect
+	daoDelete            ShortCodeConsumeDaoDelete
+	daoIncrementAttempts ShortCodeConsumeDaoIncrementAttempts
+
+	config config.ShortCodes
 }
 
-// NewShortCodeConsume wires the consume service to the DAOs that read and delete
-// stored codes.
+// NewShortCodeConsume wires the consume service to the DAOs that read, delete and
+// count the failed attempts of stored codes.
 func NewShortCodeConsume(
 	daoSelect ShortCodeConsumeDaoSelect,
 	daoDelete ShortCodeConsumeDaoDelete,
+	daoIncrementAttempts ShortCodeConsumeDaoIncrementAttempts,
+	config config.ShortCodes,
 ) *ShortCodeConsume {
 	return &ShortCodeConsume{
-		daoSelect: daoSelect,
-		daoDelete: daoDelete,
+		daoSelect:            daoSelect,
+		daoDelete:            daoDelete,
+		daoIncrementAttempts: daoIncrementAttempts,
+		config:               config,
 	}
 }
 
 // Exec verifies the submitted code and, on success, deletes it and returns the
 // consumed [ShortCode]. It returns [ErrShortCodeConsumeInvalid] when the code does
-// not match the stored hash and [ErrShortCodeConsumeExpired] when it has lapsed.
+// not match the stored hash, [ErrShortCodeConsumeTooManyAttempts] instead when that
+// failed attempt was the last one allowed, and [ErrShortCodeConsumeExpired] when
+// the code has lapsed.
 func (service *ShortCodeConsume) Exec(
 	ctx context.Context, request *ShortCodeConsumeRequest,
 ) (*ShortCode, error) {
@@ -100,14 +125,14 @@ func (service *ShortCodeConsume) Exec(
 		return nil, otel.ReportError(span, ErrShortCodeConsumeExpired)
 	}
 
-	err = lib.CompareArgon2(request.Code, entity.Code)
+	err = lib.CompareArgon2(normalizeShortCode(service.config.Usages[request.Usage], request.Code), entity.Code)
 	if err != nil {
 		// A mistyped or stale code yields lib.ErrInvalidPassword; a malformed stored hash
 		// yields lib.ErrInvalidHash or lib.ErrIncompatibleVersion. Both surface to the
 		// caller as ErrShortCodeConsumeInvalid.
 		return nil, otel.ReportError(span, errors.Join(
 			fmt.Errorf("compare short code: %w", err),
-			ErrShortCodeConsumeInvalid,
+			recordShortCodeFailedAttempt(ctx, service.daoIncrementAttempts, service.daoDelete, service.config, entity),
 		))
 	}
 
@@ -130,3 +155,82 @@ func (service *ShortCodeConsume) Exec(
 		PlainCode: request.Code,
 	}), nil
 }
+
+// recordShortCodeFailedAttempt counts a failed attempt on the short code, and deletes it when the
+// attempt was the last one allowed for its usage. It returns the error the attempt fails with.
+func recordShortCodeFailedAttempt(
+	ctx context.Context,
+	daoIncrementAttempts ShortCodeConsumeDaoIncrementAttempts,
+	daoDelete ShortCodeConsumeDaoDelete,
+	config config.ShortCodes,
+	entity *dao.ShortCode,
+) error {
+	updated, err := daoIncrementAttempts.Exec(ctx, &dao.ShortCodeIncrementAttemptsRequest{ID: entity.ID})
+	if err != nil {
+		// A code deleted since it was selected, by a concurrent attempt, cannot be redeemed anyway.
+		if errors.Is(err, dao.ErrShortCodeIncrementAttemptsNotFound) {
+			return ErrShortCodeConsumeInvalid
+		}
+
+		return fmt.Errorf("increment attempts: %w", err)
+	}
+
+	maxAttempts := config.Usages[entity.Usage].MaxAttempts
+	if maxAttempts == 0 || updated.Attempts < maxAttempts {
+		return ErrShortCodeConsumeInvalid
+	}
+
+	_, err = daoDelete.Exec(ctx, &dao.ShortCodeDeleteRequest{
+		ID:      entity.ID,
+		Now:     time.Now(),
+		Comment: dao.ShortCodeDeleteTooManyAttempts,
+	})
+	if err != nil && !errors.Is(err, dao.ErrShortCodeDeleteNotFound) {
+		return fmt.Errorf("delete short code: %w", err)
+	}
+
+	return ErrShortCodeConsumeTooManyAttempts
+}
+
+// shortCodeConsumer redeems short codes; satisfied by [ShortCodeConsume].
+type shortCodeConsumer interface {
+	Exec(ctx context.Context, request *ShortCodeConsumeRequest) (*ShortCode, error)
+}
+
+// consumeShortCodeWithinTx redeems a short code, then runs callback with it, in one transaction.
+// A rejected code does not roll the transaction back: it commits the failed attempt
+// [ShortCodeConsume] counted, without which the attempts limit would never be reached. callback
+// only runs once the code is redeemed.
+func consumeShortCodeWithinTx(
+	ctx context.Context,
+	transactor transaction.Transactor,
+	consumer shortCodeConsumer,
+	request *ShortCodeConsumeRequest,
+	callback func(ctx context.Context, shortCode *ShortCode) error,
+) error {
+	var rejected error
+
+	err := transactor.WithinTx(ctx, func(ctx context.Context) error {
+		shortCode, err := consumer.Exec(ctx, request)
+		if errors.Is(err, ErrShortCodeConsumeInvalid) || errors.Is(err, ErrShortCodeConsumeTooManyAttempts) {
+			rejected = err
+
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("consume short code: %w", err)
+		}
+
+		return callback(ctx, shortCode)
+	})
+	if err != nil {
+		return err
+	}
+
+	if rejected != nil {
+		return fmt.Errorf("consume short code: %w", rejected)
+	}
+
+	return nil
+}
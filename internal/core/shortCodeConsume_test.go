@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
@@ -28,13 +29,50 @@ func TestShortCodeConsume(t *testing.T) {
 	encrypted, err := lib.GenerateArgon2(shortCode, lib.Argon2ParamsDefault)
 	require.NoError(t, err)
 
+	// The hash covers the normalized form of a Crockford code.
+	encryptedCrockford, err := lib.GenerateArgon2("AB1C0DEF", lib.Argon2ParamsDefault)
+	require.NoError(t, err)
+
 	type daoSelectMock struct {
 		resp *dao.ShortCode
 		err  error
 	}
 
 	type daoDeleteMock struct {
-		err error
+		comment string
+		err     error
+	}
+
+	type daoIncrementAttemptsMock struct {
+		resp *dao.ShortCode
+		err  error
+	}
+
+	shortCodesConfig := config.ShortCodes{
+		Usages: map[string]config.ShortCodeUsage{
+			core.ShortCodeUsageValidateEmail: {TTL: time.Hour, MaxAttempts: 3},
+			core.ShortCodeUsageResetPassword: {TTL: time.Hour},
+			core.ShortCodeUsageRegister:      {TTL: time.Hour, Format: config.ShortCodeFormatCrockford},
+			core.ShortCodeUsageInvite:        {TTL: time.Hour, CaseInsensitive: true},
+		},
+	}
+
+	selectedShortCode := func(usage string) *dao.ShortCode {
+		return &dao.ShortCode{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Code:      encrypted,
+			Usage:     usage,
+			Target:    "test-target",
+			Data:      []byte("test-data"),
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpiresAt: futureTime,
+		}
+	}
+
+	withAttempts := func(shortCode *dao.ShortCode, attempts int) *dao.ShortCode {
+		shortCode.Attempts = attempts
+
+		return shortCode
 	}
 
 	testCases := []struct {
@@ -42,8 +80,9 @@ func TestShortCodeConsume(t *testing.T) {
 
 		request *core.ShortCodeConsumeRequest
 
-		daoSelectMock *daoSelectMock
-		daoDeleteMock *daoDeleteMock
+		daoSelectMock            *daoSelectMock
+		daoDeleteMock            *daoDeleteMock
+		daoIncrementAttemptsMock *daoIncrementAttemptsMock
 
 		expect    *core.ShortCode
 		expectErr error
@@ -69,7 +108,7 @@ func TestShortCodeConsume(t *testing.T) {
 				},
 			},
 
-			daoDeleteMock: &daoDeleteMock{},
+			daoDeleteMock: &daoDeleteMock{comment: dao.ShortCodeDeleteConsumed},
 
 			expect: &core.ShortCode{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
@@ -81,6 +120,69 @@ func TestShortCodeConsume(t *testing.T) {
 				PlainCode: shortCode,
 			},
 		},
+		{
+			name: "Success/Crockford",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageRegister,
+				// Lowercase, with a separator, an L for a 1 and an O for a 0.
+				Code: "abLc-odef",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:      encryptedCrockford,
+					Usage:     core.ShortCodeUsageRegister,
+					Target:    "test-target",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: futureTime,
+				},
+			},
+
+			daoDeleteMock: &daoDeleteMock{comment: dao.ShortCodeDeleteConsumed},
+
+			expect: &core.ShortCode{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Usage:     core.ShortCodeUsageRegister,
+				Target:    "test-target",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				ExpiresAt: futureTime,
+				PlainCode: "abLc-odef",
+			},
+		},
+		{
+			name: "Success/CaseInsensitive",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageInvite,
+				Code:   "TEST-Code",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:      encrypted,
+					Usage:     core.ShortCodeUsageInvite,
+					Target:    "test-target",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: futureTime,
+				},
+			},
+
+			daoDeleteMock: &daoDeleteMock{comment: dao.ShortCodeDeleteConsumed},
+
+			expect: &core.ShortCode{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Usage:     core.ShortCodeUsageInvite,
+				Target:    "test-target",
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				ExpiresAt: futureTime,
+				PlainCode: "TEST-Code",
+			},
+		},
 		{
 			name: "WrongCode",
 
@@ -102,8 +204,114 @@ func TestShortCodeConsume(t *testing.T) {
 				},
 			},
 
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageValidateEmail), 1),
+			},
+
 			expectErr: core.ErrShortCodeConsumeInvalid,
 		},
+		{
+			name: "WrongCode/TooManyAttempts",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Code:   "fake-code",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageValidateEmail), 2),
+			},
+
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageValidateEmail), 3),
+			},
+
+			daoDeleteMock: &daoDeleteMock{comment: dao.ShortCodeDeleteTooManyAttempts},
+
+			expectErr: core.ErrShortCodeConsumeTooManyAttempts,
+		},
+		{
+			name: "WrongCode/TooManyAttempts/AlreadyDeleted",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Code:   "fake-code",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageValidateEmail), 2),
+			},
+
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageValidateEmail), 3),
+			},
+
+			daoDeleteMock: &daoDeleteMock{
+				comment: dao.ShortCodeDeleteTooManyAttempts,
+				err:     dao.ErrShortCodeDeleteNotFound,
+			},
+
+			expectErr: core.ErrShortCodeConsumeTooManyAttempts,
+		},
+		{
+			name: "WrongCode/UnlimitedAttempts",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageResetPassword,
+				Code:   "fake-code",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageResetPassword), 99),
+			},
+
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{
+				resp: withAttempts(selectedShortCode(core.ShortCodeUsageResetPassword), 100),
+			},
+
+			expectErr: core.ErrShortCodeConsumeInvalid,
+		},
+		{
+			name: "WrongCode/ConcurrentlyDeleted",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Code:   "fake-code",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: selectedShortCode(core.ShortCodeUsageValidateEmail),
+			},
+
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{
+				err: dao.ErrShortCodeIncrementAttemptsNotFound,
+			},
+
+			expectErr: core.ErrShortCodeConsumeInvalid,
+		},
+		{
+			name: "WrongCode/IncrementAttemptsError",
+
+			request: &core.ShortCodeConsumeRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Code:   "fake-code",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: selectedShortCode(core.ShortCodeUsageValidateEmail),
+			},
+
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "ExpiredCode",
 
@@ -174,7 +382,8 @@ func TestShortCodeConsume(t *testing.T) {
 			},
 
 			daoDeleteMock: &daoDeleteMock{
-				err: errFoo,
+				comment: dao.ShortCodeDeleteConsumed,
+				err:     errFoo,
 			},
 
 			expectErr: errFoo,
@@ -187,6 +396,7 @@ func TestShortCodeConsume(t *testing.T) {
 
 			daoSelect := coremocks.NewMockShortCodeConsumeDaoSelect(t)
 			daoDelete := coremocks.NewMockShortCodeConsumeDaoDelete(t)
+			daoIncrementAttempts := coremocks.NewMockShortCodeConsumeDaoIncrementAttempts(t)
 
 			if testCase.daoSelectMock != nil {
 				daoSelect.EXPECT().
@@ -202,12 +412,18 @@ func TestShortCodeConsume(t *testing.T) {
 					Exec(mock.Anything, mock.MatchedBy(func(data *dao.ShortCodeDeleteRequest) bool {
 						return assert.Equal(t, testCase.daoSelectMock.resp.ID, data.ID) &&
 							assert.WithinDuration(t, time.Now(), data.Now, time.Second) &&
-							assert.Equal(t, "key consumed", data.Comment)
+							assert.Equal(t, testCase.daoDeleteMock.comment, data.Comment)
 					})).
 					Return(nil, testCase.daoDeleteMock.err)
 			}
 
-			service := core.NewShortCodeConsume(daoSelect, daoDelete)
+			if testCase.daoIncrementAttemptsMock != nil {
+				daoIncrementAttempts.EXPECT().
+					Exec(mock.Anything, &dao.ShortCodeIncrementAttemptsRequest{ID: testCase.daoSelectMock.resp.ID}).
+					Return(testCase.daoIncrementAttemptsMock.resp, testCase.daoIncrementAttemptsMock.err)
+			}
+
+			service := core.NewShortCodeConsume(daoSelect, daoDelete, daoIncrementAttempts, shortCodesConfig)
 
 			resp, err := service.Exec(t.Context(), testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
@@ -215,6 +431,7 @@ func TestShortCodeConsume(t *testing.T) {
 
 			daoSelect.AssertExpectations(t)
 			daoDelete.AssertExpectations(t)
+			daoIncrementAttempts.AssertExpectations(t)
 		})
 	}
 }
@@ -17,11 +17,42 @@ import (
 	"github.com/a-novel/service-authentication/v2/internal/lib"
 )
 
+var (
+	// ErrShortCodeCreateResendNotFound is returned by [ShortCodeCreate.Exec] when asked to
+	// resend a code, and the target has no active code for the usage. It is joined onto
+	// dao.ErrShortCodeSelectNotFound.
+	ErrShortCodeCreateResendNotFound = errors.New("no active short code to resend")
+	// ErrShortCodeCreateResendCooldown is matched by the [ShortCodeCreateResendCooldownError]
+	// returned when a code is resent before the cooldown of its usage ran out.
+	ErrShortCodeCreateResendCooldown = errors.New("short code resent too soon")
+)
+
+// ShortCodeCreateResendCooldownError is returned by [ShortCodeCreate.Exec] when asked to resend
+// a code issued less than the ResendCooldown of its usage ago. It matches
+// [ErrShortCodeCreateResendCooldown].
+type ShortCodeCreateResendCooldownError struct {
+	// RetryAfter is how long until the code can be resent.
+	RetryAfter time.Duration
+}
+
+func (err *ShortCodeCreateResendCooldownError) Error() string {
+	return fmt.Sprintf("%s, retry in %s", ErrShortCodeCreateResendCooldown, err.RetryAfter.Round(time.Second))
+}
+
+func (err *ShortCodeCreateResendCooldownError) Unwrap() error {
+	return ErrShortCodeCreateResendCooldown
+}
+
 // ShortCodeCreateDao persists a new short code and returns the stored row.
 type ShortCodeCreateDao interface {
 	Exec(ctx context.Context, request *dao.ShortCodeInsertRequest) (*dao.ShortCode, error)
 }
 
+// ShortCodeCreateDaoSelect loads the active short code a resend replaces.
+type ShortCodeCreateDaoSelect interface {
+	Exec(ctx context.Context, request *dao.ShortCodeSelectRequest) (*dao.ShortCode, error)
+}
+
 // ShortCodeCreateRequest describes a code to issue: the flow it is for, the
 // subject it binds to, any flow-specific data to carry, and how long it lives.
 type ShortCodeCreateRequest struct {
@@ -33,29 +64,38 @@ type ShortCodeCreateRequest struct {
 	// Override expires and replaces any existing code for the same target and usage.
 	// When false, a duplicate fails the request.
 	Override bool
+	// Resend replaces the active code for the same target and usage, which must exist, with a
+	// new one expiring at the same time; TTL is ignored. The stored hash cannot be sent again,
+	// so a new code is drawn. A code is resent at most once per ResendCooldown of its usage.
+	Resend bool
 }
 
-// ShortCodeCreate issues a short code: it generates a random plaintext code,
-// stores only its Argon2id hash, and returns the plaintext once so the caller can
-// deliver it to the target. [ShortCodeConsume] redeems it.
+// ShortCodeCreate issues a short code: it generates a random plaintext code in the
+// format of its usage, stores only its Argon2id hash, and returns the plaintext once
+// so the caller can deliver it to the target. [ShortCodeConsume] redeems it.
 type ShortCodeCreate struct {
-	dao    ShortCodeCreateDao
-	config config.ShortCodes
+	dao       ShortCodeCreateDao
+	daoSelect ShortCodeCreateDaoSelect
+	config    config.ShortCodes
 }
 
-// NewShortCodeCreate wires the create service to its DAO and short-code configuration.
+// NewShortCodeCreate wires the create service to its DAOs and short-code configuration.
 func NewShortCodeCreate(
 	dao ShortCodeCreateDao,
+	daoSelect ShortCodeCreateDaoSelect,
 	config config.ShortCodes,
 ) *ShortCodeCreate {
 	return &ShortCodeCreate{
-		dao:    dao,
-		config: config,
+		dao:       dao,
+		daoSelect: daoSelect,
+		config:    config,
 	}
 }
 
 // Exec issues a new short code and returns it with the plaintext populated in
-// [ShortCode.PlainCode]; only the hash is stored.
+// [ShortCode.PlainCode]; only the hash is stored. When resending, it returns
+// [ErrShortCodeCreateResendNotFound] if there is no code to resend, and a
+// [ShortCodeCreateResendCooldownError] if the code was issued too recently.
 func (service *ShortCodeCreate) Exec(
 	ctx context.Context, request *ShortCodeCreateRequest,
 ) (*ShortCode, error) {
@@ -67,13 +107,25 @@ func (service *ShortCodeCreate) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	plainCode, err := lib.NewRandomURLString(service.config.Size)
+	usage := service.config.Usages[request.Usage]
+	now := time.Now()
+	expiry := now.Add(request.TTL)
+
+	if request.Resend {
+		expiry, err = service.resendExpiry(ctx, request, usage, now)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+	}
+
+	plainCode, err := generateShortCode(usage, service.config.Size)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("generate short code: %w", err))
 	}
 
-	// Store only the Argon2id hash; the plaintext code never reaches the database.
-	encrypted, err := lib.GenerateArgon2(plainCode, lib.Argon2ParamsDefault)
+	// Store only the Argon2id hash; the plaintext code never reaches the database. The hash
+	// covers the normalized code, so any input the format tolerates matches it.
+	encrypted, err := lib.GenerateArgon2(normalizeShortCode(usage, plainCode), lib.Argon2ParamsDefault)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("encrypt short code: %w", err))
 	}
@@ -87,9 +139,6 @@ func (service *ShortCodeCreate) Exec(
 		return nil, otel.ReportError(span, fmt.Errorf("serialize data: %w", err))
 	}
 
-	now := time.Now()
-	expiry := now.Add(request.TTL)
-
 	entity, err := service.dao.Exec(ctx, &dao.ShortCodeInsertRequest{
 		ID:        uuid.New(),
 		Code:      encrypted,
@@ -98,7 +147,7 @@ func (service *ShortCodeCreate) Exec(
 		Data:      serializedData,
 		Now:       now,
 		ExpiresAt: expiry,
-		Override:  request.Override,
+		Override:  request.Override || request.Resend,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, err)
@@ -116,3 +165,28 @@ func (service *ShortCodeCreate) Exec(
 		PlainCode: plainCode,
 	}), nil
 }
+
+// resendExpiry returns the expiry of the active code a resend replaces, unless it was issued less
+// than the cooldown of its usage ago.
+func (service *ShortCodeCreate) resendExpiry(
+	ctx context.Context, request *ShortCodeCreateRequest, usage config.ShortCodeUsage, now time.Time,
+) (time.Time, error) {
+	active, err := service.daoSelect.Exec(ctx, &dao.ShortCodeSelectRequest{
+		Target: request.Target,
+		Usage:  request.Usage,
+	})
+	if errors.Is(err, dao.ErrShortCodeSelectNotFound) {
+		return time.Time{}, errors.Join(err, ErrShortCodeCreateResendNotFound)
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("select active short code: %w", err)
+	}
+
+	retryAfter := active.CreatedAt.Add(usage.ResendCooldown).Sub(now)
+	if retryAfter > 0 {
+		return time.Time{}, &ShortCodeCreateResendCooldownError{RetryAfter: retryAfter}
+	}
+
+	return active.ExpiresAt, nil
+}
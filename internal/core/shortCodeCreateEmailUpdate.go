@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
@@ -31,6 +32,12 @@ type ShortCodeCreateEmailUpdateDao interface {
 	Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)
 }
 
+// ShortCodeCreateEmailUpdateDaoCredentialsSelect loads the account of the user, to read
+// their preferred language when the request does not set one.
+type ShortCodeCreateEmailUpdateDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
 // ShortCodeCreateEmailUpdateSmtp is the mailer used to deliver the confirmation code.
 type ShortCodeCreateEmailUpdateSmtp = smtp.Sender
 
@@ -39,37 +46,51 @@ type ShortCodeCreateEmailUpdateSmtp = smtp.Sender
 type ShortCodeCreateEmailUpdateRequest struct {
 	ID    uuid.UUID
 	Email string `validate:"required,email,max=1024"`
-	Lang  string `validate:"required,langs"`
+	// Lang is the language of the mail. When empty, the mail follows the preferred language of
+	// the account, then FallbackLang.
+	Lang string `validate:"omitempty,langs"`
+	// FallbackLang is used when neither the request nor the account sets a language, typically
+	// negotiated from the client's Accept-Language header. [config.LangDefault] applies last.
+	FallbackLang string `validate:"omitempty,langs"`
+	// Resend re-issues the active code of the target instead of a new one, keeping its
+	// expiry. See [ShortCodeCreateRequest.Resend].
+	Resend bool
 }
 
 // ShortCodeCreateEmailUpdate issues a [ShortCodeUsageValidateEmail] code for an
 // email-change confirmation and emails it to the prospective new address, after
 // rejecting the change if that address is already registered.
 type ShortCodeCreateEmailUpdate struct {
-	service          ShortCodeCreateEmailUpdateService
-	selectDao        ShortCodeCreateEmailUpdateDao
-	smtp             smtp.Sender
-	shortCodesConfig config.ShortCodes
-	smtpConfig       config.SmtpUrls
+	service              ShortCodeCreateEmailUpdateService
+	selectDao            ShortCodeCreateEmailUpdateDao
+	daoCredentialsSelect ShortCodeCreateEmailUpdateDaoCredentialsSelect
+	smtp                 smtp.Sender
+	shortCodesConfig     config.ShortCodes
+	smtpConfig           config.SmtpUrls
+	emails               config.Emails
 
 	wg sync.WaitGroup
 }
 
 // NewShortCodeCreateEmailUpdate wires the email-change flow to the short-code
-// service, the email-existence DAO, and the mailer.
+// service, the email-existence and credentials DAOs, and the mailer.
 func NewShortCodeCreateEmailUpdate(
 	service ShortCodeCreateEmailUpdateService,
 	selectDao ShortCodeCreateEmailUpdateDao,
+	daoCredentialsSelect ShortCodeCreateEmailUpdateDaoCredentialsSelect,
 	smtp smtp.Sender,
 	shortCodesConfig config.ShortCodes,
 	smtpConfig config.SmtpUrls,
+	emails config.Emails,
 ) *ShortCodeCreateEmailUpdate {
 	return &ShortCodeCreateEmailUpdate{
-		service:          service,
-		selectDao:        selectDao,
-		smtp:             smtp,
-		shortCodesConfig: shortCodesConfig,
-		smtpConfig:       smtpConfig,
+		service:              service,
+		selectDao:            selectDao,
+		daoCredentialsSelect: daoCredentialsSelect,
+		smtp:                 smtp,
+		shortCodesConfig:     shortCodesConfig,
+		smtpConfig:           smtpConfig,
+		emails:               emails,
 	}
 }
 
@@ -91,6 +112,7 @@ func (service *ShortCodeCreateEmailUpdate) Exec(
 		attribute.String("user.id", request.ID.String()),
 		attribute.String("user.email", request.Email),
 		attribute.String("email.lang", request.Lang),
+		attribute.String("email.fallbackLang", request.FallbackLang),
 	)
 
 	err := validate.Struct(request)
@@ -98,23 +120,40 @@ func (service *ShortCodeCreateEmailUpdate) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	_, err = service.selectDao.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
-		Email: request.Email,
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	existing, err := service.selectDao.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
+		Email: email,
 	})
-	if err == nil {
+	// Users may still change how their own address is spelled, for example its case.
+	if err == nil && existing.ID != request.ID {
 		return nil, dao.ErrCredentialsUpdateEmailAlreadyExists
 	}
 
-	if !errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) {
+	if err != nil && !errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) {
 		return nil, otel.ReportError(span, fmt.Errorf("check existing email: %w", err))
 	}
 
+	lang := request.Lang
+	if lang == "" {
+		credentials, err := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{ID: request.ID})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("select credentials: %w", err))
+		}
+
+		lang = lo.CoalesceOrEmpty(credentials.Locale, request.FallbackLang, config.LangDefault)
+	}
+
 	shortCode, err := service.service.Exec(ctx, &ShortCodeCreateRequest{
 		Usage:    ShortCodeUsageValidateEmail,
 		Target:   request.ID.String(),
 		Data:     request.Email,
 		TTL:      service.shortCodesConfig.Usages[ShortCodeUsageValidateEmail].TTL,
 		Override: true,
+		Resend:   request.Resend,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("create short code: %w", err))
@@ -124,13 +163,13 @@ func (service *ShortCodeCreateEmailUpdate) Exec(
 	// send alive after the request context is cancelled, and Wait drains it on shutdown.
 	service.wg.Add(1)
 
-	go service.sendMail(context.WithoutCancel(ctx), request, shortCode)
+	go service.sendMail(context.WithoutCancel(ctx), request, lang, shortCode)
 
 	return otel.ReportSuccess(span, shortCode), nil
 }
 
 func (service *ShortCodeCreateEmailUpdate) sendMail(
-	ctx context.Context, request *ShortCodeCreateEmailUpdateRequest, shortCode *ShortCode,
+	ctx context.Context, request *ShortCodeCreateEmailUpdateRequest, lang string, shortCode *ShortCode,
 ) {
 	defer service.wg.Done()
 
@@ -140,22 +179,26 @@ func (service *ShortCodeCreateEmailUpdate) sendMail(
 
 	span.SetAttributes(
 		attribute.String("user.email", request.Email),
-		attribute.String("email.lang", request.Lang),
+		attribute.String("email.lang", lang),
 		attribute.String("short_code.target", shortCode.Target),
 	)
 
 	logger := otel.Logger()
 
+	duration := shortCodeMailDuration(
+		shortCode, service.shortCodesConfig.Usages[ShortCodeUsageValidateEmail].TTL, request.Resend,
+	)
+
 	err := service.smtp.SendMail(
 		smtp.MailUsers{{Email: request.Email}},
 		mails.Mails.EmailUpdate,
-		request.Lang,
+		lang,
 		map[string]any{
 			mails.TemplateVarShortCode: shortCode.PlainCode,
 			mails.TemplateVarTarget:    request.ID.String(),
 			"Source":                   base64.RawURLEncoding.EncodeToString([]byte(request.Email)),
 			mails.TemplateVarURL:       service.smtpConfig.UpdateEmail,
-			mails.TemplateVarDuration:  service.shortCodesConfig.Usages[ShortCodeUsageValidateEmail].TTL.Hours(),
+			mails.TemplateVarDuration:  duration,
 			mails.TemplateVarBanner:    assets.BannerBase64,
 			mails.TemplateVarPurpose:   "email-update",
 		},
@@ -3,11 +3,13 @@ package core_test
 import (
 	"encoding/base64"
 	"errors"
+	"strings"
 	"testing"
 	"text/template"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -34,7 +36,13 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 	}
 
 	type daoSelectMock struct {
-		err error
+		resp *dao.Credentials
+		err  error
+	}
+
+	type daoCredentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
 	}
 
 	testCases := []struct {
@@ -42,12 +50,14 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 
 		request *core.ShortCodeCreateEmailUpdateRequest
 
-		serviceCreateMock *serviceCreateMock
-		daoSelectMock     *daoSelectMock
-		sendMail          bool
-		sendMailPanic     bool
+		serviceCreateMock        *serviceCreateMock
+		daoSelectMock            *daoSelectMock
+		daoCredentialsSelectMock *daoCredentialsSelectMock
+		sendMail                 bool
+		sendMailPanic            bool
 
-		expectErr error
+		expectLang string
+		expectErr  error
 	}{
 		{
 			name: "Success",
@@ -104,6 +114,141 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 			sendMail:      true,
 			sendMailPanic: true,
 		},
+		{
+			name: "Success/OwnEmailSpelling",
+
+			request: &core.ShortCodeCreateEmailUpdateRequest{
+				Lang:  config.LangFR,
+				Email: "User@Provider.com",
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "user@provider.com",
+				},
+			},
+
+			sendMail: true,
+		},
+		{
+			name: "Success/AccountLocale",
+
+			request: &core.ShortCodeCreateEmailUpdateRequest{
+				FallbackLang: config.LangEN,
+				Email:        "user@provider.com",
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:  "old@provider.com",
+					Locale: config.LangFR,
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangFR,
+		},
+		{
+			name: "Success/FallbackLang",
+
+			request: &core.ShortCodeCreateEmailUpdateRequest{
+				FallbackLang: config.LangFR,
+				Email:        "user@provider.com",
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "old@provider.com",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangFR,
+		},
+		{
+			name: "Success/DefaultLang",
+
+			request: &core.ShortCodeCreateEmailUpdateRequest{
+				Email: "user@provider.com",
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "old@provider.com",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangDefault,
+		},
 		{
 			name: "Error/CreateShortCode",
 
@@ -133,11 +278,43 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 			},
 
 			daoSelectMock: &daoSelectMock{
-				err: nil,
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email: "user@provider.com",
+				},
 			},
 
 			expectErr: dao.ErrCredentialsUpdateEmailAlreadyExists,
 		},
+		{
+			name: "Error/CredentialsSelect",
+
+			request: &core.ShortCodeCreateEmailUpdateRequest{
+				Email: "user@provider.com",
+				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/UnknownLang",
+
+			request: &core.ShortCodeCreateEmailUpdateRequest{
+				FallbackLang: "xx",
+				Email:        "user@provider.com",
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
 		{
 			name: "Error/Dao",
 
@@ -161,6 +338,7 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 
 			serviceCreate := coremocks.NewMockShortCodeCreateEmailUpdateService(t)
 			daoSelect := coremocks.NewMockShortCodeCreateEmailUpdateDao(t)
+			daoCredentialsSelect := coremocks.NewMockShortCodeCreateEmailUpdateDaoCredentialsSelect(t)
 			smtpService := coremocks.NewMockShortCodeCreateEmailUpdateSmtp(t)
 
 			if testCase.serviceCreateMock != nil {
@@ -178,9 +356,15 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 			if testCase.daoSelectMock != nil {
 				daoSelect.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{
-						Email: testCase.request.Email,
+						Email: strings.ToLower(testCase.request.Email),
 					}).
-					Return(nil, testCase.daoSelectMock.err)
+					Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
+			}
+
+			if testCase.daoCredentialsSelectMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.ID}).
+					Return(testCase.daoCredentialsSelectMock.resp, testCase.daoCredentialsSelectMock.err)
 			}
 
 			if testCase.sendMail {
@@ -188,7 +372,7 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 					SendMail(
 						smtp.MailUsers{{Email: testCase.request.Email}},
 						mails.Mails.EmailUpdate,
-						testCase.request.Lang,
+						lo.CoalesceOrEmpty(testCase.expectLang, testCase.request.Lang),
 						map[string]any{
 							"ShortCode": testCase.serviceCreateMock.resp.PlainCode,
 							"Target":    testCase.request.ID.String(),
@@ -212,7 +396,13 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 			}
 
 			service := core.NewShortCodeCreateEmailUpdate(
-				serviceCreate, daoSelect, smtpService, config.ShortCodesPresetDefault, smtpConfig,
+				serviceCreate,
+				daoSelect,
+				daoCredentialsSelect,
+				smtpService,
+				config.ShortCodesPresetDefault,
+				smtpConfig,
+				config.EmailsPresetDefault,
 			)
 
 			resp, err := service.Exec(t.Context(), testCase.request)
@@ -228,6 +418,7 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 
 			serviceCreate.AssertExpectations(t)
 			daoSelect.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
 			smtpService.AssertExpectations(t)
 		})
 	}
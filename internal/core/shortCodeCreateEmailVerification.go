@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/smtp"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
+)
+
+// ErrShortCodeCreateEmailVerificationAlreadyVerified is returned by
+// [ShortCodeCreateEmailVerification.Exec] when the account's current email is already
+// verified, so there is nothing to send.
+var ErrShortCodeCreateEmailVerificationAlreadyVerified = errors.New("email already verified")
+
+// ShortCodeCreateEmailVerificationService issues the underlying short code; satisfied
+// by [ShortCodeCreate].
+type ShortCodeCreateEmailVerificationService interface {
+	Exec(ctx context.Context, request *ShortCodeCreateRequest) (*ShortCode, error)
+}
+
+// ShortCodeCreateEmailVerificationDao loads the account, to read the address to verify.
+type ShortCodeCreateEmailVerificationDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+// ShortCodeCreateEmailVerificationSmtp is the mailer used to deliver the verification code.
+type ShortCodeCreateEmailVerificationSmtp = smtp.Sender
+
+// ShortCodeCreateEmailVerificationRequest carries the user verifying their address and
+// the language of the verification mail.
+type ShortCodeCreateEmailVerificationRequest struct {
+	ID uuid.UUID `validate:"required"`
+	// Lang is the language of the mail. When empty, the mail follows the preferred language of
+	// the account, then FallbackLang.
+	Lang string `validate:"omitempty,langs"`
+	// FallbackLang is used when neither the request nor the account sets a language, typically
+	// negotiated from the client's Accept-Language header. [config.LangDefault] applies last.
+	FallbackLang string `validate:"omitempty,langs"`
+	// Resend re-issues the active code of the target instead of a new one, keeping its
+	// expiry. See [ShortCodeCreateRequest.Resend].
+	Resend bool
+}
+
+// ShortCodeCreateEmailVerification issues a [ShortCodeUsageVerifyEmail] code and emails
+// it to the account's current address. It covers accounts that never redeemed a code sent
+// to their address, such as the ones created by cmd/init or imported.
+type ShortCodeCreateEmailVerification struct {
+	service          ShortCodeCreateEmailVerificationService
+	selectDao        ShortCodeCreateEmailVerificationDao
+	smtp             smtp.Sender
+	shortCodesConfig config.ShortCodes
+	smtpConfig       config.SmtpUrls
+
+	wg sync.WaitGroup
+}
+
+// NewShortCodeCreateEmailVerification wires the email verification flow to the short-code
+// service, the credentials DAO, and the mailer.
+func NewShortCodeCreateEmailVerification(
+	service ShortCodeCreateEmailVerificationService,
+	selectDao ShortCodeCreateEmailVerificationDao,
+	smtp smtp.Sender,
+	shortCodesConfig config.ShortCodes,
+	smtpConfig config.SmtpUrls,
+) *ShortCodeCreateEmailVerification {
+	return &ShortCodeCreateEmailVerification{
+		service:          service,
+		selectDao:        selectDao,
+		smtp:             smtp,
+		shortCodesConfig: shortCodesConfig,
+		smtpConfig:       smtpConfig,
+	}
+}
+
+// Wait blocks until every in-flight verification email has finished sending, so
+// callers can drain pending deliveries before shutdown.
+func (service *ShortCodeCreateEmailVerification) Wait() {
+	service.wg.Wait()
+}
+
+// Exec issues the verification code and schedules its delivery email, returning the
+// code immediately. It fails if the account's email is already verified.
+func (service *ShortCodeCreateEmailVerification) Exec(
+	ctx context.Context, request *ShortCodeCreateEmailVerificationRequest,
+) (*ShortCode, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ShortCodeCreateEmailVerification")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user.id", request.ID.String()),
+		attribute.String("email.lang", request.Lang),
+		attribute.String("email.fallbackLang", request.FallbackLang),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	credentials, err := service.selectDao.Exec(ctx, &dao.CredentialsSelectRequest{ID: request.ID})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select credentials: %w", err))
+	}
+
+	if credentials.EmailVerifiedAt != nil {
+		return nil, otel.ReportError(span, ErrShortCodeCreateEmailVerificationAlreadyVerified)
+	}
+
+	lang := lo.CoalesceOrEmpty(request.Lang, credentials.Locale, request.FallbackLang, config.LangDefault)
+
+	// The address travels with the code, so a code sent before an email change cannot
+	// verify the new address.
+	shortCode, err := service.service.Exec(ctx, &ShortCodeCreateRequest{
+		Usage:    ShortCodeUsageVerifyEmail,
+		Target:   request.ID.String(),
+		Data:     credentials.Email,
+		TTL:      service.shortCodesConfig.Usages[ShortCodeUsageVerifyEmail].TTL,
+		Override: true,
+		Resend:   request.Resend,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("create short code: %w", err))
+	}
+
+	// Deliver the code by email in a detached goroutine: WithoutCancel keeps the
+	// send alive after the request context is cancelled, and Wait drains it on shutdown.
+	service.wg.Add(1)
+
+	go service.sendMail(context.WithoutCancel(ctx), credentials.Email, lang, request.ID, shortCode, request.Resend)
+
+	return otel.ReportSuccess(span, shortCode), nil
+}
+
+func (service *ShortCodeCreateEmailVerification) sendMail(
+	ctx context.Context, email, lang string, userID uuid.UUID, shortCode *ShortCode, resend bool,
+) {
+	defer service.wg.Done()
+
+	_, span := otel.Tracer().Start(ctx, "service.ShortCodeCreateEmailVerification(sendMail)")
+	defer span.End()
+	defer otel.RecoverPanic(ctx, span)
+
+	span.SetAttributes(
+		attribute.String("user.email", email),
+		attribute.String("email.lang", lang),
+		attribute.String("short_code.target", shortCode.Target),
+	)
+
+	logger := otel.Logger()
+
+	duration := shortCodeMailDuration(
+		shortCode, service.shortCodesConfig.Usages[ShortCodeUsageVerifyEmail].TTL, resend,
+	)
+
+	err := service.smtp.SendMail(
+		smtp.MailUsers{{Email: email}},
+		mails.Mails.EmailVerification,
+		lang,
+		map[string]any{
+			mails.TemplateVarShortCode: shortCode.PlainCode,
+			mails.TemplateVarTarget:    userID.String(),
+			mails.TemplateVarURL:       service.smtpConfig.VerifyEmail,
+			mails.TemplateVarDuration:  duration,
+			mails.TemplateVarBanner:    assets.BannerBase64,
+			mails.TemplateVarPurpose:   "email-verification",
+		},
+	)
+	if err != nil {
+		logger.ErrorContext(ctx, otel.ReportError(span, err).Error())
+
+		return
+	}
+
+	logger.InfoContext(ctx, "email verification request sent to "+email)
+	otel.ReportSuccessNoContent(span)
+}
@@ -0,0 +1,348 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/smtp"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
+)
+
+func TestShortCodeCreateEmailVerification(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	smtpConfig := config.SmtpUrls{VerifyEmail: "verify-email-url"}
+
+	type serviceCreateMock struct {
+		resp *core.ShortCode
+		err  error
+	}
+
+	type daoSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ShortCodeCreateEmailVerificationRequest
+
+		serviceCreateMock *serviceCreateMock
+		daoSelectMock     *daoSelectMock
+		sendMail          bool
+		sendMailPanic     bool
+
+		expectLang string
+		expectErr  error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				Lang: config.LangFR,
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "user@provider.com",
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     core.ShortCodeUsageVerifyEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					Data:      []byte(`"user@provider.com"`),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail: true,
+		},
+		{
+			name: "Success/SendMailPanic",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				Lang: config.LangFR,
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "user@provider.com",
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     core.ShortCodeUsageVerifyEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					Data:      []byte(`"user@provider.com"`),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:      true,
+			sendMailPanic: true,
+		},
+		{
+			name: "Success/AccountLocale",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				FallbackLang: config.LangEN,
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:  "user@provider.com",
+					Roles:  []string{config.RoleUser},
+					Locale: config.LangFR,
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     core.ShortCodeUsageVerifyEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					Data:      []byte(`"user@provider.com"`),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangFR,
+		},
+		{
+			name: "Success/FallbackLang",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				FallbackLang: config.LangFR,
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "user@provider.com",
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     core.ShortCodeUsageVerifyEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					Data:      []byte(`"user@provider.com"`),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangFR,
+		},
+		{
+			name: "Success/DefaultLang",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "user@provider.com",
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     core.ShortCodeUsageVerifyEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					Data:      []byte(`"user@provider.com"`),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangDefault,
+		},
+		{
+			name: "Error/AlreadyVerified",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				Lang: config.LangFR,
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:           "user@provider.com",
+					Roles:           []string{config.RoleUser},
+					EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+
+			expectErr: core.ErrShortCodeCreateEmailVerificationAlreadyVerified,
+		},
+		{
+			name: "Error/CreateShortCode",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				Lang: config.LangFR,
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email: "user@provider.com",
+					Roles: []string{config.RoleUser},
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Dao",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				Lang: config.LangFR,
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &core.ShortCodeCreateEmailVerificationRequest{
+				Lang: "klingon",
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceCreate := coremocks.NewMockShortCodeCreateEmailVerificationService(t)
+			daoSelect := coremocks.NewMockShortCodeCreateEmailVerificationDao(t)
+			smtpService := coremocks.NewMockShortCodeCreateEmailVerificationSmtp(t)
+
+			if testCase.daoSelectMock != nil {
+				daoSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.ID}).
+					Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
+			}
+
+			if testCase.serviceCreateMock != nil {
+				serviceCreate.EXPECT().
+					Exec(mock.Anything, &core.ShortCodeCreateRequest{
+						Usage:    core.ShortCodeUsageVerifyEmail,
+						Target:   testCase.request.ID.String(),
+						TTL:      config.ShortCodesPresetDefault.Usages[core.ShortCodeUsageVerifyEmail].TTL,
+						Data:     testCase.daoSelectMock.resp.Email,
+						Override: true,
+					}).
+					Return(testCase.serviceCreateMock.resp, testCase.serviceCreateMock.err)
+			}
+
+			if testCase.sendMail {
+				sendMail := smtpService.EXPECT().
+					SendMail(
+						smtp.MailUsers{{Email: testCase.daoSelectMock.resp.Email}},
+						mails.Mails.EmailVerification,
+						lo.CoalesceOrEmpty(testCase.expectLang, testCase.request.Lang),
+						map[string]any{
+							"ShortCode": testCase.serviceCreateMock.resp.PlainCode,
+							"Target":    testCase.request.ID.String(),
+							"URL":       smtpConfig.VerifyEmail,
+							"Duration": config.ShortCodesPresetDefault.
+								Usages[core.ShortCodeUsageVerifyEmail].
+								TTL.Hours(),
+							"Banner":   assets.BannerBase64,
+							"_Purpose": "email-verification",
+						},
+					).
+					Return(nil)
+
+				if testCase.sendMailPanic {
+					// An unabsorbed panic ends the test binary; surviving to Wait is the assertion.
+					sendMail.Run(func(smtp.MailUsers, *template.Template, string, any) {
+						panic("mail delivery exploded")
+					})
+				}
+			}
+
+			service := core.NewShortCodeCreateEmailVerification(
+				serviceCreate, daoSelect, smtpService, config.ShortCodesPresetDefault, smtpConfig,
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if err == nil {
+				require.Equal(t, testCase.serviceCreateMock.resp, resp)
+			}
+
+			if testCase.sendMail {
+				service.Wait()
+			}
+
+			serviceCreate.AssertExpectations(t)
+			daoSelect.AssertExpectations(t)
+			smtpService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/smtp"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
+)
+
+// ShortCodeInviteData is the data carried by a [ShortCodeUsageInvite] code.
+type ShortCodeInviteData struct {
+	// Role granted to the account created from the invitation.
+	Role string `json:"role"`
+	// InviterID is the user who issued the invitation.
+	InviterID uuid.UUID `json:"inviterID"`
+}
+
+// ShortCodeCreateInviteService issues the underlying short code; satisfied by
+// [ShortCodeCreate].
+type ShortCodeCreateInviteService interface {
+	Exec(ctx context.Context, request *ShortCodeCreateRequest) (*ShortCode, error)
+}
+
+// ShortCodeCreateInviteDao reports whether an email address is already
+// registered, so an invitation to an existing account can be rejected.
+type ShortCodeCreateInviteDao interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)
+}
+
+// ShortCodeCreateInviteDaoCredentialsSelect loads the inviter, to rank its role
+// against the one it grants.
+type ShortCodeCreateInviteDaoCredentialsSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
+}
+
+// ShortCodeCreateInviteRoles ranks the invited role against the inviter's; satisfied by
+// [RoleRegistry].
+type ShortCodeCreateInviteRoles interface {
+	Priority(role string) (int, error)
+	Rank(roles []string) (int, error)
+}
+
+// ShortCodeCreateInviteSmtp is the mailer used to deliver the invitation.
+type ShortCodeCreateInviteSmtp = smtp.Sender
+
+// ShortCodeCreateInviteRequest carries the inviter, the address to invite, the role
+// the new account receives, and the language of the invitation mail.
+type ShortCodeCreateInviteRequest struct {
+	InviterID uuid.UUID
+	Email     string `validate:"required,email,max=1024"`
+	// Role cannot be anonymous: an account always carries a user role or above.
+	Role string `validate:"required,role,ne=auth:anon"`
+	Lang string `validate:"required,langs"`
+	// Resend re-issues the active code of the target instead of a new one, keeping its
+	// expiry. See [ShortCodeCreateRequest.Resend].
+	Resend bool
+}
+
+// checkInviteRole applies the [CredentialsUpdateRole] rank rules to an invitation. The
+// invited account has no role yet, so the only constraint is the promotion one: an
+// inviter cannot grant a role above its own. An invited role that is not defined is an invalid
+// request.
+func checkInviteRole(roles roleRanker, inviterRoles []string, role string) error {
+	rolePriority, err := roles.Priority(role)
+	if err != nil {
+		return errors.Join(fmt.Errorf("rank invited role: %w", err), ErrInvalidRequest)
+	}
+
+	inviterRank, err := roles.Rank(inviterRoles)
+	if err != nil {
+		return fmt.Errorf("rank inviter roles: %w", err)
+	}
+
+	if rolePriority > inviterRank {
+		return fmt.Errorf("%w: invite from %v as %s", ErrCredentialsUpdateRoleToHigher, inviterRoles, role)
+	}
+
+	return nil
+}
+
+// ShortCodeCreateInvite issues a [ShortCodeUsageInvite] code that lets the owner of an
+// email address create an account with a preassigned role, and emails it to that
+// address. The invitation is rejected if the address is already registered, or if
+// the role is above the inviter's own.
+type ShortCodeCreateInvite struct {
+	service              ShortCodeCreateInviteService
+	selectDao            ShortCodeCreateInviteDao
+	daoCredentialsSelect ShortCodeCreateInviteDaoCredentialsSelect
+	roles                ShortCodeCreateInviteRoles
+	smtp                 smtp.Sender
+	shortCodesConfig     config.ShortCodes
+	smtpConfig           config.SmtpUrls
+	emails               config.Emails
+
+	wg sync.WaitGroup
+}
+
+// NewShortCodeCreateInvite wires the invitation flow to the short-code service, the
+// credentials DAOs, the role definitions, and the mailer.
+func NewShortCodeCreateInvite(
+	service ShortCodeCreateInviteService,
+	selectDao ShortCodeCreateInviteDao,
+	daoCredentialsSelect ShortCodeCreateInviteDaoCredentialsSelect,
+	roles ShortCodeCreateInviteRoles,
+	smtp smtp.Sender,
+	shortCodesConfig config.ShortCodes,
+	smtpConfig config.SmtpUrls,
+	emails config.Emails,
+) *ShortCodeCreateInvite {
+	return &ShortCodeCreateInvite{
+		service:              service,
+		selectDao:            selectDao,
+		daoCredentialsSelect: daoCredentialsSelect,
+		roles:                roles,
+		smtp:                 smtp,
+		shortCodesConfig:     shortCodesConfig,
+		smtpConfig:           smtpConfig,
+		emails:               emails,
+	}
+}
+
+// Wait blocks until every in-flight invitation email has finished sending, so
+// callers can drain pending deliveries before shutdown.
+func (service *ShortCodeCreateInvite) Wait() {
+	service.wg.Wait()
+}
+
+// Exec issues the invitation code and schedules its delivery email, returning the
+// code immediately. Inviting the same address again replaces the previous invitation.
+func (service *ShortCodeCreateInvite) Exec(
+	ctx context.Context, request *ShortCodeCreateInviteRequest,
+) (*ShortCode, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ShortCodeCreateInvite")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("inviter.id", request.InviterID.String()),
+		attribute.String("user.email", request.Email),
+		attribute.String("user.role", request.Role),
+		attribute.String("email.lang", request.Lang),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	inviter, err := service.daoCredentialsSelect.Exec(ctx, &dao.CredentialsSelectRequest{
+		ID: request.InviterID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select inviter credentials: %w", err))
+	}
+
+	err = checkInviteRole(service.roles, inviter.Roles, request.Role)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	_, err = service.selectDao.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
+		Email: email,
+	})
+	if err == nil {
+		return nil, otel.ReportError(span, dao.ErrCredentialsInsertAlreadyExists)
+	}
+
+	if !errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) {
+		return nil, otel.ReportError(span, fmt.Errorf("check existing email: %w", err))
+	}
+
+	shortCode, err := service.service.Exec(ctx, &ShortCodeCreateRequest{
+		Usage:  ShortCodeUsageInvite,
+		Target: email,
+		Data: ShortCodeInviteData{
+			Role:      request.Role,
+			InviterID: request.InviterID,
+		},
+		TTL:      service.shortCodesConfig.Usages[ShortCodeUsageInvite].TTL,
+		Override: true,
+		Resend:   request.Resend,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("create short code: %w", err))
+	}
+
+	// Deliver the code by email in a detached goroutine: WithoutCancel keeps the
+	// send alive after the request context is cancelled, and Wait drains it on shutdown.
+	service.wg.Add(1)
+
+	go service.sendMail(context.WithoutCancel(ctx), request, shortCode)
+
+	return otel.ReportSuccess(span, shortCode), nil
+}
+
+func (service *ShortCodeCreateInvite) sendMail(
+	ctx context.Context, request *ShortCodeCreateInviteRequest, shortCode *ShortCode,
+) {
+	defer service.wg.Done()
+
+	_, span := otel.Tracer().Start(ctx, "service.ShortCodeCreateInvite(sendMail)")
+	defer span.End()
+	defer otel.RecoverPanic(ctx, span)
+
+	span.SetAttributes(
+		attribute.String("user.email", request.Email),
+		attribute.String("email.lang", request.Lang),
+		attribute.String("short_code.target", shortCode.Target),
+	)
+
+	logger := otel.Logger()
+
+	duration := shortCodeMailDuration(
+		shortCode, service.shortCodesConfig.Usages[ShortCodeUsageInvite].TTL, request.Resend,
+	)
+
+	err := service.smtp.SendMail(
+		smtp.MailUsers{{Email: request.Email}},
+		mails.Mails.Invite,
+		request.Lang,
+		map[string]any{
+			mails.TemplateVarShortCode: shortCode.PlainCode,
+			mails.TemplateVarTarget:    base64.RawURLEncoding.EncodeToString([]byte(request.Email)),
+			mails.TemplateVarURL:       service.smtpConfig.Invite,
+			mails.TemplateVarDuration:  duration,
+			mails.TemplateVarBanner:    assets.BannerBase64,
+			mails.TemplateVarPurpose:   "invite",
+		},
+	)
+	if err != nil {
+		logger.ErrorContext(ctx, otel.ReportError(span, err).Error())
+
+		return
+	}
+
+	logger.InfoContext(ctx, "invitation sent to "+request.Email)
+	otel.ReportSuccessNoContent(span)
+}
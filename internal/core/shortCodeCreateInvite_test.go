@@ -0,0 +1,253 @@
+package core_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/smtp"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
+)
+
+func TestShortCodeCreateInvite(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	smtpConfig := config.SmtpUrls{Invite: "invite-url"}
+
+	inviterID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type daoCredentialsSelectMock struct {
+		resp *dao.Credentials
+		err  error
+	}
+
+	type daoSelectMock struct {
+		err error
+	}
+
+	type serviceCreateMock struct {
+		resp *core.ShortCode
+		err  error
+	}
+
+	shortCode := &core.ShortCode{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Usage:     core.ShortCodeUsageInvite,
+		Target:    "user@provider.com",
+		Data:      []byte(`{"role":"auth:admin","inviterID":"00000000-0000-0000-0000-000000000001"}`),
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2021, 1, 8, 0, 0, 0, 0, time.UTC),
+		PlainCode: "abcdef123456",
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ShortCodeCreateInviteRequest
+
+		daoCredentialsSelectMock *daoCredentialsSelectMock
+		daoSelectMock            *daoSelectMock
+		serviceCreateMock        *serviceCreateMock
+		sendMail                 bool
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ShortCodeCreateInviteRequest{
+				InviterID: inviterID,
+				Email:     "user@provider.com",
+				Role:      config.RoleAdmin,
+				Lang:      config.LangEN,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleAdmin}},
+			},
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+			serviceCreateMock: &serviceCreateMock{
+				resp: shortCode,
+			},
+			sendMail: true,
+		},
+		{
+			name: "Error/RoleAboveInviter",
+
+			request: &core.ShortCodeCreateInviteRequest{
+				InviterID: inviterID,
+				Email:     "user@provider.com",
+				Role:      config.RoleSuperAdmin,
+				Lang:      config.LangEN,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleAdmin}},
+			},
+
+			expectErr: core.ErrCredentialsUpdateRoleToHigher,
+		},
+		{
+			name: "Error/AnonRole",
+
+			request: &core.ShortCodeCreateInviteRequest{
+				InviterID: inviterID,
+				Email:     "user@provider.com",
+				Role:      config.RoleAnon,
+				Lang:      config.LangEN,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/EmailAlreadyTaken",
+
+			request: &core.ShortCodeCreateInviteRequest{
+				InviterID: inviterID,
+				Email:     "user@provider.com",
+				Role:      config.RoleUser,
+				Lang:      config.LangEN,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleAdmin}},
+			},
+			daoSelectMock: &daoSelectMock{},
+
+			expectErr: dao.ErrCredentialsInsertAlreadyExists,
+		},
+		{
+			name: "Error/SelectInviter",
+
+			request: &core.ShortCodeCreateInviteRequest{
+				InviterID: inviterID,
+				Email:     "user@provider.com",
+				Role:      config.RoleUser,
+				Lang:      config.LangEN,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/CreateShortCode",
+
+			request: &core.ShortCodeCreateInviteRequest{
+				InviterID: inviterID,
+				Email:     "user@provider.com",
+				Role:      config.RoleUser,
+				Lang:      config.LangEN,
+			},
+
+			daoCredentialsSelectMock: &daoCredentialsSelectMock{
+				resp: &dao.Credentials{ID: inviterID, Roles: []string{config.RoleAdmin}},
+			},
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+			serviceCreateMock: &serviceCreateMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceCreate := coremocks.NewMockShortCodeCreateInviteService(t)
+			daoSelect := coremocks.NewMockShortCodeCreateInviteDao(t)
+			daoCredentialsSelect := coremocks.NewMockShortCodeCreateInviteDaoCredentialsSelect(t)
+			smtpService := coremocks.NewMockShortCodeCreateInviteSmtp(t)
+
+			if testCase.daoCredentialsSelectMock != nil {
+				daoCredentialsSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectRequest{ID: testCase.request.InviterID}).
+					Return(testCase.daoCredentialsSelectMock.resp, testCase.daoCredentialsSelectMock.err)
+			}
+
+			if testCase.daoSelectMock != nil {
+				daoSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{Email: strings.ToLower(testCase.request.Email)}).
+					Return(nil, testCase.daoSelectMock.err)
+			}
+
+			if testCase.serviceCreateMock != nil {
+				serviceCreate.EXPECT().
+					Exec(mock.Anything, &core.ShortCodeCreateRequest{
+						Usage:  core.ShortCodeUsageInvite,
+						Target: strings.ToLower(testCase.request.Email),
+						Data: core.ShortCodeInviteData{
+							Role:      testCase.request.Role,
+							InviterID: testCase.request.InviterID,
+						},
+						TTL:      config.ShortCodesPresetDefault.Usages[core.ShortCodeUsageInvite].TTL,
+						Override: true,
+					}).
+					Return(testCase.serviceCreateMock.resp, testCase.serviceCreateMock.err)
+			}
+
+			if testCase.sendMail {
+				smtpService.EXPECT().
+					SendMail(
+						smtp.MailUsers{{Email: testCase.request.Email}},
+						mails.Mails.Invite,
+						testCase.request.Lang,
+						map[string]any{
+							"ShortCode": testCase.serviceCreateMock.resp.PlainCode,
+							"Target":    base64.RawURLEncoding.EncodeToString([]byte(testCase.request.Email)),
+							"URL":       smtpConfig.Invite,
+							"Duration": config.ShortCodesPresetDefault.
+								Usages[core.ShortCodeUsageInvite].
+								TTL.Hours(),
+							"Banner":   assets.BannerBase64,
+							"_Purpose": "invite",
+						},
+					).
+					Return(nil)
+			}
+
+			service := core.NewShortCodeCreateInvite(
+				serviceCreate, daoSelect, daoCredentialsSelect, config.PermissionsConfigDefault, smtpService,
+				config.ShortCodesPresetDefault, smtpConfig, config.EmailsPresetDefault,
+			)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+
+			if err == nil {
+				require.Equal(t, testCase.serviceCreateMock.resp, resp)
+			}
+
+			if testCase.sendMail {
+				service.Wait()
+			}
+
+			serviceCreate.AssertExpectations(t)
+			daoSelect.AssertExpectations(t)
+			daoCredentialsSelect.AssertExpectations(t)
+			smtpService.AssertExpectations(t)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
@@ -37,7 +38,15 @@ type ShortCodeCreatePasswordResetSmtp = smtp.Sender
 // language of the reset mail.
 type ShortCodeCreatePasswordResetRequest struct {
 	Email string `validate:"required,email,max=1024"`
-	Lang  string `validate:"required,langs"`
+	// Lang is the language of the mail. When empty, the mail follows the preferred language of
+	// the account, then FallbackLang.
+	Lang string `validate:"omitempty,langs"`
+	// FallbackLang is used when neither the request nor the account sets a language, typically
+	// negotiated from the client's Accept-Language header. [config.LangDefault] applies last.
+	FallbackLang string `validate:"omitempty,langs"`
+	// Resend re-issues the active code of the target instead of a new one, keeping its
+	// expiry. See [ShortCodeCreateRequest.Resend].
+	Resend bool
 }
 
 // ShortCodeCreatePasswordReset issues a [ShortCodeUsageResetPassword] code for an
@@ -48,6 +57,7 @@ type ShortCodeCreatePasswordReset struct {
 	smtp             smtp.Sender
 	shortCodesConfig config.ShortCodes
 	smtpConfig       config.SmtpUrls
+	emails           config.Emails
 
 	wg sync.WaitGroup
 }
@@ -60,6 +70,7 @@ func NewShortCodeCreatePasswordReset(
 	smtp smtp.Sender,
 	shortCodesConfig config.ShortCodes,
 	smtpConfig config.SmtpUrls,
+	emails config.Emails,
 ) *ShortCodeCreatePasswordReset {
 	return &ShortCodeCreatePasswordReset{
 		service:          service,
@@ -67,6 +78,7 @@ func NewShortCodeCreatePasswordReset(
 		smtp:             smtp,
 		shortCodesConfig: shortCodesConfig,
 		smtpConfig:       smtpConfig,
+		emails:           emails,
 	}
 }
 
@@ -87,6 +99,7 @@ func (service *ShortCodeCreatePasswordReset) Exec(
 	span.SetAttributes(
 		attribute.String("user.email", request.Email),
 		attribute.String("email.lang", request.Lang),
+		attribute.String("email.fallbackLang", request.FallbackLang),
 	)
 
 	err := validate.Struct(request)
@@ -94,18 +107,26 @@ func (service *ShortCodeCreatePasswordReset) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	credentials, err := service.selectDao.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
-		Email: request.Email,
+		Email: email,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("check email existence: %w", err))
 	}
 
+	lang := lo.CoalesceOrEmpty(request.Lang, credentials.Locale, request.FallbackLang, config.LangDefault)
+
 	shortCode, err := service.service.Exec(ctx, &ShortCodeCreateRequest{
 		Usage:    ShortCodeUsageResetPassword,
 		Target:   credentials.ID.String(),
 		TTL:      service.shortCodesConfig.Usages[ShortCodeUsageResetPassword].TTL,
 		Override: true,
+		Resend:   request.Resend,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("create short code: %w", err))
@@ -115,13 +136,15 @@ func (service *ShortCodeCreatePasswordReset) Exec(
 	// send alive after the request context is cancelled, and Wait drains it on shutdown.
 	service.wg.Add(1)
 
-	go service.sendMail(context.WithoutCancel(ctx), request, credentials.ID, shortCode)
+	go service.sendMail(
+		context.WithoutCancel(ctx), request.Email, lang, credentials.ID, shortCode, request.Resend,
+	)
 
 	return otel.ReportSuccess(span, shortCode), nil
 }
 
 func (service *ShortCodeCreatePasswordReset) sendMail(
-	ctx context.Context, request *ShortCodeCreatePasswordResetRequest, userID uuid.UUID, shortCode *ShortCode,
+	ctx context.Context, email, lang string, userID uuid.UUID, shortCode *ShortCode, resend bool,
 ) {
 	defer service.wg.Done()
 
@@ -130,22 +153,26 @@ func (service *ShortCodeCreatePasswordReset) sendMail(
 	defer otel.RecoverPanic(ctx, span)
 
 	span.SetAttributes(
-		attribute.String("user.email", request.Email),
-		attribute.String("email.lang", request.Lang),
+		attribute.String("user.email", email),
+		attribute.String("email.lang", lang),
 		attribute.String("short_code.target", shortCode.Target),
 	)
 
 	logger := otel.Logger()
 
+	duration := shortCodeMailDuration(
+		shortCode, service.shortCodesConfig.Usages[ShortCodeUsageResetPassword].TTL, resend,
+	)
+
 	err := service.smtp.SendMail(
-		smtp.MailUsers{{Email: request.Email}},
+		smtp.MailUsers{{Email: email}},
 		mails.Mails.PasswordReset,
-		request.Lang,
+		lang,
 		map[string]any{
 			mails.TemplateVarShortCode: shortCode.PlainCode,
 			mails.TemplateVarTarget:    userID.String(),
 			mails.TemplateVarURL:       service.smtpConfig.UpdatePassword,
-			mails.TemplateVarDuration:  service.shortCodesConfig.Usages[ShortCodeUsageResetPassword].TTL.Hours(),
+			mails.TemplateVarDuration:  duration,
 			mails.TemplateVarBanner:    assets.BannerBase64,
 			mails.TemplateVarPurpose:   "password-reset",
 		},
@@ -156,6 +183,6 @@ func (service *ShortCodeCreatePasswordReset) sendMail(
 		return
 	}
 
-	logger.InfoContext(ctx, "password reset request sent to "+request.Email)
+	logger.InfoContext(ctx, "password reset request sent to "+email)
 	otel.ReportSuccessNoContent(span)
 }
@@ -2,11 +2,13 @@ package core_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"text/template"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -47,7 +49,8 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 		sendMail          bool
 		sendMailPanic     bool
 
-		expectErr error
+		expectLang string
+		expectErr  error
 	}{
 		{
 			name: "Success",
@@ -106,6 +109,123 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 			sendMail:      true,
 			sendMailPanic: true,
 		},
+		{
+			name: "Success/RequestLangOverAccountLocale",
+
+			request: &core.ShortCodeCreatePasswordResetRequest{
+				Lang:  config.LangEN,
+				Email: "user@provider.com",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Locale: config.LangFR,
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangEN,
+		},
+		{
+			name: "Success/AccountLocale",
+
+			request: &core.ShortCodeCreatePasswordResetRequest{
+				FallbackLang: config.LangEN,
+				Email:        "user@provider.com",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Locale: config.LangFR,
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangFR,
+		},
+		{
+			name: "Success/FallbackLang",
+
+			request: &core.ShortCodeCreatePasswordResetRequest{
+				FallbackLang: config.LangFR,
+				Email:        "user@provider.com",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangFR,
+		},
+		{
+			name: "Success/DefaultLang",
+
+			request: &core.ShortCodeCreatePasswordResetRequest{
+				Email: "user@provider.com",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail:   true,
+			expectLang: config.LangDefault,
+		},
 		{
 			name: "CreateShortCodeError",
 
@@ -164,7 +284,7 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 			if testCase.daoMock != nil {
 				mockDao.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{
-						Email: testCase.request.Email,
+						Email: strings.ToLower(testCase.request.Email),
 					}).
 					Return(testCase.daoMock.resp, testCase.daoMock.err)
 			}
@@ -174,7 +294,7 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 					SendMail(
 						smtp.MailUsers{{Email: testCase.request.Email}},
 						mails.Mails.PasswordReset,
-						testCase.request.Lang,
+						lo.CoalesceOrEmpty(testCase.expectLang, testCase.request.Lang),
 						map[string]any{
 							"ShortCode": testCase.serviceCreateMock.resp.PlainCode,
 							"Target":    testCase.daoMock.resp.ID.String(),
@@ -197,7 +317,7 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 			}
 
 			service := core.NewShortCodeCreatePasswordReset(
-				serviceCreate, mockDao, smtpService, config.ShortCodesPresetDefault, smtpConfig,
+				serviceCreate, mockDao, smtpService, config.ShortCodesPresetDefault, smtpConfig, config.EmailsPresetDefault,
 			)
 
 			resp, err := service.Exec(t.Context(), testCase.request)
@@ -38,29 +38,36 @@ type ShortCodeCreateRegisterSmtp = smtp.Sender
 type ShortCodeCreateRegisterRequest struct {
 	Email string `validate:"required,email,max=1024"`
 	Lang  string `validate:"required,langs"`
+	// Resend re-issues the active code of the target instead of a new one, keeping its
+	// expiry. See [ShortCodeCreateRequest.Resend].
+	Resend bool
 }
 
 // ShortCodeCreateRegister issues a [ShortCodeUsageRegister] code for a new-account
 // sign-up and emails it to the prospective address, after rejecting the sign-up if
-// that address is already registered.
+// that address is already registered or refused by the registration policy.
 type ShortCodeCreateRegister struct {
 	service          ShortCodeCreateRegisterService
 	selectDao        ShortCodeCreateRegisterDao
 	smtp             smtp.Sender
 	shortCodesConfig config.ShortCodes
 	smtpConfig       config.SmtpUrls
+	registration     config.Registration
+	emails           config.Emails
 
 	wg sync.WaitGroup
 }
 
 // NewShortCodeCreateRegister wires the registration flow to the short-code
-// service, the email-existence DAO, and the mailer.
+// service, the email-existence DAO, the mailer, and the registration policy.
 func NewShortCodeCreateRegister(
 	service ShortCodeCreateRegisterService,
 	selectDao ShortCodeCreateRegisterDao,
 	smtp smtp.Sender,
 	shortCodesConfig config.ShortCodes,
 	smtpConfig config.SmtpUrls,
+	registration config.Registration,
+	emails config.Emails,
 ) *ShortCodeCreateRegister {
 	return &ShortCodeCreateRegister{
 		service:          service,
@@ -68,6 +75,8 @@ func NewShortCodeCreateRegister(
 		smtp:             smtp,
 		shortCodesConfig: shortCodesConfig,
 		smtpConfig:       smtpConfig,
+		registration:     registration,
+		emails:           emails,
 	}
 }
 
@@ -78,7 +87,8 @@ func (service *ShortCodeCreateRegister) Wait() {
 }
 
 // Exec issues the registration code and schedules its delivery email, returning
-// the code immediately. It fails if the address is already registered.
+// the code immediately. It fails if the address is already registered, or with one
+// of the ErrRegistration* errors if the registration policy refuses it.
 func (service *ShortCodeCreateRegister) Exec(
 	ctx context.Context, request *ShortCodeCreateRegisterRequest,
 ) (*ShortCode, error) {
@@ -95,8 +105,19 @@ func (service *ShortCodeCreateRegister) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	// The policy is checked first, so a refused address never reveals whether it is registered.
+	err = checkRegistration(service.registration, request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	_, err = service.selectDao.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
-		Email: request.Email,
+		Email: email,
 	})
 	if err == nil {
 		return nil, dao.ErrCredentialsInsertAlreadyExists
@@ -108,9 +129,10 @@ func (service *ShortCodeCreateRegister) Exec(
 
 	shortCode, err := service.service.Exec(ctx, &ShortCodeCreateRequest{
 		Usage:    ShortCodeUsageRegister,
-		Target:   request.Email,
+		Target:   email,
 		TTL:      service.shortCodesConfig.Usages[ShortCodeUsageRegister].TTL,
 		Override: true,
+		Resend:   request.Resend,
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("create short code: %w", err))
@@ -142,6 +164,10 @@ func (service *ShortCodeCreateRegister) sendMail(
 
 	logger := otel.Logger()
 
+	duration := shortCodeMailDuration(
+		shortCode, service.shortCodesConfig.Usages[ShortCodeUsageRegister].TTL, request.Resend,
+	)
+
 	err := service.smtp.SendMail(
 		smtp.MailUsers{{Email: request.Email}},
 		mails.Mails.Register,
@@ -150,7 +176,7 @@ func (service *ShortCodeCreateRegister) sendMail(
 			mails.TemplateVarShortCode: shortCode.PlainCode,
 			mails.TemplateVarTarget:    base64.RawURLEncoding.EncodeToString([]byte(request.Email)),
 			mails.TemplateVarURL:       service.smtpConfig.Register,
-			mails.TemplateVarDuration:  service.shortCodesConfig.Usages[ShortCodeUsageRegister].TTL.Hours(),
+			mails.TemplateVarDuration:  duration,
 			mails.TemplateVarBanner:    assets.BannerBase64,
 			mails.TemplateVarPurpose:   "register",
 		},
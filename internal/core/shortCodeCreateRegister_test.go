@@ -3,6 +3,7 @@ package core_test
 import (
 	"encoding/base64"
 	"errors"
+	"strings"
 	"testing"
 	"text/template"
 	"time"
@@ -40,12 +41,15 @@ func TestShortCodeCreateRegister(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		request *core.ShortCodeCreateRegisterRequest
+		request      *core.ShortCodeCreateRegisterRequest
+		registration config.Registration
 
 		serviceCreateMock *serviceCreateMock
 		daoSelectMock     *daoSelectMock
 		sendMail          bool
 		sendMailPanic     bool
+		// expectMailDuration overrides the TTL of the usage as the duration told in the email.
+		expectMailDuration float64
 
 		expectErr error
 	}{
@@ -102,6 +106,130 @@ func TestShortCodeCreateRegister(t *testing.T) {
 			sendMail:      true,
 			sendMailPanic: true,
 		},
+		{
+			name: "Success/Resend",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:   config.LangFR,
+				Email:  "user@provider.com",
+				Resend: true,
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 1, 5, 30, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail: true,
+			// The resent code keeps the expiry of the previous one: the email tells what is left, rounded up.
+			expectMailDuration: 6,
+		},
+		{
+			name: "Success/DomainAllowed",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:  config.LangFR,
+				Email: "user@team.company.com",
+			},
+			registration: config.Registration{
+				Mode:           config.RegistrationModeDomainAllowlist,
+				AllowedDomains: []string{"company.com"},
+			},
+
+			daoSelectMock: &daoSelectMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			serviceCreateMock: &serviceCreateMock{
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					PlainCode: "abcdef123456",
+				},
+			},
+
+			sendMail: true,
+		},
+		{
+			name: "Error/RegistrationClosed",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:  config.LangFR,
+				Email: "user@provider.com",
+			},
+			registration: config.Registration{Mode: config.RegistrationModeClosed},
+
+			expectErr: core.ErrRegistrationClosed,
+		},
+		{
+			name: "Error/RegistrationDomainNotAllowed",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:  config.LangFR,
+				Email: "user@provider.com",
+			},
+			registration: config.Registration{
+				Mode:           config.RegistrationModeDomainAllowlist,
+				AllowedDomains: []string{"company.com"},
+			},
+
+			expectErr: core.ErrRegistrationDomainNotAllowed,
+		},
+		{
+			name: "Error/RegistrationDomainDenied",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:  config.LangFR,
+				Email: "user@Provider.com",
+			},
+			registration: config.Registration{
+				Mode:          config.RegistrationModeOpen,
+				DeniedDomains: []string{"provider.com"},
+			},
+
+			expectErr: core.ErrRegistrationDomainDenied,
+		},
+		{
+			name: "Error/RegistrationDenyWinsOverAllow",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:  config.LangFR,
+				Email: "user@blocked.company.com",
+			},
+			registration: config.Registration{
+				Mode:           config.RegistrationModeDomainAllowlist,
+				AllowedDomains: []string{"company.com"},
+				DeniedDomains:  []string{"blocked.company.com"},
+			},
+
+			expectErr: core.ErrRegistrationDomainDenied,
+		},
+		{
+			name: "Error/RegistrationDisposableEmail",
+
+			request: &core.ShortCodeCreateRegisterRequest{
+				Lang:  config.LangFR,
+				Email: "user@mailinator.com",
+			},
+			registration: config.Registration{BlockDisposable: true},
+
+			expectErr: core.ErrRegistrationDisposableEmail,
+		},
 		{
 			name: "Error/CreateShortCode",
 
@@ -162,14 +290,20 @@ func TestShortCodeCreateRegister(t *testing.T) {
 				serviceCreate.EXPECT().
 					Exec(mock.Anything, &core.ShortCodeCreateRequest{
 						Usage:    core.ShortCodeUsageRegister,
-						Target:   testCase.request.Email,
+						Target:   strings.ToLower(testCase.request.Email),
 						TTL:      config.ShortCodesPresetDefault.Usages[core.ShortCodeUsageRegister].TTL,
 						Override: true,
+						Resend:   testCase.request.Resend,
 					}).
 					Return(testCase.serviceCreateMock.resp, testCase.serviceCreateMock.err)
 			}
 
 			if testCase.sendMail {
+				mailDuration := testCase.expectMailDuration
+				if mailDuration == 0 {
+					mailDuration = config.ShortCodesPresetDefault.Usages[core.ShortCodeUsageRegister].TTL.Hours()
+				}
+
 				sendMail := smtpService.EXPECT().
 					SendMail(
 						smtp.MailUsers{{Email: testCase.request.Email}},
@@ -179,11 +313,9 @@ func TestShortCodeCreateRegister(t *testing.T) {
 							"ShortCode": testCase.serviceCreateMock.resp.PlainCode,
 							"Target":    base64.RawURLEncoding.EncodeToString([]byte(testCase.request.Email)),
 							"URL":       smtpConfig.Register,
-							"Duration": config.ShortCodesPresetDefault.
-								Usages[core.ShortCodeUsageRegister].
-								TTL.Hours(),
-							"Banner":   assets.BannerBase64,
-							"_Purpose": "register",
+							"Duration":  mailDuration,
+							"Banner":    assets.BannerBase64,
+							"_Purpose":  "register",
 						},
 					).
 					Return(nil)
@@ -199,13 +331,14 @@ func TestShortCodeCreateRegister(t *testing.T) {
 			if testCase.daoSelectMock != nil {
 				daoSelect.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{
-						Email: testCase.request.Email,
+						Email: strings.ToLower(testCase.request.Email),
 					}).
 					Return(nil, testCase.daoSelectMock.err)
 			}
 
 			service := core.NewShortCodeCreateRegister(
 				serviceCreate, daoSelect, smtpService, config.ShortCodesPresetDefault, smtpConfig,
+				testCase.registration, config.EmailsPresetDefault,
 			)
 
 			resp, err := service.Exec(t.Context(), testCase.request)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,17 +25,41 @@ func TestShortCodeCreate(t *testing.T) {
 
 	errFoo := errors.New("foo")
 
+	shortCodesConfig := config.ShortCodes{
+		Size: 12,
+		Usages: map[string]config.ShortCodeUsage{
+			core.ShortCodeUsageValidateEmail: {TTL: time.Hour, ResendCooldown: time.Minute},
+			core.ShortCodeUsageResetPassword: {TTL: time.Hour, Format: config.ShortCodeFormatNumeric, Length: 6},
+			core.ShortCodeUsageRegister:      {TTL: time.Hour, Format: config.ShortCodeFormatCrockford, Length: 10},
+			core.ShortCodeUsageInvite:        {TTL: time.Hour, Length: 8, CaseInsensitive: true},
+			core.ShortCodeUsageVerifyEmail:   {TTL: time.Hour, Format: "base64"},
+		},
+	}
+
 	type daoMock struct {
 		resp *dao.ShortCode
 		err  error
 	}
 
+	type daoSelectMock struct {
+		resp *dao.ShortCode
+		err  error
+	}
+
+	activeExpiresAt := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+
 	testCases := []struct {
 		name string
 
 		request *core.ShortCodeCreateRequest
 
-		daoMock *daoMock
+		daoMock       *daoMock
+		daoSelectMock *daoSelectMock
+
+		// expectPlainCode matches the code delivered to the user.
+		expectPlainCode string
+		// hashedCode returns the form of the delivered code the stored hash covers.
+		hashedCode func(code string) string
 
 		expectErr error
 	}{
@@ -58,6 +83,149 @@ func TestShortCodeCreate(t *testing.T) {
 					Data:   []byte(`{"test":"data"}`),
 				},
 			},
+
+			expectPlainCode: "^[a-zA-Z0-9]{12}$",
+			hashedCode:      func(code string) string { return code },
+		},
+		{
+			name: "Success/Numeric",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageResetPassword,
+				Target: "test-target",
+				Data:   map[string]string{"test": "data"},
+				TTL:    time.Hour,
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.ShortCode{ID: uuid.New(), Usage: core.ShortCodeUsageResetPassword, Target: "test-target"},
+			},
+
+			expectPlainCode: "^[0-9]{6}$",
+			hashedCode:      func(code string) string { return code },
+		},
+		{
+			name: "Success/Crockford",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageRegister,
+				Target: "test-target",
+				Data:   map[string]string{"test": "data"},
+				TTL:    time.Hour,
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.ShortCode{ID: uuid.New(), Usage: core.ShortCodeUsageRegister, Target: "test-target"},
+			},
+
+			expectPlainCode: "^[0-9A-HJKMNP-TV-Z]{4}-[0-9A-HJKMNP-TV-Z]{4}-[0-9A-HJKMNP-TV-Z]{2}$",
+			hashedCode:      func(code string) string { return strings.ReplaceAll(code, "-", "") },
+		},
+		{
+			name: "Success/CaseInsensitive",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageInvite,
+				Target: "test-target",
+				Data:   map[string]string{"test": "data"},
+				TTL:    time.Hour,
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.ShortCode{ID: uuid.New(), Usage: core.ShortCodeUsageInvite, Target: "test-target"},
+			},
+
+			expectPlainCode: "^[a-zA-Z0-9]{8}$",
+			hashedCode:      strings.ToLower,
+		},
+		{
+			name: "Error/UnknownFormat",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageVerifyEmail,
+				Target: "test-target",
+				Data:   map[string]string{"test": "data"},
+				TTL:    time.Hour,
+			},
+
+			expectErr: core.ErrShortCodeFormatUnknown,
+		},
+		{
+			name: "Success/Resend",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Target: "test-target",
+				Data:   map[string]string{"test": "data"},
+				TTL:    time.Hour,
+				Resend: true,
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.ShortCode{
+					ID:        uuid.New(),
+					Usage:     core.ShortCodeUsageValidateEmail,
+					Target:    "test-target",
+					CreatedAt: time.Now().Add(-2 * time.Minute),
+					ExpiresAt: activeExpiresAt,
+				},
+			},
+			daoMock: &daoMock{
+				resp: &dao.ShortCode{ID: uuid.New(), Usage: core.ShortCodeUsageValidateEmail, Target: "test-target"},
+			},
+
+			expectPlainCode: "^[a-zA-Z0-9]{12}$",
+			hashedCode:      func(code string) string { return code },
+		},
+		{
+			name: "Error/ResendNotFound",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Target: "test-target",
+				TTL:    time.Hour,
+				Resend: true,
+			},
+
+			daoSelectMock: &daoSelectMock{err: dao.ErrShortCodeSelectNotFound},
+
+			expectErr: core.ErrShortCodeCreateResendNotFound,
+		},
+		{
+			name: "Error/ResendCooldown",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Target: "test-target",
+				TTL:    time.Hour,
+				Resend: true,
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.ShortCode{
+					ID:        uuid.New(),
+					Usage:     core.ShortCodeUsageValidateEmail,
+					Target:    "test-target",
+					CreatedAt: time.Now().Add(-10 * time.Second),
+					ExpiresAt: activeExpiresAt,
+				},
+			},
+
+			expectErr: core.ErrShortCodeCreateResendCooldown,
+		},
+		{
+			name: "Error/ResendSelect",
+
+			request: &core.ShortCodeCreateRequest{
+				Usage:  core.ShortCodeUsageValidateEmail,
+				Target: "test-target",
+				TTL:    time.Hour,
+				Resend: true,
+			},
+
+			daoSelectMock: &daoSelectMock{err: errFoo},
+
+			expectErr: errFoo,
 		},
 		{
 			name: "CreateShortCodeError",
@@ -83,6 +251,16 @@ func TestShortCodeCreate(t *testing.T) {
 			t.Parallel()
 
 			mockDao := coremocks.NewMockShortCodeCreateDao(t)
+			mockDaoSelect := coremocks.NewMockShortCodeCreateDaoSelect(t)
+
+			if testCase.daoSelectMock != nil {
+				mockDaoSelect.EXPECT().
+					Exec(mock.Anything, &dao.ShortCodeSelectRequest{
+						Target: testCase.request.Target,
+						Usage:  testCase.request.Usage,
+					}).
+					Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
+			}
 
 			var (
 				encryptedShortCode string
@@ -103,7 +281,7 @@ func TestShortCodeCreate(t *testing.T) {
 							assert.Equal(t, testCase.request.Usage, data.Usage) &&
 							assert.Equal(t, testCase.request.Target, data.Target) &&
 							assert.Equal(t, testCase.request.Data, dataMap) &&
-							assert.Equal(t, testCase.request.Override, data.Override)
+							assert.Equal(t, testCase.request.Override || testCase.request.Resend, data.Override)
 					})).
 					RunAndReturn(func(_ context.Context, data *dao.ShortCodeInsertRequest) (*dao.ShortCode, error) {
 						encryptedShortCode = data.Code
@@ -114,7 +292,7 @@ func TestShortCodeCreate(t *testing.T) {
 					})
 			}
 
-			service := core.NewShortCodeCreate(mockDao, config.ShortCodesPresetDefault)
+			service := core.NewShortCodeCreate(mockDao, mockDaoSelect, shortCodesConfig)
 
 			// Bracket the call rather than compare against a clock read somewhere else.
 			// The service stamps its timestamp before hashing the code with Argon2id,
@@ -129,22 +307,39 @@ func TestShortCodeCreate(t *testing.T) {
 
 			after := time.Now()
 
+			var cooldownErr *core.ShortCodeCreateResendCooldownError
+			if errors.As(err, &cooldownErr) {
+				// The service reads its clock between before and after, so the remaining
+				// cooldown is bracketed the same way.
+				cooldownEnd := testCase.daoSelectMock.resp.CreatedAt.Add(time.Minute)
+				assert.GreaterOrEqual(t, cooldownErr.RetryAfter, cooldownEnd.Sub(after))
+				assert.LessOrEqual(t, cooldownErr.RetryAfter, cooldownEnd.Sub(before))
+			}
+
 			if testCase.expectErr == nil {
 				require.NotNil(t, resp)
 				require.NotEmpty(t, encryptedShortCode)
 
 				assert.WithinRange(t, stampedNow, before, after)
-				// Exact, not approximate: the service reads the clock once and derives the
-				// expiry from that read, so anything else means it read it twice.
-				assert.Equal(t, stampedNow.Add(testCase.request.TTL), stampedExpiresAt)
+
+				if testCase.request.Resend {
+					// A resent code keeps the expiry of the code it replaces.
+					assert.Equal(t, testCase.daoSelectMock.resp.ExpiresAt, stampedExpiresAt)
+				} else {
+					// Exact, not approximate: the service reads the clock once and derives the
+					// expiry from that read, so anything else means it read it twice.
+					assert.Equal(t, stampedNow.Add(testCase.request.TTL), stampedExpiresAt)
+				}
 
 				require.Equal(t, testCase.request.Usage, resp.Usage)
 				require.Equal(t, testCase.request.Target, resp.Target)
 
-				require.NoError(t, lib.CompareArgon2(resp.PlainCode, encryptedShortCode))
+				require.Regexp(t, testCase.expectPlainCode, resp.PlainCode)
+				require.NoError(t, lib.CompareArgon2(testCase.hashedCode(resp.PlainCode), encryptedShortCode))
 			}
 
 			mockDao.AssertExpectations(t)
+			mockDaoSelect.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	otelglobal "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+const (
+	// ShortCodeJanitorActionExpire labels the short codes soft-deleted by the janitor, in its
+	// metrics.
+	ShortCodeJanitorActionExpire = "expire"
+	// ShortCodeJanitorActionPurge labels the short codes removed by the janitor, in its metrics.
+	ShortCodeJanitorActionPurge = "purge"
+)
+
+type ShortCodeJanitorDaoLock interface {
+	Exec(ctx context.Context, callback func(ctx context.Context) error) (bool, error)
+}
+
+type ShortCodeJanitorDaoExpire interface {
+	Exec(ctx context.Context, request *dao.ShortCodeExpireRequest) (int, error)
+}
+
+type ShortCodeJanitorDaoPurge interface {
+	Exec(ctx context.Context, request *dao.ShortCodePurgeRequest) (int, error)
+}
+
+type ShortCodeJanitorRequest struct{}
+
+type ShortCodeJanitorResponse struct {
+	// Elected is false when another instance was running the janitor, in which case this one did
+	// nothing.
+	Elected bool
+	// Expired is the number of expired short codes soft-deleted.
+	Expired int
+	// Purged is the number of deleted short codes removed, past their retention.
+	Purged int
+}
+
+// ShortCodeJanitor cleans up the short_codes table. It soft-deletes the short codes that expired
+// without being redeemed, then removes for good those deleted for longer than the retention.
+//
+// A single instance runs it at a time: the others skip their turn. The rows are processed in
+// batches, each committed on its own, so the janitor never locks much of the table at once; an
+// interrupted run leaves the remaining rows to the next one. The rows processed are counted by
+// the short_codes.janitor.processed metric, labeled by action.
+type ShortCodeJanitor struct {
+	daoLock   ShortCodeJanitorDaoLock
+	daoExpire ShortCodeJanitorDaoExpire
+	daoPurge  ShortCodeJanitorDaoPurge
+	config    config.ShortCodesJanitor
+
+	processed metric.Int64Counter
+}
+
+func NewShortCodeJanitor(
+	daoLock ShortCodeJanitorDaoLock,
+	daoExpire ShortCodeJanitorDaoExpire,
+	daoPurge ShortCodeJanitorDaoPurge,
+	config config.ShortCodesJanitor,
+) *ShortCodeJanitor {
+	processed := lo.Must(otelglobal.Meter("service.ShortCodeJanitor").Int64Counter(
+		"short_codes.janitor.processed",
+		metric.WithDescription("Short codes soft-deleted or removed by the janitor."),
+		metric.WithUnit("{row}"),
+	))
+
+	return &ShortCodeJanitor{
+		daoLock:   daoLock,
+		daoExpire: daoExpire,
+		daoPurge:  daoPurge,
+		config:    config,
+		processed: processed,
+	}
+}
+
+// Exec runs the janitor once, unless another instance is running it.
+func (service *ShortCodeJanitor) Exec(
+	ctx context.Context, _ *ShortCodeJanitorRequest,
+) (*ShortCodeJanitorResponse, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ShortCodeJanitor")
+	defer span.End()
+
+	now := time.Now()
+	response := new(ShortCodeJanitorResponse)
+
+	elected, err := service.daoLock.Exec(ctx, func(ctx context.Context) error {
+		var err error
+
+		response.Expired, err = service.processBatches(ctx, ShortCodeJanitorActionExpire, func() (int, error) {
+			return service.daoExpire.Exec(ctx, &dao.ShortCodeExpireRequest{
+				Now:   now,
+				Limit: service.config.BatchSize,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("expire short codes: %w", err)
+		}
+
+		response.Purged, err = service.processBatches(ctx, ShortCodeJanitorActionPurge, func() (int, error) {
+			return service.daoPurge.Exec(ctx, &dao.ShortCodePurgeRequest{
+				DeletedBefore: now.Add(-service.config.Retention),
+				Limit:         service.config.BatchSize,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("purge short codes: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	response.Elected = elected
+
+	span.SetAttributes(
+		attribute.Bool("janitor.elected", response.Elected),
+		attribute.Int("shortCodes.expired", response.Expired),
+		attribute.Int("shortCodes.purged", response.Purged),
+	)
+
+	return otel.ReportSuccess(span, response), nil
+}
+
+// Watch runs the janitor every interval, until ctx is done. A failed run is logged, and retried
+// on the next tick.
+func (service *ShortCodeJanitor) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := service.Exec(ctx, &ShortCodeJanitorRequest{})
+			if err != nil {
+				otel.Logger().ErrorContext(ctx, fmt.Errorf("clean up short codes: %w", err).Error())
+			}
+		}
+	}
+}
+
+// processBatches runs batch until it processes fewer rows than the batch size, and returns the
+// total. Each batch is counted as soon as it is committed, so the metric stays accurate when a
+// later batch fails.
+func (service *ShortCodeJanitor) processBatches(
+	ctx context.Context, action string, batch func() (int, error),
+) (int, error) {
+	var total int
+
+	for {
+		count, err := batch()
+		if err != nil {
+			return total, err
+		}
+
+		total += count
+
+		service.processed.Add(ctx, int64(count), metric.WithAttributes(attribute.String("action", action)))
+
+		if count < service.config.BatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestShortCodeJanitor(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	cfg := config.ShortCodesJanitor{
+		Retention: 24 * time.Hour,
+		BatchSize: 2,
+	}
+
+	type batchMock struct {
+		resp int
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		lockElected bool
+		lockErr     error
+
+		// expireMocks and purgeMocks are returned in order, one per batch.
+		expireMocks []batchMock
+		purgeMocks  []batchMock
+
+		expect    *core.ShortCodeJanitorResponse
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			lockElected: true,
+
+			expireMocks: []batchMock{{resp: 2}, {resp: 2}, {resp: 1}},
+			purgeMocks:  []batchMock{{resp: 2}, {resp: 0}},
+
+			expect: &core.ShortCodeJanitorResponse{
+				Elected: true,
+				Expired: 5,
+				Purged:  2,
+			},
+		},
+		{
+			name: "Success/NothingToClean",
+
+			lockElected: true,
+
+			expireMocks: []batchMock{{resp: 0}},
+			purgeMocks:  []batchMock{{resp: 0}},
+
+			expect: &core.ShortCodeJanitorResponse{Elected: true},
+		},
+		{
+			name: "Success/NotElected",
+
+			expect: &core.ShortCodeJanitorResponse{},
+		},
+		{
+			name: "Error/Lock",
+
+			lockErr: errFoo,
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Expire",
+
+			lockElected: true,
+
+			expireMocks: []batchMock{{resp: 2}, {err: errFoo}},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Purge",
+
+			lockElected: true,
+
+			expireMocks: []batchMock{{resp: 0}},
+			purgeMocks:  []batchMock{{err: errFoo}},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoLock := coremocks.NewMockShortCodeJanitorDaoLock(t)
+			daoExpire := coremocks.NewMockShortCodeJanitorDaoExpire(t)
+			daoPurge := coremocks.NewMockShortCodeJanitorDaoPurge(t)
+
+			daoLock.EXPECT().
+				Exec(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, callback func(ctx context.Context) error) (bool, error) {
+					if !testCase.lockElected {
+						return false, testCase.lockErr
+					}
+
+					return true, callback(ctx)
+				})
+
+			for _, batch := range testCase.expireMocks {
+				daoExpire.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.ShortCodeExpireRequest) bool {
+						return assert.WithinDuration(t, time.Now(), data.Now, time.Minute) &&
+							assert.Equal(t, cfg.BatchSize, data.Limit)
+					})).
+					Return(batch.resp, batch.err).
+					Once()
+			}
+
+			for _, batch := range testCase.purgeMocks {
+				daoPurge.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.ShortCodePurgeRequest) bool {
+						return assert.WithinDuration(t, time.Now().Add(-cfg.Retention), data.DeletedBefore, time.Minute) &&
+							assert.Equal(t, cfg.BatchSize, data.Limit)
+					})).
+					Return(batch.resp, batch.err).
+					Once()
+			}
+
+			service := core.NewShortCodeJanitor(daoLock, daoExpire, daoPurge, cfg)
+
+			resp, err := service.Exec(t.Context(), &core.ShortCodeJanitorRequest{})
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoLock.AssertExpectations(t)
+			daoExpire.AssertExpectations(t)
+			daoPurge.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type ShortCodeListDao interface {
+	Exec(ctx context.Context, request *dao.ShortCodeListRequest) ([]*dao.ShortCode, error)
+}
+
+type ShortCodeListDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type ShortCodeListRequest struct {
+	Limit  int `validate:"required,min=1,max=100"`
+	Offset int `validate:"min=0"`
+
+	// Target, Usage and Status, if set, narrow the listing to the codes issued for that
+	// target, for that usage, or in that status. Status is one of the ShortCodeStatus*
+	// constants.
+	Target string `validate:"max=1024"`
+	Usage  string `validate:"omitempty,usage"`
+	Status string `validate:"omitempty,oneof=active expired deleted"`
+
+	// CurrentUserID and RequestID identify the listing in the audit trail.
+	CurrentUserID uuid.UUID
+	RequestID     string
+}
+
+// ShortCodeList returns a paginated list of short codes, newest first, for administrators
+// investigating a leak or a support request. Only metadata is returned: codes are stored hashed,
+// and their flow data stays private.
+//
+// Every page served is recorded in the audit trail.
+type ShortCodeList struct {
+	dao                 ShortCodeListDao
+	daoAuditEventInsert ShortCodeListDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewShortCodeList(
+	dao ShortCodeListDao,
+	daoAuditEventInsert ShortCodeListDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *ShortCodeList {
+	return &ShortCodeList{
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+func (service *ShortCodeList) Exec(
+	ctx context.Context, request *ShortCodeListRequest,
+) ([]*ShortCodeMetadata, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ShortCodeList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("request.limit", request.Limit),
+		attribute.Int("request.offset", request.Offset),
+		attribute.String("request.usage", request.Usage),
+		attribute.String("request.status", request.Status),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var entities []*dao.ShortCode
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		entities, err = service.dao.Exec(ctx, &dao.ShortCodeListRequest{
+			Limit:  request.Limit,
+			Offset: request.Offset,
+			Target: request.Target,
+			Usage:  request.Usage,
+			Status: request.Status,
+		})
+		if err != nil {
+			return fmt.Errorf("list short codes: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			Action:    AuditActionShortCodesList,
+			RequestID: request.RequestID,
+			Read:      true,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("response.count", len(entities)))
+
+	return otel.ReportSuccess(span, lo.Map(entities, loadShortCodeMetadata)), nil
+}
@@ -0,0 +1,249 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestShortCodeList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	pastTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	futureTime := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	type daoListMock struct {
+		resp []*dao.ShortCode
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ShortCodeListRequest
+
+		daoListMock *daoListMock
+		// auditEventInsertErr is returned by the audit trail, which records every listing.
+		auditEventInsertErr error
+
+		expect    []*core.ShortCodeMetadata
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ShortCodeListRequest{
+				Limit:         10,
+				Offset:        5,
+				Target:        "test-target",
+				Usage:         core.ShortCodeUsageRegister,
+				Status:        core.ShortCodeStatusActive,
+				CurrentUserID: callerID,
+				RequestID:     "request-1",
+			},
+
+			daoListMock: &daoListMock{
+				resp: []*dao.ShortCode{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Usage:     core.ShortCodeUsageRegister,
+						Target:    "test-target",
+						CreatedAt: createdAt,
+						ExpiresAt: futureTime,
+						Attempts:  2,
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						Usage:     core.ShortCodeUsageRegister,
+						Target:    "test-target",
+						CreatedAt: createdAt,
+						ExpiresAt: pastTime,
+					},
+					{
+						ID:             uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						Usage:          core.ShortCodeUsageRegister,
+						Target:         "test-target",
+						CreatedAt:      createdAt,
+						ExpiresAt:      futureTime,
+						DeletedAt:      &deletedAt,
+						DeletedComment: lo.ToPtr(dao.ShortCodeDeleteConsumed),
+					},
+					{
+						ID:             uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+						Usage:          core.ShortCodeUsageRegister,
+						Target:         "test-target",
+						CreatedAt:      createdAt,
+						ExpiresAt:      pastTime,
+						DeletedAt:      &deletedAt,
+						DeletedComment: lo.ToPtr(dao.ShortCodeDeleteExpired),
+					},
+				},
+			},
+
+			expect: []*core.ShortCodeMetadata{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Usage:     core.ShortCodeUsageRegister,
+					Target:    "test-target",
+					Status:    core.ShortCodeStatusActive,
+					Attempts:  2,
+					CreatedAt: createdAt,
+					ExpiresAt: futureTime,
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Usage:     core.ShortCodeUsageRegister,
+					Target:    "test-target",
+					Status:    core.ShortCodeStatusExpired,
+					CreatedAt: createdAt,
+					ExpiresAt: pastTime,
+				},
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					Usage:          core.ShortCodeUsageRegister,
+					Target:         "test-target",
+					Status:         core.ShortCodeStatusDeleted,
+					CreatedAt:      createdAt,
+					ExpiresAt:      futureTime,
+					DeletedAt:      &deletedAt,
+					DeletedComment: lo.ToPtr(dao.ShortCodeDeleteConsumed),
+				},
+				{
+					// Deleted by the janitor once expired.
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					Usage:          core.ShortCodeUsageRegister,
+					Target:         "test-target",
+					Status:         core.ShortCodeStatusExpired,
+					CreatedAt:      createdAt,
+					ExpiresAt:      pastTime,
+					DeletedAt:      &deletedAt,
+					DeletedComment: lo.ToPtr(dao.ShortCodeDeleteExpired),
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &core.ShortCodeListRequest{
+				Limit:         10,
+				CurrentUserID: callerID,
+			},
+
+			daoListMock: &daoListMock{resp: []*dao.ShortCode{}},
+
+			expect: []*core.ShortCodeMetadata{},
+		},
+		{
+			name: "Error/InvalidStatus",
+
+			request: &core.ShortCodeListRequest{
+				Limit:  10,
+				Status: "fake",
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidUsage",
+
+			request: &core.ShortCodeListRequest{
+				Limit: 10,
+				Usage: "fake",
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NoLimit",
+
+			request: &core.ShortCodeListRequest{},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/List",
+
+			request: &core.ShortCodeListRequest{
+				Limit:         10,
+				CurrentUserID: callerID,
+			},
+
+			daoListMock: &daoListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.ShortCodeListRequest{
+				Limit:         10,
+				CurrentUserID: callerID,
+			},
+
+			daoListMock:         &daoListMock{resp: []*dao.ShortCode{}},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoList := coremocks.NewMockShortCodeListDao(t)
+			daoAuditEventInsert := coremocks.NewMockShortCodeListDaoAuditEventInsert(t)
+
+			if testCase.daoListMock != nil {
+				daoList.EXPECT().
+					Exec(mock.Anything, &dao.ShortCodeListRequest{
+						Limit:  testCase.request.Limit,
+						Offset: testCase.request.Offset,
+						Target: testCase.request.Target,
+						Usage:  testCase.request.Usage,
+						Status: testCase.request.Status,
+					}).
+					Return(testCase.daoListMock.resp, testCase.daoListMock.err)
+			}
+
+			if testCase.daoListMock != nil && testCase.daoListMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						return assert.Equal(t, core.AuditActionShortCodesList, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Nil(t, data.TargetID) &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID) &&
+							assert.True(t, data.Unchained)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			service := core.NewShortCodeList(daoList, daoAuditEventInsert, transactiontest.NewTransactor())
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoList.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/transaction"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type ShortCodeRevokeDao interface {
+	Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)
+}
+
+type ShortCodeRevokeDaoAuditEventInsert interface {
+	Exec(ctx context.Context, request *dao.AuditEventInsertRequest) (*dao.AuditEvent, error)
+}
+
+type ShortCodeRevokeRequest struct {
+	ID uuid.UUID `validate:"required"`
+	// Reason explains the revocation. It is stored in the deletion comment of the code.
+	Reason string `validate:"required,max=512"`
+
+	// CurrentUserID and RequestID identify the revocation in the audit trail.
+	CurrentUserID uuid.UUID
+	RequestID     string
+}
+
+// ShortCodeRevoke deletes an active short code before it is redeemed, for instance when an
+// administrator learns it leaked. The deletion comment is [dao.ShortCodeDeleteRevoked], followed
+// by the reason. Expired and already deleted codes cannot be revoked.
+//
+// The revocation is recorded in the audit trail, in the transaction of the delete.
+type ShortCodeRevoke struct {
+	dao                 ShortCodeRevokeDao
+	daoAuditEventInsert ShortCodeRevokeDaoAuditEventInsert
+	transactor          transaction.Transactor
+}
+
+func NewShortCodeRevoke(
+	dao ShortCodeRevokeDao,
+	daoAuditEventInsert ShortCodeRevokeDaoAuditEventInsert,
+	transactor transaction.Transactor,
+) *ShortCodeRevoke {
+	return &ShortCodeRevoke{
+		dao:                 dao,
+		daoAuditEventInsert: daoAuditEventInsert,
+		transactor:          transactor,
+	}
+}
+
+func (service *ShortCodeRevoke) Exec(
+	ctx context.Context, request *ShortCodeRevokeRequest,
+) (*ShortCodeMetadata, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ShortCodeRevoke")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("shortCode.id", request.ID.String()),
+		attribute.String("actor.id", request.CurrentUserID.String()),
+	)
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	var entity *dao.ShortCode
+
+	err = service.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		entity, err = service.dao.Exec(ctx, &dao.ShortCodeDeleteRequest{
+			ID:      request.ID,
+			Now:     time.Now(),
+			Comment: dao.ShortCodeDeleteRevoked + ": " + request.Reason,
+		})
+		if err != nil {
+			return fmt.Errorf("delete short code: %w", err)
+		}
+
+		return recordAuditEvent(ctx, service.daoAuditEventInsert, &auditEventData{
+			ActorID:   &request.CurrentUserID,
+			Action:    AuditActionShortCodesRevoke,
+			After:     newAuditShortCodeState(entity),
+			RequestID: request.RequestID,
+		})
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadShortCodeMetadata(entity, 0)), nil
+}
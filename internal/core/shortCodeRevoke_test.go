@@ -0,0 +1,180 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/transaction/transactiontest"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestShortCodeRevoke(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	shortCodeID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	deletedAt := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	type daoDeleteMock struct {
+		resp *dao.ShortCode
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ShortCodeRevokeRequest
+
+		daoDeleteMock *daoDeleteMock
+		// auditEventInsertErr is returned by the audit trail, which records every revocation.
+		auditEventInsertErr error
+
+		expect    *core.ShortCodeMetadata
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &core.ShortCodeRevokeRequest{
+				ID:            shortCodeID,
+				Reason:        "leaked in a support ticket",
+				CurrentUserID: callerID,
+				RequestID:     "request-1",
+			},
+
+			daoDeleteMock: &daoDeleteMock{
+				resp: &dao.ShortCode{
+					ID:             shortCodeID,
+					Code:           "encrypted-code",
+					Usage:          core.ShortCodeUsageResetPassword,
+					Target:         "test-target",
+					Data:           []byte("test-data"),
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt:      expiresAt,
+					Attempts:       1,
+					DeletedAt:      &deletedAt,
+					DeletedComment: lo.ToPtr("revoked by admin: leaked in a support ticket"),
+				},
+			},
+
+			expect: &core.ShortCodeMetadata{
+				ID:             shortCodeID,
+				Usage:          core.ShortCodeUsageResetPassword,
+				Target:         "test-target",
+				Status:         core.ShortCodeStatusDeleted,
+				Attempts:       1,
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:      expiresAt,
+				DeletedAt:      &deletedAt,
+				DeletedComment: lo.ToPtr("revoked by admin: leaked in a support ticket"),
+			},
+		},
+		{
+			name: "Error/NoReason",
+
+			request: &core.ShortCodeRevokeRequest{
+				ID:            shortCodeID,
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NoID",
+
+			request: &core.ShortCodeRevokeRequest{
+				Reason:        "leaked",
+				CurrentUserID: callerID,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &core.ShortCodeRevokeRequest{
+				ID:            shortCodeID,
+				Reason:        "leaked",
+				CurrentUserID: callerID,
+			},
+
+			daoDeleteMock: &daoDeleteMock{err: dao.ErrShortCodeDeleteNotFound},
+
+			expectErr: dao.ErrShortCodeDeleteNotFound,
+		},
+		{
+			name: "Error/AuditEvent",
+
+			request: &core.ShortCodeRevokeRequest{
+				ID:            shortCodeID,
+				Reason:        "leaked",
+				CurrentUserID: callerID,
+			},
+
+			daoDeleteMock:       &daoDeleteMock{resp: &dao.ShortCode{ID: shortCodeID}},
+			auditEventInsertErr: errFoo,
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoDelete := coremocks.NewMockShortCodeRevokeDao(t)
+			daoAuditEventInsert := coremocks.NewMockShortCodeRevokeDaoAuditEventInsert(t)
+
+			if testCase.daoDeleteMock != nil {
+				daoDelete.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.ShortCodeDeleteRequest) bool {
+						return assert.Equal(t, testCase.request.ID, data.ID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second) &&
+							assert.Equal(t, dao.ShortCodeDeleteRevoked+": "+testCase.request.Reason, data.Comment)
+					})).
+					Return(testCase.daoDeleteMock.resp, testCase.daoDeleteMock.err)
+			}
+
+			if testCase.daoDeleteMock != nil && testCase.daoDeleteMock.err == nil {
+				daoAuditEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.AuditEventInsertRequest) bool {
+						var after map[string]any
+
+						return assert.Equal(t, core.AuditActionShortCodesRevoke, data.Action) &&
+							assert.Equal(t, &testCase.request.CurrentUserID, data.ActorID) &&
+							assert.Nil(t, data.TargetID) &&
+							assert.Nil(t, data.Before) &&
+							assert.NoError(t, json.Unmarshal(data.After, &after)) &&
+							assert.Equal(t, testCase.request.ID.String(), after["id"]) &&
+							assert.NotContains(t, after, "code") &&
+							assert.Equal(t, testCase.request.RequestID, data.RequestID)
+					})).
+					Return(&dao.AuditEvent{}, testCase.auditEventInsertErr)
+			}
+
+			service := core.NewShortCodeRevoke(daoDelete, daoAuditEventInsert, transactiontest.NewTransactor())
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoDelete.AssertExpectations(t)
+			daoAuditEventInsert.AssertExpectations(t)
+		})
+	}
+}
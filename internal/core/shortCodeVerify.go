@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+const (
+	// ShortCodeVerifyStatusValid is the status of a code that can be redeemed.
+	ShortCodeVerifyStatusValid = "valid"
+	// ShortCodeVerifyStatusExpired is the status of a code that matches, but outlived its
+	// expiration date.
+	ShortCodeVerifyStatusExpired = "expired"
+	// ShortCodeVerifyStatusInvalid is the status of any other code: wrong, never issued,
+	// or invalidated (consumed, revoked, overridden or locked), whether it expired since or not.
+	ShortCodeVerifyStatusInvalid = "invalid"
+)
+
+// ShortCodeVerifyDaoSelect loads the latest short code for a (Usage, Target) pair,
+// expired or not, so the submitted code can be verified against it.
+type ShortCodeVerifyDaoSelect interface {
+	Exec(ctx context.Context, request *dao.ShortCodeSelectLatestRequest) (*dao.ShortCode, error)
+}
+
+// ShortCodeVerifyDaoDelete deletes a short code once its failed attempts reach the limit.
+type ShortCodeVerifyDaoDelete interface {
+	Exec(ctx context.Context, request *dao.ShortCodeDeleteRequest) (*dao.ShortCode, error)
+}
+
+// ShortCodeVerifyDaoIncrementAttempts counts a failed attempt at verifying a short code.
+type ShortCodeVerifyDaoIncrementAttempts interface {
+	Exec(ctx context.Context, request *dao.ShortCodeIncrementAttemptsRequest) (*dao.ShortCode, error)
+}
+
+// ShortCodeVerifyRequest identifies the code to verify, like [ShortCodeConsumeRequest].
+type ShortCodeVerifyRequest struct {
+	Usage  string `validate:"required,usage"`
+	Target string `validate:"required,max=1024"`
+	Code   string `validate:"required,max=1024"`
+}
+
+// ShortCodeVerify tells whether a user-submitted short code would be accepted, without
+// redeeming it. It lets clients report an expired link before the user fills a form.
+//
+// A wrong code counts as a failed attempt, as with [ShortCodeConsume]: verifying cannot
+// be used to guess a code past the attempts limit of its usage.
+type ShortCodeVerify struct {
+	daoSelect            ShortCodeVerifyDaoSelect
+	daoDelete            ShortCodeVerifyDaoDelete
+	daoIncrementAttempts ShortCodeVerifyDaoIncrementAttempts
+
+	config config.ShortCodes
+}
+
+// NewShortCodeVerify wires the verify service to the DAOs that read stored codes, and
+// count their failed attempts.
+func NewShortCodeVerify(
+	daoSelect ShortCodeVerifyDaoSelect,
+	daoDelete ShortCodeVerifyDaoDelete,
+	daoIncrementAttempts ShortCodeVerifyDaoIncrementAttempts,
+	config config.ShortCodes,
+) *ShortCodeVerify {
+	return &ShortCodeVerify{
+		daoSelect:            daoSelect,
+		daoDelete:            daoDelete,
+		daoIncrementAttempts: daoIncrementAttempts,
+		config:               config,
+	}
+}
+
+// Exec verifies the submitted code, and returns one of the ShortCodeVerifyStatus*
+// constants. Only a code matching the stored hash, and past its expiration date rather than
+// invalidated, is reported as expired, so the status reveals nothing about the codes issued to
+// a target.
+func (service *ShortCodeVerify) Exec(ctx context.Context, request *ShortCodeVerifyRequest) (string, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ShortCodeVerify")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return "", otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	entity, err := service.daoSelect.Exec(ctx, &dao.ShortCodeSelectLatestRequest{
+		Target: request.Target,
+		Usage:  request.Usage,
+	})
+	if errors.Is(err, dao.ErrShortCodeSelectLatestNotFound) {
+		// Same as ShortCodeConsume: a missing code costs the same as a wrong one.
+		lib.DummyCompareArgon2(request.Code)
+
+		return otel.ReportSuccess(span, ShortCodeVerifyStatusInvalid), nil
+	}
+
+	if err != nil {
+		return "", otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(attribute.String("shortCode.id", entity.ID.String()))
+
+	// A code deleted for any other reason than the janitor sweeping it once expired was consumed,
+	// revoked, overridden or locked: it did not expire, it was invalidated.
+	deleted := entity.DeletedAt != nil && lo.FromPtr(entity.DeletedComment) != dao.ShortCodeDeleteExpired
+	expired := entity.ExpiresAt.Before(time.Now())
+
+	err = lib.CompareArgon2(normalizeShortCode(service.config.Usages[request.Usage], request.Code), entity.Code)
+	if err != nil {
+		// A code that cannot be redeemed anymore has no attempt to count.
+		if deleted || expired {
+			return otel.ReportSuccess(span, ShortCodeVerifyStatusInvalid), nil
+		}
+
+		err = recordShortCodeFailedAttempt(
+			ctx, service.daoIncrementAttempts, service.daoDelete, service.config, entity,
+		)
+		if err != nil && !errors.Is(err, ErrShortCodeConsumeInvalid) &&
+			!errors.Is(err, ErrShortCodeConsumeTooManyAttempts) {
+			return "", otel.ReportError(span, fmt.Errorf("record failed attempt: %w", err))
+		}
+
+		return otel.ReportSuccess(span, ShortCodeVerifyStatusInvalid), nil
+	}
+
+	if deleted {
+		return otel.ReportSuccess(span, ShortCodeVerifyStatusInvalid), nil
+	}
+
+	if expired {
+		return otel.ReportSuccess(span, ShortCodeVerifyStatusExpired), nil
+	}
+
+	return otel.ReportSuccess(span, ShortCodeVerifyStatusValid), nil
+}
@@ -0,0 +1,323 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestShortCodeVerify(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	futureTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	pastTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	shortCode := "test-code"
+	encrypted, err := lib.GenerateArgon2(shortCode, lib.Argon2ParamsDefault)
+	require.NoError(t, err)
+
+	// The hash covers the normalized form of a Crockford code.
+	encryptedCrockford, err := lib.GenerateArgon2("AB1C0DEF", lib.Argon2ParamsDefault)
+	require.NoError(t, err)
+
+	type daoSelectMock struct {
+		resp *dao.ShortCode
+		err  error
+	}
+
+	type daoDeleteMock struct {
+		err error
+	}
+
+	type daoIncrementAttemptsMock struct {
+		resp *dao.ShortCode
+		err  error
+	}
+
+	shortCodesConfig := config.ShortCodes{
+		Usages: map[string]config.ShortCodeUsage{
+			core.ShortCodeUsageValidateEmail: {TTL: time.Hour, MaxAttempts: 3},
+			core.ShortCodeUsageRegister:      {TTL: time.Hour, Format: config.ShortCodeFormatCrockford},
+		},
+	}
+
+	selectedShortCode := func(expiresAt time.Time) *dao.ShortCode {
+		return &dao.ShortCode{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Code:      encrypted,
+			Usage:     core.ShortCodeUsageValidateEmail,
+			Target:    "test-target",
+			Data:      []byte("test-data"),
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpiresAt: expiresAt,
+		}
+	}
+
+	withAttempts := func(shortCode *dao.ShortCode, attempts int) *dao.ShortCode {
+		shortCode.Attempts = attempts
+
+		return shortCode
+	}
+
+	withDeleted := func(shortCode *dao.ShortCode, comment string) *dao.ShortCode {
+		shortCode.DeletedAt = lo.ToPtr(time.Now())
+		shortCode.DeletedComment = lo.ToPtr(comment)
+
+		return shortCode
+	}
+
+	request := &core.ShortCodeVerifyRequest{
+		Target: "test-target",
+		Usage:  core.ShortCodeUsageValidateEmail,
+		Code:   shortCode,
+	}
+
+	wrongRequest := &core.ShortCodeVerifyRequest{
+		Target: "test-target",
+		Usage:  core.ShortCodeUsageValidateEmail,
+		Code:   "wrong-code",
+	}
+
+	testCases := []struct {
+		name string
+
+		request *core.ShortCodeVerifyRequest
+
+		daoSelectMock            *daoSelectMock
+		daoDeleteMock            *daoDeleteMock
+		daoIncrementAttemptsMock *daoIncrementAttemptsMock
+
+		expect    string
+		expectErr error
+	}{
+		{
+			name: "Valid",
+
+			request: request,
+
+			daoSelectMock: &daoSelectMock{resp: selectedShortCode(futureTime)},
+
+			expect: core.ShortCodeVerifyStatusValid,
+		},
+		{
+			name: "Valid/Crockford",
+
+			request: &core.ShortCodeVerifyRequest{
+				Target: "test-target",
+				Usage:  core.ShortCodeUsageRegister,
+				// Lowercase, with a separator, an L for a 1 and an O for a 0.
+				Code: "abLc-odef",
+			},
+
+			daoSelectMock: &daoSelectMock{
+				resp: &dao.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:      encryptedCrockford,
+					Usage:     core.ShortCodeUsageRegister,
+					Target:    "test-target",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt: futureTime,
+				},
+			},
+
+			expect: core.ShortCodeVerifyStatusValid,
+		},
+		{
+			name: "Expired",
+
+			request: request,
+
+			daoSelectMock: &daoSelectMock{resp: selectedShortCode(pastTime)},
+
+			expect: core.ShortCodeVerifyStatusExpired,
+		},
+		{
+			name: "Expired/Deleted",
+
+			request: request,
+
+			// Swept by the janitor once expired.
+			daoSelectMock: &daoSelectMock{
+				resp: withDeleted(selectedShortCode(pastTime), dao.ShortCodeDeleteExpired),
+			},
+
+			expect: core.ShortCodeVerifyStatusExpired,
+		},
+		{
+			name: "Consumed",
+
+			request: request,
+
+			daoSelectMock: &daoSelectMock{
+				resp: withDeleted(selectedShortCode(futureTime), dao.ShortCodeDeleteConsumed),
+			},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "Consumed/Expired",
+
+			request: request,
+
+			// Invalidated before it expired: the expiration date passing since changes nothing.
+			daoSelectMock: &daoSelectMock{
+				resp: withDeleted(selectedShortCode(pastTime), dao.ShortCodeDeleteConsumed),
+			},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "Revoked",
+
+			request: request,
+
+			daoSelectMock: &daoSelectMock{
+				resp: withDeleted(selectedShortCode(futureTime), dao.ShortCodeDeleteRevoked),
+			},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "WrongCode",
+
+			request: wrongRequest,
+
+			daoSelectMock:            &daoSelectMock{resp: selectedShortCode(futureTime)},
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{resp: withAttempts(selectedShortCode(futureTime), 1)},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "WrongCode/TooManyAttempts",
+
+			request: wrongRequest,
+
+			daoSelectMock:            &daoSelectMock{resp: selectedShortCode(futureTime)},
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{resp: withAttempts(selectedShortCode(futureTime), 3)},
+			daoDeleteMock:            &daoDeleteMock{},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "WrongCode/Expired",
+
+			request: wrongRequest,
+
+			// An expired code is not reported to a wrong guess, and cannot be redeemed anymore: no
+			// attempt is counted.
+			daoSelectMock: &daoSelectMock{resp: selectedShortCode(pastTime)},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "WrongCode/Deleted",
+
+			request: wrongRequest,
+
+			// A code locked after too many attempts cannot be redeemed anymore: no attempt is counted.
+			daoSelectMock: &daoSelectMock{
+				resp: withDeleted(selectedShortCode(futureTime), dao.ShortCodeDeleteTooManyAttempts),
+			},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "WrongCode/IncrementAttemptsError",
+
+			request: wrongRequest,
+
+			daoSelectMock:            &daoSelectMock{resp: selectedShortCode(futureTime)},
+			daoIncrementAttemptsMock: &daoIncrementAttemptsMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "NoCode",
+
+			request: request,
+
+			daoSelectMock: &daoSelectMock{err: dao.ErrShortCodeSelectLatestNotFound},
+
+			expect: core.ShortCodeVerifyStatusInvalid,
+		},
+		{
+			name: "SelectError",
+
+			request: request,
+
+			daoSelectMock: &daoSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "InvalidRequest",
+
+			request: &core.ShortCodeVerifyRequest{
+				Target: "test-target",
+				Usage:  "unknown-usage",
+				Code:   shortCode,
+			},
+
+			expectErr: core.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			daoSelect := coremocks.NewMockShortCodeVerifyDaoSelect(t)
+			daoDelete := coremocks.NewMockShortCodeVerifyDaoDelete(t)
+			daoIncrementAttempts := coremocks.NewMockShortCodeVerifyDaoIncrementAttempts(t)
+
+			if testCase.daoSelectMock != nil {
+				daoSelect.EXPECT().
+					Exec(mock.Anything, &dao.ShortCodeSelectLatestRequest{
+						Target: testCase.request.Target,
+						Usage:  testCase.request.Usage,
+					}).
+					Return(testCase.daoSelectMock.resp, testCase.daoSelectMock.err)
+			}
+
+			if testCase.daoDeleteMock != nil {
+				daoDelete.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(data *dao.ShortCodeDeleteRequest) bool {
+						return assert.Equal(t, testCase.daoSelectMock.resp.ID, data.ID) &&
+							assert.WithinDuration(t, time.Now(), data.Now, time.Second) &&
+							assert.Equal(t, dao.ShortCodeDeleteTooManyAttempts, data.Comment)
+					})).
+					Return(nil, testCase.daoDeleteMock.err)
+			}
+
+			if testCase.daoIncrementAttemptsMock != nil {
+				daoIncrementAttempts.EXPECT().
+					Exec(mock.Anything, &dao.ShortCodeIncrementAttemptsRequest{ID: testCase.daoSelectMock.resp.ID}).
+					Return(testCase.daoIncrementAttemptsMock.resp, testCase.daoIncrementAttemptsMock.err)
+			}
+
+			service := core.NewShortCodeVerify(daoSelect, daoDelete, daoIncrementAttempts, shortCodesConfig)
+
+			resp, err := service.Exec(t.Context(), testCase.request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, resp)
+
+			daoSelect.AssertExpectations(t)
+			daoDelete.AssertExpectations(t)
+			daoIncrementAttempts.AssertExpectations(t)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 
 	"github.com/a-novel/service-json-keys/v2/pkg/go"
@@ -66,11 +68,7 @@ func signTokenPair(
 		return nil, fmt.Errorf("parse refresh token: %w", err)
 	}
 
-	accessTokenPayload, err := grpcf.MarshalJSONAsAny(AccessTokenClaims{
-		UserID:         &credentials.ID,
-		Roles:          []string{credentials.Role},
-		RefreshTokenID: refreshTokenClaims.Jti,
-	})
+	accessTokenPayload, err := grpcf.MarshalJSONAsAny(newAccessTokenClaims(credentials, refreshTokenClaims.Jti))
 	if err != nil {
 		return nil, fmt.Errorf("marshal access claims: %w", err)
 	}
@@ -88,3 +86,22 @@ func signTokenPair(
 		RefreshToken: refreshToken.GetToken(),
 	}, nil
 }
+
+// newAccessTokenClaims builds the claims of an access token for the given credentials. The
+// token carries the roles the user holds, and the ones temporarily granted to it: those cap the
+// validity of the token, see [AccessTokenClaims.GrantExpiresAt].
+func newAccessTokenClaims(credentials *dao.Credentials, refreshTokenID string) AccessTokenClaims {
+	roles := credentials.Roles
+	if len(credentials.GrantedRoles) > 0 {
+		roles = lo.Union(credentials.Roles, credentials.GrantedRoles)
+		slices.Sort(roles)
+	}
+
+	return AccessTokenClaims{
+		UserID:         &credentials.ID,
+		Roles:          roles,
+		RefreshTokenID: refreshTokenID,
+		EmailVerified:  credentials.EmailVerifiedAt != nil,
+		GrantExpiresAt: credentials.GrantsExpireAt,
+	}
+}
@@ -4,16 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 
 	"github.com/a-novel/service-json-keys/v2/pkg/go"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/smtp"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/lib"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
 )
 
 // TokenCreateDao provides access to credentials lookup by email.
@@ -21,6 +29,24 @@ type TokenCreateDao interface {
 	Exec(ctx context.Context, request *dao.CredentialsSelectByEmailRequest) (*dao.Credentials, error)
 }
 
+// TokenCreateDaoLoginEventInsert records the attempt in the login history.
+type TokenCreateDaoLoginEventInsert interface {
+	Exec(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)
+}
+
+// TokenCreateDaoUpdateLastLogin records the time of a successful sign-in on the credentials.
+type TokenCreateDaoUpdateLastLogin interface {
+	Exec(ctx context.Context, request *dao.CredentialsUpdateLastLoginRequest) (*dao.Credentials, error)
+}
+
+// TokenCreateDaoLoginEventDeviceExists looks the device of a sign-in up in the login history.
+type TokenCreateDaoLoginEventDeviceExists interface {
+	Exec(ctx context.Context, request *dao.LoginEventDeviceExistsRequest) (bool, error)
+}
+
+// TokenCreateSmtp is the mailer used to notify the owner of a sign-in from a new device.
+type TokenCreateSmtp = smtp.Sender
+
 // TokenCreateServiceSignClaims provides JWT signing capabilities.
 type TokenCreateServiceSignClaims interface {
 	ClaimsSign(
@@ -34,25 +60,61 @@ type TokenCreateRequest struct {
 	Email string `validate:"required,email,max=1024"`
 	// Password is the plaintext password to verify against the stored hash.
 	Password string `validate:"required,max=1024"`
+
+	// IP and UserAgent identify the client, and are recorded in the login history. Both
+	// are optional.
+	IP        string
+	UserAgent string
 }
 
 // TokenCreate authenticates a user by email and password and issues a fresh
 // access/refresh token pair.
+//
+// Every attempt lands in the login history, and a successful one also updates the last
+// login time of the account. Both are recorded on a best-effort basis: a failure to write
+// them is logged, and does not fail the sign-in.
+//
+// A successful sign-in from a device, an IP and user agent pair, the login history has no
+// success for notifies the owner of the account, unless they opted out of non-critical
+// notices. The first sign-in of an account has no history to compare with, and sends none.
 type TokenCreate struct {
-	dao               TokenCreateDao
-	serviceSignClaims TokenCreateServiceSignClaims
+	dao                       TokenCreateDao
+	daoLoginEventInsert       TokenCreateDaoLoginEventInsert
+	daoUpdateLastLogin        TokenCreateDaoUpdateLastLogin
+	daoLoginEventDeviceExists TokenCreateDaoLoginEventDeviceExists
+	serviceSignClaims         TokenCreateServiceSignClaims
+	smtp                      smtp.Sender
+	emails                    config.Emails
+
+	wg sync.WaitGroup
 }
 
 func NewTokenCreate(
 	dao TokenCreateDao,
+	daoLoginEventInsert TokenCreateDaoLoginEventInsert,
+	daoUpdateLastLogin TokenCreateDaoUpdateLastLogin,
+	daoLoginEventDeviceExists TokenCreateDaoLoginEventDeviceExists,
 	serviceSignClaims TokenCreateServiceSignClaims,
+	smtp smtp.Sender,
+	emails config.Emails,
 ) *TokenCreate {
 	return &TokenCreate{
-		dao:               dao,
-		serviceSignClaims: serviceSignClaims,
+		dao:                       dao,
+		daoLoginEventInsert:       daoLoginEventInsert,
+		daoUpdateLastLogin:        daoUpdateLastLogin,
+		daoLoginEventDeviceExists: daoLoginEventDeviceExists,
+		serviceSignClaims:         serviceSignClaims,
+		smtp:                      smtp,
+		emails:                    emails,
 	}
 }
 
+// Wait blocks until every in-flight new-device notice has finished sending, so callers can
+// drain pending deliveries before shutdown.
+func (service *TokenCreate) Wait() {
+	service.wg.Wait()
+}
+
 // Exec verifies the request's email and password and returns a freshly signed
 // access/refresh token pair. See signTokenPair for how the two tokens are bound.
 //
@@ -72,8 +134,13 @@ func (service *TokenCreate) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	email, err := service.emails.Canonical(request.Email)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	credentials, err := service.dao.Exec(ctx, &dao.CredentialsSelectByEmailRequest{
-		Email: request.Email,
+		Email: email,
 	})
 	if err != nil {
 		if errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) {
@@ -81,6 +148,7 @@ func (service *TokenCreate) Exec(
 			// wrong password, and the latency reveals nothing about whether the email
 			// is registered. Both outcomes map to 401 downstream.
 			lib.DummyCompareArgon2(request.Password)
+			service.recordAttempt(ctx, request, nil, dao.LoginEventOutcomeUnknownEmail)
 		}
 
 		return nil, otel.ReportError(span, err)
@@ -88,7 +156,7 @@ func (service *TokenCreate) Exec(
 
 	span.SetAttributes(
 		attribute.String("credentials.id", credentials.ID.String()),
-		attribute.String("credentials.role", credentials.Role),
+		attribute.StringSlice("credentials.roles", credentials.Roles),
 	)
 
 	err = lib.CompareArgon2(request.Password, credentials.Password)
@@ -96,6 +164,10 @@ func (service *TokenCreate) Exec(
 		// A wrong password yields lib.ErrInvalidPassword, which the handler maps to 401;
 		// a malformed stored hash yields lib.ErrInvalidHash or lib.ErrIncompatibleVersion.
 		// Both land on the span so it shows what the request hit.
+		if errors.Is(err, lib.ErrInvalidPassword) {
+			service.recordAttempt(ctx, request, credentials, dao.LoginEventOutcomeInvalidPassword)
+		}
+
 		return nil, otel.ReportError(span, fmt.Errorf("compare password: %w", err))
 	}
 
@@ -104,5 +176,105 @@ func (service *TokenCreate) Exec(
 		return nil, otel.ReportError(span, fmt.Errorf("sign token pair: %w", err))
 	}
 
+	// Look the device up before this sign-in joins the history.
+	service.checkDevice(ctx, request, credentials)
+
+	service.recordAttempt(ctx, request, credentials, dao.LoginEventOutcomeSuccess)
+
+	_, err = service.daoUpdateLastLogin.Exec(ctx, &dao.CredentialsUpdateLastLoginRequest{
+		ID:  credentials.ID,
+		Now: time.Now(),
+	})
+	if err != nil {
+		otel.Logger().ErrorContext(ctx, fmt.Errorf("update last login: %w", err).Error())
+	}
+
 	return otel.ReportSuccess(span, tokens), nil
 }
+
+// checkDevice notifies the owner of the account when the device of the request never signed in
+// before. Errors are logged: the notice is best-effort, like the history it relies on.
+func (service *TokenCreate) checkDevice(
+	ctx context.Context, request *TokenCreateRequest, credentials *dao.Credentials,
+) {
+	// A first sign-in has no history to compare with. Without any client information, every
+	// sign-in would look like the same device.
+	if credentials.NoticesOptOut || credentials.LastLoginAt == nil || (request.IP == "" && request.UserAgent == "") {
+		return
+	}
+
+	exists, err := service.daoLoginEventDeviceExists.Exec(ctx, &dao.LoginEventDeviceExistsRequest{
+		UserID:    credentials.ID,
+		IP:        request.IP,
+		UserAgent: request.UserAgent,
+	})
+	if err != nil {
+		otel.Logger().ErrorContext(ctx, fmt.Errorf("look up device: %w", err).Error())
+
+		return
+	}
+
+	if exists {
+		return
+	}
+
+	service.wg.Add(1)
+
+	go service.sendMail(
+		context.WithoutCancel(ctx),
+		credentials.Email,
+		lo.CoalesceOrEmpty(credentials.Locale, config.LangDefault),
+		request,
+	)
+}
+
+func (service *TokenCreate) sendMail(ctx context.Context, email, lang string, request *TokenCreateRequest) {
+	defer service.wg.Done()
+
+	_, span := otel.Tracer().Start(ctx, "service.TokenCreate(sendMail)")
+	defer span.End()
+	defer otel.RecoverPanic(ctx, span)
+
+	span.SetAttributes(
+		attribute.String("user.email", email),
+		attribute.String("email.lang", lang),
+	)
+
+	logger := otel.Logger()
+
+	err := service.smtp.SendMail(
+		smtp.MailUsers{{Email: email}},
+		mails.Mails.NewDevice,
+		lang,
+		map[string]any{
+			mails.TemplateVarIP:        request.IP,
+			mails.TemplateVarUserAgent: request.UserAgent,
+			mails.TemplateVarBanner:    assets.BannerBase64,
+			mails.TemplateVarPurpose:   "new-device",
+		},
+	)
+	if err != nil {
+		logger.ErrorContext(ctx, otel.ReportError(span, err).Error())
+
+		return
+	}
+
+	logger.InfoContext(ctx, "new device notice sent to "+email)
+	otel.ReportSuccessNoContent(span)
+}
+
+// recordAttempt adds the attempt to the login history. Credentials are nil when the email
+// matched no account.
+func (service *TokenCreate) recordAttempt(
+	ctx context.Context, request *TokenCreateRequest, credentials *dao.Credentials, outcome string,
+) {
+	var userID *uuid.UUID
+	if credentials != nil {
+		userID = &credentials.ID
+	}
+
+	recordLoginEvent(
+		ctx, service.daoLoginEventInsert,
+		userID, request.Email, dao.LoginEventKindLogin, outcome, request.IP, request.UserAgent,
+	)
+}
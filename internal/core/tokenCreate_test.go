@@ -2,7 +2,9 @@ package core_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -12,12 +14,15 @@ import (
 	"github.com/a-novel/service-json-keys/v2/pkg/go"
 
 	"github.com/a-novel-kit/golib/grpcf"
+	"github.com/a-novel-kit/golib/smtp"
 
 	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/lib"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails"
+	"github.com/a-novel/service-authentication/v2/internal/models/mails/assets"
 )
 
 func TestTokenCreate(t *testing.T) {
@@ -43,6 +48,22 @@ func TestTokenCreate(t *testing.T) {
 		err error
 	}
 
+	type loginEventMock struct {
+		outcome string
+		err     error
+	}
+
+	type updateLastLoginMock struct {
+		err error
+	}
+
+	type deviceExistsMock struct {
+		resp bool
+		err  error
+	}
+
+	lastLoginAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	testCases := []struct {
 		name string
 
@@ -51,6 +72,12 @@ func TestTokenCreate(t *testing.T) {
 		daoMock               *daoMock
 		issueRefreshTokenMock *issueRefreshTokenMock
 		issueTokenMock        *issueTokenMock
+		loginEventMock        *loginEventMock
+		updateLastLoginMock   *updateLastLoginMock
+		deviceExistsMock      *deviceExistsMock
+
+		// expectMailLang is the language of the new-device notice. Empty when no notice is sent.
+		expectMailLang string
 
 		expect    *core.Token
 		expectErr error
@@ -58,13 +85,199 @@ func TestTokenCreate(t *testing.T) {
 		{
 			name: "Success",
 
-			request: &core.TokenCreateRequest{Email: "user@provider.com", Password: passwordRaw},
+			request: &core.TokenCreateRequest{
+				Email:     "user@provider.com",
+				Password:  passwordRaw,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Password: passwordArgon2ed,
+					Roles:    []string{config.RoleUser},
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/NewDevice",
+
+			request: &core.TokenCreateRequest{
+				Email:     "user@provider.com",
+				Password:  passwordRaw,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:       "user@provider.com",
+					Password:    passwordArgon2ed,
+					Roles:       []string{config.RoleUser},
+					Locale:      config.LangFR,
+					LastLoginAt: &lastLoginAt,
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+			deviceExistsMock:    &deviceExistsMock{resp: false},
+
+			expectMailLang: config.LangFR,
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/KnownDevice",
+
+			request: &core.TokenCreateRequest{
+				Email:     "user@provider.com",
+				Password:  passwordRaw,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:       "user@provider.com",
+					Password:    passwordArgon2ed,
+					Roles:       []string{config.RoleUser},
+					LastLoginAt: &lastLoginAt,
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+			deviceExistsMock:    &deviceExistsMock{resp: true},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/NewDevice/OptOut",
+
+			request: &core.TokenCreateRequest{
+				Email:     "user@provider.com",
+				Password:  passwordRaw,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:         "user@provider.com",
+					Password:      passwordArgon2ed,
+					Roles:         []string{config.RoleUser},
+					NoticesOptOut: true,
+					LastLoginAt:   &lastLoginAt,
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			// The lookup is best-effort: its failure does not fail the sign-in.
+			name: "Success/DeviceLookupError",
+
+			request: &core.TokenCreateRequest{
+				Email:     "user@provider.com",
+				Password:  passwordRaw,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:       "user@provider.com",
+					Password:    passwordArgon2ed,
+					Roles:       []string{config.RoleUser},
+					LastLoginAt: &lastLoginAt,
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+			deviceExistsMock:    &deviceExistsMock{err: errFoo},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/EmailCase",
+
+			request: &core.TokenCreateRequest{Email: "User@Provider.com", Password: passwordRaw},
 
 			daoMock: &daoMock{
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleUser,
+					Roles:    []string{config.RoleUser},
 				},
 			},
 
@@ -76,6 +289,39 @@ func TestTokenCreate(t *testing.T) {
 				},
 			},
 
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			name: "Success/EmailVerified",
+
+			request: &core.TokenCreateRequest{Email: "user@provider.com", Password: passwordRaw},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Password:        passwordArgon2ed,
+					Roles:           []string{config.RoleUser},
+					EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
 			expect: &core.Token{
 				AccessToken:  "access-token",
 				RefreshToken: mockUnsignedRefreshToken,
@@ -90,7 +336,7 @@ func TestTokenCreate(t *testing.T) {
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleUser,
+					Roles:    []string{config.RoleUser},
 				},
 			},
 
@@ -102,6 +348,9 @@ func TestTokenCreate(t *testing.T) {
 				},
 			},
 
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
 			expect: &core.Token{
 				AccessToken:  "access-token",
 				RefreshToken: mockUnsignedRefreshToken,
@@ -116,7 +365,7 @@ func TestTokenCreate(t *testing.T) {
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleAdmin,
+					Roles:    []string{config.RoleAdmin},
 				},
 			},
 
@@ -128,6 +377,9 @@ func TestTokenCreate(t *testing.T) {
 				},
 			},
 
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
 			expect: &core.Token{
 				AccessToken:  "access-token",
 				RefreshToken: mockUnsignedRefreshToken,
@@ -142,7 +394,38 @@ func TestTokenCreate(t *testing.T) {
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleSuperAdmin,
+					Roles:    []string{config.RoleSuperAdmin},
+				},
+			},
+
+			issueRefreshTokenMock: &issueRefreshTokenMock{},
+
+			issueTokenMock: &issueTokenMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: "access-token",
+				},
+			},
+
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess},
+			updateLastLoginMock: &updateLastLoginMock{},
+
+			expect: &core.Token{
+				AccessToken:  "access-token",
+				RefreshToken: mockUnsignedRefreshToken,
+			},
+		},
+		{
+			// The login history is informational: failing to write it does not fail the
+			// sign-in.
+			name: "Success/RecordFailure",
+
+			request: &core.TokenCreateRequest{Email: "user@provider.com", Password: passwordRaw},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Password: passwordArgon2ed,
+					Roles:    []string{config.RoleUser},
 				},
 			},
 
@@ -154,6 +437,9 @@ func TestTokenCreate(t *testing.T) {
 				},
 			},
 
+			loginEventMock:      &loginEventMock{outcome: dao.LoginEventOutcomeSuccess, err: errFoo},
+			updateLastLoginMock: &updateLastLoginMock{err: errFoo},
+
 			expect: &core.Token{
 				AccessToken:  "access-token",
 				RefreshToken: mockUnsignedRefreshToken,
@@ -168,12 +454,27 @@ func TestTokenCreate(t *testing.T) {
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleUser,
+					Roles:    []string{config.RoleUser},
 				},
 			},
 
+			loginEventMock: &loginEventMock{outcome: dao.LoginEventOutcomeInvalidPassword},
+
 			expectErr: lib.ErrInvalidPassword,
 		},
+		{
+			name: "Error/UnknownEmail",
+
+			request: &core.TokenCreateRequest{Email: "user@provider.com", Password: passwordRaw},
+
+			daoMock: &daoMock{
+				err: dao.ErrCredentialsSelectByEmailNotFound,
+			},
+
+			loginEventMock: &loginEventMock{outcome: dao.LoginEventOutcomeUnknownEmail},
+
+			expectErr: dao.ErrCredentialsSelectByEmailNotFound,
+		},
 		{
 			name: "Error/IssueToken",
 
@@ -183,7 +484,7 @@ func TestTokenCreate(t *testing.T) {
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleUser,
+					Roles:    []string{config.RoleUser},
 				},
 			},
 
@@ -204,7 +505,7 @@ func TestTokenCreate(t *testing.T) {
 				resp: &dao.Credentials{
 					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Password: passwordArgon2ed,
-					Role:     config.RoleUser,
+					Roles:    []string{config.RoleUser},
 				},
 			},
 
@@ -234,12 +535,16 @@ func TestTokenCreate(t *testing.T) {
 			ctx := t.Context()
 
 			mockDao := coremocks.NewMockTokenCreateDao(t)
+			daoLoginEventInsert := coremocks.NewMockTokenCreateDaoLoginEventInsert(t)
+			daoUpdateLastLogin := coremocks.NewMockTokenCreateDaoUpdateLastLogin(t)
 			serviceSignClaims := coremocks.NewMockTokenCreateServiceSignClaims(t)
+			daoDeviceExists := coremocks.NewMockTokenCreateDaoLoginEventDeviceExists(t)
+			smtpService := coremocks.NewMockTokenCreateSmtp(t)
 
 			if testCase.daoMock != nil {
 				mockDao.EXPECT().
 					Exec(mock.Anything, &dao.CredentialsSelectByEmailRequest{
-						Email: testCase.request.Email,
+						Email: strings.ToLower(testCase.request.Email),
 					}).
 					Return(testCase.daoMock.resp, testCase.daoMock.err)
 			}
@@ -271,22 +576,92 @@ func TestTokenCreate(t *testing.T) {
 							Usage: servicejsonkeys.KeyUsageAuth,
 							Payload: lo.Must(grpcf.MarshalJSONAsAny(core.AccessTokenClaims{
 								UserID:         &testCase.daoMock.resp.ID,
-								Roles:          []string{testCase.daoMock.resp.Role},
+								Roles:          testCase.daoMock.resp.Roles,
 								RefreshTokenID: mockUnsignedJTI,
+								EmailVerified:  testCase.daoMock.resp.EmailVerifiedAt != nil,
 							})),
 						},
 					).
 					Return(testCase.issueTokenMock.resp, testCase.issueTokenMock.err)
 			}
 
-			service := core.NewTokenCreate(mockDao, serviceSignClaims)
+			if testCase.loginEventMock != nil {
+				var userID *uuid.UUID
+				if testCase.daoMock.resp != nil {
+					userID = &testCase.daoMock.resp.ID
+				}
+
+				daoLoginEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(req *dao.LoginEventInsertRequest) bool {
+						return req.ID != uuid.Nil &&
+							lo.FromPtr(req.UserID) == lo.FromPtr(userID) &&
+							(req.UserID == nil) == (userID == nil) &&
+							req.Email == testCase.request.Email &&
+							req.Kind == dao.LoginEventKindLogin &&
+							req.Outcome == testCase.loginEventMock.outcome &&
+							req.IP == testCase.request.IP &&
+							req.UserAgent == testCase.request.UserAgent &&
+							time.Since(req.Now) < time.Minute
+					})).
+					Return(nil, testCase.loginEventMock.err)
+			}
+
+			if testCase.updateLastLoginMock != nil {
+				daoUpdateLastLogin.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(req *dao.CredentialsUpdateLastLoginRequest) bool {
+						return req.ID == testCase.daoMock.resp.ID && time.Since(req.Now) < time.Minute
+					})).
+					Return(nil, testCase.updateLastLoginMock.err)
+			}
+
+			if testCase.deviceExistsMock != nil {
+				daoDeviceExists.EXPECT().
+					Exec(mock.Anything, &dao.LoginEventDeviceExistsRequest{
+						UserID:    testCase.daoMock.resp.ID,
+						IP:        testCase.request.IP,
+						UserAgent: testCase.request.UserAgent,
+					}).
+					Return(testCase.deviceExistsMock.resp, testCase.deviceExistsMock.err)
+			}
+
+			if testCase.expectMailLang != "" {
+				smtpService.EXPECT().
+					SendMail(
+						smtp.MailUsers{{Email: testCase.daoMock.resp.Email}},
+						mails.Mails.NewDevice,
+						testCase.expectMailLang,
+						map[string]any{
+							"IP":        testCase.request.IP,
+							"UserAgent": testCase.request.UserAgent,
+							"Banner":    assets.BannerBase64,
+							"_Purpose":  "new-device",
+						},
+					).
+					Return(nil)
+			}
+
+			service := core.NewTokenCreate(
+				mockDao,
+				daoLoginEventInsert,
+				daoUpdateLastLogin,
+				daoDeviceExists,
+				serviceSignClaims,
+				smtpService,
+				config.EmailsPresetDefault,
+			)
 
 			resp, err := service.Exec(ctx, testCase.request)
 			require.ErrorIs(t, err, testCase.expectErr)
 			require.Equal(t, testCase.expect, resp)
 
+			service.Wait()
+
 			mockDao.AssertExpectations(t)
+			daoLoginEventInsert.AssertExpectations(t)
+			daoUpdateLastLogin.AssertExpectations(t)
 			serviceSignClaims.AssertExpectations(t)
+			daoDeviceExists.AssertExpectations(t)
+			smtpService.AssertExpectations(t)
 		})
 	}
 }
@@ -3,7 +3,10 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/rand/v2"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
@@ -15,6 +18,7 @@ import (
 	"github.com/a-novel-kit/jwt/v2/jwp"
 	"github.com/a-novel-kit/jwt/v2/jws"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 )
 
@@ -36,6 +40,10 @@ var (
 	// token's RefreshTokenID claim does not match the refresh token's JTI. An access
 	// token may only be renewed by the refresh token that minted it.
 	ErrTokenRefreshMismatchSource = errors.New("refresh token not issued from access token")
+	// ErrTokenRefreshAccountMerged is returned by [TokenRefresh.Exec] when the account of the
+	// session was merged into another one. The session ends: the user signs in again, on the
+	// destination account.
+	ErrTokenRefreshAccountMerged = errors.New("account was merged into another one")
 )
 
 // TokenRefreshDao reloads the current credentials of the user being refreshed.
@@ -43,6 +51,17 @@ type TokenRefreshDao interface {
 	Exec(ctx context.Context, request *dao.CredentialsSelectRequest) (*dao.Credentials, error)
 }
 
+// TokenRefreshDaoLoginEventInsert records sampled refreshes in the login history.
+type TokenRefreshDaoLoginEventInsert interface {
+	Exec(ctx context.Context, request *dao.LoginEventInsertRequest) (*dao.LoginEvent, error)
+}
+
+// TokenRefreshDaoCredentialsRedirectSelect resolves the ID of a merged account to the account it
+// was merged into.
+type TokenRefreshDaoCredentialsRedirectSelect interface {
+	Exec(ctx context.Context, request *dao.CredentialsRedirectSelectRequest) (*dao.CredentialsRedirect, error)
+}
+
 // TokenRefreshServiceSignClaims signs the new access token.
 type TokenRefreshServiceSignClaims interface {
 	ClaimsSign(
@@ -71,28 +90,50 @@ type TokenRefreshServiceVerifyRefreshClaims interface {
 type TokenRefreshRequest struct {
 	AccessToken  string `validate:"required,max=1024"`
 	RefreshToken string `validate:"required,max=1024"`
+
+	// IP and UserAgent identify the client, and are recorded in the login history when
+	// the refresh is sampled. Both are optional.
+	IP        string
+	UserAgent string
 }
 
 // TokenRefresh renews an access token from a valid refresh token, minting a new access
 // token that reflects the user's current roles while reusing the same refresh token.
+//
+// A share of the successful refreshes, set by config.LoginEvents, is recorded in the login
+// history on a best-effort basis.
+//
+// A session of an account merged into another one is not carried over to the destination: a
+// new pair would outlive the refresh token it replaces, so a leaked token of the merged account
+// would grant a session that never ends. The refresh fails with [ErrTokenRefreshAccountMerged]
+// instead.
 type TokenRefresh struct {
-	dao                        TokenRefreshDao
-	serviceSignClaims          TokenRefreshServiceSignClaims
-	serviceVerifyClaims        TokenRefreshServiceVerifyClaims
-	serviceVerifyRefreshClaims TokenRefreshServiceVerifyRefreshClaims
+	dao                          TokenRefreshDao
+	daoLoginEventInsert          TokenRefreshDaoLoginEventInsert
+	daoCredentialsRedirectSelect TokenRefreshDaoCredentialsRedirectSelect
+	serviceSignClaims            TokenRefreshServiceSignClaims
+	serviceVerifyClaims          TokenRefreshServiceVerifyClaims
+	serviceVerifyRefreshClaims   TokenRefreshServiceVerifyRefreshClaims
+	loginEvents                  config.LoginEvents
 }
 
 func NewTokenRefresh(
 	dao TokenRefreshDao,
+	daoLoginEventInsert TokenRefreshDaoLoginEventInsert,
+	daoCredentialsRedirectSelect TokenRefreshDaoCredentialsRedirectSelect,
 	serviceSignClaims TokenRefreshServiceSignClaims,
 	serviceVerifyClaims TokenRefreshServiceVerifyClaims,
 	serviceVerifyRefreshClaims TokenRefreshServiceVerifyRefreshClaims,
+	loginEvents config.LoginEvents,
 ) *TokenRefresh {
 	return &TokenRefresh{
-		dao:                        dao,
-		serviceSignClaims:          serviceSignClaims,
-		serviceVerifyClaims:        serviceVerifyClaims,
-		serviceVerifyRefreshClaims: serviceVerifyRefreshClaims,
+		dao:                          dao,
+		daoLoginEventInsert:          daoLoginEventInsert,
+		daoCredentialsRedirectSelect: daoCredentialsRedirectSelect,
+		serviceSignClaims:            serviceSignClaims,
+		serviceVerifyClaims:          serviceVerifyClaims,
+		serviceVerifyRefreshClaims:   serviceVerifyRefreshClaims,
+		loginEvents:                  loginEvents,
 	}
 }
 
@@ -161,19 +202,23 @@ func (service *TokenRefresh) Exec(
 		return nil, otel.ReportError(span, ErrTokenRefreshMismatchSource)
 	}
 
-	// Reload credentials so any role change since the original sign lands in the new token.
+	// Reload credentials so any role change or email verification since the original sign lands
+	// in the new token.
 	credentials, err := service.dao.Exec(ctx, &dao.CredentialsSelectRequest{
 		ID: lo.FromPtr(accessTokenClaims.UserID),
 	})
+	if errors.Is(err, dao.ErrCredentialsSelectNotFound) {
+		err = service.missingCredentialsError(ctx, lo.FromPtr(accessTokenClaims.UserID), err)
+
+		return nil, otel.ReportError(span, err)
+	}
+
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
 
-	newAccessTokenClaims, err := grpcf.MarshalJSONAsAny(AccessTokenClaims{
-		UserID:         accessTokenClaims.UserID,
-		Roles:          []string{credentials.Role},
-		RefreshTokenID: refreshTokenClaims.Jti,
-	})
+	// The temporary grants are read again too: an expired grant is not carried over.
+	newAccessTokenPayload, err := grpcf.MarshalJSONAsAny(newAccessTokenClaims(credentials, refreshTokenClaims.Jti))
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
@@ -182,15 +227,40 @@ func (service *TokenRefresh) Exec(
 		ctx,
 		&servicejsonkeys.ClaimsSignRequest{
 			Usage:   servicejsonkeys.KeyUsageAuth,
-			Payload: newAccessTokenClaims,
+			Payload: newAccessTokenPayload,
 		},
 	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
 
+	// rand.Float64 is in [0, 1): a rate of 0 records nothing, a rate of 1 everything.
+	if rand.Float64() < service.loginEvents.RefreshSampleRate {
+		recordLoginEvent(
+			ctx, service.daoLoginEventInsert,
+			&credentials.ID, "", dao.LoginEventKindRefresh, dao.LoginEventOutcomeSuccess, request.IP, request.UserAgent,
+		)
+	}
+
 	return otel.ReportSuccess(span, &Token{
 		AccessToken:  newAccessToken.GetToken(),
 		RefreshToken: request.RefreshToken, // Refresh token does not change.
 	}), nil
 }
+
+// missingCredentialsError tells a deleted account from a merged one, so clients can explain why
+// the session ended. notFoundErr is returned as is when the account was not merged.
+func (service *TokenRefresh) missingCredentialsError(ctx context.Context, userID uuid.UUID, notFoundErr error) error {
+	redirect, err := service.daoCredentialsRedirectSelect.Exec(ctx, &dao.CredentialsRedirectSelectRequest{
+		SourceID: userID,
+	})
+	if errors.Is(err, dao.ErrCredentialsRedirectSelectNotFound) {
+		return notFoundErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("select redirect: %w", err)
+	}
+
+	return fmt.Errorf("%w: %v merged into %v", ErrTokenRefreshAccountMerged, userID, redirect.DestinationID)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -16,6 +17,7 @@ import (
 	"github.com/a-novel-kit/jwt/v2/jwp"
 	"github.com/a-novel-kit/jwt/v2/jws"
 
+	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	coremocks "github.com/a-novel/service-authentication/v2/internal/core/mocks"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
@@ -46,6 +48,15 @@ func TestTokenRefresh(t *testing.T) {
 		err  error
 	}
 
+	type loginEventMock struct {
+		err error
+	}
+
+	type redirectMock struct {
+		resp *dao.CredentialsRedirect
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
@@ -56,6 +67,18 @@ func TestTokenRefresh(t *testing.T) {
 		serviceVerifyClaimsMock        *serviceVerifyClaimsMock
 		serviceVerifyRefreshClaimsMock *serviceVerifyRefreshClaimsMock
 
+		// sampleRate is the share of refreshes recorded. Cases leave it at 0 unless they
+		// test the login history, and set it to 1 when they do, so sampling is deterministic.
+		sampleRate     float64
+		loginEventMock *loginEventMock
+
+		// redirectMock resolves the user when its credentials are not found.
+		redirectMock *redirectMock
+
+		// expectRoles are the roles of the new access token, when they differ from the roles
+		// the credentials hold.
+		expectRoles []string
+
 		expect    *core.Token
 		expectErr error
 	}{
@@ -84,8 +107,145 @@ func TestTokenRefresh(t *testing.T) {
 
 			daoMock: &daoMock{
 				resp: &dao.Credentials{
-					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Role: "admin",
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles: []string{"admin"},
+				},
+			},
+
+			signClaimsMock: &signClaimsMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				},
+			},
+
+			expect: &core.Token{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+		},
+
+		{
+			name: "Success/Sampled",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+				IP:           "192.0.2.1",
+				UserAgent:    "Mozilla/5.0",
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"admin"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles: []string{"admin"},
+				},
+			},
+
+			signClaimsMock: &signClaimsMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				},
+			},
+
+			sampleRate:     1,
+			loginEventMock: &loginEventMock{},
+
+			expect: &core.Token{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+		},
+		{
+			// Recording the refresh is best-effort, and never fails it.
+			name: "Success/SampledRecordFailure",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+				IP:           "192.0.2.1",
+				UserAgent:    "Mozilla/5.0",
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"admin"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles: []string{"admin"},
+				},
+			},
+
+			signClaimsMock: &signClaimsMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				},
+			},
+
+			sampleRate:     1,
+			loginEventMock: &loginEventMock{err: errFoo},
+
+			expect: &core.Token{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+		},
+
+		{
+			name: "Success/EmailVerified",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"admin"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles:           []string{"admin"},
+					EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
 				},
 			},
 
@@ -100,7 +260,51 @@ func TestTokenRefresh(t *testing.T) {
 				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
 			},
 		},
+		{
+			name: "Success/Grants",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"user"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				resp: &dao.Credentials{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles:          []string{"user"},
+					GrantedRoles:   []string{"admin"},
+					GrantsExpireAt: lo.ToPtr(time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC)),
+				},
+			},
+
+			signClaimsMock: &signClaimsMock{
+				resp: &servicejsonkeys.ClaimsSignResponse{
+					Token: base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				},
+			},
+
+			expectRoles: []string{"admin", "user"},
 
+			expect: &core.Token{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+		},
 		{
 			name: "SignError",
 
@@ -126,8 +330,8 @@ func TestTokenRefresh(t *testing.T) {
 
 			daoMock: &daoMock{
 				resp: &dao.Credentials{
-					ID:   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Role: "admin",
+					ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles: []string{"admin"},
 				},
 			},
 
@@ -348,6 +552,110 @@ func TestTokenRefresh(t *testing.T) {
 
 			expectErr: core.ErrTokenRefreshMismatchSource,
 		},
+		{
+			// A merged account does not resume on the destination: a new pair would outlive the
+			// refresh token it replaces.
+			name: "Error/Redirected",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"user"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			redirectMock: &redirectMock{
+				resp: &dao.CredentialsRedirect{
+					SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					DestinationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				},
+			},
+
+			expectErr: core.ErrTokenRefreshAccountMerged,
+		},
+		{
+			name: "SelectCredentialsNotFound",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"user"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			redirectMock: &redirectMock{
+				err: dao.ErrCredentialsRedirectSelectNotFound,
+			},
+
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "SelectRedirectError",
+
+			request: &core.TokenRefreshRequest{
+				AccessToken:  base64.RawURLEncoding.EncodeToString([]byte("access-token")),
+				RefreshToken: base64.RawURLEncoding.EncodeToString([]byte("refresh_token")),
+			},
+
+			serviceVerifyClaimsMock: &serviceVerifyClaimsMock{
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"user"},
+					RefreshTokenID: "refresh_token_id",
+				},
+			},
+
+			serviceVerifyRefreshClaimsMock: &serviceVerifyRefreshClaimsMock{
+				resp: &core.RefreshTokenClaims{
+					Jti:    "refresh_token_id",
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+			},
+
+			daoMock: &daoMock{
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			redirectMock: &redirectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -355,6 +663,8 @@ func TestTokenRefresh(t *testing.T) {
 			t.Parallel()
 
 			mockDao := coremocks.NewMockTokenRefreshDao(t)
+			daoLoginEventInsert := coremocks.NewMockTokenRefreshDaoLoginEventInsert(t)
+			daoCredentialsRedirectSelect := coremocks.NewMockTokenRefreshDaoCredentialsRedirectSelect(t)
 			serviceSignClaims := coremocks.NewMockTokenRefreshServiceSignClaims(t)
 			serviceVerifyClaims := coremocks.NewMockTokenRefreshServiceVerifyClaims(t)
 			serviceVerifyRefreshClaims := coremocks.NewMockTokenRefreshServiceVerifyRefreshClaims(t)
@@ -400,19 +710,47 @@ func TestTokenRefresh(t *testing.T) {
 							Usage: servicejsonkeys.KeyUsageAuth,
 							Payload: lo.Must(grpcf.MarshalJSONAsAny(&core.AccessTokenClaims{
 								UserID:         testCase.serviceVerifyClaimsMock.resp.UserID,
-								Roles:          []string{testCase.daoMock.resp.Role},
+								Roles:          lo.CoalesceSliceOrEmpty(testCase.expectRoles, testCase.daoMock.resp.Roles),
 								RefreshTokenID: testCase.serviceVerifyRefreshClaimsMock.resp.Jti,
+								EmailVerified:  testCase.daoMock.resp.EmailVerifiedAt != nil,
+								GrantExpiresAt: testCase.daoMock.resp.GrantsExpireAt,
 							})),
 						},
 					).
 					Return(testCase.signClaimsMock.resp, testCase.signClaimsMock.err)
 			}
 
+			if testCase.loginEventMock != nil {
+				daoLoginEventInsert.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(req *dao.LoginEventInsertRequest) bool {
+						return req.ID != uuid.Nil &&
+							lo.FromPtr(req.UserID) == testCase.daoMock.resp.ID &&
+							req.Email == "" &&
+							req.Kind == dao.LoginEventKindRefresh &&
+							req.Outcome == dao.LoginEventOutcomeSuccess &&
+							req.IP == testCase.request.IP &&
+							req.UserAgent == testCase.request.UserAgent &&
+							time.Since(req.Now) < time.Minute
+					})).
+					Return(nil, testCase.loginEventMock.err)
+			}
+
+			if testCase.redirectMock != nil {
+				daoCredentialsRedirectSelect.EXPECT().
+					Exec(mock.Anything, &dao.CredentialsRedirectSelectRequest{
+						SourceID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					}).
+					Return(testCase.redirectMock.resp, testCase.redirectMock.err)
+			}
+
 			service := core.NewTokenRefresh(
 				mockDao,
+				daoLoginEventInsert,
+				daoCredentialsRedirectSelect,
 				serviceSignClaims,
 				serviceVerifyClaims,
 				serviceVerifyRefreshClaims,
+				config.LoginEvents{RefreshSampleRate: testCase.sampleRate},
 			)
 
 			resp, err := service.Exec(t.Context(), testCase.request)
@@ -421,6 +759,8 @@ func TestTokenRefresh(t *testing.T) {
 			require.Equal(t, testCase.expect, resp)
 
 			mockDao.AssertExpectations(t)
+			daoLoginEventInsert.AssertExpectations(t)
+			daoCredentialsRedirectSelect.AssertExpectations(t)
 			serviceSignClaims.AssertExpectations(t)
 			serviceVerifyClaims.AssertExpectations(t)
 			serviceVerifyRefreshClaims.AssertExpectations(t)
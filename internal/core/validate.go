@@ -41,6 +41,16 @@ func init() {
 		panic(err)
 	}
 
+	err = validate.RegisterValidation("permission", ValidatePermission)
+	if err != nil {
+		panic(err)
+	}
+
+	err = validate.RegisterValidation("permissionGrant", ValidatePermissionGrant)
+	if err != nil {
+		panic(err)
+	}
+
 	err = validate.RegisterValidation("usage", ValidateShortCodeUsage)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// AuditEvent records an administrative action. Events form a hash chain, in the order of Seq:
+// each hash covers the content of its event and the hash of the event before it. Reads are
+// recorded outside the chain, without a hash.
+type AuditEvent struct {
+	bun.BaseModel `bun:"table:audit_events"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// Seq is the position of the event in the chain, assigned by the database.
+	Seq int64 `bun:"seq"`
+
+	// ActorID is the user that performed the action. Nil for actions run by the system.
+	ActorID *uuid.UUID `bun:"actor_id,type:uuid"`
+	// TargetID is the account the action applied to. Nil when the action has no single target.
+	TargetID *uuid.UUID `bun:"target_id,type:uuid"`
+	// Action names what was done, for example "credentials.updateRole".
+	Action string `bun:"action"`
+
+	// Before and After are JSON snapshots of the state the action changed. Nil when the action
+	// changed nothing, or created the state.
+	Before []byte `bun:"before"`
+	After  []byte `bun:"after"`
+
+	// RequestID identifies the HTTP request that caused the action. Empty outside a request.
+	RequestID string `bun:"request_id,nullzero"`
+
+	CreatedAt time.Time `bun:"created_at"`
+
+	// Hash chains the event to the one before it. See [AuditEventHash]. Nil for the events recorded
+	// outside the chain.
+	Hash []byte `bun:"hash"`
+}
+
+// AuditEventHash computes the hash of event, chained to previous, the hash of the event before
+// it in the chain. previous is nil for the first event.
+//
+// Every field is length-prefixed, so no two distinct events share an encoding. Seq and Hash are
+// left out: the chain itself orders the events.
+func AuditEventHash(previous []byte, event *AuditEvent) []byte {
+	digest := sha256.New()
+
+	writeField := func(field []byte) {
+		_, _ = digest.Write(binary.BigEndian.AppendUint64(nil, uint64(len(field))))
+		_, _ = digest.Write(field)
+	}
+
+	optionalUUID := func(id *uuid.UUID) []byte {
+		if id == nil {
+			return nil
+		}
+
+		return id[:]
+	}
+
+	writeField(previous)
+	writeField(event.ID[:])
+	writeField(optionalUUID(event.ActorID))
+	writeField(optionalUUID(event.TargetID))
+	writeField([]byte(event.Action))
+	writeField(event.Before)
+	writeField(event.After)
+	writeField([]byte(event.RequestID))
+	writeField(binary.BigEndian.AppendUint64(nil, uint64(event.CreatedAt.Unix())))
+
+	return digest.Sum(nil)
+}
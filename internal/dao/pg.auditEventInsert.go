@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.auditEventInsert.sql
+var auditEventInsertQuery string
+
+// AuditEventInsertRequest is the input to [AuditEventInsert.Exec].
+type AuditEventInsertRequest struct {
+	// See AuditEvent.ID.
+	ID uuid.UUID
+	// See AuditEvent.ActorID.
+	ActorID *uuid.UUID
+	// See AuditEvent.TargetID.
+	TargetID *uuid.UUID
+	// See AuditEvent.Action.
+	Action string
+	// See AuditEvent.Before. Must be valid JSON when set.
+	Before []byte
+	// See AuditEvent.After. Must be valid JSON when set.
+	After []byte
+	// See AuditEvent.RequestID.
+	RequestID string
+	// Now is the timestamp recorded as the event's creation time. It is truncated to the second,
+	// the precision of the column, before being hashed.
+	Now time.Time
+	// Unchained records the event outside the hash chain: it gets no hash, and takes no lock.
+	// Reads use it, so lookups never wait on one another.
+	Unchained bool
+}
+
+// AuditEventInsert appends an event to the audit chain, or records it outside the chain when
+// the request is Unchained.
+//
+// It joins the caller's transaction when there is one, so the event commits or rolls back with
+// the action it describes. Writers of the chain are serialized on an advisory lock held until
+// that transaction ends: the hash of the current head is read after the previous writer
+// committed. This requires read-committed isolation, the default of the injected transactor.
+type AuditEventInsert struct{}
+
+func NewAuditEventInsert() *AuditEventInsert {
+	return &AuditEventInsert{}
+}
+
+func (dao *AuditEventInsert) Exec(ctx context.Context, request *AuditEventInsertRequest) (*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.AuditEventInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("auditEvent.id", request.ID.String()),
+		attribute.String("auditEvent.action", request.Action),
+		attribute.String("auditEvent.requestID", request.RequestID),
+		attribute.Bool("auditEvent.unchained", request.Unchained),
+	)
+
+	entity := &AuditEvent{
+		ID:        request.ID,
+		ActorID:   request.ActorID,
+		TargetID:  request.TargetID,
+		Action:    request.Action,
+		Before:    request.Before,
+		After:     request.After,
+		RequestID: request.RequestID,
+		// Postgres rounds to the column precision; truncating here keeps the hashed value and the
+		// stored one identical.
+		CreatedAt: request.Now.Truncate(time.Second),
+	}
+
+	err := postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+		var hash []byte
+
+		if !request.Unchained {
+			previous, err := dao.lockHead(ctx)
+			if err != nil {
+				return fmt.Errorf("lock chain head: %w", err)
+			}
+
+			hash = AuditEventHash(previous, entity)
+		}
+
+		tx, err := postgres.GetContext(ctx)
+		if err != nil {
+			return fmt.Errorf("get database handle: %w", err)
+		}
+
+		err = tx.NewRaw(
+			auditEventInsertQuery,
+			entity.ID,
+			entity.ActorID,
+			entity.TargetID,
+			entity.Action,
+			string(entity.Before),
+			string(entity.After),
+			entity.RequestID,
+			entity.CreatedAt,
+			hash,
+		).Scan(ctx, entity)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
+
+//go:embed pg.auditEventInsert.lock.sql
+var auditEventInsertLockQuery string
+
+//go:embed pg.auditEventInsert.head.sql
+var auditEventInsertHeadQuery string
+
+// lockHead takes the chain lock, then returns the hash of the last event of the chain. It returns
+// a nil hash when the chain is empty.
+func (dao *AuditEventInsert) lockHead(ctx context.Context) ([]byte, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.AuditEventInsert(lockHead)")
+	defer span.End()
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get database handle: %w", err))
+	}
+
+	_, err = tx.NewRaw(auditEventInsertLockQuery).Exec(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("acquire lock: %w", err))
+	}
+
+	head := new(AuditEvent)
+
+	err = tx.NewRaw(auditEventInsertHeadQuery).Scan(ctx, head)
+	if errors.Is(err, sql.ErrNoRows) {
+		return otel.ReportSuccess(span, []byte(nil)), nil
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("select head: %w", err))
+	}
+
+	return otel.ReportSuccess(span, head.Hash), nil
+}
@@ -0,0 +1,10 @@
+SELECT
+  *
+FROM
+  audit_events
+WHERE
+  hash IS NOT NULL
+ORDER BY
+  seq DESC
+LIMIT
+  1;
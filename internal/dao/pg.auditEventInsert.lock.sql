@@ -0,0 +1,4 @@
+-- Held until the transaction ends, so writers append to the chain one at a time. The key is
+-- arbitrary, but must stay the same across releases.
+SELECT
+  pg_advisory_xact_lock(hashtext('audit_events'));
@@ -0,0 +1,26 @@
+INSERT INTO
+  audit_events (
+    id,
+    actor_id,
+    target_id,
+    action,
+    before,
+    after,
+    request_id,
+    created_at,
+    hash
+  )
+VALUES
+  (
+    ?0,
+    ?1,
+    ?2,
+    ?3,
+    NULLIF(?4, '')::json,
+    NULLIF(?5, '')::json,
+    NULLIF(?6, ''),
+    ?7,
+    ?8
+  )
+RETURNING
+  *;
@@ -0,0 +1,76 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestAuditEventInsert(t *testing.T) {
+	t.Parallel()
+
+	postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+		t.Helper()
+
+		insertDAO := dao.NewAuditEventInsert()
+
+		first, err := insertDAO.Exec(ctx, &dao.AuditEventInsertRequest{
+			ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Action: "credentials.superAdmin.create",
+			After:  []byte(`{"role": "auth:superadmin"}`),
+			// Sub-second precision is dropped before hashing, so the stored row still verifies.
+			Now: time.Date(2021, 1, 1, 0, 0, 0, 600_000_000, time.UTC),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, &dao.AuditEvent{
+			ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Seq:    first.Seq,
+			Action: "credentials.superAdmin.create",
+			// json keeps the bytes as written, spacing included.
+			After:     []byte(`{"role": "auth:superadmin"}`),
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Hash:      first.Hash,
+		}, first)
+		require.Equal(t, dao.AuditEventHash(nil, first), first.Hash)
+
+		// Reads are recorded outside the chain: no hash, and no effect on the next event of the chain.
+		read, err := insertDAO.Exec(ctx, &dao.AuditEventInsertRequest{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			Action:    "credentials.list",
+			Now:       time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+			Unchained: true,
+		})
+		require.NoError(t, err)
+
+		require.Greater(t, read.Seq, first.Seq)
+		require.Nil(t, read.Hash)
+
+		second, err := insertDAO.Exec(ctx, &dao.AuditEventInsertRequest{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000011")),
+			Action:    "credentials.updateRole",
+			Before:    []byte(`{"role":"auth:user"}`),
+			After:     []byte(`{"role":"auth:admin"}`),
+			RequestID: "request-1",
+			Now:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+
+		require.Greater(t, second.Seq, read.Seq)
+		require.Equal(t, dao.AuditEventHash(first.Hash, second), second.Hash)
+		require.Equal(t, "request-1", second.RequestID)
+	})
+}
@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.auditEventList.sql
+var auditEventListQuery string
+
+// AuditEventListRequest is the input to [AuditEventList.Exec].
+type AuditEventListRequest struct {
+	// Limit caps the number of events returned. Zero returns every event.
+	Limit  int
+	Offset int
+
+	// ActorID and TargetID, if set, restrict the result to the events of that actor, or on
+	// that target.
+	ActorID  *uuid.UUID
+	TargetID *uuid.UUID
+
+	// AfterSeq, if set, resumes the listing right after the event at that position.
+	AfterSeq *int64
+	// Ascending lists the events in chain order, oldest first. The default lists the newest
+	// first.
+	Ascending bool
+	// Chained restricts the result to the events of the hash chain, leaving out those recorded
+	// outside it.
+	Chained bool
+}
+
+// AuditEventList returns a set of paginated audit events from the database.
+type AuditEventList struct{}
+
+func NewAuditEventList() *AuditEventList {
+	return &AuditEventList{}
+}
+
+func (dao *AuditEventList) Exec(ctx context.Context, request *AuditEventListRequest) ([]*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.AuditEventList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("data.limit", request.Limit),
+		attribute.Int("data.offset", request.Offset),
+		attribute.Bool("data.actorID", request.ActorID != nil),
+		attribute.Bool("data.targetID", request.TargetID != nil),
+		attribute.Bool("data.afterSeq", request.AfterSeq != nil),
+		attribute.Bool("data.ascending", request.Ascending),
+		attribute.Bool("data.chained", request.Chained),
+	)
+
+	operator, direction := bun.Safe("<"), bun.Safe("DESC")
+	if request.Ascending {
+		operator, direction = bun.Safe(">"), bun.Safe("ASC")
+	}
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*AuditEvent, 0, request.Limit)
+
+	err = tx.NewRaw(
+		auditEventListQuery,
+		bun.NullZero(request.Limit),
+		request.Offset,
+		request.ActorID,
+		request.TargetID,
+		request.AfterSeq,
+		operator,
+		direction,
+		request.Chained,
+	).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
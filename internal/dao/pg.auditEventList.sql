@@ -0,0 +1,32 @@
+-- Served by audit_events_seq_key, or by the actor and target indexes when filtering on either.
+--
+-- The comparison operator of the keyset condition and the sort direction are trusted SQL fragments
+-- set together by the DAO, so the listing always resumes right after the cursor in the requested
+-- order.
+SELECT
+  *
+FROM
+  audit_events
+WHERE
+  (
+    ?2::uuid IS NULL
+    OR actor_id = ?2
+  )
+  AND (
+    ?3::uuid IS NULL
+    OR target_id = ?3
+  )
+  AND (
+    ?4::bigint IS NULL
+    OR seq ?5 ?4
+  )
+  AND (
+    NOT ?7
+    OR hash IS NOT NULL
+  )
+ORDER BY
+  seq ?6
+LIMIT
+  ?0
+OFFSET
+  ?1;
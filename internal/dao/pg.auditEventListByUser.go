@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.auditEventListByUser.sql
+var auditEventListByUserQuery string
+
+// AuditEventListByUserRequest is the input to [AuditEventListByUser.Exec].
+type AuditEventListByUserRequest struct {
+	// UserID is the account whose events are listed.
+	UserID uuid.UUID
+}
+
+// AuditEventListByUser returns every audit event a user performed, or that applied to them,
+// newest first.
+type AuditEventListByUser struct{}
+
+func NewAuditEventListByUser() *AuditEventListByUser {
+	return &AuditEventListByUser{}
+}
+
+func (dao *AuditEventListByUser) Exec(
+	ctx context.Context, request *AuditEventListByUserRequest,
+) ([]*AuditEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.AuditEventListByUser")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("data.userID", request.UserID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var entities []*AuditEvent
+
+	err = tx.NewRaw(auditEventListByUserQuery, request.UserID).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
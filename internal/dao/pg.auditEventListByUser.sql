@@ -0,0 +1,10 @@
+-- Served by a bitmap OR of audit_events_actor_id_idx and audit_events_target_id_idx.
+SELECT
+  *
+FROM
+  audit_events
+WHERE
+  actor_id = ?0
+  OR target_id = ?0
+ORDER BY
+  seq DESC;
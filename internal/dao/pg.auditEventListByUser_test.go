@@ -0,0 +1,97 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestAuditEventListByUser(t *testing.T) {
+	t.Parallel()
+
+	user := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	other := uuid.MustParse("00000000-0000-0000-0000-000000000011")
+
+	fixtures := []*dao.AuditEventInsertRequest{
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ActorID:  &user,
+			TargetID: &other,
+			Action:   "credentials.get",
+			Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ActorID: &other,
+			Action:  "credentials.list",
+			Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ActorID:  &other,
+			TargetID: &user,
+			Action:   "credentials.updateRole",
+			Now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			TargetID: &user,
+			Action:   "credentials.grantRole.expire",
+			Now:      time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.AuditEventListByUserRequest
+
+		expect []uuid.UUID
+	}{
+		{
+			name:    "Success",
+			request: &dao.AuditEventListByUserRequest{UserID: user},
+			expect:  []uuid.UUID{fixtures[3].ID, fixtures[2].ID, fixtures[0].ID},
+		},
+		{
+			name:    "Success/NoEvents",
+			request: &dao.AuditEventListByUserRequest{UserID: uuid.MustParse("00000000-0000-0000-0000-000000000012")},
+			expect:  []uuid.UUID{},
+		},
+	}
+
+	insertDAO := dao.NewAuditEventInsert()
+	listDAO := dao.NewAuditEventListByUser()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				for _, fixture := range fixtures {
+					_, err := insertDAO.Exec(ctx, fixture)
+					require.NoError(t, err)
+				}
+
+				events, err := listDAO.Exec(ctx, testCase.request)
+				require.NoError(t, err)
+
+				require.Equal(t, testCase.expect, lo.Map(events, func(item *dao.AuditEvent, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestAuditEventList(t *testing.T) {
+	t.Parallel()
+
+	actor := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	target := uuid.MustParse("00000000-0000-0000-0000-000000000011")
+
+	fixtures := []*dao.AuditEventInsertRequest{
+		{
+			ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Action: "credentials.superAdmin.create",
+			Now:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ActorID:  &actor,
+			TargetID: &target,
+			Action:   "credentials.get",
+			Now:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ActorID:   &actor,
+			Action:    "credentials.list",
+			Now:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			Unchained: true,
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.AuditEventListRequest
+		// afterIdx is the index of a fixture whose seq, known once inserted, becomes
+		// request.AfterSeq.
+		afterIdx *int
+
+		expect []uuid.UUID
+	}{
+		{
+			name:    "Success",
+			request: &dao.AuditEventListRequest{Limit: 10},
+			expect:  []uuid.UUID{fixtures[2].ID, fixtures[1].ID, fixtures[0].ID},
+		},
+		{
+			name:    "Success/Ascending",
+			request: &dao.AuditEventListRequest{Ascending: true},
+			expect:  []uuid.UUID{fixtures[0].ID, fixtures[1].ID, fixtures[2].ID},
+		},
+		{
+			name:    "Success/Paginated",
+			request: &dao.AuditEventListRequest{Limit: 1, Offset: 1},
+			expect:  []uuid.UUID{fixtures[1].ID},
+		},
+		{
+			name:    "Success/Actor",
+			request: &dao.AuditEventListRequest{Limit: 10, ActorID: &actor},
+			expect:  []uuid.UUID{fixtures[2].ID, fixtures[1].ID},
+		},
+		{
+			name:    "Success/Target",
+			request: &dao.AuditEventListRequest{Limit: 10, TargetID: &target},
+			expect:  []uuid.UUID{fixtures[1].ID},
+		},
+		{
+			name:    "Success/Chained",
+			request: &dao.AuditEventListRequest{Limit: 10, Chained: true},
+			expect:  []uuid.UUID{fixtures[1].ID, fixtures[0].ID},
+		},
+		{
+			name:     "Success/AfterSeq",
+			request:  &dao.AuditEventListRequest{Limit: 10, Ascending: true},
+			afterIdx: lo.ToPtr(0),
+			expect:   []uuid.UUID{fixtures[1].ID, fixtures[2].ID},
+		},
+		{
+			name:     "Success/AfterSeqDescending",
+			request:  &dao.AuditEventListRequest{Limit: 10},
+			afterIdx: lo.ToPtr(2),
+			expect:   []uuid.UUID{fixtures[1].ID, fixtures[0].ID},
+		},
+	}
+
+	insertDAO := dao.NewAuditEventInsert()
+	listDAO := dao.NewAuditEventList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				inserted := make([]*dao.AuditEvent, len(fixtures))
+
+				for i, fixture := range fixtures {
+					event, err := insertDAO.Exec(ctx, fixture)
+					require.NoError(t, err)
+
+					inserted[i] = event
+				}
+
+				request := *testCase.request
+				if testCase.afterIdx != nil {
+					request.AfterSeq = &inserted[*testCase.afterIdx].Seq
+				}
+
+				events, err := listDAO.Exec(ctx, &request)
+				require.NoError(t, err)
+
+				require.Equal(t, testCase.expect, lo.Map(events, func(item *dao.AuditEvent, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		})
+	}
+}
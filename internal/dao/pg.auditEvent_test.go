@@ -0,0 +1,87 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+func TestAuditEventHash(t *testing.T) {
+	t.Parallel()
+
+	event := func() *dao.AuditEvent {
+		return &dao.AuditEvent{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Seq:       1,
+			ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			Action:    "credentials.updateRole",
+			Before:    []byte(`{"role":"auth:user"}`),
+			After:     []byte(`{"role":"auth:admin"}`),
+			RequestID: "request-1",
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	previous := []byte("previous")
+	reference := dao.AuditEventHash(previous, event())
+
+	require.Len(t, reference, 32)
+	require.Equal(t, reference, dao.AuditEventHash(previous, event()), "hash must be deterministic")
+
+	testCases := []struct {
+		name     string
+		previous []byte
+		mutate   func(event *dao.AuditEvent)
+	}{
+		{name: "Previous", previous: []byte("other"), mutate: func(*dao.AuditEvent) {}},
+		{name: "Genesis", mutate: func(*dao.AuditEvent) {}},
+		{name: "ID", previous: previous, mutate: func(event *dao.AuditEvent) { event.ID = uuid.New() }},
+		{name: "Actor", previous: previous, mutate: func(event *dao.AuditEvent) { event.ActorID = nil }},
+		{name: "Target", previous: previous, mutate: func(event *dao.AuditEvent) { event.TargetID = nil }},
+		{name: "Action", previous: previous, mutate: func(event *dao.AuditEvent) { event.Action = "credentials.get" }},
+		{name: "Before", previous: previous, mutate: func(event *dao.AuditEvent) { event.Before = nil }},
+		{name: "After", previous: previous, mutate: func(event *dao.AuditEvent) {
+			event.After = []byte(`{"role":"auth:superadmin"}`)
+		}},
+		{name: "RequestID", previous: previous, mutate: func(event *dao.AuditEvent) { event.RequestID = "" }},
+		{name: "CreatedAt", previous: previous, mutate: func(event *dao.AuditEvent) {
+			event.CreatedAt = event.CreatedAt.Add(time.Second)
+		}},
+		{
+			// Moving bytes across a field boundary must change the hash.
+			name:     "FieldBoundary",
+			previous: previous,
+			mutate: func(event *dao.AuditEvent) {
+				event.Before = []byte(`{"role":"auth:user"}{"role":"auth:admin"}`)
+				event.After = nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mutated := event()
+			testCase.mutate(mutated)
+
+			require.NotEqual(t, reference, dao.AuditEventHash(testCase.previous, mutated))
+		})
+	}
+
+	t.Run("IgnoresSeqAndHash", func(t *testing.T) {
+		t.Parallel()
+
+		mutated := event()
+		mutated.Seq = 42
+		mutated.Hash = []byte("stored")
+
+		require.Equal(t, reference, dao.AuditEventHash(previous, mutated))
+	})
+}
@@ -0,0 +1,21 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CredentialRole grants a role to a set of credentials.
+type CredentialRole struct {
+	bun.BaseModel `bun:"table:credential_roles"`
+
+	// CredentialID is the ID of the credentials holding the role.
+	CredentialID uuid.UUID `bun:"credential_id,pk,type:uuid"`
+	// Role is the name of the role, as defined in the roles table.
+	Role string `bun:"role,pk"`
+
+	// CreatedAt is when the role was granted.
+	CreatedAt time.Time `bun:"created_at"`
+}
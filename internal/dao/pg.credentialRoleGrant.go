@@ -0,0 +1,29 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CredentialRoleGrant grants a role to a set of credentials for a limited time, on top of the
+// roles they hold. Expired grants are ignored, then deleted by [CredentialRoleGrantDeleteExpired].
+type CredentialRoleGrant struct {
+	bun.BaseModel `bun:"table:credential_role_grants"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+
+	// CredentialID is the ID of the credentials the role is granted to.
+	CredentialID uuid.UUID `bun:"credential_id,type:uuid"`
+	// Role is the name of the granted role, as defined in the roles table.
+	Role string `bun:"role"`
+	// Reason explains why the role was granted.
+	Reason string `bun:"reason"`
+	// GrantedBy is the user that granted the role. Nil once that user is deleted.
+	GrantedBy *uuid.UUID `bun:"granted_by,type:uuid"`
+
+	CreatedAt time.Time `bun:"created_at"`
+	// ExpiresAt is when the grant stops being honored.
+	ExpiresAt time.Time `bun:"expires_at"`
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialRoleGrantDeleteExpired.sql
+var credentialRoleGrantDeleteExpiredQuery string
+
+// CredentialRoleGrantDeleteExpiredRequest is the input to [CredentialRoleGrantDeleteExpired.Exec].
+type CredentialRoleGrantDeleteExpiredRequest struct {
+	// Now is the reference time: grants that expire at or before it are deleted.
+	Now time.Time
+	// Limit caps the number of grants deleted at once.
+	Limit int
+}
+
+// CredentialRoleGrantDeleteExpired deletes expired role grants, oldest expiry first, and returns
+// them. Grants locked by a concurrent call are left to it.
+type CredentialRoleGrantDeleteExpired struct{}
+
+func NewCredentialRoleGrantDeleteExpired() *CredentialRoleGrantDeleteExpired {
+	return &CredentialRoleGrantDeleteExpired{}
+}
+
+func (dao *CredentialRoleGrantDeleteExpired) Exec(
+	ctx context.Context, request *CredentialRoleGrantDeleteExpiredRequest,
+) ([]*CredentialRoleGrant, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialRoleGrantDeleteExpired")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("now", request.Now.String()),
+		attribute.Int("limit", request.Limit),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*CredentialRoleGrant, 0)
+
+	err = tx.NewRaw(credentialRoleGrantDeleteExpiredQuery, request.Now, request.Limit).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	slices.SortFunc(entities, func(a, b *CredentialRoleGrant) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+
+	span.SetAttributes(attribute.Int("grants.count", len(entities)))
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,19 @@
+-- Concurrent sweepers skip the grants another one is deleting, rather than waiting on them.
+DELETE FROM credential_role_grants
+WHERE
+  id IN (
+    SELECT
+      id
+    FROM
+      credential_role_grants
+    WHERE
+      expires_at <= ?0
+    ORDER BY
+      expires_at
+    LIMIT
+      ?1
+    FOR UPDATE
+      SKIP LOCKED
+  )
+RETURNING
+  *;
@@ -0,0 +1,132 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialRoleGrantDeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	credentials := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "user@provider.com",
+		EmailCanonical: "user@provider.com",
+		CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	newGrant := func(id string, expiresAt time.Time) *dao.CredentialRoleGrant {
+		return &dao.CredentialRoleGrant{
+			ID:           uuid.MustParse(id),
+			CredentialID: credentials.ID,
+			Role:         "auth:admin",
+			Reason:       "support ticket",
+			CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExpiresAt:    expiresAt,
+		}
+	}
+
+	grants := []*dao.CredentialRoleGrant{
+		newGrant("00000000-0000-0000-0000-000000000012", time.Date(2021, 1, 2, 2, 0, 0, 0, time.UTC)),
+		newGrant("00000000-0000-0000-0000-000000000011", time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC)),
+		newGrant("00000000-0000-0000-0000-000000000013", time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC)),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialRoleGrantDeleteExpiredRequest
+
+		expect []*dao.CredentialRoleGrant
+		// expectRemaining are the IDs of the grants left after the call.
+		expectRemaining []uuid.UUID
+	}{
+		{
+			name: "Success",
+
+			request: &dao.CredentialRoleGrantDeleteExpiredRequest{
+				Now:   time.Date(2021, 1, 2, 2, 0, 0, 0, time.UTC),
+				Limit: 10,
+			},
+
+			expect: []*dao.CredentialRoleGrant{grants[1], grants[0]},
+			expectRemaining: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+			},
+		},
+		{
+			name: "Success/Limit",
+
+			request: &dao.CredentialRoleGrantDeleteExpiredRequest{
+				Now:   time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC),
+				Limit: 1,
+			},
+
+			expect: []*dao.CredentialRoleGrant{grants[1]},
+			expectRemaining: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+			},
+		},
+		{
+			name: "Success/NoneExpired",
+
+			request: &dao.CredentialRoleGrantDeleteExpiredRequest{
+				Now:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Limit: 10,
+			},
+
+			expect: []*dao.CredentialRoleGrant{},
+			expectRemaining: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+			},
+		},
+	}
+
+	deleteDAO := dao.NewCredentialRoleGrantDeleteExpired()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(credentials).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&grants).Exec(ctx)
+				require.NoError(t, err)
+
+				deleted, err := deleteDAO.Exec(ctx, testCase.request)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expect, deleted)
+
+				var remaining []*dao.CredentialRoleGrant
+
+				err = db.NewSelect().Model(&remaining).Order("id").Scan(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectRemaining, lo.Map(
+					remaining, func(item *dao.CredentialRoleGrant, _ int) uuid.UUID { return item.ID },
+				))
+			})
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialRoleGrantInsert.sql
+var credentialRoleGrantInsertQuery string
+
+// ErrCredentialRoleGrantInsertNotFound is returned by [CredentialRoleGrantInsert.Exec] when the
+// credentials or the role do not exist. It is detected from the Postgres
+// foreign-key-violation SQLSTATE (23503) and joined onto the underlying driver error so callers
+// can branch on it with errors.Is.
+var ErrCredentialRoleGrantInsertNotFound = errors.New("credentials or role not found")
+
+// CredentialRoleGrantInsertRequest is the input to [CredentialRoleGrantInsert.Exec].
+type CredentialRoleGrantInsertRequest struct {
+	// See CredentialRoleGrant.ID.
+	ID uuid.UUID
+	// See CredentialRoleGrant.CredentialID.
+	CredentialID uuid.UUID
+	// See CredentialRoleGrant.Role.
+	Role string
+	// See CredentialRoleGrant.Reason.
+	Reason string
+	// See CredentialRoleGrant.GrantedBy.
+	GrantedBy *uuid.UUID
+	// Now is the timestamp recorded as the grant's creation time.
+	Now time.Time
+	// See CredentialRoleGrant.ExpiresAt.
+	ExpiresAt time.Time
+}
+
+// CredentialRoleGrantInsert grants a role to a set of credentials, until the grant expires.
+type CredentialRoleGrantInsert struct{}
+
+func NewCredentialRoleGrantInsert() *CredentialRoleGrantInsert {
+	return &CredentialRoleGrantInsert{}
+}
+
+func (dao *CredentialRoleGrantInsert) Exec(
+	ctx context.Context, request *CredentialRoleGrantInsertRequest,
+) (*CredentialRoleGrant, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialRoleGrantInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("grant.id", request.ID.String()),
+		attribute.String("grant.credentialID", request.CredentialID.String()),
+		attribute.String("grant.role", request.Role),
+		attribute.String("grant.expiresAt", request.ExpiresAt.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(CredentialRoleGrant)
+
+	err = tx.NewRaw(
+		credentialRoleGrantInsertQuery,
+		request.ID,
+		request.CredentialID,
+		request.Role,
+		request.Reason,
+		request.GrantedBy,
+		request.Now,
+		request.ExpiresAt,
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23503" {
+			err = errors.Join(err, ErrCredentialRoleGrantInsertNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
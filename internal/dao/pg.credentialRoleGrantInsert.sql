@@ -0,0 +1,14 @@
+INSERT INTO
+  credential_role_grants (
+    id,
+    credential_id,
+    role,
+    reason,
+    granted_by,
+    created_at,
+    expires_at
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6)
+RETURNING
+  *;
@@ -0,0 +1,156 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialRoleGrantInsert(t *testing.T) {
+	t.Parallel()
+
+	fixtures := []*dao.Credentials{
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Email:          "admin@provider.com",
+			EmailCanonical: "admin@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Email:          "user@provider.com",
+			EmailCanonical: "user@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialRoleGrantInsertRequest
+
+		expect    *dao.CredentialRoleGrant
+		expectErr error
+		// expectAnyErr is set when the schema must reject the row, with no sentinel.
+		expectAnyErr bool
+	}{
+		{
+			name: "Success",
+
+			request: &dao.CredentialRoleGrantInsertRequest{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Role:         "auth:admin",
+				Reason:       "support ticket",
+				GrantedBy:    lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Now:          time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.CredentialRoleGrant{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Role:         "auth:admin",
+				Reason:       "support ticket",
+				GrantedBy:    lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				CreatedAt:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/UnknownCredentials",
+
+			request: &dao.CredentialRoleGrantInsertRequest{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				Role:         "auth:admin",
+				Reason:       "support ticket",
+				Now:          time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrCredentialRoleGrantInsertNotFound,
+		},
+		{
+			name: "Error/UnknownRole",
+
+			request: &dao.CredentialRoleGrantInsertRequest{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Role:         "auth:unknown",
+				Reason:       "support ticket",
+				Now:          time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrCredentialRoleGrantInsertNotFound,
+		},
+		{
+			name: "Error/ExpiresBeforeCreation",
+
+			request: &dao.CredentialRoleGrantInsertRequest{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Role:         "auth:admin",
+				Reason:       "support ticket",
+				Now:          time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectAnyErr: true,
+		},
+		{
+			name: "Error/EmptyReason",
+
+			request: &dao.CredentialRoleGrantInsertRequest{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Role:         "auth:admin",
+				Now:          time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:    time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+
+			expectAnyErr: true,
+		},
+	}
+
+	insertDAO := dao.NewCredentialRoleGrantInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				grant, err := insertDAO.Exec(ctx, testCase.request)
+				if testCase.expectAnyErr {
+					require.Error(t, err)
+
+					return
+				}
+
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, grant)
+			})
+		})
+	}
+}
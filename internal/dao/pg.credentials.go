@@ -14,14 +14,41 @@ type Credentials struct {
 	// ID of the user, used to look up its information across the platform.
 	ID uuid.UUID `bun:"id,pk,type:uuid"`
 
-	// Email the user authenticates with, unique across all users.
+	// Email the user authenticates with, as they typed it.
 	Email string `bun:"email"`
+	// EmailCanonical is the canonical form of Email, which identifies the account: it is
+	// unique across all users, and lookups by email compare against it.
+	EmailCanonical string `bun:"email_canonical"`
 	// Password is the Argon2id hash of the user's password; the plaintext is never
 	// stored.
 	Password string `bun:"password"`
 
-	// Role determines which actions the user is allowed to take.
-	Role string `bun:"role"`
+	// Roles determine which actions the user is allowed to take. They are stored in the
+	// credential_roles table, so queries aggregate them: writes to credentials never carry them.
+	// Nil when the user holds no role.
+	Roles []string `bun:"roles,array,scanonly"`
+	// GrantedRoles are the roles granted to the user for a limited time, see
+	// [CredentialRoleGrant], minus those it already holds. Only [CredentialsSelect] and
+	// [CredentialsSelectByEmail] load them, along with GrantsExpireAt: the roles carried by
+	// the tokens come from those queries. Nil when the user has no active grant.
+	GrantedRoles []string `bun:"granted_roles,array,scanonly"`
+	// GrantsExpireAt is when the first of GrantedRoles expires. Nil without GrantedRoles.
+	GrantsExpireAt *time.Time `bun:"grants_expire_at,scanonly"`
+
+	// EmailVerifiedAt is when the user last proved control of Email, by redeeming a code
+	// sent to it. Nil when the address was never verified.
+	EmailVerifiedAt *time.Time `bun:"email_verified_at"`
+
+	// Locale is the language the user receives emails in. Empty when the user expressed no
+	// preference.
+	Locale string `bun:"locale"`
+	// NoticesOptOut is true when the user turned off the notices that are not critical to the
+	// security of the account, such as the sign-in from a new device.
+	NoticesOptOut bool `bun:"notices_opt_out"`
+
+	// LastLoginAt is when the user last signed in with their password. Nil when they never
+	// did.
+	LastLoginAt *time.Time `bun:"last_login_at"`
 
 	CreatedAt time.Time `bun:"created_at"`
 	UpdatedAt time.Time `bun:"updated_at"`
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsCount.sql
+var credentialsCountQuery string
+
+// CredentialsCountRequest is the input to [CredentialsCount.Exec]. Its filters behave
+// like their [CredentialsListRequest] counterparts.
+type CredentialsCountRequest struct {
+	Roles []string
+
+	Email       string
+	EmailPrefix bool
+
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	InactiveSince *time.Time
+}
+
+// CredentialsCount returns the number of credentials matching a set of filters, so a
+// paginated listing can report its total size.
+type CredentialsCount struct{}
+
+func NewCredentialsCount() *CredentialsCount {
+	return &CredentialsCount{}
+}
+
+func (dao *CredentialsCount) Exec(ctx context.Context, request *CredentialsCountRequest) (int, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsCount")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.StringSlice("data.roles", request.Roles),
+		attribute.String("data.email", request.Email),
+		attribute.Bool("data.emailPrefix", request.EmailPrefix),
+	)
+
+	if len(request.Roles) == 0 {
+		// See CredentialsList: an empty, non-nil slice renders as NULL.
+		request.Roles = []string{}
+	}
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var count int
+
+	err = tx.NewRaw(
+		credentialsCountQuery,
+		bun.List(request.Roles),
+		bun.NullZero(credentialsEmailPattern(request.Email, request.EmailPrefix)),
+		request.CreatedAfter,
+		request.CreatedBefore,
+		request.InactiveSince,
+	).Scan(ctx, &count)
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, count), nil
+}
@@ -0,0 +1,35 @@
+-- Mirrors the filters of pg.credentialsList.sql, without pagination.
+SELECT
+  COUNT(*)
+FROM
+  credentials
+WHERE
+  (
+    (?0) IS NULL -- If no role is provided (empty array), don't filter on roles.
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        credential_roles
+      WHERE
+        credential_id = credentials.id
+        AND role IN (?0)
+    )
+  )
+  AND (
+    ?1::text IS NULL
+    OR lower(email) LIKE ?1
+  )
+  AND (
+    ?2::timestamptz IS NULL
+    OR created_at >= ?2
+  )
+  AND (
+    ?3::timestamptz IS NULL
+    OR created_at < ?3
+  )
+  AND (
+    ?4::timestamptz IS NULL
+    OR last_login_at IS NULL
+    OR last_login_at < ?4
+  );
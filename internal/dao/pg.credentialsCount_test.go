@@ -0,0 +1,114 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsCount(t *testing.T) {
+	t.Parallel()
+
+	fixtures := []*dao.Credentials{
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Email:          "user1@email.com",
+			EmailCanonical: "user1@email.com",
+			Roles:          []string{"auth:user"},
+			CreatedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			LastLoginAt:    lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Email:          "admin2@email.com",
+			EmailCanonical: "admin2@email.com",
+			Roles:          []string{"auth:admin", "auth:user"},
+			CreatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			LastLoginAt:    lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Email:          "user3@email.com",
+			EmailCanonical: "user3@email.com",
+			Roles:          []string{"auth:user"},
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialsCountRequest
+
+		expect    int
+		expectErr error
+	}{
+		{
+			name:    "Success",
+			request: &dao.CredentialsCountRequest{},
+			expect:  3,
+		},
+		{
+			name:    "Success/Roles",
+			request: &dao.CredentialsCountRequest{Roles: []string{"auth:admin"}},
+			expect:  1,
+		},
+		{
+			name:    "Success/EmailPrefix",
+			request: &dao.CredentialsCountRequest{Email: "User", EmailPrefix: true},
+			expect:  2,
+		},
+		{
+			name: "Success/CreatedRange",
+			request: &dao.CredentialsCountRequest{
+				CreatedAfter: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: 2,
+		},
+		{
+			name: "Success/InactiveSince",
+			request: &dao.CredentialsCountRequest{
+				InactiveSince: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: 2, // includes the account that never signed in
+		},
+	}
+
+	countDAO := dao.NewCredentialsCount()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(lo.ToPtr(credentialRoleFixtures(fixtures))).Exec(ctx)
+				require.NoError(t, err)
+
+				count, err := countDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, count)
+			})
+		})
+	}
+}
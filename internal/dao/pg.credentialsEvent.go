@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CredentialsEventKindMerge is the kind of the event written when an account is merged into
+// another one.
+const CredentialsEventKindMerge = "credentials.merge"
+
+// CredentialsEvent is a row of the outbox other services read to act on account changes. It is
+// written in the transaction of the change it describes.
+type CredentialsEvent struct {
+	bun.BaseModel `bun:"table:credentials_events"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// Seq is the position of the event in the outbox, assigned by the database.
+	Seq int64 `bun:"seq"`
+
+	// Kind is one of the CredentialsEventKind* constants.
+	Kind string `bun:"kind"`
+	// UserID is the account the event is about. For a merge, the destination.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// SourceID is, for a merge, the deleted account whose data moves to UserID.
+	SourceID *uuid.UUID `bun:"source_id,type:uuid"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
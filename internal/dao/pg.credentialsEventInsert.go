@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsEventInsert.lock.sql
+var credentialsEventInsertLockQuery string
+
+//go:embed pg.credentialsEventInsert.sql
+var credentialsEventInsertQuery string
+
+// CredentialsEventInsertRequest is the input to [CredentialsEventInsert.Exec].
+type CredentialsEventInsertRequest struct {
+	// See CredentialsEvent.ID.
+	ID uuid.UUID
+	// See CredentialsEvent.Kind.
+	Kind string
+	// See CredentialsEvent.UserID.
+	UserID uuid.UUID
+	// See CredentialsEvent.SourceID.
+	SourceID *uuid.UUID
+	// Now is the timestamp recorded as the event's creation time.
+	Now time.Time
+}
+
+// CredentialsEventInsert writes an event to the outbox.
+//
+// It joins the caller's transaction when there is one, so the event commits or rolls back with
+// the change it describes. Writers are serialized on an advisory lock held until that transaction
+// ends, so events commit in the order of their Seq.
+type CredentialsEventInsert struct{}
+
+func NewCredentialsEventInsert() *CredentialsEventInsert {
+	return &CredentialsEventInsert{}
+}
+
+func (dao *CredentialsEventInsert) Exec(
+	ctx context.Context, request *CredentialsEventInsertRequest,
+) (*CredentialsEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsEventInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentialsEvent.id", request.ID.String()),
+		attribute.String("credentialsEvent.kind", request.Kind),
+		attribute.String("credentialsEvent.userID", request.UserID.String()),
+	)
+
+	entity := new(CredentialsEvent)
+
+	err := postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+		tx, err := postgres.GetContext(ctx)
+		if err != nil {
+			return fmt.Errorf("get database handle: %w", err)
+		}
+
+		_, err = tx.NewRaw(credentialsEventInsertLockQuery).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("acquire lock: %w", err)
+		}
+
+		err = tx.NewRaw(
+			credentialsEventInsertQuery,
+			request.ID,
+			request.Kind,
+			request.UserID,
+			request.SourceID,
+			request.Now,
+		).Scan(ctx, entity)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,5 @@
+-- Held until the transaction ends, so a row never commits after a row with a higher seq: a
+-- consumer resuming after the last seq it read never skips one. The key is arbitrary, but must
+-- stay the same across releases.
+SELECT
+  pg_advisory_xact_lock(hashtext('credentials_events'));
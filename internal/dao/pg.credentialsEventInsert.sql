@@ -0,0 +1,6 @@
+INSERT INTO
+  credentials_events (id, kind, user_id, source_id, created_at)
+VALUES
+  (?0, ?1, ?2, ?3, ?4)
+RETURNING
+  *;
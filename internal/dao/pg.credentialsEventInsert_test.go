@@ -0,0 +1,86 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsEventInsert(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialsEventInsertRequest
+
+		expect *dao.CredentialsEvent
+		// expectErr is set when the schema must reject the row.
+		expectErr bool
+	}{
+		{
+			name: "Success",
+
+			request: &dao.CredentialsEventInsertRequest{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Kind:     dao.CredentialsEventKindMerge,
+				UserID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				SourceID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Now:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.CredentialsEvent{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Seq:       1,
+				Kind:      dao.CredentialsEventKindMerge,
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				SourceID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/UnknownKind",
+
+			request: &dao.CredentialsEventInsertRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Kind:   "credentials.unknown",
+				UserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: true,
+		},
+	}
+
+	dao := dao.NewCredentialsEventInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				resp, err := dao.Exec(ctx, testCase.request)
+				if testCase.expectErr {
+					require.Error(t, err)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, testCase.expect, resp)
+			})
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsEventList.sql
+var credentialsEventListQuery string
+
+// CredentialsEventListRequest is the input to [CredentialsEventList.Exec].
+type CredentialsEventListRequest struct {
+	// Limit caps the number of events returned. Zero returns every event.
+	Limit int
+	// AfterSeq, if set, resumes the listing right after the event at that position.
+	AfterSeq *int64
+}
+
+// CredentialsEventList returns the events of the outbox, oldest first.
+type CredentialsEventList struct{}
+
+func NewCredentialsEventList() *CredentialsEventList {
+	return &CredentialsEventList{}
+}
+
+func (dao *CredentialsEventList) Exec(
+	ctx context.Context, request *CredentialsEventListRequest,
+) ([]*CredentialsEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsEventList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("data.limit", request.Limit),
+		attribute.Bool("data.afterSeq", request.AfterSeq != nil),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*CredentialsEvent, 0, request.Limit)
+
+	err = tx.NewRaw(credentialsEventListQuery, bun.NullZero(request.Limit), request.AfterSeq).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
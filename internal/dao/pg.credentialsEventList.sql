@@ -0,0 +1,12 @@
+-- Served by credentials_events_seq_key.
+SELECT
+  *
+FROM
+  credentials_events
+WHERE
+  ?1::bigint IS NULL
+  OR seq > ?1
+ORDER BY
+  seq
+LIMIT
+  ?0;
@@ -0,0 +1,113 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsEventList(t *testing.T) {
+	t.Parallel()
+
+	fixtures := []*dao.CredentialsEventInsertRequest{
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Kind:     dao.CredentialsEventKindMerge,
+			UserID:   uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			SourceID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000011")),
+			Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Kind:     dao.CredentialsEventKindMerge,
+			UserID:   uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			SourceID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000012")),
+			Now:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Kind:     dao.CredentialsEventKindMerge,
+			UserID:   uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+			SourceID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			Now:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialsEventListRequest
+		// afterIdx is the index of a fixture whose seq, known once inserted, becomes
+		// request.AfterSeq.
+		afterIdx *int
+
+		expect []uuid.UUID
+	}{
+		{
+			name:    "Success",
+			request: &dao.CredentialsEventListRequest{},
+			expect:  []uuid.UUID{fixtures[0].ID, fixtures[1].ID, fixtures[2].ID},
+		},
+		{
+			name:    "Success/Limit",
+			request: &dao.CredentialsEventListRequest{Limit: 2},
+			expect:  []uuid.UUID{fixtures[0].ID, fixtures[1].ID},
+		},
+		{
+			name:     "Success/AfterSeq",
+			request:  &dao.CredentialsEventListRequest{Limit: 10},
+			afterIdx: lo.ToPtr(0),
+			expect:   []uuid.UUID{fixtures[1].ID, fixtures[2].ID},
+		},
+		{
+			name:     "Success/AfterLast",
+			request:  &dao.CredentialsEventListRequest{Limit: 10},
+			afterIdx: lo.ToPtr(2),
+			expect:   []uuid.UUID{},
+		},
+	}
+
+	insertDAO := dao.NewCredentialsEventInsert()
+	listDAO := dao.NewCredentialsEventList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				inserted := make([]*dao.CredentialsEvent, len(fixtures))
+
+				for i, fixture := range fixtures {
+					event, err := insertDAO.Exec(ctx, fixture)
+					require.NoError(t, err)
+
+					inserted[i] = event
+				}
+
+				request := *testCase.request
+				if testCase.afterIdx != nil {
+					request.AfterSeq = &inserted[*testCase.afterIdx].Seq
+				}
+
+				events, err := listDAO.Exec(ctx, &request)
+				require.NoError(t, err)
+
+				require.Equal(t, testCase.expect, lo.Map(events, func(item *dao.CredentialsEvent, _ int) uuid.UUID {
+					return item.ID
+				}))
+			})
+		})
+	}
+}
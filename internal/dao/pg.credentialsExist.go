@@ -16,7 +16,8 @@ var credentialsExistQuery string
 
 // CredentialsExistRequest is the input to [CredentialsExist.Exec].
 type CredentialsExistRequest struct {
-	// Email to check for an existing registration. Comparison is case-sensitive.
+	// Email to check for an existing registration, in canonical form. It is compared
+	// against Credentials.EmailCanonical.
 	Email string
 }
 
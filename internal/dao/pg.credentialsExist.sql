@@ -3,4 +3,4 @@ SELECT
 FROM
   credentials
 WHERE
-  email = ?0;
+  email_canonical = ?0;
@@ -33,12 +33,12 @@ func TestCredentialsExist(t *testing.T) {
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -19,7 +20,7 @@ import (
 var credentialsInsertQuery string
 
 // ErrCredentialsInsertAlreadyExists is returned by [CredentialsInsert.Exec] when
-// the email is already registered. It is detected from the Postgres unique-violation
+// the canonical email is already registered. It is detected from the Postgres unique-violation
 // SQLSTATE (23505) and joined onto the underlying driver error so callers can
 // branch on it with errors.Is.
 var ErrCredentialsInsertAlreadyExists = errors.New("credentials already exists")
@@ -30,16 +31,23 @@ type CredentialsInsertRequest struct {
 	ID uuid.UUID
 	// See Credentials.Email.
 	Email string
+	// See Credentials.EmailCanonical.
+	EmailCanonical string
 	// See Credentials.Password.
 	Password string
-	// See Credentials.Role.
-	Role string
+	// See Credentials.Roles. Duplicates are not allowed.
+	Roles []string
+	// See Credentials.EmailVerifiedAt. Set it when the caller has just redeemed a code sent
+	// to Email.
+	EmailVerifiedAt *time.Time
+	// See Credentials.Locale. Optional.
+	Locale string
 	// Now is the timestamp recorded as the row's creation time.
 	Now time.Time
 }
 
-// CredentialsInsert inserts a new set of credentials into the database. The email
-// must be unique; a duplicate returns [ErrCredentialsInsertAlreadyExists].
+// CredentialsInsert inserts a new set of credentials into the database. The canonical
+// email must be unique; a duplicate returns [ErrCredentialsInsertAlreadyExists].
 type CredentialsInsert struct{}
 
 func NewCredentialsInsert() *CredentialsInsert {
@@ -55,8 +63,11 @@ func (dao *CredentialsInsert) Exec(
 	span.SetAttributes(
 		attribute.String("credentials.id", request.ID.String()),
 		attribute.String("credentials.email", request.Email),
+		attribute.String("credentials.emailCanonical", request.EmailCanonical),
 		// The password never goes on the span. A redaction still carries its length.
-		attribute.String("credentials.role", request.Role),
+		attribute.StringSlice("credentials.roles", request.Roles),
+		attribute.Bool("credentials.emailVerified", request.EmailVerifiedAt != nil),
+		attribute.String("credentials.locale", request.Locale),
 		attribute.Int64("credentials.now", request.Now.Unix()),
 	)
 
@@ -74,7 +85,10 @@ func (dao *CredentialsInsert) Exec(
 		request.Password,
 		request.Now,
 		request.Now,
-		request.Role,
+		pgdialect.Array(request.Roles),
+		request.EmailVerifiedAt,
+		request.EmailCanonical,
+		request.Locale,
 	).Scan(ctx, entity)
 	if err != nil {
 		var pgErr pgdriver.Error
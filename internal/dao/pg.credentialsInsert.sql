@@ -1,6 +1,32 @@
-INSERT INTO
-  credentials (id, email, password, created_at, updated_at, role)
-VALUES
-  (?0, ?1, ?2, ?3, ?4, ?5)
-RETURNING
-  *;
+WITH
+  inserted AS (
+    INSERT INTO
+      credentials (id, email, password, created_at, updated_at, email_verified_at, email_canonical, locale)
+    VALUES
+      (?0, ?1, ?2, ?3, ?4, ?6, ?7, NULLIF(?8, ''))
+    RETURNING
+      *
+  ),
+  granted AS (
+    INSERT INTO
+      credential_roles (credential_id, role, created_at)
+    SELECT
+      inserted.id,
+      granted_role,
+      ?3
+    FROM
+      inserted,
+      unnest(?5::text[]) AS granted_role
+    RETURNING
+      role
+  )
+SELECT
+  inserted.*,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      granted
+  ) AS roles
+FROM
+  inserted;
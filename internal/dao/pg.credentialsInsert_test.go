@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -32,38 +33,112 @@ func TestCredentialsInsert(t *testing.T) {
 			name: "Success",
 
 			request: &dao.CredentialsInsertRequest{
-				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Email:    "user@provider.com",
-				Password: "password-hashed",
-				Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				Role:     "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
 			},
 
 			expect: &dao.Credentials{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Email:     "user@provider.com",
-				Password:  "password-hashed",
-				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				Role:      "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+			},
+		},
+		{
+			name: "EmailVerified",
+
+			request: &dao.CredentialsInsertRequest{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:           "user@provider.com",
+				EmailCanonical:  "user@provider.com",
+				Password:        "password-hashed",
+				Now:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:           []string{"auth:user"},
+				EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+
+			expect: &dao.Credentials{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:           "user@provider.com",
+				EmailCanonical:  "user@provider.com",
+				Password:        "password-hashed",
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Roles:           []string{"auth:user"},
+			},
+		},
+		{
+			name: "Locale",
+
+			request: &dao.CredentialsInsertRequest{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+				Locale:         "fr",
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+				Locale:         "fr",
 			},
 		},
 		{
 			name: "NoPassword",
 
 			request: &dao.CredentialsInsertRequest{
-				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Email: "user@provider.com",
-				Now:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				Role:  "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+			},
+		},
+		{
+			name: "MultipleRoles",
+
+			request: &dao.CredentialsInsertRequest{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user", "auth:admin"},
 			},
 
 			expect: &dao.Credentials{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Email:     "user@provider.com",
-				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				Role:      "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:admin", "auth:user"},
 			},
 		},
 		{
@@ -71,21 +146,47 @@ func TestCredentialsInsert(t *testing.T) {
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsInsertRequest{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+			},
+
+			expectErr: dao.ErrCredentialsInsertAlreadyExists,
+		},
+		{
+			name: "Error/AlreadyExistsCanonical",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
 			request: &dao.CredentialsInsertRequest{
-				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-				Email:    "user@provider.com",
-				Password: "password-hashed",
-				Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				Role:     "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Email:          "User@Provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-hashed",
+				Now:            time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
 			},
 
 			expectErr: dao.ErrCredentialsInsertAlreadyExists,
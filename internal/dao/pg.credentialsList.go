@@ -4,7 +4,10 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -15,6 +18,13 @@ import (
 //go:embed pg.credentialsList.sql
 var credentialsListQuery string
 
+// CredentialsListKey is the position of a credential in the listing order. Listing
+// resumes strictly after it, so it works as a keyset cursor.
+type CredentialsListKey struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
 // CredentialsListRequest is the input to [CredentialsList.Exec].
 type CredentialsListRequest struct {
 	Limit  int
@@ -22,6 +32,45 @@ type CredentialsListRequest struct {
 	// Roles, if non-empty, restricts the result to credentials whose role is in
 	// the slice. An empty slice returns credentials of every role.
 	Roles []string
+
+	// Email, if set, restricts the result to credentials whose email contains it.
+	// The match is case-insensitive, and wildcard characters are matched literally.
+	Email string
+	// EmailPrefix narrows the Email match to emails starting with it. Prefix searches
+	// use an index; substring searches scan the table.
+	EmailPrefix bool
+
+	// CreatedAfter and CreatedBefore bound the creation date of the returned
+	// credentials, as a half-open range [CreatedAfter, CreatedBefore). Either may
+	// be nil to leave that side open.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	// InactiveSince, if set, restricts the result to credentials that did not sign in
+	// since that date, including those that never signed in.
+	InactiveSince *time.Time
+
+	// After, if set, resumes the listing right after the given key. It is usually
+	// the key of the last item of the previous page.
+	After *CredentialsListKey
+	// Ascending lists the oldest credentials first. The default lists the newest
+	// first.
+	Ascending bool
+}
+
+// credentialsEmailPattern turns a search string into a case-insensitive LIKE pattern. The
+// search is matched literally: LIKE wildcards in it are escaped.
+func credentialsEmailPattern(email string, prefix bool) string {
+	if email == "" {
+		return ""
+	}
+
+	pattern := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(email)) + "%"
+	if !prefix {
+		pattern = "%" + pattern
+	}
+
+	return pattern
 }
 
 // CredentialsList returns a set of paginated credentials from the database. Only the public fields are returned,
@@ -42,6 +91,10 @@ func (dao *CredentialsList) Exec(
 		attribute.Int("data.limit", request.Limit),
 		attribute.Int("data.offset", request.Offset),
 		attribute.StringSlice("data.roles", request.Roles),
+		attribute.String("data.email", request.Email),
+		attribute.Bool("data.emailPrefix", request.EmailPrefix),
+		attribute.Bool("data.after", request.After != nil),
+		attribute.Bool("data.ascending", request.Ascending),
 	)
 
 	if len(request.Roles) == 0 {
@@ -51,6 +104,21 @@ func (dao *CredentialsList) Exec(
 		request.Roles = []string{}
 	}
 
+	var (
+		afterCreatedAt *time.Time
+		afterID        any
+	)
+
+	if request.After != nil {
+		afterCreatedAt = &request.After.CreatedAt
+		afterID = request.After.ID
+	}
+
+	operator, direction := bun.Safe("<"), bun.Safe("DESC")
+	if request.Ascending {
+		operator, direction = bun.Safe(">"), bun.Safe("ASC")
+	}
+
 	tx, err := postgres.GetContext(ctx)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
@@ -63,6 +131,14 @@ func (dao *CredentialsList) Exec(
 		bun.NullZero(request.Limit),
 		request.Offset,
 		bun.List(request.Roles),
+		bun.NullZero(credentialsEmailPattern(request.Email, request.EmailPrefix)),
+		request.CreatedAfter,
+		request.CreatedBefore,
+		afterCreatedAt,
+		afterID,
+		operator,
+		direction,
+		request.InactiveSince,
 	).Scan(ctx, &entities)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
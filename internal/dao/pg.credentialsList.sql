@@ -1,22 +1,67 @@
 -- id breaks ties on created_at, and created_at does not mutate. Ordering on updated_at let a
 -- credential touched between two page queries move across the boundary and be skipped or repeated,
 -- and its timestamp(0) precision made same-second rows a tie the planner could order either way.
+--
+-- The comparison operator of the keyset condition and the sort direction are trusted SQL fragments
+-- set together by the DAO, so the listing always resumes right after the cursor in the requested
+-- order.
 SELECT
   id,
   email,
-  role,
+  email_canonical,
+  email_verified_at,
+  locale,
+  notices_opt_out,
+  last_login_at,
   created_at,
-  updated_at
+  updated_at,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles
 FROM
   credentials
 WHERE
   (
     (?2) IS NULL -- If no role is provided (empty array), don't filter on roles.
-    OR role IN (?2)
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        credential_roles
+      WHERE
+        credential_id = credentials.id
+        AND role IN (?2)
+    )
+  )
+  AND (
+    ?3::text IS NULL
+    OR lower(email) LIKE ?3
+  )
+  AND (
+    ?4::timestamptz IS NULL
+    OR created_at >= ?4
+  )
+  AND (
+    ?5::timestamptz IS NULL
+    OR created_at < ?5
+  )
+  AND (
+    ?6::timestamptz IS NULL
+    OR (created_at, id) ?8 (?6, ?7::uuid)
+  )
+  AND (
+    ?10::timestamptz IS NULL
+    OR last_login_at IS NULL
+    OR last_login_at < ?10
   )
 ORDER BY
-  created_at DESC,
-  id DESC
+  created_at ?9,
+  id ?9
 LIMIT
   ?0
 OFFSET
@@ -2,10 +2,12 @@ package dao_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -25,25 +27,30 @@ func TestCredentialsList(t *testing.T) {
 	// created_at descends 1 > 2 > 3; updated_at ascends, so ordering on it would reverse
 	// the result.
 	cred1 := &dao.Credentials{
-		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-		Email:     "user1@email.com",
-		Role:      "auth:user",
-		CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
-		UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "user1@email.com",
+		EmailCanonical: "user1@email.com",
+		Roles:          []string{"auth:user"},
+		CreatedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		LastLoginAt:    lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
 	}
 	cred2 := &dao.Credentials{
-		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-		Email:     "user2@email.com",
-		Role:      "auth:admin",
-		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-		UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Email:          "user2@email.com",
+		EmailCanonical: "user2@email.com",
+		Roles:          []string{"auth:admin"},
+		CreatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		LastLoginAt:    lo.ToPtr(time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)),
 	}
 	cred3 := &dao.Credentials{
-		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
-		Email:     "user3@email.com",
-		Role:      "auth:user",
-		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-		UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Email:          "user3@email.com",
+		EmailCanonical: "user3@email.com",
+		Roles:          []string{"auth:user"},
+		CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 	}
 
 	testCases := []struct {
@@ -84,6 +91,80 @@ func TestCredentialsList(t *testing.T) {
 			request:  &dao.CredentialsListRequest{Roles: []string{"auth:user"}},
 			expect:   []*dao.Credentials{cred1, cred3}, // admin cred2 excluded
 		},
+		{
+			name: "Success/EmailContains",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request:  &dao.CredentialsListRequest{Email: "ER2@EMAIL"},
+			expect:   []*dao.Credentials{cred2},
+		},
+		{
+			name: "Success/EmailPrefix",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request:  &dao.CredentialsListRequest{Email: "user", EmailPrefix: true},
+			expect:   []*dao.Credentials{cred1, cred2, cred3},
+		},
+		{
+			name: "Success/EmailPrefixNoMatch",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request:  &dao.CredentialsListRequest{Email: "ser", EmailPrefix: true},
+			expect:   []*dao.Credentials{},
+		},
+		{
+			name: "Success/EmailWildcardsAreLiteral",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request:  &dao.CredentialsListRequest{Email: "user_"},
+			expect:   []*dao.Credentials{},
+		},
+		{
+			name: "Success/CreatedRange",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request: &dao.CredentialsListRequest{
+				CreatedAfter:  lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+				CreatedBefore: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: []*dao.Credentials{cred2}, // the range is half-open
+		},
+		{
+			name: "Success/InactiveSince",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request: &dao.CredentialsListRequest{
+				InactiveSince: lo.ToPtr(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+			expect: []*dao.Credentials{cred2, cred3}, // cred3 never signed in
+		},
+		{
+			name: "Success/Ascending",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request:  &dao.CredentialsListRequest{Ascending: true},
+			expect:   []*dao.Credentials{cred3, cred2, cred1},
+		},
+		{
+			name: "Success/After",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request: &dao.CredentialsListRequest{
+				Limit: 1,
+				After: &dao.CredentialsListKey{CreatedAt: cred1.CreatedAt, ID: cred1.ID},
+			},
+			expect: []*dao.Credentials{cred2},
+		},
+		{
+			name: "Success/AfterAscending",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+			request: &dao.CredentialsListRequest{
+				After:     &dao.CredentialsListKey{CreatedAt: cred3.CreatedAt, ID: cred3.ID},
+				Ascending: true,
+			},
+			expect: []*dao.Credentials{cred2, cred1},
+		},
 	}
 
 	listDAO := dao.NewCredentialsList()
@@ -101,6 +182,9 @@ func TestCredentialsList(t *testing.T) {
 				if len(testCase.fixtures) > 0 {
 					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
 					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(lo.ToPtr(credentialRoleFixtures(testCase.fixtures))).Exec(ctx)
+					require.NoError(t, err)
 				}
 
 				credentials, err := listDAO.Exec(ctx, testCase.request)
@@ -146,11 +230,11 @@ func TestCredentialsListPaginationIsStable(t *testing.T) {
 
 			for i := 1; i <= 4; i++ {
 				fixtures = append(fixtures, &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-00000000000" + string(rune('0'+i))),
-					Email:     "user" + string(rune('0'+i)) + "@email.com",
-					Role:      "auth:user",
-					CreatedAt: ts,
-					UpdatedAt: ts,
+					ID:             uuid.MustParse("00000000-0000-0000-0000-00000000000" + string(rune('0'+i))),
+					Email:          "user" + string(rune('0'+i)) + "@email.com",
+					EmailCanonical: "user" + string(rune('0'+i)) + "@email.com",
+					CreatedAt:      ts,
+					UpdatedAt:      ts,
 				})
 			}
 
@@ -187,11 +271,11 @@ func TestCredentialsListPaginationIsStable(t *testing.T) {
 
 			for i := 1; i <= 4; i++ {
 				fixtures = append(fixtures, &dao.Credentials{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-00000000000" + string(rune('0'+i))),
-					Email:     "user" + string(rune('0'+i)) + "@email.com",
-					Role:      "auth:user",
-					CreatedAt: time.Date(2021, 1, i, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, i, 0, 0, 0, 0, time.UTC),
+					ID:             uuid.MustParse("00000000-0000-0000-0000-00000000000" + string(rune('0'+i))),
+					Email:          "user" + string(rune('0'+i)) + "@email.com",
+					EmailCanonical: "user" + string(rune('0'+i)) + "@email.com",
+					CreatedAt:      time.Date(2021, 1, i, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, i, 0, 0, 0, 0, time.UTC),
 				})
 			}
 
@@ -223,3 +307,70 @@ func TestCredentialsListPaginationIsStable(t *testing.T) {
 		})
 	})
 }
+
+// Keyset pagination resumes after the last row of the previous page, so rows sharing a
+// created_at are split on id without loss, in both directions.
+func TestCredentialsListKeysetPagination(t *testing.T) {
+	t.Parallel()
+
+	listDAO := dao.NewCredentialsList()
+
+	for _, ascending := range []bool{false, true} {
+		t.Run(fmt.Sprintf("ascending=%v", ascending), func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				// Two groups of rows sharing a created_at, so page boundaries fall inside a tie.
+				fixtures := make([]*dao.Credentials, 0, 5)
+
+				for i := 1; i <= 5; i++ {
+					fixtures = append(fixtures, &dao.Credentials{
+						ID:             uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", i)),
+						Email:          fmt.Sprintf("user%d@email.com", i),
+						EmailCanonical: fmt.Sprintf("user%d@email.com", i),
+						CreatedAt:      time.Date(2021, 1, 1+i%2, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					})
+				}
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				seen := map[uuid.UUID]int{}
+
+				var after *dao.CredentialsListKey
+
+				for range 3 {
+					page, err := listDAO.Exec(ctx, &dao.CredentialsListRequest{
+						Limit:     2,
+						After:     after,
+						Ascending: ascending,
+					})
+					require.NoError(t, err)
+
+					for _, item := range page {
+						seen[item.ID]++
+					}
+
+					if len(page) == 0 {
+						break
+					}
+
+					last := page[len(page)-1]
+					after = &dao.CredentialsListKey{CreatedAt: last.CreatedAt, ID: last.ID}
+				}
+
+				require.Len(t, seen, 5, "the pages must union to all five rows")
+
+				for id, count := range seen {
+					require.Equal(t, 1, count, "row %s appeared %d times across the pages", id, count)
+				}
+			})
+		})
+	}
+}
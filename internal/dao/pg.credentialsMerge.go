@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsMerge.sql
+var credentialsMergeQuery string
+
+//go:embed pg.credentialsMerge.loginEvents.sql
+var credentialsMergeLoginEventsQuery string
+
+//go:embed pg.credentialsMerge.redirects.sql
+var credentialsMergeRedirectsQuery string
+
+// ErrCredentialsMergeNotFound is returned by [CredentialsMerge.Exec] when no credentials match
+// the source ID. It is joined onto the underlying sql.ErrNoRows so callers can branch on it with
+// errors.Is.
+var ErrCredentialsMergeNotFound = errors.New("credentials not found")
+
+// CredentialsMergeRequest is the input to [CredentialsMerge.Exec].
+type CredentialsMergeRequest struct {
+	// SourceID is the account to merge, and delete.
+	SourceID uuid.UUID
+	// DestinationID is the account that receives the data of the source. It must exist.
+	DestinationID uuid.UUID
+	// See CredentialsRedirect.ActorID.
+	ActorID *uuid.UUID
+	// Now is the timestamp recorded as the redirect's creation time.
+	Now time.Time
+}
+
+// CredentialsMerge moves the data of an account to another one, then replaces it with a
+// redirect to that account.
+//
+// The login history of the source moves to the destination, and so do the redirects that
+// pointed to the source. The source credentials are deleted. Roles are left to the caller.
+//
+// It joins the caller's transaction when there is one, so the merge commits or rolls back with
+// the rest of the caller's work.
+type CredentialsMerge struct{}
+
+func NewCredentialsMerge() *CredentialsMerge {
+	return &CredentialsMerge{}
+}
+
+func (dao *CredentialsMerge) Exec(
+	ctx context.Context, request *CredentialsMergeRequest,
+) (*CredentialsRedirect, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsMerge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentials.sourceID", request.SourceID.String()),
+		attribute.String("credentials.destinationID", request.DestinationID.String()),
+		attribute.Int64("credentials.now", request.Now.Unix()),
+	)
+
+	entity := new(CredentialsRedirect)
+
+	err := postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+		tx, err := postgres.GetContext(ctx)
+		if err != nil {
+			return fmt.Errorf("get database handle: %w", err)
+		}
+
+		_, err = tx.NewRaw(credentialsMergeLoginEventsQuery, request.SourceID, request.DestinationID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("move login events: %w", err)
+		}
+
+		_, err = tx.NewRaw(credentialsMergeRedirectsQuery, request.SourceID, request.DestinationID).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("repoint redirects: %w", err)
+		}
+
+		err = tx.NewRaw(
+			credentialsMergeQuery,
+			request.SourceID,
+			request.DestinationID,
+			request.ActorID,
+			request.Now,
+		).Scan(ctx, entity)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.Join(err, ErrCredentialsMergeNotFound)
+			}
+
+			return fmt.Errorf("replace source: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,5 @@
+UPDATE login_events
+SET
+  user_id = ?1
+WHERE
+  user_id = ?0;
@@ -0,0 +1,7 @@
+-- Redirects to the source now point to the destination, before the source is deleted and its
+-- redirects with it.
+UPDATE credentials_redirects
+SET
+  destination_id = ?1
+WHERE
+  destination_id = ?0;
@@ -0,0 +1,21 @@
+WITH
+  source AS (
+    DELETE FROM credentials
+    WHERE
+      id = ?0
+    RETURNING
+      id,
+      email
+  )
+INSERT INTO
+  credentials_redirects (source_id, destination_id, source_email, actor_id, created_at)
+SELECT
+  source.id,
+  ?1,
+  source.email,
+  ?2,
+  ?3
+FROM
+  source
+RETURNING
+  *;
@@ -0,0 +1,169 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsMerge(t *testing.T) {
+	t.Parallel()
+
+	source := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	destination := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	actor := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	// older is an account merged into the source, before the source itself is merged.
+	older := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	credentials := []*dao.Credentials{
+		{
+			ID:             source,
+			Email:          "source@provider.com",
+			EmailCanonical: "source@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             destination,
+			Email:          "destination@provider.com",
+			EmailCanonical: "destination@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialsMergeRequest
+
+		expect            *dao.CredentialsRedirect
+		expectLoginEvents []*dao.LoginEvent
+		expectRedirects   []*dao.CredentialsRedirect
+		expectErr         error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.CredentialsMergeRequest{
+				SourceID:      source,
+				DestinationID: destination,
+				ActorID:       &actor,
+				Now:           time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.CredentialsRedirect{
+				SourceID:      source,
+				DestinationID: destination,
+				SourceEmail:   "source@provider.com",
+				ActorID:       &actor,
+				CreatedAt:     time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			expectLoginEvents: []*dao.LoginEvent{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					UserID:    lo.ToPtr(destination),
+					Email:     "source@provider.com",
+					Kind:      dao.LoginEventKindLogin,
+					Outcome:   dao.LoginEventOutcomeSuccess,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			expectRedirects: []*dao.CredentialsRedirect{
+				{
+					SourceID:      source,
+					DestinationID: destination,
+					SourceEmail:   "source@provider.com",
+					ActorID:       &actor,
+					CreatedAt:     time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					SourceID:      older,
+					DestinationID: destination,
+					SourceEmail:   "older@provider.com",
+					CreatedAt:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.CredentialsMergeRequest{
+				SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000009"),
+				DestinationID: destination,
+				ActorID:       &actor,
+				Now:           time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrCredentialsMergeNotFound,
+		},
+	}
+
+	mergeDAO := dao.NewCredentialsMerge()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&credentials).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&dao.LoginEvent{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					UserID:    lo.ToPtr(source),
+					Email:     "source@provider.com",
+					Kind:      dao.LoginEventKindLogin,
+					Outcome:   dao.LoginEventOutcomeSuccess,
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				}).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&dao.CredentialsRedirect{
+					SourceID:      older,
+					DestinationID: source,
+					SourceEmail:   "older@provider.com",
+					CreatedAt:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				}).Exec(ctx)
+				require.NoError(t, err)
+
+				redirect, err := mergeDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, redirect)
+
+				if testCase.expectErr != nil {
+					return
+				}
+
+				exists, err := db.NewSelect().Model((*dao.Credentials)(nil)).Where("id = ?", source).Exists(ctx)
+				require.NoError(t, err)
+				require.False(t, exists)
+
+				var loginEvents []*dao.LoginEvent
+
+				require.NoError(t, db.NewSelect().Model(&loginEvents).Order("id").Scan(ctx))
+				require.Equal(t, testCase.expectLoginEvents, loginEvents)
+
+				var redirects []*dao.CredentialsRedirect
+
+				require.NoError(t, db.NewSelect().Model(&redirects).Order("source_id").Scan(ctx))
+				require.Equal(t, testCase.expectRedirects, redirects)
+			})
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CredentialsRedirect is the tombstone of an account merged into another one. The source account
+// no longer exists; its ID resolves to the destination.
+type CredentialsRedirect struct {
+	bun.BaseModel `bun:"table:credentials_redirects"`
+
+	// SourceID is the ID of the merged, and deleted, account.
+	SourceID uuid.UUID `bun:"source_id,pk,type:uuid"`
+	// DestinationID is the account the source was merged into.
+	DestinationID uuid.UUID `bun:"destination_id,type:uuid"`
+	// SourceEmail is the email of the source account at the time of the merge.
+	SourceEmail string `bun:"source_email"`
+
+	// ActorID is the user that merged the accounts. Nil for merges run by the system.
+	ActorID *uuid.UUID `bun:"actor_id,type:uuid"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsRedirectSelect.sql
+var credentialsRedirectSelectQuery string
+
+// ErrCredentialsRedirectSelectNotFound is returned by [CredentialsRedirectSelect.Exec] when the
+// ID is not the one of a merged account. It is joined onto the underlying sql.ErrNoRows so
+// callers can branch on it with errors.Is.
+var ErrCredentialsRedirectSelectNotFound = errors.New("credentials redirect not found")
+
+// CredentialsRedirectSelectRequest is the input to [CredentialsRedirectSelect.Exec].
+type CredentialsRedirectSelectRequest struct {
+	// SourceID is the ID of the merged account.
+	SourceID uuid.UUID
+}
+
+// CredentialsRedirectSelect resolves the ID of a merged account to the account it was merged
+// into.
+type CredentialsRedirectSelect struct{}
+
+func NewCredentialsRedirectSelect() *CredentialsRedirectSelect {
+	return &CredentialsRedirectSelect{}
+}
+
+func (dao *CredentialsRedirectSelect) Exec(
+	ctx context.Context, request *CredentialsRedirectSelectRequest,
+) (*CredentialsRedirect, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsRedirectSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("credentials.sourceID", request.SourceID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(CredentialsRedirect)
+
+	err = tx.NewRaw(credentialsRedirectSelectQuery, request.SourceID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrCredentialsRedirectSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
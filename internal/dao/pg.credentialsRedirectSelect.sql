@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  credentials_redirects
+WHERE
+  source_id = ?0;
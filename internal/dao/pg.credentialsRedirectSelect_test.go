@@ -0,0 +1,88 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsRedirectSelect(t *testing.T) {
+	t.Parallel()
+
+	destination := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Email:          "destination@provider.com",
+		EmailCanonical: "destination@provider.com",
+		CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	redirect := &dao.CredentialsRedirect{
+		SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		DestinationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		SourceEmail:   "source@provider.com",
+		CreatedAt:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.CredentialsRedirectSelectRequest
+
+		expect    *dao.CredentialsRedirect
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.CredentialsRedirectSelectRequest{
+				SourceID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			expect: redirect,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.CredentialsRedirectSelectRequest{
+				SourceID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			expectErr: dao.ErrCredentialsRedirectSelectNotFound,
+		},
+	}
+
+	dao := dao.NewCredentialsRedirectSelect()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(destination).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(redirect).Exec(ctx)
+				require.NoError(t, err)
+
+				resp, err := dao.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+			})
+		})
+	}
+}
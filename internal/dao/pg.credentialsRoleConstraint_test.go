@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -15,12 +16,29 @@ import (
 	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
 )
 
-// The schema now refuses a role the application does not know, and defaults new rows to a role it
-// does — the two halves of the fix that kept a bad role out of the database in the first place.
+// credentialRoleFixtures returns the credential_roles rows granting each credentials its Roles.
+// Roles are not a column of credentials, so inserting the credentials alone does not store them.
+func credentialRoleFixtures(credentials []*dao.Credentials) []*dao.CredentialRole {
+	return lo.FlatMap(credentials, func(item *dao.Credentials, _ int) []*dao.CredentialRole {
+		return lo.Map(item.Roles, func(role string, _ int) *dao.CredentialRole {
+			return &dao.CredentialRole{CredentialID: item.ID, Role: role, CreatedAt: item.CreatedAt}
+		})
+	})
+}
+
+// The schema refuses a role with no definition, and drops the roles of deleted credentials.
 func TestCredentialsRoleSchema(t *testing.T) {
 	t.Parallel()
 
-	t.Run("the check constraint rejects an unknown role", func(t *testing.T) {
+	credentials := &dao.Credentials{
+		ID:             uuid.New(),
+		Email:          "role@email.com",
+		EmailCanonical: "role@email.com",
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	t.Run("the foreign key rejects an unknown role", func(t *testing.T) {
 		t.Parallel()
 
 		postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
@@ -29,20 +47,20 @@ func TestCredentialsRoleSchema(t *testing.T) {
 			db, err := postgres.GetContext(ctx)
 			require.NoError(t, err)
 
-			// 'user' is exactly the value the old default produced; the constraint must
-			// refuse it now.
-			_, err = db.NewInsert().Model(&dao.Credentials{
-				ID:        uuid.New(),
-				Email:     "bad-role@email.com",
-				Role:      "user",
-				CreatedAt: time.Now(),
-				UpdatedAt: time.Now(),
+			_, err = db.NewInsert().Model(credentials).Exec(ctx)
+			require.NoError(t, err)
+
+			// 'user' is the value the first role column defaulted to; it was never a defined role.
+			_, err = db.NewInsert().Model(&dao.CredentialRole{
+				CredentialID: credentials.ID,
+				Role:         "user",
+				CreatedAt:    time.Now(),
 			}).Exec(ctx)
-			require.Error(t, err, "the database must reject a role the application does not define")
+			require.Error(t, err, "the database must reject a role the roles table does not define")
 		})
 	})
 
-	t.Run("a new row without a role defaults to auth:user", func(t *testing.T) {
+	t.Run("deleting credentials deletes their roles", func(t *testing.T) {
 		t.Parallel()
 
 		postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
@@ -51,19 +69,24 @@ func TestCredentialsRoleSchema(t *testing.T) {
 			db, err := postgres.GetContext(ctx)
 			require.NoError(t, err)
 
-			id := uuid.New()
+			_, err = db.NewInsert().Model(credentials).Exec(ctx)
+			require.NoError(t, err)
 
-			// Insert without naming a role, so the column default applies.
-			_, err = db.NewRaw(
-				"INSERT INTO credentials (id, email, password, created_at, updated_at) VALUES (?, ?, ?, now(), now())",
-				id, "defaulted@email.com", "hash",
-			).Exec(ctx)
+			_, err = db.NewInsert().Model(&dao.CredentialRole{
+				CredentialID: credentials.ID,
+				Role:         "auth:user",
+				CreatedAt:    time.Now(),
+			}).Exec(ctx)
 			require.NoError(t, err)
 
-			var role string
+			_, err = db.NewDelete().Model(credentials).WherePK().Exec(ctx)
+			require.NoError(t, err)
 
-			require.NoError(t, db.NewRaw("SELECT role FROM credentials WHERE id = ?", id).Scan(ctx, &role))
-			require.Equal(t, "auth:user", role, "the default must be a role the application knows")
+			count, err := db.NewSelect().Model((*dao.CredentialRole)(nil)).
+				Where("credential_id = ?", credentials.ID).
+				Count(ctx)
+			require.NoError(t, err)
+			require.Zero(t, count)
 		})
 	})
 }
@@ -17,6 +17,9 @@ import (
 //go:embed pg.credentialsSelect.sql
 var credentialsSelectQuery string
 
+//go:embed pg.credentialsSelect.lock.sql
+var credentialsSelectLockQuery string
+
 // ErrCredentialsSelectNotFound is returned by [CredentialsSelect.Exec] when no
 // row matches the requested ID. It is joined onto the underlying sql.ErrNoRows
 // so callers can branch on it with errors.Is.
@@ -26,6 +29,9 @@ var ErrCredentialsSelectNotFound = errors.New("credentials not found")
 type CredentialsSelectRequest struct {
 	// ID of the credentials to fetch.
 	ID uuid.UUID
+	// Lock locks the credentials row before reading, and holds it until the caller's transaction
+	// ends. It has no lasting effect outside a transaction.
+	Lock bool
 }
 
 // CredentialsSelect fetches a single credentials row by ID. Use
@@ -42,13 +48,29 @@ func (dao *CredentialsSelect) Exec(
 	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsSelect")
 	defer span.End()
 
-	span.SetAttributes(attribute.String("id", request.ID.String()))
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.Bool("lock", request.Lock),
+	)
 
 	tx, err := postgres.GetContext(ctx)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
 	}
 
+	if request.Lock {
+		var id uuid.UUID
+
+		err = tx.NewRaw(credentialsSelectLockQuery, request.ID).Scan(ctx, &id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.Join(err, ErrCredentialsSelectNotFound)
+			}
+
+			return nil, otel.ReportError(span, fmt.Errorf("acquire lock: %w", err))
+		}
+	}
+
 	entity := new(Credentials)
 
 	err = tx.NewRaw(credentialsSelectQuery, request.ID).Scan(ctx, entity)
@@ -0,0 +1,9 @@
+-- Held until the transaction ends, so concurrent writers of the roles of the credentials read
+-- them one at a time.
+SELECT
+  id
+FROM
+  credentials
+WHERE
+  id = ?0
+FOR UPDATE;
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsSelectBatch.sql
+var credentialsSelectBatchQuery string
+
+// CredentialsSelectBatchRequest is the input to [CredentialsSelectBatch.Exec].
+type CredentialsSelectBatchRequest struct {
+	// IDs of the credentials to fetch.
+	IDs []uuid.UUID
+}
+
+// CredentialsSelectBatch fetches the credentials matching a set of IDs in a single
+// query. IDs with no matching row are skipped rather than reported as an error, and
+// rows come back in no particular order. Only the public fields are returned, private
+// authentication information is left empty.
+type CredentialsSelectBatch struct{}
+
+func NewCredentialsSelectBatch() *CredentialsSelectBatch {
+	return &CredentialsSelectBatch{}
+}
+
+func (dao *CredentialsSelectBatch) Exec(
+	ctx context.Context, request *CredentialsSelectBatchRequest,
+) ([]*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsSelectBatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.StringSlice("data.ids", lo.Map(request.IDs, func(item uuid.UUID, _ int) string {
+		return item.String()
+	})))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*Credentials, 0, len(request.IDs))
+
+	err = tx.NewRaw(credentialsSelectBatchQuery, pgdialect.Array(request.IDs)).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,21 @@
+SELECT
+  id,
+  email,
+  email_verified_at,
+  locale,
+  notices_opt_out,
+  last_login_at,
+  created_at,
+  updated_at,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles
+FROM
+  credentials
+WHERE
+  id = ANY (?0::uuid[]);
@@ -0,0 +1,127 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsSelectBatch(t *testing.T) {
+	t.Parallel()
+
+	cred1 := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "user1@email.com",
+		EmailCanonical: "user1@email.com",
+		Password:       "password-1",
+		Roles:          []string{"auth:user"},
+		CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	cred2 := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Email:          "user2@email.com",
+		EmailCanonical: "user2@email.com",
+		Password:       "password-2",
+		Roles:          []string{"auth:admin", "auth:user"},
+		CreatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	cred3 := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Email:          "user3@email.com",
+		EmailCanonical: "user3@email.com",
+		Password:       "password-3",
+		Roles:          []string{"auth:user"},
+		CreatedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	// The query never returns the password hash.
+	public := func(credentials *dao.Credentials) *dao.Credentials {
+		out := *credentials
+		out.Password = ""
+
+		return &out
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Credentials
+
+		request *dao.CredentialsSelectBatchRequest
+
+		expect    []*dao.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+
+			request: &dao.CredentialsSelectBatchRequest{
+				IDs: []uuid.UUID{cred1.ID, cred3.ID},
+			},
+
+			expect: []*dao.Credentials{public(cred1), public(cred3)},
+		},
+		{
+			name: "Success/Missing",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+
+			request: &dao.CredentialsSelectBatchRequest{
+				IDs: []uuid.UUID{cred2.ID, uuid.MustParse("00000000-0000-0000-0000-000000000004")},
+			},
+
+			expect: []*dao.Credentials{public(cred2)},
+		},
+		{
+			name: "Success/Empty",
+
+			fixtures: []*dao.Credentials{cred1, cred2, cred3},
+
+			request: &dao.CredentialsSelectBatchRequest{},
+
+			expect: []*dao.Credentials{},
+		},
+	}
+
+	selectDAO := dao.NewCredentialsSelectBatch()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(lo.ToPtr(credentialRoleFixtures(testCase.fixtures))).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				credentials, err := selectDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.ElementsMatch(t, testCase.expect, credentials)
+			})
+		})
+	}
+}
@@ -24,7 +24,8 @@ var ErrCredentialsSelectByEmailNotFound = errors.New("credentials not found")
 
 // CredentialsSelectByEmailRequest is the input to [CredentialsSelectByEmail.Exec].
 type CredentialsSelectByEmailRequest struct {
-	// Email of the credentials to fetch. Comparison is case-sensitive.
+	// Email of the credentials to fetch, in canonical form. It is compared against
+	// Credentials.EmailCanonical.
 	Email string
 }
 
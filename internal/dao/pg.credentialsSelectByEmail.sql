@@ -1,6 +1,58 @@
 SELECT
-  *
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles,
+  -- Active temporary grants, but for the roles the credentials already hold.
+  (
+    SELECT
+      array_agg(DISTINCT role ORDER BY role)
+    FROM
+      credential_role_grants
+    WHERE
+      credential_id = credentials.id
+      AND expires_at > CURRENT_TIMESTAMP
+      AND role NOT IN (
+        SELECT
+          role
+        FROM
+          credential_roles
+        WHERE
+          credential_id = credentials.id
+      )
+  ) AS granted_roles,
+  -- A role granted more than once lasts until its latest grant expires: the first role to go
+  -- sets the end of the elevation.
+  (
+    SELECT
+      min(role_expires_at)
+    FROM
+      (
+        SELECT
+          max(expires_at) AS role_expires_at
+        FROM
+          credential_role_grants
+        WHERE
+          credential_id = credentials.id
+          AND expires_at > CURRENT_TIMESTAMP
+          AND role NOT IN (
+            SELECT
+              role
+            FROM
+              credential_roles
+            WHERE
+              credential_id = credentials.id
+          )
+        GROUP BY
+          role
+      ) AS granted
+  ) AS grants_expire_at
 FROM
   credentials
 WHERE
-  email = ?0;
+  email_canonical = ?0;
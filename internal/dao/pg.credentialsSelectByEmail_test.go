@@ -33,12 +33,12 @@ func TestCredentialsSelectByEmail(t *testing.T) {
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
@@ -47,12 +47,39 @@ func TestCredentialsSelectByEmail(t *testing.T) {
 			},
 
 			expect: &dao.Credentials{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email:     "user@provider.com",
-				Password:  "password-2-hashed",
-				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				Role:      "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Canonical",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "User@Provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsSelectByEmailRequest{
+				Email: "user@provider.com",
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "User@Provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 		},
 		{
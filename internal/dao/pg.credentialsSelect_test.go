@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -18,10 +19,15 @@ import (
 func TestCredentialsSelect(t *testing.T) {
 	t.Parallel()
 
+	// Grants are checked against the clock of the database.
+	now := time.Now().UTC().Truncate(time.Second)
+
 	testCases := []struct {
 		name string
 
-		fixtures []*dao.Credentials
+		fixtures      []*dao.Credentials
+		roleFixtures  []*dao.CredentialRole
+		grantFixtures []*dao.CredentialRoleGrant
 
 		request *dao.CredentialsSelectRequest
 
@@ -33,12 +39,52 @@ func TestCredentialsSelect(t *testing.T) {
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			roleFixtures: []*dao.CredentialRole{
+				{
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:user",
+					CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:admin",
+					CreatedAt:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsSelectRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:admin", "auth:user"},
+			},
+		},
+		{
+			name: "Success/NoRoles",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
@@ -47,12 +93,130 @@ func TestCredentialsSelect(t *testing.T) {
 			},
 
 			expect: &dao.Credentials{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email:     "user@provider.com",
-				Password:  "password-2-hashed",
-				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				Role:      "auth:user",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Grants",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			roleFixtures: []*dao.CredentialRole{
+				{
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:user",
+					CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			grantFixtures: []*dao.CredentialRoleGrant{
+				// Granted twice: the role lasts until the latest grant expires.
+				{
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:admin",
+					Reason:       "support ticket",
+					CreatedAt:    now.Add(-time.Hour),
+					ExpiresAt:    now.Add(time.Hour),
+				},
+				{
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:admin",
+					Reason:       "support ticket",
+					CreatedAt:    now.Add(-time.Hour),
+					ExpiresAt:    now.Add(2 * time.Hour),
+				},
+				{
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:anon",
+					Reason:       "support ticket",
+					CreatedAt:    now.Add(-time.Hour),
+					ExpiresAt:    now.Add(3 * time.Hour),
+				},
+				// Already held: ignored.
+				{
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000014"),
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:user",
+					Reason:       "support ticket",
+					CreatedAt:    now.Add(-time.Hour),
+					ExpiresAt:    now.Add(30 * time.Minute),
+				},
+				// Expired, not swept yet: ignored.
+				{
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000015"),
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:superadmin",
+					Reason:       "support ticket",
+					CreatedAt:    now.Add(-2 * time.Hour),
+					ExpiresAt:    now.Add(-time.Hour),
+				},
+			},
+
+			request: &dao.CredentialsSelectRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+				GrantedRoles:   []string{"auth:admin", "auth:anon"},
+				GrantsExpireAt: lo.ToPtr(now.Add(2 * time.Hour)),
+			},
+		},
+		{
+			name: "Success/Lock",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			roleFixtures: []*dao.CredentialRole{
+				{
+					CredentialID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:         "auth:user",
+					CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsSelectRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Lock: true,
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
 			},
 		},
 		{
@@ -62,6 +226,16 @@ func TestCredentialsSelect(t *testing.T) {
 				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 			},
 
+			expectErr: dao.ErrCredentialsSelectNotFound,
+		},
+		{
+			name: "Error/NotFound/Lock",
+
+			request: &dao.CredentialsSelectRequest{
+				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Lock: true,
+			},
+
 			expectErr: dao.ErrCredentialsSelectNotFound,
 		},
 	}
@@ -83,6 +257,16 @@ func TestCredentialsSelect(t *testing.T) {
 					require.NoError(t, err)
 				}
 
+				if len(testCase.roleFixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.roleFixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				if len(testCase.grantFixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.grantFixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
 				key, err := dao.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
 				require.Equal(t, testCase.expect, key)
@@ -39,12 +39,15 @@ type CredentialsUpdateEmailRequest struct {
 	// Email is the new address to assign. Caller must verify ownership (typically
 	// via a short code emailed to this address) before invoking the dao.
 	Email string
-	// Now is the timestamp recorded as the row's update time.
+	// EmailCanonical is the canonical form of Email.
+	EmailCanonical string
+	// Now is the timestamp recorded as the row's update time. It is also recorded as the
+	// verification time of the new address.
 	Now time.Time
 }
 
 // CredentialsUpdateEmail updates the email address of a set of credentials. The new address should be validated
-// beforehand.
+// beforehand, so it is marked as verified.
 type CredentialsUpdateEmail struct{}
 
 func NewCredentialsUpdateEmail() *CredentialsUpdateEmail {
@@ -60,6 +63,7 @@ func (dao *CredentialsUpdateEmail) Exec(
 	span.SetAttributes(
 		attribute.String("credentials.id", request.ID.String()),
 		attribute.String("credentials.email", request.Email),
+		attribute.String("credentials.emailCanonical", request.EmailCanonical),
 		attribute.Int64("credentials.now", request.Now.Unix()),
 	)
 
@@ -70,7 +74,9 @@ func (dao *CredentialsUpdateEmail) Exec(
 
 	entity := new(Credentials)
 
-	err = tx.NewRaw(credentialsUpdateEmailQuery, request.Email, request.Now, request.ID).Scan(ctx, entity)
+	err = tx.NewRaw(
+		credentialsUpdateEmailQuery, request.Email, request.Now, request.ID, request.EmailCanonical,
+	).Scan(ctx, entity)
 	if err != nil {
 		var pgErr pgdriver.Error
 		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
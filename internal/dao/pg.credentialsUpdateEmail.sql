@@ -1,8 +1,18 @@
 UPDATE credentials
 SET
   email = ?0,
+  email_canonical = ?3,
+  email_verified_at = ?1,
   updated_at = ?1
 WHERE
   id = ?2
 RETURNING
-  *;
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles;
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsUpdateEmailCanonical.sql
+var credentialsUpdateEmailCanonicalQuery string
+
+var (
+	// ErrCredentialsUpdateEmailCanonicalAlreadyExists is returned by
+	// [CredentialsUpdateEmailCanonical.Exec] when another user already holds the
+	// canonical email. It is detected from the Postgres unique-violation SQLSTATE
+	// (23505) and joined onto the driver error.
+	ErrCredentialsUpdateEmailCanonicalAlreadyExists = errors.New("credentials already exists")
+	// ErrCredentialsUpdateEmailCanonicalNotFound is returned by
+	// [CredentialsUpdateEmailCanonical.Exec] when no row matches the requested ID. It is
+	// joined onto the underlying sql.ErrNoRows.
+	ErrCredentialsUpdateEmailCanonicalNotFound = errors.New("credentials not found")
+)
+
+// CredentialsUpdateEmailCanonicalRequest is the input to [CredentialsUpdateEmailCanonical.Exec].
+type CredentialsUpdateEmailCanonicalRequest struct {
+	// ID of the credentials to update.
+	ID uuid.UUID
+	// EmailCanonical is the recomputed canonical form of the current email.
+	EmailCanonical string
+}
+
+// CredentialsUpdateEmailCanonical recomputes the canonical email of a set of credentials,
+// after the rules that derive it changed. The email itself is left untouched.
+type CredentialsUpdateEmailCanonical struct{}
+
+func NewCredentialsUpdateEmailCanonical() *CredentialsUpdateEmailCanonical {
+	return &CredentialsUpdateEmailCanonical{}
+}
+
+func (dao *CredentialsUpdateEmailCanonical) Exec(
+	ctx context.Context, request *CredentialsUpdateEmailCanonicalRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsUpdateEmailCanonical")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentials.id", request.ID.String()),
+		attribute.String("credentials.emailCanonical", request.EmailCanonical),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Credentials)
+
+	err = tx.NewRaw(credentialsUpdateEmailCanonicalQuery, request.EmailCanonical, request.ID).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrCredentialsUpdateEmailCanonicalAlreadyExists)
+		} else if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrCredentialsUpdateEmailCanonicalNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,17 @@
+-- The canonical email is derived from the email, so recomputing it is not an update of the account:
+-- updated_at is left untouched.
+UPDATE credentials
+SET
+  email_canonical = ?0
+WHERE
+  id = ?1
+RETURNING
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles;
@@ -0,0 +1,123 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsUpdateEmailCanonical(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Credentials
+
+		request *dao.CredentialsUpdateEmailCanonicalRequest
+
+		expect    *dao.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "f.oo@gmail.com",
+					EmailCanonical: "f.oo@gmail.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsUpdateEmailCanonicalRequest{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				EmailCanonical: "foo@gmail.com",
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "f.oo@gmail.com",
+				EmailCanonical: "foo@gmail.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:          "foo@gmail.com",
+					EmailCanonical: "foo@gmail.com",
+					Password:       "password-1-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "f.oo@gmail.com",
+					EmailCanonical: "f.oo@gmail.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsUpdateEmailCanonicalRequest{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				EmailCanonical: "foo@gmail.com",
+			},
+
+			expectErr: dao.ErrCredentialsUpdateEmailCanonicalAlreadyExists,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.CredentialsUpdateEmailCanonicalRequest{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				EmailCanonical: "foo@gmail.com",
+			},
+
+			expectErr: dao.ErrCredentialsUpdateEmailCanonicalNotFound,
+		},
+	}
+
+	dao := dao.NewCredentialsUpdateEmailCanonical()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				credentials, err := dao.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, credentials)
+			})
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsUpdateEmailVerified.sql
+var credentialsUpdateEmailVerifiedQuery string
+
+// ErrCredentialsUpdateEmailVerifiedNotFound is returned by
+// [CredentialsUpdateEmailVerified.Exec] when no row matches the requested ID. It is
+// joined onto the underlying sql.ErrNoRows so callers can branch on it with
+// errors.Is.
+var ErrCredentialsUpdateEmailVerifiedNotFound = errors.New("credentials not found")
+
+// CredentialsUpdateEmailVerifiedRequest is the input to [CredentialsUpdateEmailVerified.Exec].
+type CredentialsUpdateEmailVerifiedRequest struct {
+	// ID of the credentials to update.
+	ID uuid.UUID
+	// Now is the timestamp recorded as the verification and update time of the row.
+	Now time.Time
+}
+
+// CredentialsUpdateEmailVerified marks the current email of a set of credentials as verified.
+// The caller must have checked the user controls the address, typically by redeeming a code
+// sent to it.
+type CredentialsUpdateEmailVerified struct{}
+
+func NewCredentialsUpdateEmailVerified() *CredentialsUpdateEmailVerified {
+	return &CredentialsUpdateEmailVerified{}
+}
+
+func (dao *CredentialsUpdateEmailVerified) Exec(
+	ctx context.Context, request *CredentialsUpdateEmailVerifiedRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsUpdateEmailVerified")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentials.id", request.ID.String()),
+		attribute.Int64("credentials.now", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Credentials)
+
+	err = tx.NewRaw(credentialsUpdateEmailVerifiedQuery, request.Now, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrCredentialsUpdateEmailVerifiedNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,16 @@
+UPDATE credentials
+SET
+  email_verified_at = ?0,
+  updated_at = ?0
+WHERE
+  id = ?1
+RETURNING
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles;
@@ -0,0 +1,126 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsUpdateEmailVerified(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Credentials
+
+		request *dao.CredentialsUpdateEmailVerifiedRequest
+
+		expect    *dao.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.CredentialsUpdateEmailVerifiedRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Credentials{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:           "user@provider.com",
+				EmailCanonical:  "user@provider.com",
+				Password:        "password-2-hashed",
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "AlreadyVerified",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:           "user@provider.com",
+					EmailCanonical:  "user@provider.com",
+					Password:        "password-2-hashed",
+					CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+
+			request: &dao.CredentialsUpdateEmailVerifiedRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Credentials{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:           "user@provider.com",
+				EmailCanonical:  "user@provider.com",
+				Password:        "password-2-hashed",
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.CredentialsUpdateEmailVerifiedRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrCredentialsUpdateEmailVerifiedNotFound,
+		},
+	}
+
+	dao := dao.NewCredentialsUpdateEmailVerified()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				credentials, err := dao.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, credentials)
+			})
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -33,37 +34,40 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
 			request: &dao.CredentialsUpdateEmailRequest{
-				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email: "new-user@provider.com",
-				Now:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "new-user@provider.com",
+				EmailCanonical: "new-user@provider.com",
+				Now:            time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 
 			expect: &dao.Credentials{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email:     "new-user@provider.com",
-				Password:  "password-2-hashed",
-				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				Role:      "auth:user",
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:           "new-user@provider.com",
+				EmailCanonical:  "new-user@provider.com",
+				Password:        "password-2-hashed",
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				EmailVerifiedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
 			},
 		},
 		{
 			name: "Error/NotFound",
 
 			request: &dao.CredentialsUpdateEmailRequest{
-				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email: "new-user@provider.com",
-				Now:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "new-user@provider.com",
+				EmailCanonical: "new-user@provider.com",
+				Now:            time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 
 			expectErr: dao.ErrCredentialsUpdateEmailNotFound,
@@ -73,27 +77,28 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-					Email:     "new-user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:          "new-user@provider.com",
+					EmailCanonical: "new-user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
 				},
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 
 			request: &dao.CredentialsUpdateEmailRequest{
-				ID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email: "new-user@provider.com",
-				Now:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "new-user@provider.com",
+				EmailCanonical: "new-user@provider.com",
+				Now:            time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 
 			expectErr: dao.ErrCredentialsUpdateEmailAlreadyExists,
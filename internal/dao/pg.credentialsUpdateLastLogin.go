@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsUpdateLastLogin.sql
+var credentialsUpdateLastLoginQuery string
+
+// ErrCredentialsUpdateLastLoginNotFound is returned by [CredentialsUpdateLastLogin.Exec] when
+// no row matches the requested ID. It is joined onto the underlying sql.ErrNoRows so
+// callers can branch on it with errors.Is.
+var ErrCredentialsUpdateLastLoginNotFound = errors.New("credentials not found")
+
+// CredentialsUpdateLastLoginRequest is the input to [CredentialsUpdateLastLogin.Exec].
+type CredentialsUpdateLastLoginRequest struct {
+	// ID of the credentials to update.
+	ID uuid.UUID
+	// Now is the timestamp recorded as the user's last login.
+	Now time.Time
+}
+
+// CredentialsUpdateLastLogin records that a user just signed in.
+type CredentialsUpdateLastLogin struct{}
+
+func NewCredentialsUpdateLastLogin() *CredentialsUpdateLastLogin {
+	return &CredentialsUpdateLastLogin{}
+}
+
+func (dao *CredentialsUpdateLastLogin) Exec(
+	ctx context.Context, request *CredentialsUpdateLastLoginRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsUpdateLastLogin")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentials.id", request.ID.String()),
+		attribute.Int64("credentials.now", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Credentials)
+
+	err = tx.NewRaw(credentialsUpdateLastLoginQuery, request.Now, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrCredentialsUpdateLastLoginNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
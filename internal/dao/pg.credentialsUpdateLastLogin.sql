@@ -0,0 +1,16 @@
+-- updated_at tracks changes the user makes to the account; signing in is not one of them.
+UPDATE credentials
+SET
+  last_login_at = ?0
+WHERE
+  id = ?1
+RETURNING
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles;
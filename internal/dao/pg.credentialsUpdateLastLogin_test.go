@@ -0,0 +1,98 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestCredentialsUpdateLastLogin(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Credentials
+
+		request *dao.CredentialsUpdateLastLoginRequest
+
+		expect    *dao.Credentials
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					LastLoginAt:    lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+
+			request: &dao.CredentialsUpdateLastLoginRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			// Signing in is not a change to the account: updated_at stays put.
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				LastLoginAt:    lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.CredentialsUpdateLastLoginRequest{
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Now: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrCredentialsUpdateLastLoginNotFound,
+		},
+	}
+
+	dao := dao.NewCredentialsUpdateLastLogin()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				credentials, err := dao.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, credentials)
+			})
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsUpdateLocale.sql
+var credentialsUpdateLocaleQuery string
+
+// ErrCredentialsUpdateLocaleNotFound is returned by [CredentialsUpdateLocale.Exec] when
+// no row matches the requested ID. It is joined onto the underlying sql.ErrNoRows so
+// callers can branch on it with errors.Is.
+var ErrCredentialsUpdateLocaleNotFound = errors.New("credentials not found")
+
+// CredentialsUpdateLocaleRequest is the input to [CredentialsUpdateLocale.Exec].
+type CredentialsUpdateLocaleRequest struct {
+	// ID of the credentials to update.
+	ID uuid.UUID
+	// Locale is the new preferred language of the user.
+	Locale string
+	// Now is the timestamp recorded as the row's update time.
+	Now time.Time
+}
+
+// CredentialsUpdateLocale sets the language a user receives emails in.
+type CredentialsUpdateLocale struct{}
+
+func NewCredentialsUpdateLocale() *CredentialsUpdateLocale {
+	return &CredentialsUpdateLocale{}
+}
+
+func (dao *CredentialsUpdateLocale) Exec(
+	ctx context.Context, request *CredentialsUpdateLocaleRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsUpdateLocale")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentials.id", request.ID.String()),
+		attribute.String("credentials.locale", request.Locale),
+		attribute.Int64("credentials.now", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Credentials)
+
+	err = tx.NewRaw(credentialsUpdateLocaleQuery, request.Locale, request.Now, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrCredentialsUpdateLocaleNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
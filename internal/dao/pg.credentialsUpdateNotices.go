@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.credentialsUpdateNotices.sql
+var credentialsUpdateNoticesQuery string
+
+// ErrCredentialsUpdateNoticesNotFound is returned by [CredentialsUpdateNotices.Exec] when
+// no row matches the requested ID. It is joined onto the underlying sql.ErrNoRows so
+// callers can branch on it with errors.Is.
+var ErrCredentialsUpdateNoticesNotFound = errors.New("credentials not found")
+
+// CredentialsUpdateNoticesRequest is the input to [CredentialsUpdateNotices.Exec].
+type CredentialsUpdateNoticesRequest struct {
+	// ID of the credentials to update.
+	ID uuid.UUID
+	// See Credentials.NoticesOptOut.
+	NoticesOptOut bool
+	// Now is the timestamp recorded as the row's update time.
+	Now time.Time
+}
+
+// CredentialsUpdateNotices sets whether a user receives the notices that are not critical to
+// the security of their account.
+type CredentialsUpdateNotices struct{}
+
+func NewCredentialsUpdateNotices() *CredentialsUpdateNotices {
+	return &CredentialsUpdateNotices{}
+}
+
+func (dao *CredentialsUpdateNotices) Exec(
+	ctx context.Context, request *CredentialsUpdateNoticesRequest,
+) (*Credentials, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.CredentialsUpdateNotices")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("credentials.id", request.ID.String()),
+		attribute.Bool("credentials.noticesOptOut", request.NoticesOptOut),
+		attribute.Int64("credentials.now", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Credentials)
+
+	err = tx.NewRaw(credentialsUpdateNoticesQuery, request.NoticesOptOut, request.Now, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrCredentialsUpdateNoticesNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
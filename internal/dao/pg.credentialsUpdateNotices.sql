@@ -0,0 +1,16 @@
+UPDATE credentials
+SET
+  notices_opt_out = ?0,
+  updated_at = ?1
+WHERE
+  id = ?2
+RETURNING
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles;
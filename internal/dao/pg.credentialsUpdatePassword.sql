@@ -5,4 +5,12 @@ SET
 WHERE
   id = ?2
 RETURNING
-  *;
+  *,
+  (
+    SELECT
+      array_agg(role ORDER BY role)
+    FROM
+      credential_roles
+    WHERE
+      credential_id = credentials.id
+  ) AS roles;
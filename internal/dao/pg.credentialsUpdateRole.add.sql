@@ -0,0 +1,10 @@
+-- Granting a role the credentials already hold keeps its original grant time.
+INSERT INTO
+  credential_roles (credential_id, role, created_at)
+SELECT
+  ?0,
+  granted_role,
+  ?2
+FROM
+  unnest(?1::text[]) AS granted_role
+ON CONFLICT (credential_id, role) DO NOTHING;
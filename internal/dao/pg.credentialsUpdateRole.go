@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel-kit/golib/otel"
@@ -18,6 +19,12 @@ import (
 //go:embed pg.credentialsUpdateRole.sql
 var credentialsUpdateRoleQuery string
 
+//go:embed pg.credentialsUpdateRole.remove.sql
+var credentialsUpdateRoleRemoveQuery string
+
+//go:embed pg.credentialsUpdateRole.add.sql
+var credentialsUpdateRoleAddQuery string
+
 // ErrCredentialsUpdateRoleNotFound is returned by [CredentialsUpdateRole.Exec]
 // when no row matches the requested ID. It is joined onto the underlying
 // sql.ErrNoRows so callers can branch on it with errors.Is.
@@ -27,14 +34,22 @@ var ErrCredentialsUpdateRoleNotFound = errors.New("credentials not found")
 type CredentialsUpdateRoleRequest struct {
 	// ID of the credentials to update.
 	ID uuid.UUID
-	// Role is the new role name to assign. Validating it against the configured
-	// permission map belongs to the service layer.
-	Role string
-	// Now is the timestamp recorded as the row's update time.
+	// Add lists the roles to grant. Roles the credentials already hold are ignored.
+	// Validating them against the configured permission map belongs to the service layer.
+	Add []string
+	// Remove lists the roles to revoke. Roles the credentials do not hold are ignored.
+	// Remove applies before Add, so a role listed in both is kept.
+	Remove []string
+	// Now is the timestamp recorded as the row's update time, and the grant time of the
+	// added roles.
 	Now time.Time
 }
 
-// CredentialsUpdateRole assigns a new role to a set of credentials.
+// CredentialsUpdateRole grants and revokes roles of a set of credentials, and returns the
+// updated credentials with the roles they end up with.
+//
+// It joins the caller's transaction when there is one, so the update commits or rolls back with
+// the rest of the caller's work.
 type CredentialsUpdateRole struct{}
 
 func NewCredentialsUpdateRole() *CredentialsUpdateRole {
@@ -49,24 +64,57 @@ func (dao *CredentialsUpdateRole) Exec(
 
 	span.SetAttributes(
 		attribute.String("credentials.id", request.ID.String()),
-		attribute.String("credentials.role", request.Role),
+		attribute.StringSlice("credentials.roles.add", request.Add),
+		attribute.StringSlice("credentials.roles.remove", request.Remove),
 		attribute.Int64("credentials.now", request.Now.Unix()),
 	)
 
-	tx, err := postgres.GetContext(ctx)
-	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
-	}
-
 	entity := new(Credentials)
 
-	err = tx.NewRaw(credentialsUpdateRoleQuery, request.Role, request.Now, request.ID).Scan(ctx, entity)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			err = errors.Join(err, ErrCredentialsUpdateRoleNotFound)
+	err := postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+		tx, err := postgres.GetContext(ctx)
+		if err != nil {
+			return fmt.Errorf("get database handle: %w", err)
 		}
 
-		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+		var id uuid.UUID
+
+		err = tx.NewRaw(credentialsUpdateRoleQuery, request.Now, request.ID).Scan(ctx, &id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.Join(err, ErrCredentialsUpdateRoleNotFound)
+			}
+
+			return fmt.Errorf("update credentials: %w", err)
+		}
+
+		if len(request.Remove) > 0 {
+			_, err = tx.NewRaw(
+				credentialsUpdateRoleRemoveQuery, request.ID, pgdialect.Array(request.Remove),
+			).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("revoke roles: %w", err)
+			}
+		}
+
+		if len(request.Add) > 0 {
+			_, err = tx.NewRaw(
+				credentialsUpdateRoleAddQuery, request.ID, pgdialect.Array(request.Add), request.Now,
+			).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("grant roles: %w", err)
+			}
+		}
+
+		err = tx.NewRaw(credentialsSelectQuery, request.ID).Scan(ctx, entity)
+		if err != nil {
+			return fmt.Errorf("select credentials: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
 	}
 
 	return otel.ReportSuccess(span, entity), nil
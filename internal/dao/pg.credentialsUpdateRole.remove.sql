@@ -0,0 +1,4 @@
+DELETE FROM credential_roles
+WHERE
+  credential_id = ?0
+  AND role = ANY (?1::text[]);
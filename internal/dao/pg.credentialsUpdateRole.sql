@@ -1,7 +1,7 @@
 UPDATE credentials
-SET role = ?0,
-updated_at = ?1
+SET
+  updated_at = ?0
 WHERE
-  id = ?2
+  id = ?1
 RETURNING
-  *;
+  id;
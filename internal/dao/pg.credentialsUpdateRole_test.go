@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -29,41 +30,171 @@ func TestCredentialsUpdateRole(t *testing.T) {
 		expectErr error
 	}{
 		{
-			name: "Success",
+			name: "Success/Add",
 
 			fixtures: []*dao.Credentials{
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					Email:     "user@provider.com",
-					Password:  "password-2-hashed",
-					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-					Role:      "auth:user",
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Roles:          []string{"auth:user"},
 				},
 			},
 
 			request: &dao.CredentialsUpdateRoleRequest{
-				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role: "auth:admin",
-				Now:  time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:    []string{"auth:admin"},
+				Remove: nil,
+				Now:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 
 			expect: &dao.Credentials{
-				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Email:     "user@provider.com",
-				Password:  "password-2-hashed",
-				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
-				Role:      "auth:admin",
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:admin", "auth:user"},
+			},
+		},
+		{
+			name: "Success/Remove",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Roles:          []string{"auth:admin", "auth:user"},
+				},
+			},
+
+			request: &dao.CredentialsUpdateRoleRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:    nil,
+				Remove: []string{"auth:admin"},
+				Now:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+			},
+		},
+		{
+			name: "Success/AddAndRemove",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Roles:          []string{"auth:user"},
+				},
+			},
+
+			request: &dao.CredentialsUpdateRoleRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:    []string{"auth:admin"},
+				Remove: []string{"auth:user"},
+				Now:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:admin"},
+			},
+		},
+		{
+			name: "Success/AlreadyHeld",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Roles:          []string{"auth:user"},
+				},
+			},
+
+			request: &dao.CredentialsUpdateRoleRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:    []string{"auth:user"},
+				Remove: []string{"auth:admin"},
+				Now:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Roles:          []string{"auth:user"},
+			},
+		},
+		{
+			name: "Success/RemoveAll",
+
+			fixtures: []*dao.Credentials{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Email:          "user@provider.com",
+					EmailCanonical: "user@provider.com",
+					Password:       "password-2-hashed",
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Roles:          []string{"auth:user"},
+				},
+			},
+
+			request: &dao.CredentialsUpdateRoleRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add:    nil,
+				Remove: []string{"auth:user"},
+				Now:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Credentials{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Email:          "user@provider.com",
+				EmailCanonical: "user@provider.com",
+				Password:       "password-2-hashed",
+				CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 		},
 		{
 			name: "Error/NotFound",
 
 			request: &dao.CredentialsUpdateRoleRequest{
-				ID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-				Role: "auth:admin",
-				Now:  time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				ID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Add: []string{"auth:admin"},
+				Now: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 			},
 
 			expectErr: dao.ErrCredentialsUpdateRoleNotFound,
@@ -85,6 +216,9 @@ func TestCredentialsUpdateRole(t *testing.T) {
 				if len(testCase.fixtures) > 0 {
 					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
 					require.NoError(t, err)
+
+					_, err = db.NewInsert().Model(lo.ToPtr(credentialRoleFixtures(testCase.fixtures))).Exec(ctx)
+					require.NoError(t, err)
 				}
 
 				credentials, err := dao.Exec(ctx, testCase.request)
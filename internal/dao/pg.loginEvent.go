@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// LoginEventKindLogin is a sign-in with an email and a password.
+	LoginEventKindLogin = "login"
+	// LoginEventKindRefresh is the renewal of an access token from a refresh token.
+	LoginEventKindRefresh = "refresh"
+)
+
+const (
+	// LoginEventOutcomeSuccess is an attempt that issued a token.
+	LoginEventOutcomeSuccess = "success"
+	// LoginEventOutcomeInvalidPassword is an attempt on an existing account, with the wrong
+	// password.
+	LoginEventOutcomeInvalidPassword = "invalid_password"
+	// LoginEventOutcomeUnknownEmail is an attempt with an email that matches no account.
+	LoginEventOutcomeUnknownEmail = "unknown_email"
+)
+
+// LoginEvent records an attempt to obtain a token, successful or not.
+type LoginEvent struct {
+	bun.BaseModel `bun:"table:login_events"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+
+	// UserID is the account the attempt targeted. Nil when the email matched no account.
+	UserID *uuid.UUID `bun:"user_id,type:uuid"`
+	// Email is the address submitted with the attempt. Empty for refreshes, which carry
+	// no email.
+	Email string `bun:"email,nullzero"`
+
+	// Kind is one of the LoginEventKind* constants.
+	Kind string `bun:"kind"`
+	// Outcome is one of the LoginEventOutcome* constants.
+	Outcome string `bun:"outcome"`
+
+	// IP and UserAgent identify where the attempt came from, as seen by the service.
+	IP        string `bun:"ip,nullzero"`
+	UserAgent string `bun:"user_agent,nullzero"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
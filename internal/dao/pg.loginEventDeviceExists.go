@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.loginEventDeviceExists.sql
+var loginEventDeviceExistsQuery string
+
+// LoginEventDeviceExistsRequest is the input to [LoginEventDeviceExists.Exec].
+type LoginEventDeviceExistsRequest struct {
+	// UserID is the account to look the device up for.
+	UserID uuid.UUID
+	// IP and UserAgent identify the device. Empty values only match events that recorded none.
+	IP        string
+	UserAgent string
+}
+
+// LoginEventDeviceExists reports whether an account was already signed in from a device, that
+// is an IP and user agent pair, according to its login history.
+//
+// An unknown device yields false with a nil error.
+type LoginEventDeviceExists struct{}
+
+func NewLoginEventDeviceExists() *LoginEventDeviceExists {
+	return &LoginEventDeviceExists{}
+}
+
+func (dao *LoginEventDeviceExists) Exec(ctx context.Context, request *LoginEventDeviceExistsRequest) (bool, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.LoginEventDeviceExists")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("loginEvent.userID", request.UserID.String()),
+		attribute.String("loginEvent.ip", request.IP),
+		attribute.String("loginEvent.userAgent", request.UserAgent),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return false, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	res, err := tx.NewRaw(loginEventDeviceExistsQuery, request.UserID, request.IP, request.UserAgent).Exec(ctx)
+	if err != nil {
+		return false, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, otel.ReportError(span, fmt.Errorf("get rows affected: %w", err))
+	}
+
+	return otel.ReportSuccess(span, n >= 1), nil
+}
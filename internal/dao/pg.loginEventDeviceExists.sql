@@ -0,0 +1,12 @@
+-- Refreshes count too: a device that renewed a session was signed in before.
+SELECT
+  1
+FROM
+  login_events
+WHERE
+  user_id = ?0
+  AND outcome = 'success'
+  AND ip IS NOT DISTINCT FROM NULLIF(?1, '')
+  AND user_agent IS NOT DISTINCT FROM NULLIF(?2, '')
+LIMIT
+  1;
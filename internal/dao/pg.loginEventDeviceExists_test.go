@@ -0,0 +1,137 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestLoginEventDeviceExists(t *testing.T) {
+	t.Parallel()
+
+	user1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	user2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	credentials := []*dao.Credentials{
+		{
+			ID:             user1,
+			Email:          "user1@provider.com",
+			EmailCanonical: "user1@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             user2,
+			Email:          "user2@provider.com",
+			EmailCanonical: "user2@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtures := []*dao.LoginEvent{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+			UserID:    lo.ToPtr(user1),
+			Email:     "user1@provider.com",
+			Kind:      dao.LoginEventKindLogin,
+			Outcome:   dao.LoginEventOutcomeSuccess,
+			IP:        "192.0.2.1",
+			UserAgent: "Mozilla/5.0",
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+			UserID:    lo.ToPtr(user1),
+			Email:     "user1@provider.com",
+			Kind:      dao.LoginEventKindLogin,
+			Outcome:   dao.LoginEventOutcomeInvalidPassword,
+			IP:        "192.0.2.2",
+			UserAgent: "Mozilla/5.0",
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+			UserID:    lo.ToPtr(user1),
+			Kind:      dao.LoginEventKindRefresh,
+			Outcome:   dao.LoginEventOutcomeSuccess,
+			IP:        "192.0.2.3",
+			CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.LoginEventDeviceExistsRequest
+
+		expect    bool
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.LoginEventDeviceExistsRequest{UserID: user1, IP: "192.0.2.1", UserAgent: "Mozilla/5.0"},
+			expect:  true,
+		},
+		{
+			name: "Success/Refresh",
+
+			request: &dao.LoginEventDeviceExistsRequest{UserID: user1, IP: "192.0.2.3"},
+			expect:  true,
+		},
+		{
+			name: "Success/OtherUserAgent",
+
+			request: &dao.LoginEventDeviceExistsRequest{UserID: user1, IP: "192.0.2.1", UserAgent: "curl/8.0"},
+			expect:  false,
+		},
+		{
+			name: "Success/FailedAttempt",
+
+			request: &dao.LoginEventDeviceExistsRequest{UserID: user1, IP: "192.0.2.2", UserAgent: "Mozilla/5.0"},
+			expect:  false,
+		},
+		{
+			name: "Success/OtherUser",
+
+			request: &dao.LoginEventDeviceExistsRequest{UserID: user2, IP: "192.0.2.1", UserAgent: "Mozilla/5.0"},
+			expect:  false,
+		},
+	}
+
+	existsDAO := dao.NewLoginEventDeviceExists()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&credentials).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				exists, err := existsDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, exists)
+			})
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.loginEventInsert.sql
+var loginEventInsertQuery string
+
+// LoginEventInsertRequest is the input to [LoginEventInsert.Exec].
+type LoginEventInsertRequest struct {
+	// See LoginEvent.ID.
+	ID uuid.UUID
+	// See LoginEvent.UserID.
+	UserID *uuid.UUID
+	// See LoginEvent.Email.
+	Email string
+	// See LoginEvent.Kind.
+	Kind string
+	// See LoginEvent.Outcome.
+	Outcome string
+	// See LoginEvent.IP.
+	IP string
+	// See LoginEvent.UserAgent.
+	UserAgent string
+	// Now is the timestamp recorded as the event's creation time.
+	Now time.Time
+}
+
+// LoginEventInsert records a new login event in the database.
+type LoginEventInsert struct{}
+
+func NewLoginEventInsert() *LoginEventInsert {
+	return &LoginEventInsert{}
+}
+
+func (dao *LoginEventInsert) Exec(ctx context.Context, request *LoginEventInsertRequest) (*LoginEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.LoginEventInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("loginEvent.id", request.ID.String()),
+		attribute.String("loginEvent.kind", request.Kind),
+		attribute.String("loginEvent.outcome", request.Outcome),
+	)
+
+	if request.UserID != nil {
+		span.SetAttributes(attribute.String("loginEvent.userID", request.UserID.String()))
+	}
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(LoginEvent)
+
+	err = tx.NewRaw(
+		loginEventInsertQuery,
+		request.ID,
+		request.UserID,
+		request.Email,
+		request.Kind,
+		request.Outcome,
+		request.IP,
+		request.UserAgent,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,15 @@
+INSERT INTO
+  login_events (
+    id,
+    user_id,
+    email,
+    kind,
+    outcome,
+    ip,
+    user_agent,
+    created_at
+  )
+VALUES
+  (?0, ?1, NULLIF(?2, ''), ?3, ?4, NULLIF(?5, ''), NULLIF(?6, ''), ?7)
+RETURNING
+  *;
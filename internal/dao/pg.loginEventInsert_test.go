@@ -0,0 +1,125 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestLoginEventInsert(t *testing.T) {
+	t.Parallel()
+
+	credentials := &dao.Credentials{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Email:          "user@provider.com",
+		EmailCanonical: "user@provider.com",
+		CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.LoginEventInsertRequest
+
+		expect *dao.LoginEvent
+		// expectErr is set when the schema must reject the row.
+		expectErr bool
+	}{
+		{
+			name: "Success",
+
+			request: &dao.LoginEventInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				UserID:    lo.ToPtr(credentials.ID),
+				Email:     "user@provider.com",
+				Kind:      dao.LoginEventKindLogin,
+				Outcome:   dao.LoginEventOutcomeSuccess,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+				Now:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoginEvent{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				UserID:    lo.ToPtr(credentials.ID),
+				Email:     "user@provider.com",
+				Kind:      dao.LoginEventKindLogin,
+				Outcome:   dao.LoginEventOutcomeSuccess,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/UnknownEmail",
+
+			request: &dao.LoginEventInsertRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Email:   "ghost@provider.com",
+				Kind:    dao.LoginEventKindLogin,
+				Outcome: dao.LoginEventOutcomeUnknownEmail,
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.LoginEvent{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Email:     "ghost@provider.com",
+				Kind:      dao.LoginEventKindLogin,
+				Outcome:   dao.LoginEventOutcomeUnknownEmail,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/InvalidOutcome",
+
+			request: &dao.LoginEventInsertRequest{
+				ID:      uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				UserID:  lo.ToPtr(credentials.ID),
+				Kind:    dao.LoginEventKindRefresh,
+				Outcome: "maybe",
+				Now:     time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: true,
+		},
+	}
+
+	insertDAO := dao.NewLoginEventInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(credentials).Exec(ctx)
+				require.NoError(t, err)
+
+				event, err := insertDAO.Exec(ctx, testCase.request)
+				if testCase.expectErr {
+					require.Error(t, err)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, testCase.expect, event)
+			})
+		})
+	}
+}
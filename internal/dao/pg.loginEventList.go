@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.loginEventList.sql
+var loginEventListQuery string
+
+// LoginEventListRequest is the input to [LoginEventList.Exec].
+type LoginEventListRequest struct {
+	// UserID is the account whose events are listed.
+	UserID uuid.UUID
+	// Limit caps the number of events returned. Zero returns every event.
+	Limit  int
+	Offset int
+}
+
+// LoginEventList returns the login history of a user, newest first. Attempts with an
+// email that matched no account are tied to no user, so they never show up here.
+type LoginEventList struct{}
+
+func NewLoginEventList() *LoginEventList {
+	return &LoginEventList{}
+}
+
+func (dao *LoginEventList) Exec(ctx context.Context, request *LoginEventListRequest) ([]*LoginEvent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.LoginEventList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("data.userID", request.UserID.String()),
+		attribute.Int("data.limit", request.Limit),
+		attribute.Int("data.offset", request.Offset),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*LoginEvent, 0, request.Limit)
+
+	err = tx.NewRaw(loginEventListQuery, request.UserID, bun.NullZero(request.Limit), request.Offset).
+		Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,14 @@
+-- Served by login_events_user_id_created_at_idx, newest first.
+SELECT
+  *
+FROM
+  login_events
+WHERE
+  user_id = ?0
+ORDER BY
+  created_at DESC,
+  id DESC
+LIMIT
+  ?1
+OFFSET
+  ?2;
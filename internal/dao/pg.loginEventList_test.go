@@ -0,0 +1,136 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestLoginEventList(t *testing.T) {
+	t.Parallel()
+
+	user1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	user2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	credentials := []*dao.Credentials{
+		{
+			ID:             user1,
+			Email:          "user1@provider.com",
+			EmailCanonical: "user1@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             user2,
+			Email:          "user2@provider.com",
+			EmailCanonical: "user2@provider.com",
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	event1 := &dao.LoginEvent{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+		UserID:    lo.ToPtr(user1),
+		Email:     "user1@provider.com",
+		Kind:      dao.LoginEventKindLogin,
+		Outcome:   dao.LoginEventOutcomeInvalidPassword,
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	event2 := &dao.LoginEvent{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+		UserID:    lo.ToPtr(user1),
+		Email:     "user1@provider.com",
+		Kind:      dao.LoginEventKindLogin,
+		Outcome:   dao.LoginEventOutcomeSuccess,
+		IP:        "192.0.2.1",
+		CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	event3 := &dao.LoginEvent{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+		UserID:    lo.ToPtr(user1),
+		Kind:      dao.LoginEventKindRefresh,
+		Outcome:   dao.LoginEventOutcomeSuccess,
+		CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+	}
+	otherUserEvent := &dao.LoginEvent{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000014"),
+		UserID:    lo.ToPtr(user2),
+		Email:     "user2@provider.com",
+		Kind:      dao.LoginEventKindLogin,
+		Outcome:   dao.LoginEventOutcomeSuccess,
+		CreatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+	unknownEmailEvent := &dao.LoginEvent{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000015"),
+		Email:     "user1@provider.org",
+		Kind:      dao.LoginEventKindLogin,
+		Outcome:   dao.LoginEventOutcomeUnknownEmail,
+		CreatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	fixtures := []*dao.LoginEvent{event1, event2, event3, otherUserEvent, unknownEmailEvent}
+
+	testCases := []struct {
+		name string
+
+		request *dao.LoginEventListRequest
+
+		expect    []*dao.LoginEvent
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.LoginEventListRequest{UserID: user1},
+			expect:  []*dao.LoginEvent{event3, event2, event1},
+		},
+		{
+			name: "Success/Paginate",
+
+			request: &dao.LoginEventListRequest{UserID: user1, Limit: 1, Offset: 1},
+			expect:  []*dao.LoginEvent{event2},
+		},
+		{
+			name: "Success/NoEvents",
+
+			request: &dao.LoginEventListRequest{UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003")},
+			expect:  []*dao.LoginEvent{},
+		},
+	}
+
+	listDAO := dao.NewLoginEventList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&credentials).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				events, err := listDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, events)
+			})
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Role bundles the permissions granted to the users holding it.
+type Role struct {
+	bun.BaseModel `bun:"table:roles"`
+
+	// Name identifies the role. Accounts and tokens reference roles by name.
+	Name string `bun:"name,pk"`
+	// Priority ranks the role in the hierarchy; a higher value outranks a lower one.
+	Priority int `bun:"priority"`
+	// Permissions lists the permissions the role grants by itself.
+	Permissions []string `bun:"permissions,array"`
+
+	// Inherits lists the roles whose permissions this role also grants, sorted by name. They are
+	// stored in the role_inherits table, so queries aggregate them: writes to roles never carry
+	// them. Nil when the role inherits none.
+	Inherits []string `bun:"inherits,array,scanonly"`
+
+	CreatedAt time.Time `bun:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at"`
+}
+
+// RoleInherit makes a role inherit the permissions of another one.
+type RoleInherit struct {
+	bun.BaseModel `bun:"table:role_inherits"`
+
+	// Role is the name of the inheriting role.
+	Role string `bun:"role,pk"`
+	// Inherits is the name of the inherited role.
+	Inherits string `bun:"inherits,pk"`
+}
@@ -0,0 +1,125 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+// roleInheritFixtures returns the role_inherits rows for the Inherits of each role. Inherits are
+// not a column of roles, so inserting the roles alone does not store them.
+func roleInheritFixtures(roles []*dao.Role) []*dao.RoleInherit {
+	return lo.FlatMap(roles, func(item *dao.Role, _ int) []*dao.RoleInherit {
+		return lo.Map(item.Inherits, func(parent string, _ int) *dao.RoleInherit {
+			return &dao.RoleInherit{Role: item.Name, Inherits: parent}
+		})
+	})
+}
+
+// insertRoleFixtures stores roles along with their inherits.
+func insertRoleFixtures(ctx context.Context, t *testing.T, roles []*dao.Role) {
+	t.Helper()
+
+	if len(roles) == 0 {
+		return
+	}
+
+	db, err := postgres.GetContext(ctx)
+	require.NoError(t, err)
+
+	_, err = db.NewInsert().Model(&roles).Exec(ctx)
+	require.NoError(t, err)
+
+	inherits := roleInheritFixtures(roles)
+	if len(inherits) > 0 {
+		_, err = db.NewInsert().Model(&inherits).Exec(ctx)
+		require.NoError(t, err)
+	}
+}
+
+// The schema keeps the inheritance graph consistent: a role cannot inherit itself, and an
+// inherited role cannot be deleted.
+func TestRoleSchema(t *testing.T) {
+	t.Parallel()
+
+	roles := []*dao.Role{
+		{
+			Name:        "test:parent",
+			Priority:    1,
+			Permissions: []string{"test:read"},
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		},
+		{
+			Name:        "test:child",
+			Priority:    2,
+			Permissions: []string{"test:write"},
+			Inherits:    []string{"test:parent"},
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		},
+	}
+
+	t.Run("the check constraint rejects a role inheriting itself", func(t *testing.T) {
+		t.Parallel()
+
+		postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+			t.Helper()
+
+			insertRoleFixtures(ctx, t, roles)
+
+			db, err := postgres.GetContext(ctx)
+			require.NoError(t, err)
+
+			_, err = db.NewInsert().Model(&dao.RoleInherit{Role: "test:parent", Inherits: "test:parent"}).Exec(ctx)
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("the foreign key rejects deleting an inherited role", func(t *testing.T) {
+		t.Parallel()
+
+		postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+			t.Helper()
+
+			insertRoleFixtures(ctx, t, roles)
+
+			db, err := postgres.GetContext(ctx)
+			require.NoError(t, err)
+
+			_, err = db.NewDelete().Model(roles[0]).WherePK().Exec(ctx)
+			require.Error(t, err)
+		})
+	})
+
+	t.Run("deleting a role deletes its inherits", func(t *testing.T) {
+		t.Parallel()
+
+		postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+			t.Helper()
+
+			insertRoleFixtures(ctx, t, roles)
+
+			db, err := postgres.GetContext(ctx)
+			require.NoError(t, err)
+
+			_, err = db.NewDelete().Model(roles[1]).WherePK().Exec(ctx)
+			require.NoError(t, err)
+
+			count, err := db.NewSelect().Model((*dao.RoleInherit)(nil)).
+				Where("role = ?", roles[1].Name).
+				Count(ctx)
+			require.NoError(t, err)
+			require.Zero(t, count)
+		})
+	})
+}
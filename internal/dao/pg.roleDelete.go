@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.roleDelete.sql
+var roleDeleteQuery string
+
+var (
+	// ErrRoleDeleteNotFound is returned by [RoleDelete.Exec] when no role has the requested
+	// name. It is joined onto the underlying sql.ErrNoRows so callers can branch on it with
+	// errors.Is.
+	ErrRoleDeleteNotFound = errors.New("role not found")
+	// ErrRoleDeleteInUse is returned by [RoleDelete.Exec] when an account holds the role, or
+	// another role inherits it. It is detected from the Postgres foreign-key-violation SQLSTATE
+	// (23503) and joined onto the underlying driver error so callers can branch on it with
+	// errors.Is.
+	ErrRoleDeleteInUse = errors.New("role is in use")
+)
+
+// RoleDeleteRequest is the input to [RoleDelete.Exec].
+type RoleDeleteRequest struct {
+	// Name of the role to delete.
+	Name string
+}
+
+// RoleDelete deletes a role, and returns it as it was before the deletion. The roles it inherits
+// are kept; the role must no longer be held nor inherited.
+type RoleDelete struct{}
+
+func NewRoleDelete() *RoleDelete {
+	return &RoleDelete{}
+}
+
+func (dao *RoleDelete) Exec(ctx context.Context, request *RoleDeleteRequest) (*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.RoleDelete")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("role.name", request.Name))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Role)
+
+	err = tx.NewRaw(roleDeleteQuery, request.Name).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			err = errors.Join(err, ErrRoleDeleteNotFound)
+		case errors.As(err, &pgErr) && pgErr.Field('C') == "23503":
+			err = errors.Join(err, ErrRoleDeleteInUse)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,13 @@
+DELETE FROM roles
+WHERE
+  name = ?0
+RETURNING
+  *,
+  (
+    SELECT
+      array_agg(inherits ORDER BY inherits)
+    FROM
+      role_inherits
+    WHERE
+      role = roles.name
+  ) AS inherits;
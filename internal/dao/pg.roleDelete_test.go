@@ -0,0 +1,129 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestRoleDelete(t *testing.T) {
+	t.Parallel()
+
+	fixtures := []*dao.Role{
+		{
+			Name:        "test:reader",
+			Priority:    1,
+			Permissions: []string{"test:read"},
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:        "test:editor",
+			Priority:    2,
+			Permissions: []string{"test:write"},
+			Inherits:    []string{"test:reader"},
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:        "test:held",
+			Priority:    1,
+			Permissions: []string{"test:hold"},
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	credentialsFixtures := []*dao.Credentials{
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Email:          "user@provider.com",
+			EmailCanonical: "user@provider.com",
+			Roles:          []string{"test:held"},
+			CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.RoleDeleteRequest
+
+		expect    *dao.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.RoleDeleteRequest{Name: "test:editor"},
+
+			expect: &dao.Role{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"test:reader"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/Inherited",
+
+			request: &dao.RoleDeleteRequest{Name: "test:reader"},
+
+			expectErr: dao.ErrRoleDeleteInUse,
+		},
+		{
+			name: "Error/Held",
+
+			request: &dao.RoleDeleteRequest{Name: "test:held"},
+
+			expectErr: dao.ErrRoleDeleteInUse,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.RoleDeleteRequest{Name: "test:unknown"},
+
+			expectErr: dao.ErrRoleDeleteNotFound,
+		},
+	}
+
+	deleteDAO := dao.NewRoleDelete()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				insertRoleFixtures(ctx, t, fixtures)
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&credentialsFixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(lo.ToPtr(credentialRoleFixtures(credentialsFixtures))).Exec(ctx)
+				require.NoError(t, err)
+
+				role, err := deleteDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, role)
+			})
+		})
+	}
+}
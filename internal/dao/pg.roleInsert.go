@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.roleInsert.sql
+var roleInsertQuery string
+
+// ErrRoleInsertAlreadyExists is returned by [RoleInsert.Exec] when a role with the same name
+// exists. It is detected from the Postgres unique-violation SQLSTATE (23505) and joined onto the
+// underlying driver error so callers can branch on it with errors.Is.
+var ErrRoleInsertAlreadyExists = errors.New("role already exists")
+
+// RoleInsertRequest is the input to [RoleInsert.Exec].
+type RoleInsertRequest struct {
+	// See Role.Name.
+	Name string
+	// See Role.Priority.
+	Priority int
+	// See Role.Permissions.
+	Permissions []string
+	// See Role.Inherits. Every inherited role must exist, and duplicates are not allowed.
+	Inherits []string
+	// Now is the timestamp recorded as the row's creation time.
+	Now time.Time
+}
+
+// RoleInsert creates a role. Checking that its inheritance introduces no cycle belongs to the
+// service layer.
+type RoleInsert struct{}
+
+func NewRoleInsert() *RoleInsert {
+	return &RoleInsert{}
+}
+
+func (dao *RoleInsert) Exec(ctx context.Context, request *RoleInsertRequest) (*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.RoleInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("role.name", request.Name),
+		attribute.Int("role.priority", request.Priority),
+		attribute.StringSlice("role.permissions", request.Permissions),
+		attribute.StringSlice("role.inherits", request.Inherits),
+		attribute.Int64("role.now", request.Now.Unix()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Role)
+
+	err = tx.NewRaw(
+		roleInsertQuery,
+		request.Name,
+		request.Priority,
+		pgdialect.Array(request.Permissions),
+		request.Now,
+		pgdialect.Array(request.Inherits),
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrRoleInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
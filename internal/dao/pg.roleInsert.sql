@@ -0,0 +1,31 @@
+WITH
+  inserted AS (
+    INSERT INTO
+      roles (name, priority, permissions, created_at, updated_at)
+    VALUES
+      (?0, ?1, COALESCE(?2::text[], '{}'), ?3, ?3)
+    RETURNING
+      *
+  ),
+  inherited AS (
+    INSERT INTO
+      role_inherits (role, inherits)
+    SELECT
+      inserted.name,
+      parent
+    FROM
+      inserted,
+      unnest(?4::text[]) AS parent
+    RETURNING
+      inherits
+  )
+SELECT
+  inserted.*,
+  (
+    SELECT
+      array_agg(inherits ORDER BY inherits)
+    FROM
+      inherited
+  ) AS inherits
+FROM
+  inserted;
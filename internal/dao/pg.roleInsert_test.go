@@ -0,0 +1,136 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestRoleInsert(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Role
+
+		request *dao.RoleInsertRequest
+
+		expect    *dao.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.RoleInsertRequest{
+				Name:        "test:reader",
+				Priority:    1,
+				Permissions: []string{"test:read"},
+				Now:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:reader",
+				Priority:    1,
+				Permissions: []string{"test:read"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Inherits",
+
+			fixtures: []*dao.Role{
+				{
+					Name:        "test:reader",
+					Priority:    1,
+					Permissions: []string{"test:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.RoleInsertRequest{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"test:reader", "auth:user"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"auth:user", "test:reader"},
+				CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/NoPermissions",
+
+			request: &dao.RoleInsertRequest{
+				Name:     "test:empty",
+				Priority: 0,
+				Now:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:empty",
+				Priority:    0,
+				Permissions: []string{},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			fixtures: []*dao.Role{
+				{
+					Name:        "test:reader",
+					Priority:    1,
+					Permissions: []string{"test:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.RoleInsertRequest{
+				Name:        "test:reader",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrRoleInsertAlreadyExists,
+		},
+	}
+
+	insertDAO := dao.NewRoleInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				insertRoleFixtures(ctx, t, testCase.fixtures)
+
+				role, err := insertDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, role)
+			})
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.roleList.sql
+var roleListQuery string
+
+//go:embed pg.roleList.lock.sql
+var roleListLockQuery string
+
+// RoleListRequest is the input to [RoleList.Exec].
+type RoleListRequest struct {
+	// Lock takes the roles lock before reading, and holds it until the caller's transaction ends.
+	// Writers that check a change against the current roles must set it, so no other change lands
+	// between the check and the write.
+	Lock bool
+}
+
+// RoleList returns every role, by ascending priority then name.
+type RoleList struct{}
+
+func NewRoleList() *RoleList {
+	return &RoleList{}
+}
+
+func (dao *RoleList) Exec(ctx context.Context, request *RoleListRequest) ([]*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.RoleList")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("roles.lock", request.Lock))
+
+	entities := make([]*Role, 0)
+
+	err := postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+		tx, err := postgres.GetContext(ctx)
+		if err != nil {
+			return fmt.Errorf("get database handle: %w", err)
+		}
+
+		if request.Lock {
+			_, err = tx.NewRaw(roleListLockQuery).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("acquire lock: %w", err)
+			}
+		}
+
+		err = tx.NewRaw(roleListQuery).Scan(ctx, &entities)
+		if err != nil {
+			return fmt.Errorf("execute query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
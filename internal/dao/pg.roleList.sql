@@ -0,0 +1,15 @@
+SELECT
+  roles.*,
+  (
+    SELECT
+      array_agg(inherits ORDER BY inherits)
+    FROM
+      role_inherits
+    WHERE
+      role = roles.name
+  ) AS inherits
+FROM
+  roles
+ORDER BY
+  priority,
+  name;
@@ -0,0 +1,146 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestRoleList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Role
+
+		request *dao.RoleListRequest
+
+		expect    []*dao.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.Role{
+				{
+					Name:        "test:editor",
+					Priority:    2,
+					Permissions: []string{"test:write"},
+					Inherits:    []string{"test:reader", "test:viewer"},
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Name:        "test:reader",
+					Priority:    1,
+					Permissions: []string{"test:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Name:        "test:viewer",
+					Priority:    1,
+					Permissions: []string{"test:view"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.RoleListRequest{},
+
+			expect: []*dao.Role{
+				{
+					Name:        "test:reader",
+					Priority:    1,
+					Permissions: []string{"test:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Name:        "test:viewer",
+					Priority:    1,
+					Permissions: []string{"test:view"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Name:        "test:editor",
+					Priority:    2,
+					Permissions: []string{"test:write"},
+					Inherits:    []string{"test:reader", "test:viewer"},
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Lock",
+
+			fixtures: []*dao.Role{
+				{
+					Name:        "test:reader",
+					Priority:    1,
+					Permissions: []string{"test:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.RoleListRequest{Lock: true},
+
+			expect: []*dao.Role{
+				{
+					Name:        "test:reader",
+					Priority:    1,
+					Permissions: []string{"test:read"},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/NoResults",
+
+			request: &dao.RoleListRequest{},
+
+			expect: []*dao.Role{},
+		},
+	}
+
+	listDAO := dao.NewRoleList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				// Start from an empty table rather than the roles seeded by the migrations.
+				_, err = db.NewDelete().Model((*dao.RoleInherit)(nil)).Where("TRUE").Exec(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewDelete().Model((*dao.Role)(nil)).Where("TRUE").Exec(ctx)
+				require.NoError(t, err)
+
+				insertRoleFixtures(ctx, t, testCase.fixtures)
+
+				roles, err := listDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, roles)
+			})
+		})
+	}
+}
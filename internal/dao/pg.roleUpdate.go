@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.roleUpdate.sql
+var roleUpdateQuery string
+
+//go:embed pg.roleUpdate.inherits.sql
+var roleUpdateInheritsQuery string
+
+// ErrRoleUpdateNotFound is returned by [RoleUpdate.Exec] when no role has the requested name.
+// It is joined onto the underlying sql.ErrNoRows so callers can branch on it with errors.Is.
+var ErrRoleUpdateNotFound = errors.New("role not found")
+
+// RoleUpdateRequest is the input to [RoleUpdate.Exec].
+type RoleUpdateRequest struct {
+	// Name of the role to update.
+	Name string
+	// See Role.Priority.
+	Priority int
+	// See Role.Permissions. Replaces the current list.
+	Permissions []string
+	// See Role.Inherits. Replaces the current list. Every inherited role must exist, and
+	// duplicates are not allowed.
+	Inherits []string
+	// Now is the timestamp recorded as the row's update time.
+	Now time.Time
+}
+
+// RoleUpdate replaces the definition of a role, and returns the updated role. Checking that its
+// inheritance introduces no cycle belongs to the service layer.
+//
+// It joins the caller's transaction when there is one, so the update commits or rolls back with
+// the rest of the caller's work.
+type RoleUpdate struct{}
+
+func NewRoleUpdate() *RoleUpdate {
+	return &RoleUpdate{}
+}
+
+func (dao *RoleUpdate) Exec(ctx context.Context, request *RoleUpdateRequest) (*Role, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.RoleUpdate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("role.name", request.Name),
+		attribute.Int("role.priority", request.Priority),
+		attribute.StringSlice("role.permissions", request.Permissions),
+		attribute.StringSlice("role.inherits", request.Inherits),
+		attribute.Int64("role.now", request.Now.Unix()),
+	)
+
+	entity := new(Role)
+
+	err := postgres.WithinTx(ctx, nil, func(ctx context.Context) error {
+		tx, err := postgres.GetContext(ctx)
+		if err != nil {
+			return fmt.Errorf("get database handle: %w", err)
+		}
+
+		err = tx.NewRaw(
+			roleUpdateQuery,
+			request.Priority,
+			pgdialect.Array(request.Permissions),
+			request.Now,
+			request.Name,
+		).Scan(ctx, entity)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.Join(err, ErrRoleUpdateNotFound)
+			}
+
+			return fmt.Errorf("update role: %w", err)
+		}
+
+		err = tx.NewRaw(
+			roleUpdateInheritsQuery, request.Name, pgdialect.Array(request.Inherits),
+		).Scan(ctx, pgdialect.Array(&entity.Inherits))
+		if err != nil {
+			return fmt.Errorf("update inherited roles: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("run transaction: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
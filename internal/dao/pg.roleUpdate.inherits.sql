@@ -0,0 +1,23 @@
+-- Only the rows that change are written: an inherited role kept by the update is neither
+-- deleted nor inserted again.
+WITH
+  removed AS (
+    DELETE FROM role_inherits
+    WHERE
+      role = ?0
+      AND inherits <> ALL (COALESCE(?1::text[], '{}'))
+  ),
+  added AS (
+    INSERT INTO
+      role_inherits (role, inherits)
+    SELECT
+      ?0,
+      parent
+    FROM
+      unnest(?1::text[]) AS parent
+    ON CONFLICT DO NOTHING
+  )
+SELECT
+  array_agg(parent ORDER BY parent) AS inherits
+FROM
+  unnest(?1::text[]) AS parent;
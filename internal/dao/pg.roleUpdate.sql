@@ -0,0 +1,9 @@
+UPDATE roles
+SET
+  priority = ?0,
+  permissions = COALESCE(?1::text[], '{}'),
+  updated_at = ?2
+WHERE
+  name = ?3
+RETURNING
+  *;
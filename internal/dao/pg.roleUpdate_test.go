@@ -0,0 +1,172 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestRoleUpdate(t *testing.T) {
+	t.Parallel()
+
+	fixtures := []*dao.Role{
+		{
+			Name:        "test:reader",
+			Priority:    1,
+			Permissions: []string{"test:read"},
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:        "test:viewer",
+			Priority:    1,
+			Permissions: []string{"test:view"},
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:        "test:editor",
+			Priority:    2,
+			Permissions: []string{"test:write"},
+			Inherits:    []string{"test:reader"},
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.RoleUpdateRequest
+
+		expect    *dao.Role
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.RoleUpdateRequest{
+				Name:        "test:editor",
+				Priority:    3,
+				Permissions: []string{"test:write", "test:delete"},
+				Inherits:    []string{"test:reader"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:editor",
+				Priority:    3,
+				Permissions: []string{"test:write", "test:delete"},
+				Inherits:    []string{"test:reader"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/ReplaceInherits",
+
+			request: &dao.RoleUpdateRequest{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"test:viewer"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"test:viewer"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/AddInherits",
+
+			request: &dao.RoleUpdateRequest{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"test:viewer", "test:reader"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Inherits:    []string{"test:reader", "test:viewer"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/ClearInherits",
+
+			request: &dao.RoleUpdateRequest{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Role{
+				Name:        "test:editor",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.RoleUpdateRequest{
+				Name:        "test:unknown",
+				Priority:    2,
+				Permissions: []string{"test:write"},
+				Now:         time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrRoleUpdateNotFound,
+		},
+	}
+
+	updateDAO := dao.NewRoleUpdate()
+	listDAO := dao.NewRoleList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				insertRoleFixtures(ctx, t, fixtures)
+
+				role, err := updateDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, role)
+
+				if testCase.expect == nil {
+					return
+				}
+
+				// The stored inherits match the returned ones.
+				roles, err := listDAO.Exec(ctx, &dao.RoleListRequest{})
+				require.NoError(t, err)
+				require.Contains(t, roles, testCase.expect)
+			})
+		})
+	}
+}
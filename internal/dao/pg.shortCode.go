@@ -40,9 +40,14 @@ type ShortCode struct {
 	// lifetimes are kept short — usually a matter of days.
 	ExpiresAt time.Time `bun:"expires_at"`
 
+	// Attempts counts the failed attempts at redeeming the short code. Once it reaches
+	// the limit of the usage, the short code is deleted.
+	Attempts int `bun:"attempts"`
+
 	// DeletedAt marks a short code invalidated before its expiration: consumed,
-	// superseded by a newer code for the same Target / Usage pair, or manually
-	// suppressed by an admin after a leak. DeletedComment records the reason.
+	// superseded by a newer code for the same Target / Usage pair, locked after too
+	// many failed attempts, or manually suppressed by an admin after a leak.
+	// DeletedComment records the reason.
 	DeletedAt      *time.Time `bun:"deleted_at"`
 	DeletedComment *string    `bun:"deleted_comment"`
 }
@@ -29,10 +29,19 @@ const (
 	// ShortCodeDeleteConsumed is the deletion comment set when the short code has been
 	// redeemed successfully.
 	ShortCodeDeleteConsumed = "key consumed"
+	// ShortCodeDeleteTooManyAttempts is the deletion comment set when the short code
+	// reached the limit of failed attempts of its usage.
+	ShortCodeDeleteTooManyAttempts = "too many failed attempts"
+	// ShortCodeDeleteRevoked prefixes the deletion comment set when an administrator
+	// revokes the short code, for instance after a leak. The reason they gave follows.
+	ShortCodeDeleteRevoked = "revoked by admin"
+	// ShortCodeDeleteExpired is the deletion comment set by the janitor on the short codes
+	// that expired without being redeemed.
+	ShortCodeDeleteExpired = "key expired"
 )
 
 // ShortCodeDeleteRequest is the input to [ShortCodeDelete.Exec]. Comment is usually
-// [ShortCodeDeleteOverride] or [ShortCodeDeleteConsumed]; any value is accepted and
+// one of the ShortCodeDelete* constants; any value is accepted and
 // persisted on the deleted row for later auditing.
 type ShortCodeDeleteRequest struct {
 	// ID of the short code to delete.
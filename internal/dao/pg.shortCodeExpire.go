@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodeExpire.sql
+var shortCodeExpireQuery string
+
+// ShortCodeExpireRequest is the input to [ShortCodeExpire.Exec].
+type ShortCodeExpireRequest struct {
+	// Now is the reference time: active codes that expire at or before it are soft-deleted,
+	// with Now as their deletion time.
+	Now time.Time
+	// Limit caps the number of codes soft-deleted at once.
+	Limit int
+}
+
+// ShortCodeExpire soft-deletes the expired short codes that are still active, oldest expiry
+// first, with the ShortCodeDeleteExpired comment. It returns the number of codes it deleted.
+// Codes locked by a concurrent call are left to it.
+type ShortCodeExpire struct{}
+
+func NewShortCodeExpire() *ShortCodeExpire {
+	return &ShortCodeExpire{}
+}
+
+func (dao *ShortCodeExpire) Exec(ctx context.Context, request *ShortCodeExpireRequest) (int, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodeExpire")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("now", request.Now.String()),
+		attribute.Int("limit", request.Limit),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	res, err := tx.NewRaw(shortCodeExpireQuery, request.Now, ShortCodeDeleteExpired, request.Limit).Exec(ctx)
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("get rows affected: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int64("shortCodes.count", n))
+
+	return otel.ReportSuccess(span, int(n)), nil
+}
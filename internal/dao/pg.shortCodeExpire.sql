@@ -0,0 +1,21 @@
+-- Concurrent janitors skip the codes another one is expiring, rather than waiting on them.
+UPDATE short_codes
+SET
+  deleted_at = ?0,
+  deleted_comment = ?1
+WHERE
+  id IN (
+    SELECT
+      id
+    FROM
+      short_codes
+    WHERE
+      deleted_at IS NULL
+      AND expires_at <= ?0
+    ORDER BY
+      expires_at
+    LIMIT
+      ?2
+    FOR UPDATE
+      SKIP LOCKED
+  );
@@ -0,0 +1,145 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodeExpire(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	fixtures := []*dao.ShortCode{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Usage:     "test",
+			Target:    "test-target-1",
+			CreatedAt: createdAt,
+			ExpiresAt: time.Date(2021, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			Usage:     "test",
+			Target:    "test-target-2",
+			CreatedAt: createdAt,
+			ExpiresAt: time.Date(2021, 1, 2, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			Usage:     "test",
+			Target:    "test-target-3",
+			CreatedAt: createdAt,
+			ExpiresAt: time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC),
+		},
+		// Already deleted: left untouched.
+		{
+			ID:             uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			Usage:          "test",
+			Target:         "test-target-4",
+			CreatedAt:      createdAt,
+			ExpiresAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			DeletedAt:      &deletedAt,
+			DeletedComment: lo.ToPtr(dao.ShortCodeDeleteConsumed),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.ShortCodeExpireRequest
+
+		expect int
+		// expectExpired are the IDs of the codes deleted by the call.
+		expectExpired []uuid.UUID
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ShortCodeExpireRequest{
+				Now:   time.Date(2021, 1, 2, 2, 0, 0, 0, time.UTC),
+				Limit: 10,
+			},
+
+			expect: 2,
+			expectExpired: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+		},
+		{
+			name: "Success/Limit",
+
+			request: &dao.ShortCodeExpireRequest{
+				Now:   time.Date(2021, 1, 2, 3, 0, 0, 0, time.UTC),
+				Limit: 1,
+			},
+
+			expect: 1,
+			expectExpired: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+		},
+		{
+			name: "Success/NoneExpired",
+
+			request: &dao.ShortCodeExpireRequest{
+				Now:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				Limit: 10,
+			},
+
+			expect:        0,
+			expectExpired: []uuid.UUID{},
+		},
+	}
+
+	repository := dao.NewShortCodeExpire()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				count, err := repository.Exec(ctx, testCase.request)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expect, count)
+
+				var expired []*dao.ShortCode
+
+				err = db.NewSelect().
+					Model(&expired).
+					Where("deleted_comment = ?", dao.ShortCodeDeleteExpired).
+					Order("id").
+					Scan(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectExpired, lo.Map(
+					expired, func(item *dao.ShortCode, _ int) uuid.UUID { return item.ID },
+				))
+
+				for _, item := range expired {
+					require.Equal(t, testCase.request.Now, item.DeletedAt.UTC())
+				}
+			})
+		})
+	}
+}
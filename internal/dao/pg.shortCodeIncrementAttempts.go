@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodeIncrementAttempts.sql
+var shortCodeIncrementAttemptsQuery string
+
+// ErrShortCodeIncrementAttemptsNotFound is returned by [ShortCodeIncrementAttempts.Exec] when
+// no active short code matches the requested ID. It is joined onto the underlying sql.ErrNoRows.
+var ErrShortCodeIncrementAttemptsNotFound = errors.New("short code not found")
+
+type ShortCodeIncrementAttemptsRequest struct {
+	// ID of the short code that failed to be redeemed.
+	ID uuid.UUID
+}
+
+// ShortCodeIncrementAttempts records a failed attempt at redeeming an active short code, and
+// returns it with the updated count. The increment is atomic, so concurrent attempts are all
+// counted.
+type ShortCodeIncrementAttempts struct{}
+
+func NewShortCodeIncrementAttempts() *ShortCodeIncrementAttempts {
+	return &ShortCodeIncrementAttempts{}
+}
+
+func (dao *ShortCodeIncrementAttempts) Exec(
+	ctx context.Context, request *ShortCodeIncrementAttemptsRequest,
+) (*ShortCode, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodeIncrementAttempts")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("shortCode.id", request.ID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ShortCode)
+
+	err = tx.NewRaw(shortCodeIncrementAttemptsQuery, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrShortCodeIncrementAttemptsNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int("shortCode.attempts", entity.Attempts))
+
+	return otel.ReportSuccess(span, entity), nil
+}
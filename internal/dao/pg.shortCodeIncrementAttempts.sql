@@ -0,0 +1,9 @@
+UPDATE short_codes
+SET
+  attempts = attempts + 1
+WHERE
+  id = ?0
+  AND deleted_at IS NULL
+  AND expires_at > CURRENT_TIMESTAMP
+RETURNING
+  *;
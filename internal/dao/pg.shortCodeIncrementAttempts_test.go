@@ -0,0 +1,152 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodeIncrementAttempts(t *testing.T) {
+	t.Parallel()
+
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	testCases := []struct {
+		name string
+
+		request  *dao.ShortCodeIncrementAttemptsRequest
+		fixtures []*dao.ShortCode
+
+		expect    *dao.ShortCode
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ShortCodeIncrementAttemptsRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test",
+					Target:    "test-target-1",
+					CreatedAt: hourAgo,
+					ExpiresAt: hourLater,
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Usage:     "test",
+					Target:    "test-target-2",
+					Attempts:  2,
+					CreatedAt: hourAgo,
+					ExpiresAt: hourLater,
+				},
+			},
+
+			expect: &dao.ShortCode{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Usage:     "test",
+				Target:    "test-target-2",
+				Attempts:  3,
+				CreatedAt: hourAgo,
+				ExpiresAt: hourLater,
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: &dao.ShortCodeIncrementAttemptsRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Usage:     "test",
+					Target:    "test-target-1",
+					CreatedAt: hourAgo,
+					ExpiresAt: hourLater,
+				},
+			},
+
+			expectErr: dao.ErrShortCodeIncrementAttemptsNotFound,
+		},
+		{
+			name: "Expired",
+
+			request: &dao.ShortCodeIncrementAttemptsRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Usage:     "test",
+					Target:    "test-target-2",
+					CreatedAt: hourAgo,
+					ExpiresAt: hourAgo,
+				},
+			},
+
+			expectErr: dao.ErrShortCodeIncrementAttemptsNotFound,
+		},
+		{
+			name: "Deleted",
+
+			request: &dao.ShortCodeIncrementAttemptsRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Usage:          "test",
+					Target:         "test-target-2",
+					CreatedAt:      hourAgo,
+					ExpiresAt:      hourLater,
+					DeletedAt:      lo.ToPtr(hourAgo),
+					DeletedComment: lo.ToPtr(dao.ShortCodeDeleteTooManyAttempts),
+				},
+			},
+
+			expectErr: dao.ErrShortCodeIncrementAttemptsNotFound,
+		},
+	}
+
+	dao := dao.NewShortCodeIncrementAttempts()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				key, err := dao.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, key)
+			})
+		})
+	}
+}
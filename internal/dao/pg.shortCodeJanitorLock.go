@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	"database/sql/driver"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodeJanitorLock.sql
+var shortCodeJanitorLockQuery string
+
+//go:embed pg.shortCodeJanitorLock.unlock.sql
+var shortCodeJanitorLockUnlockQuery string
+
+// ShortCodeJanitorLock elects the instance running the short codes janitor: it runs a callback
+// only while holding a lock no other instance holds, and reports whether it did.
+//
+// The lock is held by a connection, not a transaction, so the callback can commit its work in
+// several short transactions. On a pool, the callback receives a context carrying a connection
+// set aside for it, on which every statement commits on its own. The lock is released when the
+// callback returns, or with the connection if it is lost.
+type ShortCodeJanitorLock struct{}
+
+func NewShortCodeJanitorLock() *ShortCodeJanitorLock {
+	return &ShortCodeJanitorLock{}
+}
+
+func (dao *ShortCodeJanitorLock) Exec(ctx context.Context, callback func(ctx context.Context) error) (bool, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodeJanitorLock")
+	defer span.End()
+
+	db, err := postgres.GetContext(ctx)
+	if err != nil {
+		return false, otel.ReportError(span, fmt.Errorf("get database handle: %w", err))
+	}
+
+	// Already in a transaction or on a dedicated connection: the lock is taken on it.
+	if pool, isPool := db.(*bun.DB); isPool {
+		conn, err := pool.Conn(ctx)
+		if err != nil {
+			return false, otel.ReportError(span, fmt.Errorf("get connection: %w", err))
+		}
+
+		defer func() { _ = conn.Close() }()
+
+		db = &conn
+	}
+
+	var acquired bool
+
+	err = db.NewRaw(shortCodeJanitorLockQuery).Scan(ctx, &acquired)
+	if err != nil {
+		return false, otel.ReportError(span, fmt.Errorf("acquire lock: %w", err))
+	}
+
+	span.SetAttributes(attribute.Bool("acquired", acquired))
+
+	if !acquired {
+		return otel.ReportSuccess(span, false), nil
+	}
+
+	err = callback(postgres.WithTx(ctx, db))
+
+	// The lock must be released even when ctx is done, or it would outlive the run.
+	_, unlockErr := db.NewRaw(shortCodeJanitorLockUnlockQuery).Exec(context.WithoutCancel(ctx))
+	if unlockErr != nil {
+		// A pooled connection would keep the lock: discard it instead, which releases it.
+		if conn, isConn := db.(*bun.Conn); isConn {
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+
+		err = errors.Join(err, fmt.Errorf("release lock: %w", unlockErr))
+	}
+
+	if err != nil {
+		return true, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, true), nil
+}
@@ -0,0 +1,4 @@
+-- Held by the session until released, so it spans the janitor's batches, each committed on its
+-- own. The key is arbitrary, but must stay the same across releases.
+SELECT
+  pg_try_advisory_lock(hashtext('short_codes_janitor'));
@@ -0,0 +1,2 @@
+SELECT
+  pg_advisory_unlock(hashtext('short_codes_janitor'));
@@ -0,0 +1,57 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodeJanitorLock(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	repository := dao.NewShortCodeJanitorLock()
+
+	postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+		t.Helper()
+
+		acquired, err := repository.Exec(ctx, func(lockCtx context.Context) error {
+			// Another instance, on its own connection, is not elected while the lock is held.
+			nestedAcquired, err := repository.Exec(ctx, func(context.Context) error {
+				t.Error("callback must not run without the lock")
+
+				return nil
+			})
+			require.NoError(t, err)
+			require.False(t, nestedAcquired)
+
+			// The callback runs on the connection that holds the lock.
+			db, err := postgres.GetContext(lockCtx)
+			require.NoError(t, err)
+
+			_, err = db.NewRaw("SELECT 1").Exec(lockCtx)
+
+			return err
+		})
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		// The lock is released once the callback returns, even on error.
+		acquired, err = repository.Exec(ctx, func(context.Context) error { return errFoo })
+		require.ErrorIs(t, err, errFoo)
+		require.True(t, acquired)
+
+		acquired, err = repository.Exec(ctx, func(context.Context) error { return nil })
+		require.NoError(t, err)
+		require.True(t, acquired)
+	})
+}
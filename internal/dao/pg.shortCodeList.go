@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodeList.sql
+var shortCodeListQuery string
+
+// Statuses a short code listing can be filtered on.
+const (
+	// ShortCodeStatusActive matches the codes that can still be redeemed.
+	ShortCodeStatusActive = "active"
+	// ShortCodeStatusExpired matches the codes that outlived their expiration date, including
+	// those the janitor deleted since with the ShortCodeDeleteExpired comment.
+	ShortCodeStatusExpired = "expired"
+	// ShortCodeStatusDeleted matches the codes deleted for any other reason. The reason is their
+	// deletion comment.
+	ShortCodeStatusDeleted = "deleted"
+)
+
+// ShortCodeListRequest is the input to [ShortCodeList.Exec].
+type ShortCodeListRequest struct {
+	// Limit caps the number of short codes returned. Zero returns every short code.
+	Limit  int
+	Offset int
+
+	// Target and Usage, if set, restrict the result to the short codes issued for that
+	// target, or that usage.
+	Target string
+	Usage  string
+	// Status, if set, restricts the result to the short codes in that status. It is one of
+	// the ShortCodeStatus* constants.
+	Status string
+}
+
+// ShortCodeList returns a set of paginated short codes, newest first. Expired and deleted codes
+// are included unless filtered out by status. The Code hash and the Data are left empty.
+type ShortCodeList struct{}
+
+func NewShortCodeList() *ShortCodeList {
+	return &ShortCodeList{}
+}
+
+func (dao *ShortCodeList) Exec(ctx context.Context, request *ShortCodeListRequest) ([]*ShortCode, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodeList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("data.limit", request.Limit),
+		attribute.Int("data.offset", request.Offset),
+		attribute.String("data.target", request.Target),
+		attribute.String("data.usage", request.Usage),
+		attribute.String("data.status", request.Status),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*ShortCode, 0, request.Limit)
+
+	err = tx.NewRaw(
+		shortCodeListQuery,
+		bun.NullZero(request.Limit),
+		request.Offset,
+		bun.NullZero(request.Target),
+		bun.NullZero(request.Usage),
+		bun.NullZero(request.Status),
+		ShortCodeDeleteExpired,
+	).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
@@ -0,0 +1,55 @@
+-- Lists short codes for administrators. The code hash and the flow data are left out: the listing
+-- exposes metadata only.
+--
+-- The status of a code is derived: expired when it outlived its expiration date, whether or not the
+-- janitor deleted it since, deleted when it has any other deletion, active otherwise.
+SELECT
+  id,
+  usage,
+  target,
+  created_at,
+  expires_at,
+  attempts,
+  deleted_at,
+  deleted_comment
+FROM
+  short_codes
+WHERE
+  (
+    ?2::text IS NULL
+    OR target = ?2
+  )
+  AND (
+    ?3::text IS NULL
+    OR usage = ?3
+  )
+  AND (
+    ?4::text IS NULL
+    OR (
+      ?4 = 'active'
+      AND deleted_at IS NULL
+      AND expires_at > CURRENT_TIMESTAMP
+    )
+    OR (
+      ?4 = 'expired'
+      AND (
+        (
+          deleted_at IS NULL
+          AND expires_at <= CURRENT_TIMESTAMP
+        )
+        OR deleted_comment = ?5
+      )
+    )
+    OR (
+      ?4 = 'deleted'
+      AND deleted_at IS NOT NULL
+      AND deleted_comment IS DISTINCT FROM ?5
+    )
+  )
+ORDER BY
+  created_at DESC,
+  id DESC
+LIMIT
+  ?0
+OFFSET
+  ?1;
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodeListByTargets.sql
+var shortCodeListByTargetsQuery string
+
+// ShortCodeListByTargetsRequest is the input to [ShortCodeListByTargets.Exec].
+type ShortCodeListByTargetsRequest struct {
+	// Targets lists every value a short code may have been issued for; matches
+	// [ShortCode.Target]. A single account can appear under several targets (its
+	// email for registration, its ID for the other flows).
+	Targets []string
+}
+
+// ShortCodeListByTargets returns the full history of short codes issued for a set of
+// targets, newest first. Unlike [ShortCodeSelect], expired and deleted codes are
+// included, along with their deletion comment. The Code hash is left empty.
+type ShortCodeListByTargets struct{}
+
+func NewShortCodeListByTargets() *ShortCodeListByTargets {
+	return &ShortCodeListByTargets{}
+}
+
+func (dao *ShortCodeListByTargets) Exec(
+	ctx context.Context, request *ShortCodeListByTargetsRequest,
+) ([]*ShortCode, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodeListByTargets")
+	defer span.End()
+
+	span.SetAttributes(attribute.StringSlice("data.targets", request.Targets))
+
+	if len(request.Targets) == 0 {
+		// bun.List needs a non-nil slice to render a valid expression. An empty slice
+		// renders as NULL, which matches no target.
+		request.Targets = []string{}
+	}
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entities := make([]*ShortCode, 0)
+
+	err = tx.NewRaw(shortCodeListByTargetsQuery, bun.List(request.Targets)).Scan(ctx, &entities)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entities), nil
+}
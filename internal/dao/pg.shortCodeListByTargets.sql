@@ -0,0 +1,18 @@
+-- Returns every short code ever issued to the targets, including expired and deleted ones, so the
+-- caller sees the full history. The code hash is left out: it is never meant to leave the database.
+SELECT
+  id,
+  usage,
+  target,
+  data,
+  created_at,
+  expires_at,
+  deleted_at,
+  deleted_comment
+FROM
+  short_codes
+WHERE
+  target IN (?0)
+ORDER BY
+  created_at DESC,
+  id DESC;
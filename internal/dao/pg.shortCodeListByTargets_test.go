@@ -0,0 +1,141 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodeListByTargets(t *testing.T) {
+	t.Parallel()
+
+	twoHoursAgo := time.Now().Add(-2 * time.Hour).UTC().Round(time.Second)
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	active := &dao.ShortCode{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Code:      "test-code-1",
+		Usage:     "test-usage",
+		Target:    "test-target",
+		Data:      []byte("test-data"),
+		CreatedAt: hourAgo,
+		ExpiresAt: hourLater,
+	}
+	deleted := &dao.ShortCode{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Code:           "test-code-2",
+		Usage:          "test-usage",
+		Target:         "test-target",
+		Data:           []byte("test-data"),
+		CreatedAt:      twoHoursAgo,
+		ExpiresAt:      hourLater,
+		DeletedAt:      &hourAgo,
+		DeletedComment: lo.ToPtr("test-comment"),
+	}
+	expired := &dao.ShortCode{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Code:      "test-code-3",
+		Usage:     "test-usage-2",
+		Target:    "test-target-2",
+		CreatedAt: twoHoursAgo,
+		ExpiresAt: hourAgo,
+	}
+	other := &dao.ShortCode{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		Code:      "test-code-4",
+		Usage:     "test-usage",
+		Target:    "test-target-3",
+		CreatedAt: hourAgo,
+		ExpiresAt: hourLater,
+	}
+
+	// The query never returns the code hash.
+	withoutCode := func(shortCode *dao.ShortCode) *dao.ShortCode {
+		out := *shortCode
+		out.Code = ""
+
+		return &out
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.ShortCode
+
+		request *dao.ShortCodeListByTargetsRequest
+
+		expect    []*dao.ShortCode
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, other},
+
+			request: &dao.ShortCodeListByTargetsRequest{
+				Targets: []string{"test-target", "test-target-2"},
+			},
+
+			expect: []*dao.ShortCode{
+				withoutCode(active),
+				withoutCode(expired),
+				withoutCode(deleted),
+			},
+		},
+		{
+			name: "NoMatch",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, other},
+
+			request: &dao.ShortCodeListByTargetsRequest{
+				Targets: []string{"test-target-4"},
+			},
+
+			expect: []*dao.ShortCode{},
+		},
+		{
+			name: "NoTargets",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, other},
+
+			request: &dao.ShortCodeListByTargetsRequest{},
+
+			expect: []*dao.ShortCode{},
+		},
+	}
+
+	listDAO := dao.NewShortCodeListByTargets()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				shortCodes, err := listDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, shortCodes)
+			})
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodeList(t *testing.T) {
+	t.Parallel()
+
+	fourHoursAgo := time.Now().Add(-4 * time.Hour).UTC().Round(time.Second)
+	threeHoursAgo := time.Now().Add(-3 * time.Hour).UTC().Round(time.Second)
+	twoHoursAgo := time.Now().Add(-2 * time.Hour).UTC().Round(time.Second)
+	hourAgo := time.Now().Add(-time.Hour).UTC().Round(time.Second)
+	hourLater := time.Now().Add(time.Hour).UTC().Round(time.Second)
+
+	active := &dao.ShortCode{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Code:      "test-code-1",
+		Usage:     "test-usage",
+		Target:    "test-target",
+		Data:      []byte("test-data"),
+		CreatedAt: hourAgo,
+		ExpiresAt: hourLater,
+		Attempts:  2,
+	}
+	deleted := &dao.ShortCode{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Code:           "test-code-2",
+		Usage:          "test-usage",
+		Target:         "test-target",
+		Data:           []byte("test-data"),
+		CreatedAt:      twoHoursAgo,
+		ExpiresAt:      hourLater,
+		DeletedAt:      &hourAgo,
+		DeletedComment: lo.ToPtr("test-comment"),
+	}
+	expired := &dao.ShortCode{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		Code:      "test-code-3",
+		Usage:     "test-usage-2",
+		Target:    "test-target-2",
+		CreatedAt: threeHoursAgo,
+		ExpiresAt: hourAgo,
+	}
+	// Deleted by the janitor once expired: still listed as expired.
+	swept := &dao.ShortCode{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		Code:           "test-code-4",
+		Usage:          "test-usage-2",
+		Target:         "test-target-3",
+		CreatedAt:      fourHoursAgo,
+		ExpiresAt:      threeHoursAgo,
+		DeletedAt:      &twoHoursAgo,
+		DeletedComment: lo.ToPtr(dao.ShortCodeDeleteExpired),
+	}
+
+	// The query never returns the code hash, nor the data.
+	metadata := func(shortCode *dao.ShortCode) *dao.ShortCode {
+		out := *shortCode
+		out.Code = ""
+		out.Data = nil
+
+		return &out
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.ShortCode
+
+		request *dao.ShortCodeListRequest
+
+		expect    []*dao.ShortCode
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{},
+
+			expect: []*dao.ShortCode{metadata(active), metadata(deleted), metadata(expired), metadata(swept)},
+		},
+		{
+			name: "Paginate",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Limit: 1, Offset: 1},
+
+			expect: []*dao.ShortCode{metadata(deleted)},
+		},
+		{
+			name: "FilterTarget",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Target: "test-target-2"},
+
+			expect: []*dao.ShortCode{metadata(expired)},
+		},
+		{
+			name: "FilterUsage",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Usage: "test-usage"},
+
+			expect: []*dao.ShortCode{metadata(active), metadata(deleted)},
+		},
+		{
+			name: "FilterStatus/Active",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Status: dao.ShortCodeStatusActive},
+
+			expect: []*dao.ShortCode{metadata(active)},
+		},
+		{
+			name: "FilterStatus/Expired",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Status: dao.ShortCodeStatusExpired},
+
+			expect: []*dao.ShortCode{metadata(expired), metadata(swept)},
+		},
+		{
+			name: "FilterStatus/Deleted",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Status: dao.ShortCodeStatusDeleted},
+
+			expect: []*dao.ShortCode{metadata(deleted)},
+		},
+		{
+			name: "NoMatch",
+
+			fixtures: []*dao.ShortCode{active, deleted, expired, swept},
+
+			request: &dao.ShortCodeListRequest{Target: "test-target-2", Status: dao.ShortCodeStatusActive},
+
+			expect: []*dao.ShortCode{},
+		},
+	}
+
+	listDAO := dao.NewShortCodeList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				shortCodes, err := listDAO.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, shortCodes)
+			})
+		})
+	}
+}
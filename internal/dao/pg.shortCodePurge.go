@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodePurge.sql
+var shortCodePurgeQuery string
+
+// ShortCodePurgeRequest is the input to [ShortCodePurge.Exec].
+type ShortCodePurgeRequest struct {
+	// DeletedBefore is the retention cutoff: codes soft-deleted strictly before it are removed.
+	DeletedBefore time.Time
+	// Limit caps the number of codes removed at once.
+	Limit int
+}
+
+// ShortCodePurge permanently removes the short codes soft-deleted before a cutoff, oldest
+// deletion first, and returns the number of codes it removed. Active codes are never removed,
+// even once expired: [ShortCodeExpire] soft-deletes them first. Codes locked by a concurrent
+// call are left to it.
+type ShortCodePurge struct{}
+
+func NewShortCodePurge() *ShortCodePurge {
+	return &ShortCodePurge{}
+}
+
+func (dao *ShortCodePurge) Exec(ctx context.Context, request *ShortCodePurgeRequest) (int, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodePurge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("deletedBefore", request.DeletedBefore.String()),
+		attribute.Int("limit", request.Limit),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	res, err := tx.NewRaw(shortCodePurgeQuery, request.DeletedBefore, request.Limit).Exec(ctx)
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, otel.ReportError(span, fmt.Errorf("get rows affected: %w", err))
+	}
+
+	span.SetAttributes(attribute.Int64("shortCodes.count", n))
+
+	return otel.ReportSuccess(span, int(n)), nil
+}
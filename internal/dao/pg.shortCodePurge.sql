@@ -0,0 +1,17 @@
+-- Concurrent janitors skip the codes another one is purging, rather than waiting on them.
+DELETE FROM short_codes
+WHERE
+  id IN (
+    SELECT
+      id
+    FROM
+      short_codes
+    WHERE
+      deleted_at < ?0
+    ORDER BY
+      deleted_at
+    LIMIT
+      ?1
+    FOR UPDATE
+      SKIP LOCKED
+  );
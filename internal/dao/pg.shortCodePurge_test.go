@@ -0,0 +1,135 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodePurge(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newDeleted := func(id string, deletedAt time.Time) *dao.ShortCode {
+		return &dao.ShortCode{
+			ID:             uuid.MustParse(id),
+			Usage:          "test",
+			Target:         "test-target-" + id,
+			CreatedAt:      createdAt,
+			ExpiresAt:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			DeletedAt:      &deletedAt,
+			DeletedComment: lo.ToPtr(dao.ShortCodeDeleteExpired),
+		}
+	}
+
+	fixtures := []*dao.ShortCode{
+		newDeleted("00000000-0000-0000-0000-000000000001", time.Date(2021, 1, 3, 2, 0, 0, 0, time.UTC)),
+		newDeleted("00000000-0000-0000-0000-000000000002", time.Date(2021, 1, 3, 1, 0, 0, 0, time.UTC)),
+		newDeleted("00000000-0000-0000-0000-000000000003", time.Date(2021, 1, 3, 3, 0, 0, 0, time.UTC)),
+		// Expired but still active: only expired codes that were soft-deleted are purged.
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			Usage:     "test",
+			Target:    "test-target-4",
+			CreatedAt: createdAt,
+			ExpiresAt: time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.ShortCodePurgeRequest
+
+		expect int
+		// expectRemaining are the IDs of the codes left after the call.
+		expectRemaining []uuid.UUID
+	}{
+		{
+			name: "Success",
+
+			request: &dao.ShortCodePurgeRequest{
+				DeletedBefore: time.Date(2021, 1, 3, 3, 0, 0, 0, time.UTC),
+				Limit:         10,
+			},
+
+			expect: 2,
+			expectRemaining: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			},
+		},
+		{
+			name: "Success/Limit",
+
+			request: &dao.ShortCodePurgeRequest{
+				DeletedBefore: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+				Limit:         1,
+			},
+
+			expect: 1,
+			expectRemaining: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			},
+		},
+		{
+			name: "Success/NoneOutdated",
+
+			request: &dao.ShortCodePurgeRequest{
+				DeletedBefore: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				Limit:         10,
+			},
+
+			expect: 0,
+			expectRemaining: []uuid.UUID{
+				uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			},
+		},
+	}
+
+	repository := dao.NewShortCodePurge()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				count, err := repository.Exec(ctx, testCase.request)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expect, count)
+
+				var remaining []*dao.ShortCode
+
+				err = db.NewSelect().Model(&remaining).Order("id").Scan(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectRemaining, lo.Map(
+					remaining, func(item *dao.ShortCode, _ int) uuid.UUID { return item.ID },
+				))
+			})
+		})
+	}
+}
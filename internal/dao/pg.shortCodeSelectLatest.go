@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.shortCodeSelectLatest.sql
+var shortCodeSelectLatestQuery string
+
+// ErrShortCodeSelectLatestNotFound is returned by [ShortCodeSelectLatest.Exec] when no
+// short code matches the (Usage, Target) pair, other than codes invalidated before they
+// expired: consumed, superseded, locked or revoked.
+var ErrShortCodeSelectLatestNotFound = errors.New("short code not found")
+
+// ShortCodeSelectLatestRequest is the input to [ShortCodeSelectLatest.Exec].
+type ShortCodeSelectLatestRequest struct {
+	// Usage selects which flow this code is valid for; matches [ShortCode.Usage].
+	Usage string
+	// Target identifies the subject of the operation (e.g. the email address);
+	// matches [ShortCode.Target].
+	Target string
+}
+
+// ShortCodeSelectLatest fetches the latest short code matching a (Usage, Target) pair,
+// whether it is active or expired. Unlike [ShortCodeSelect], it tells an expired code
+// apart from a code that was never issued.
+type ShortCodeSelectLatest struct{}
+
+func NewShortCodeSelectLatest() *ShortCodeSelectLatest {
+	return &ShortCodeSelectLatest{}
+}
+
+func (dao *ShortCodeSelectLatest) Exec(
+	ctx context.Context, request *ShortCodeSelectLatestRequest,
+) (*ShortCode, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ShortCodeSelectLatest")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("data", request.Target),
+		attribute.String("usage", request.Usage),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ShortCode)
+
+	err = tx.NewRaw(shortCodeSelectLatestQuery, request.Target, request.Usage).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrShortCodeSelectLatestNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,17 @@
+-- A code deleted at or after its expiry was deleted because it expired, by the janitor or before
+-- a newer code was inserted: it is still reported, unlike the codes invalidated while valid.
+SELECT
+  *
+FROM
+  short_codes
+WHERE
+  target = ?0
+  AND usage = ?1
+  AND (
+    deleted_at IS NULL
+    OR deleted_at >= expires_at
+  )
+ORDER BY
+  created_at DESC
+LIMIT
+  1;
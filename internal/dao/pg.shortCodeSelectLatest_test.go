@@ -0,0 +1,231 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-authentication/v2/internal/config/configtest"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/models/migrations"
+)
+
+func TestShortCodeSelectLatest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Round(time.Second)
+	hourAgo := now.Add(-time.Hour)
+	twoHoursAgo := now.Add(-2 * time.Hour)
+	hourLater := now.Add(time.Hour)
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.ShortCode
+
+		request *dao.ShortCodeSelectLatestRequest
+
+		expect    *dao.ShortCode
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:      "test-code",
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: hourAgo,
+					ExpiresAt: hourLater,
+				},
+			},
+
+			request: &dao.ShortCodeSelectLatestRequest{
+				Target: "test-target",
+				Usage:  "test-usage",
+			},
+
+			expect: &dao.ShortCode{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Code:      "test-code",
+				Usage:     "test-usage",
+				Target:    "test-target",
+				Data:      []byte("test-data"),
+				CreatedAt: hourAgo,
+				ExpiresAt: hourLater,
+			},
+		},
+		{
+			name: "Expired",
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:      "test-code",
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: twoHoursAgo,
+					ExpiresAt: hourAgo,
+				},
+			},
+
+			request: &dao.ShortCodeSelectLatestRequest{
+				Target: "test-target",
+				Usage:  "test-usage",
+			},
+
+			expect: &dao.ShortCode{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Code:      "test-code",
+				Usage:     "test-usage",
+				Target:    "test-target",
+				Data:      []byte("test-data"),
+				CreatedAt: twoHoursAgo,
+				ExpiresAt: hourAgo,
+			},
+		},
+		{
+			name: "Expired/Deleted",
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:           "test-code",
+					Usage:          "test-usage",
+					Target:         "test-target",
+					Data:           []byte("test-data"),
+					CreatedAt:      twoHoursAgo,
+					ExpiresAt:      hourAgo,
+					DeletedAt:      &now,
+					DeletedComment: lo.ToPtr("key expired"),
+				},
+			},
+
+			request: &dao.ShortCodeSelectLatestRequest{
+				Target: "test-target",
+				Usage:  "test-usage",
+			},
+
+			expect: &dao.ShortCode{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Code:           "test-code",
+				Usage:          "test-usage",
+				Target:         "test-target",
+				Data:           []byte("test-data"),
+				CreatedAt:      twoHoursAgo,
+				ExpiresAt:      hourAgo,
+				DeletedAt:      &now,
+				DeletedComment: lo.ToPtr("key expired"),
+			},
+		},
+		{
+			name: "Latest",
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:           "test-code",
+					Usage:          "test-usage",
+					Target:         "test-target",
+					Data:           []byte("test-data"),
+					CreatedAt:      twoHoursAgo,
+					ExpiresAt:      hourAgo,
+					DeletedAt:      &now,
+					DeletedComment: lo.ToPtr("key expired"),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Code:      "test-code",
+					Usage:     "test-usage",
+					Target:    "test-target",
+					Data:      []byte("test-data"),
+					CreatedAt: hourAgo,
+					ExpiresAt: hourLater,
+				},
+			},
+
+			request: &dao.ShortCodeSelectLatestRequest{
+				Target: "test-target",
+				Usage:  "test-usage",
+			},
+
+			expect: &dao.ShortCode{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Code:      "test-code",
+				Usage:     "test-usage",
+				Target:    "test-target",
+				Data:      []byte("test-data"),
+				CreatedAt: hourAgo,
+				ExpiresAt: hourLater,
+			},
+		},
+		{
+			name: "NotFound",
+
+			request: &dao.ShortCodeSelectLatestRequest{
+				Target: "test-target",
+				Usage:  "test-usage",
+			},
+
+			expectErr: dao.ErrShortCodeSelectLatestNotFound,
+		},
+		{
+			name: "IgnoreDeletedBeforeExpiry",
+
+			fixtures: []*dao.ShortCode{
+				{
+					ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Code:           "test-code",
+					Usage:          "test-usage",
+					Target:         "test-target",
+					Data:           []byte("test-data"),
+					CreatedAt:      twoHoursAgo,
+					ExpiresAt:      hourLater,
+					DeletedAt:      &hourAgo,
+					DeletedComment: lo.ToPtr("key consumed"),
+				},
+			},
+
+			request: &dao.ShortCodeSelectLatestRequest{
+				Target: "test-target",
+				Usage:  "test-usage",
+			},
+
+			expectErr: dao.ErrShortCodeSelectLatestNotFound,
+		},
+	}
+
+	dao := dao.NewShortCodeSelectLatest()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunDBTest(t, configtest.PostgresPreset, migrations.Migrations, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				shortCode, err := dao.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, shortCode)
+			})
+		})
+	}
+}
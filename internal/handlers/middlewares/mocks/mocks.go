@@ -8,6 +8,8 @@ import (
 	"context"
 
 	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
 	"github.com/a-novel/service-json-keys/v2/pkg/go"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -106,3 +108,432 @@ func (_c *MockAuthClaimsVerifier_VerifyClaims_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockAuthPermissions creates a new instance of MockAuthPermissions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthPermissions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthPermissions {
+	mock := &MockAuthPermissions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthPermissions is an autogenerated mock type for the AuthPermissions type
+type MockAuthPermissions struct {
+	mock.Mock
+}
+
+type MockAuthPermissions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthPermissions) EXPECT() *MockAuthPermissions_Expecter {
+	return &MockAuthPermissions_Expecter{mock: &_m.Mock}
+}
+
+// PermissionsByRole provides a mock function for the type MockAuthPermissions
+func (_mock *MockAuthPermissions) PermissionsByRole() map[string][]string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermissionsByRole")
+	}
+
+	var r0 map[string][]string
+	if returnFunc, ok := ret.Get(0).(func() map[string][]string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	return r0
+}
+
+// MockAuthPermissions_PermissionsByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermissionsByRole'
+type MockAuthPermissions_PermissionsByRole_Call struct {
+	*mock.Call
+}
+
+// PermissionsByRole is a helper method to define mock.On call
+func (_e *MockAuthPermissions_Expecter) PermissionsByRole() *MockAuthPermissions_PermissionsByRole_Call {
+	return &MockAuthPermissions_PermissionsByRole_Call{Call: _e.mock.On("PermissionsByRole")}
+}
+
+func (_c *MockAuthPermissions_PermissionsByRole_Call) Run(run func()) *MockAuthPermissions_PermissionsByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAuthPermissions_PermissionsByRole_Call) Return(stringToStrings map[string][]string) *MockAuthPermissions_PermissionsByRole_Call {
+	_c.Call.Return(stringToStrings)
+	return _c
+}
+
+func (_c *MockAuthPermissions_PermissionsByRole_Call) RunAndReturn(run func() map[string][]string) *MockAuthPermissions_PermissionsByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRequirement creates a new instance of MockRequirement. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRequirement(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRequirement {
+	mock := &MockRequirement{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRequirement is an autogenerated mock type for the Requirement type
+type MockRequirement struct {
+	mock.Mock
+}
+
+type MockRequirement_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRequirement) EXPECT() *MockRequirement_Expecter {
+	return &MockRequirement_Expecter{mock: &_m.Mock}
+}
+
+// String provides a mock function for the type MockRequirement
+func (_mock *MockRequirement) String() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for String")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockRequirement_String_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'String'
+type MockRequirement_String_Call struct {
+	*mock.Call
+}
+
+// String is a helper method to define mock.On call
+func (_e *MockRequirement_Expecter) String() *MockRequirement_String_Call {
+	return &MockRequirement_String_Call{Call: _e.mock.On("String")}
+}
+
+func (_c *MockRequirement_String_Call) Run(run func()) *MockRequirement_String_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRequirement_String_Call) Return(s string) *MockRequirement_String_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRequirement_String_Call) RunAndReturn(run func() string) *MockRequirement_String_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmet provides a mock function for the type MockRequirement
+func (_mock *MockRequirement) Unmet(granted map[string]bool) middlewares.Requirement {
+	ret := _mock.Called(granted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmet")
+	}
+
+	var r0 middlewares.Requirement
+	if returnFunc, ok := ret.Get(0).(func(map[string]bool) middlewares.Requirement); ok {
+		r0 = returnFunc(granted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middlewares.Requirement)
+		}
+	}
+	return r0
+}
+
+// MockRequirement_Unmet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmet'
+type MockRequirement_Unmet_Call struct {
+	*mock.Call
+}
+
+// Unmet is a helper method to define mock.On call
+//   - granted map[string]bool
+func (_e *MockRequirement_Expecter) Unmet(granted any) *MockRequirement_Unmet_Call {
+	return &MockRequirement_Unmet_Call{Call: _e.mock.On("Unmet", granted)}
+}
+
+func (_c *MockRequirement_Unmet_Call) Run(run func(granted map[string]bool)) *MockRequirement_Unmet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 map[string]bool
+		if args[0] != nil {
+			arg0 = args[0].(map[string]bool)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRequirement_Unmet_Call) Return(requirement middlewares.Requirement) *MockRequirement_Unmet_Call {
+	_c.Call.Return(requirement)
+	return _c
+}
+
+func (_c *MockRequirement_Unmet_Call) RunAndReturn(run func(granted map[string]bool) middlewares.Requirement) *MockRequirement_Unmet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPolicyEvaluator creates a new instance of MockPolicyEvaluator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPolicyEvaluator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPolicyEvaluator {
+	mock := &MockPolicyEvaluator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPolicyEvaluator is an autogenerated mock type for the PolicyEvaluator type
+type MockPolicyEvaluator struct {
+	mock.Mock
+}
+
+type MockPolicyEvaluator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPolicyEvaluator) EXPECT() *MockPolicyEvaluator_Expecter {
+	return &MockPolicyEvaluator_Expecter{mock: &_m.Mock}
+}
+
+// Eval provides a mock function for the type MockPolicyEvaluator
+func (_mock *MockPolicyEvaluator) Eval(ctx context.Context, name string, input lib.PolicyInput) (bool, error) {
+	ret := _mock.Called(ctx, name, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Eval")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, lib.PolicyInput) (bool, error)); ok {
+		return returnFunc(ctx, name, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, lib.PolicyInput) bool); ok {
+		r0 = returnFunc(ctx, name, input)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, lib.PolicyInput) error); ok {
+		r1 = returnFunc(ctx, name, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPolicyEvaluator_Eval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Eval'
+type MockPolicyEvaluator_Eval_Call struct {
+	*mock.Call
+}
+
+// Eval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - input lib.PolicyInput
+func (_e *MockPolicyEvaluator_Expecter) Eval(ctx any, name any, input any) *MockPolicyEvaluator_Eval_Call {
+	return &MockPolicyEvaluator_Eval_Call{Call: _e.mock.On("Eval", ctx, name, input)}
+}
+
+func (_c *MockPolicyEvaluator_Eval_Call) Run(run func(ctx context.Context, name string, input lib.PolicyInput)) *MockPolicyEvaluator_Eval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 lib.PolicyInput
+		if args[2] != nil {
+			arg2 = args[2].(lib.PolicyInput)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPolicyEvaluator_Eval_Call) Return(b bool, err error) *MockPolicyEvaluator_Eval_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPolicyEvaluator_Eval_Call) RunAndReturn(run func(ctx context.Context, name string, input lib.PolicyInput) (bool, error)) *MockPolicyEvaluator_Eval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPolicyRoles creates a new instance of MockPolicyRoles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPolicyRoles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPolicyRoles {
+	mock := &MockPolicyRoles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPolicyRoles is an autogenerated mock type for the PolicyRoles type
+type MockPolicyRoles struct {
+	mock.Mock
+}
+
+type MockPolicyRoles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPolicyRoles) EXPECT() *MockPolicyRoles_Expecter {
+	return &MockPolicyRoles_Expecter{mock: &_m.Mock}
+}
+
+// PermissionsByRole provides a mock function for the type MockPolicyRoles
+func (_mock *MockPolicyRoles) PermissionsByRole() map[string][]string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermissionsByRole")
+	}
+
+	var r0 map[string][]string
+	if returnFunc, ok := ret.Get(0).(func() map[string][]string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	return r0
+}
+
+// MockPolicyRoles_PermissionsByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermissionsByRole'
+type MockPolicyRoles_PermissionsByRole_Call struct {
+	*mock.Call
+}
+
+// PermissionsByRole is a helper method to define mock.On call
+func (_e *MockPolicyRoles_Expecter) PermissionsByRole() *MockPolicyRoles_PermissionsByRole_Call {
+	return &MockPolicyRoles_PermissionsByRole_Call{Call: _e.mock.On("PermissionsByRole")}
+}
+
+func (_c *MockPolicyRoles_PermissionsByRole_Call) Run(run func()) *MockPolicyRoles_PermissionsByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPolicyRoles_PermissionsByRole_Call) Return(stringToStrings map[string][]string) *MockPolicyRoles_PermissionsByRole_Call {
+	_c.Call.Return(stringToStrings)
+	return _c
+}
+
+func (_c *MockPolicyRoles_PermissionsByRole_Call) RunAndReturn(run func() map[string][]string) *MockPolicyRoles_PermissionsByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rank provides a mock function for the type MockPolicyRoles
+func (_mock *MockPolicyRoles) Rank(roles []string) (int, error) {
+	ret := _mock.Called(roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rank")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (int, error)); ok {
+		return returnFunc(roles)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) int); ok {
+		r0 = returnFunc(roles)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPolicyRoles_Rank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rank'
+type MockPolicyRoles_Rank_Call struct {
+	*mock.Call
+}
+
+// Rank is a helper method to define mock.On call
+//   - roles []string
+func (_e *MockPolicyRoles_Expecter) Rank(roles any) *MockPolicyRoles_Rank_Call {
+	return &MockPolicyRoles_Rank_Call{Call: _e.mock.On("Rank", roles)}
+}
+
+func (_c *MockPolicyRoles_Rank_Call) Run(run func(roles []string)) *MockPolicyRoles_Rank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPolicyRoles_Rank_Call) Return(n int, err error) *MockPolicyRoles_Rank_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPolicyRoles_Rank_Call) RunAndReturn(run func(roles []string) (int, error)) *MockPolicyRoles_Rank_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,8 +6,9 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
-	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/a-novel/service-json-keys/v2/pkg/go"
 
@@ -34,11 +35,25 @@ type AuthClaimsVerifier interface {
 	VerifyClaims(ctx context.Context, req *servicejsonkeys.VerifyClaimsRequest) (*core.AccessTokenClaims, error)
 }
 
+// AuthPermissions resolves roles to the permissions they grant. It is read on every request, so
+// an implementation backed by a reloadable source applies role changes without a restart.
+type AuthPermissions interface {
+	// PermissionsByRole maps a role name to every permission it grants, inherited ones included.
+	PermissionsByRole() map[string][]string
+}
+
+// StaticPermissions is an [AuthPermissions] that never changes.
+type StaticPermissions map[string][]string
+
+// PermissionsByRole implements [AuthPermissions].
+func (permissions StaticPermissions) PermissionsByRole() map[string][]string {
+	return permissions
+}
+
 // Auth provides JWT-based authentication and role-based authorization middleware.
-// It verifies access tokens and checks that the user has at least one of the required permissions.
+// It verifies access tokens and checks that the user's permissions meet the route's [Requirement].
 type Auth struct {
-	// permissionsByRole maps a role name to every permission it grants.
-	permissionsByRole map[string][]string
+	permissions AuthPermissions
 
 	claimsVerifier AuthClaimsVerifier
 
@@ -46,16 +61,16 @@ type Auth struct {
 }
 
 // NewAuth returns an [Auth] that verifies access tokens with the given claims
-// verifier and resolves caller roles to permissions through permissionsByRole.
+// verifier and resolves caller roles to permissions through permissions.
 func NewAuth(
 	claimsVerifier AuthClaimsVerifier,
-	permissionsByRole map[string][]string,
+	permissions AuthPermissions,
 	logger logging.Log,
 ) *Auth {
 	return &Auth{
-		permissionsByRole: permissionsByRole,
-		claimsVerifier:    claimsVerifier,
-		logger:            logger,
+		permissions:    permissions,
+		claimsVerifier: claimsVerifier,
+		logger:         logger,
 	}
 }
 
@@ -64,19 +79,35 @@ func NewAuth(
 // user is admitted and an unauthenticated request passes through with no claims on
 // the context (use this for optional-auth endpoints). With one or more required
 // permissions, the request is admitted when at least one of the user's role-granted
-// permissions is in the required set.
+// permissions is in the required set. It is [Auth.Require] with [AnyOfPermissions].
 //
 // Verified claims are stored on the request context for downstream handlers; use
 // [GetClaimsContext] or [MustGetClaimsContext] to retrieve them.
 func (middleware *Auth) Middleware(requiredPermissions []string) func(http.Handler) http.Handler {
+	if len(requiredPermissions) == 0 {
+		return middleware.Require(nil)
+	}
+
+	return middleware.Require(AnyOfPermissions(requiredPermissions...))
+}
+
+// Require returns an HTTP middleware that authenticates the request and gates it on a
+// [Requirement], met by the permissions the user's roles grant, all roles together. A nil
+// requirement makes authentication optional, like [Auth.Middleware] without permissions.
+// A denial reports the unmet part of the requirement on the span.
+func (middleware *Auth) Require(requirement Requirement) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx, span := otel.Tracer().Start(r.Context(), "middlewares.Auth")
 			defer span.End()
 
+			if requirement != nil {
+				span.SetAttributes(attribute.String("auth.requirement", requirement.String()))
+			}
+
 			token := r.Header.Get("Authorization")
 			// Optional-auth endpoint: pass through with no claims on the context.
-			if token == "" && len(requiredPermissions) == 0 {
+			if token == "" && requirement == nil {
 				otel.ReportSuccessNoContent(span)
 				next.ServeHTTP(w, r.WithContext(ctx))
 
@@ -120,47 +151,47 @@ func (middleware *Auth) Middleware(requiredPermissions []string) func(http.Handl
 				return
 			}
 
-			ctx = SetClaimsContext(ctx, claims)
+			// A token carrying a temporary role outlives the grant it was signed under: past the grant
+			// expiry, the caller must refresh it to a token without the role.
+			if claims.GrantExpiresAt != nil && !time.Now().Before(*claims.GrantExpiresAt) {
+				httpf.HandleError(
+					ctx, middleware.logger, w, span,
+					httpf.ErrMap{nil: http.StatusUnauthorized},
+					fmt.Errorf("%w: role grant expired", ErrInvalidAuth),
+				)
 
-			if len(requiredPermissions) > 0 {
-				grantedPermissions := map[string]bool{}
+				return
+			}
 
-				for _, permission := range requiredPermissions {
-					grantedPermissions[permission] = true
-				}
+			ctx = SetClaimsContext(ctx, claims)
+
+			if requirement != nil {
+				granted := map[string]bool{}
 
-				var allowed bool
+				permissionsByRole := middleware.permissions.PermissionsByRole()
 
 				for _, role := range claims.Roles {
-					permissions, known := middleware.permissionsByRole[role]
+					permissions, known := permissionsByRole[role]
 					if !known {
-						// The token carries a role the config does not define. It was
-						// signed by this system, so this is a data or config integrity
-						// fault, not a caller error — fail it loudly and named rather
-						// than as a permission denial byte-identical to a legitimate one.
-						httpf.HandleError(
-							ctx, middleware.logger, w, span,
-							httpf.ErrMap{nil: http.StatusInternalServerError},
-							fmt.Errorf("%w: %q in token", config.ErrUnknownRole, role),
-						)
-
-						return
-					}
+						// The token carries a role the config does not define, for instance one
+						// deleted since the token was issued. It grants nothing: the requirement
+						// below refuses the request if the other roles do not meet it.
+						middleware.logger.Warn(ctx, fmt.Sprintf("%s: %q in token grants no permission", config.ErrUnknownRole, role))
 
-					if lo.ContainsBy(permissions, func(item string) bool {
-						return grantedPermissions[item]
-					}) {
-						allowed = true
+						continue
+					}
 
-						break
+					for _, permission := range permissions {
+						granted[permission] = true
 					}
 				}
 
-				if !allowed {
+				if unmet := requirement.Unmet(granted); unmet != nil {
+					span.SetAttributes(attribute.String("auth.requirement.unmet", unmet.String()))
 					httpf.HandleError(
 						ctx, middleware.logger, w, span,
 						httpf.ErrMap{nil: http.StatusForbidden},
-						fmt.Errorf("%w: user does not have any of the required permissions", ErrInvalidAuth),
+						fmt.Errorf("%w: user does not meet the requirement %s", ErrInvalidAuth, unmet),
 					)
 
 					return
@@ -0,0 +1,124 @@
+package middlewares
+
+import (
+	"strings"
+)
+
+// Requirement is a permission expression the auth middleware gates a route on: a single
+// [Permission], or a combination of requirements built with [AnyOf] and [AllOf], nested at will.
+//
+//	AllOf(Permission("credentials:get"), AnyOf(Permission("audit:list"), Permission("roles:list")))
+type Requirement interface {
+	// Unmet returns the part of the requirement the granted permissions fail, or nil when they
+	// meet it.
+	Unmet(granted map[string]bool) Requirement
+	// String renders the requirement, for error messages and spans.
+	String() string
+}
+
+// Permission requires a single permission.
+type Permission string
+
+// Unmet implements [Requirement].
+func (permission Permission) Unmet(granted map[string]bool) Requirement {
+	if granted[string(permission)] {
+		return nil
+	}
+
+	return permission
+}
+
+func (permission Permission) String() string {
+	return string(permission)
+}
+
+// AnyOfRequirement is met when at least one of its requirements is. Build it with [AnyOf].
+type AnyOfRequirement []Requirement
+
+// AnyOf requires at least one of the requirements. An empty AnyOf is met by any caller.
+func AnyOf(requirements ...Requirement) AnyOfRequirement {
+	return requirements
+}
+
+// AnyOfPermissions requires at least one of the permissions. It is the requirement of
+// [Auth.Middleware].
+func AnyOfPermissions(permissions ...string) AnyOfRequirement {
+	requirements := make(AnyOfRequirement, len(permissions))
+	for i, permission := range permissions {
+		requirements[i] = Permission(permission)
+	}
+
+	return requirements
+}
+
+// Unmet implements [Requirement]. When no requirement is met, the whole AnyOf is returned: no
+// single one of them is more to blame than the others.
+func (requirement AnyOfRequirement) Unmet(granted map[string]bool) Requirement {
+	if len(requirement) == 0 {
+		return nil
+	}
+
+	for _, item := range requirement {
+		if item.Unmet(granted) == nil {
+			return nil
+		}
+	}
+
+	return requirement
+}
+
+func (requirement AnyOfRequirement) String() string {
+	return joinRequirements("anyOf", requirement)
+}
+
+// AllOfRequirement is met when every one of its requirements is. Build it with [AllOf].
+type AllOfRequirement []Requirement
+
+// AllOf requires every one of the requirements. An empty AllOf is met by any caller.
+func AllOf(requirements ...Requirement) AllOfRequirement {
+	return requirements
+}
+
+// AllOfPermissions requires every one of the permissions.
+func AllOfPermissions(permissions ...string) AllOfRequirement {
+	requirements := make(AllOfRequirement, len(permissions))
+	for i, permission := range permissions {
+		requirements[i] = Permission(permission)
+	}
+
+	return requirements
+}
+
+// Unmet implements [Requirement]. It returns the unmet part of the first requirement that fails.
+func (requirement AllOfRequirement) Unmet(granted map[string]bool) Requirement {
+	for _, item := range requirement {
+		if unmet := item.Unmet(granted); unmet != nil {
+			return unmet
+		}
+	}
+
+	return nil
+}
+
+func (requirement AllOfRequirement) String() string {
+	return joinRequirements("allOf", requirement)
+}
+
+func joinRequirements(operator string, requirements []Requirement) string {
+	var output strings.Builder
+
+	output.WriteString(operator)
+	output.WriteString("(")
+
+	for i, requirement := range requirements {
+		if i > 0 {
+			output.WriteString(", ")
+		}
+
+		output.WriteString(requirement.String())
+	}
+
+	output.WriteString(")")
+
+	return output.String()
+}
@@ -0,0 +1,108 @@
+package middlewares_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+func TestRequirement(t *testing.T) {
+	t.Parallel()
+
+	granted := map[string]bool{"read": true, "write": true}
+
+	testCases := []struct {
+		name string
+
+		requirement middlewares.Requirement
+
+		expectUnmet  middlewares.Requirement
+		expectString string
+	}{
+		{
+			name: "Permission",
+
+			requirement: middlewares.Permission("read"),
+
+			expectString: "read",
+		},
+		{
+			name: "Permission/Unmet",
+
+			requirement: middlewares.Permission("delete"),
+
+			expectUnmet:  middlewares.Permission("delete"),
+			expectString: "delete",
+		},
+		{
+			name: "AnyOf",
+
+			requirement: middlewares.AnyOfPermissions("delete", "write"),
+
+			expectString: "anyOf(delete, write)",
+		},
+		{
+			name: "AnyOf/Unmet",
+
+			requirement: middlewares.AnyOfPermissions("delete", "admin"),
+
+			expectUnmet:  middlewares.AnyOfPermissions("delete", "admin"),
+			expectString: "anyOf(delete, admin)",
+		},
+		{
+			name: "AllOf",
+
+			requirement: middlewares.AllOfPermissions("read", "write"),
+
+			expectString: "allOf(read, write)",
+		},
+		{
+			// The unmet part is the failing permission, not the whole requirement.
+			name: "AllOf/Unmet",
+
+			requirement: middlewares.AllOfPermissions("read", "delete", "admin"),
+
+			expectUnmet:  middlewares.Permission("delete"),
+			expectString: "allOf(read, delete, admin)",
+		},
+		{
+			name: "Nested",
+
+			requirement: middlewares.AllOf(
+				middlewares.Permission("read"),
+				middlewares.AnyOf(middlewares.Permission("delete"), middlewares.AllOfPermissions("write")),
+			),
+
+			expectString: "allOf(read, anyOf(delete, allOf(write)))",
+		},
+		{
+			name: "Nested/Unmet",
+
+			requirement: middlewares.AllOf(
+				middlewares.Permission("read"),
+				middlewares.AnyOfPermissions("delete", "admin"),
+			),
+
+			expectUnmet:  middlewares.AnyOfPermissions("delete", "admin"),
+			expectString: "allOf(read, anyOf(delete, admin))",
+		},
+		{
+			name: "Empty",
+
+			requirement: middlewares.AnyOf(),
+
+			expectString: "anyOf()",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expectUnmet, testCase.requirement.Unmet(granted))
+			require.Equal(t, testCase.expectString, testCase.requirement.String())
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -27,6 +28,9 @@ func TestAuth(t *testing.T) {
 
 	errFoo := errors.New("foo")
 
+	grantExpiresAt := time.Now().Add(time.Hour)
+	grantExpiredAt := time.Now().Add(-time.Minute)
+
 	type verifyClaimsMock struct {
 		reqToken string
 		resp     *core.AccessTokenClaims
@@ -116,6 +120,31 @@ func TestAuth(t *testing.T) {
 				Roles:  []string{"role1"},
 			},
 		},
+		{
+			name: "Success/GrantValid",
+
+			authHeader: "Bearer token",
+
+			permissions: []string{"write"},
+			permissionsByRole: map[string][]string{
+				"role1": {"read", "write"},
+			},
+			verifyClaimsMock: &verifyClaimsMock{
+				reqToken: "token",
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"role1"},
+					GrantExpiresAt: &grantExpiresAt,
+				},
+			},
+
+			expectStatus: http.StatusOK,
+			expectClaims: &core.AccessTokenClaims{
+				UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Roles:          []string{"role1"},
+				GrantExpiresAt: &grantExpiresAt,
+			},
+		},
 		{
 			name: "Success/NoTokenButNoPermissionsRequired",
 
@@ -198,10 +227,32 @@ func TestAuth(t *testing.T) {
 			expectStatus: http.StatusForbidden,
 		},
 		{
-			// A token role the config does not define is an integrity fault, not a
-			// permission denial: it returns 500, distinct from the 403 above, so it is
-			// not lost among legitimate denials. This is the shape the 'user' default
-			// produced.
+			// The other roles of the token still apply.
+			name: "Success/UnknownRole",
+
+			authHeader: "Bearer token",
+
+			permissions: []string{"write"},
+			permissionsByRole: map[string][]string{
+				"role1": {"read", "write"},
+			},
+			verifyClaimsMock: &verifyClaimsMock{
+				reqToken: "token",
+				resp: &core.AccessTokenClaims{
+					UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:  []string{"ghost", "role1"},
+				},
+			},
+
+			expectStatus: http.StatusOK,
+			expectClaims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Roles:  []string{"ghost", "role1"},
+			},
+		},
+		{
+			// A token role the config does not define grants nothing: the requirement
+			// is unmet, as with any other missing permission.
 			name: "Error/UnknownRole",
 
 			authHeader: "Bearer token",
@@ -218,7 +269,27 @@ func TestAuth(t *testing.T) {
 				},
 			},
 
-			expectStatus: http.StatusInternalServerError,
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			// The token is still signed and unexpired, but the temporary role it carries is not.
+			name: "Error/GrantExpired",
+
+			authHeader: "Bearer token",
+
+			permissionsByRole: map[string][]string{
+				"role1": {"read", "write"},
+			},
+			verifyClaimsMock: &verifyClaimsMock{
+				reqToken: "token",
+				resp: &core.AccessTokenClaims{
+					UserID:         lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					Roles:          []string{"role1"},
+					GrantExpiresAt: &grantExpiredAt,
+				},
+			},
+
+			expectStatus: http.StatusUnauthorized,
 		},
 		{
 			name: "Error/InvalidSignature",
@@ -273,7 +344,9 @@ func TestAuth(t *testing.T) {
 					Return(testCase.verifyClaimsMock.resp, testCase.verifyClaimsMock.err)
 			}
 
-			middleware := middlewares.NewAuth(service, testCase.permissionsByRole, config.LoggerDev)
+			middleware := middlewares.NewAuth(
+				service, middlewares.StaticPermissions(testCase.permissionsByRole), config.LoggerDev,
+			)
 			w := httptest.NewRecorder()
 
 			ctxClaims := new(*core.AccessTokenClaims)
@@ -307,6 +380,142 @@ func TestAuth(t *testing.T) {
 	}
 }
 
+func TestAuthReloadsPermissions(t *testing.T) {
+	t.Parallel()
+
+	service := middlewaresmocks.NewMockAuthClaimsVerifier(t)
+	permissions := middlewaresmocks.NewMockAuthPermissions(t)
+
+	service.EXPECT().
+		VerifyClaims(mock.Anything, mock.Anything).
+		Return(&core.AccessTokenClaims{
+			UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			Roles:  []string{"role1"},
+		}, nil)
+
+	// The role gains the permission between the two requests.
+	permissions.EXPECT().
+		PermissionsByRole().
+		Return(map[string][]string{"role1": {"read"}}).
+		Once()
+	permissions.EXPECT().
+		PermissionsByRole().
+		Return(map[string][]string{"role1": {"read", "write"}}).
+		Once()
+
+	middleware := middlewares.NewAuth(service, permissions, config.LoggerDev)
+	handler := middleware.Middleware([]string{"write"})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, expectStatus := range []int{http.StatusForbidden, http.StatusOK} {
+		w := httptest.NewRecorder()
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		handler.ServeHTTP(w, req)
+
+		require.Equal(t, expectStatus, w.Result().StatusCode)
+	}
+}
+
+func TestAuthRequire(t *testing.T) {
+	t.Parallel()
+
+	permissionsByRole := middlewares.StaticPermissions{
+		"role1": {"read"},
+		"role2": {"write"},
+	}
+
+	testCases := []struct {
+		name string
+
+		roles       []string
+		requirement middlewares.Requirement
+
+		expectStatus int
+	}{
+		{
+			// Permissions granted by different roles add up.
+			name: "AllOf/AcrossRoles",
+
+			roles:       []string{"role1", "role2"},
+			requirement: middlewares.AllOfPermissions("read", "write"),
+
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "AllOf/Missing",
+
+			roles:       []string{"role1"},
+			requirement: middlewares.AllOfPermissions("read", "write"),
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Nested",
+
+			roles: []string{"role2"},
+			requirement: middlewares.AnyOf(
+				middlewares.AllOfPermissions("read", "write"),
+				middlewares.Permission("write"),
+			),
+
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Empty",
+
+			roles:       []string{"role1"},
+			requirement: middlewares.AllOf(),
+
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := middlewaresmocks.NewMockAuthClaimsVerifier(t)
+			service.EXPECT().
+				VerifyClaims(mock.Anything, mock.Anything).
+				Return(&core.AccessTokenClaims{Roles: testCase.roles}, nil)
+
+			middleware := middlewares.NewAuth(service, permissionsByRole, config.LoggerDev)
+			handler := middleware.Require(testCase.requirement)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			w := httptest.NewRecorder()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, testCase.expectStatus, w.Result().StatusCode)
+		})
+	}
+
+	t.Run("Nil/NoToken", func(t *testing.T) {
+		t.Parallel()
+
+		middleware := middlewares.NewAuth(
+			middlewaresmocks.NewMockAuthClaimsVerifier(t), permissionsByRole, config.LoggerDev,
+		)
+		handler := middleware.Require(nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+}
+
 func TestGetClaimsContext(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,187 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+var (
+	// ErrPolicyDenied indicates the policy gating the route refused the request.
+	ErrPolicyDenied = errors.New("denied by policy")
+	// ErrPolicyTargetNotFound is returned by a [PolicyTargetResolver] when the resource the
+	// request acts on does not exist. The request gets a 404.
+	ErrPolicyTargetNotFound = errors.New("policy target not found")
+	// ErrPolicyTargetInvalid is returned by a [PolicyTargetResolver] when the request does not
+	// name the resource it acts on in a readable way. The request gets a 400.
+	ErrPolicyTargetInvalid = errors.New("invalid policy target")
+)
+
+// PolicyEvaluator evaluates named policies against a request; satisfied by [lib.PolicyEngine].
+//
+// nosemgrep: agora-dep-interface-method-must-be-exec
+type PolicyEvaluator interface {
+	Eval(ctx context.Context, name string, input lib.PolicyInput) (bool, error)
+}
+
+// PolicyRoles resolves the caller's roles to the permissions they grant, and to its rank.
+type PolicyRoles interface {
+	PermissionsByRole() map[string][]string
+	Rank(roles []string) (int, error)
+}
+
+// PolicyTargetResolver returns the attributes of the resource a request acts on, read by policies
+// through the target variable. The caller's claims are on the request context. It returns
+// [ErrPolicyTargetNotFound] when the resource does not exist, and [ErrPolicyTargetInvalid] when
+// the request does not name it properly.
+type PolicyTargetResolver func(r *http.Request) (map[string]any, error)
+
+// Policy gates routes on named authorization policies, for rules permissions cannot express,
+// such as "the caller outranks the account it acts on". It runs after [Auth], whose claims it
+// reads.
+type Policy struct {
+	evaluator PolicyEvaluator
+	roles     PolicyRoles
+
+	logger logging.Log
+}
+
+// NewPolicy returns a [Policy] that evaluates policies with evaluator, and resolves the caller's
+// permissions and rank through roles.
+func NewPolicy(evaluator PolicyEvaluator, roles PolicyRoles, logger logging.Log) *Policy {
+	return &Policy{
+		evaluator: evaluator,
+		roles:     roles,
+		logger:    logger,
+	}
+}
+
+// Middleware returns an HTTP middleware that admits the request only when the named policy allows
+// it. The policy reads the caller's claims, the request, and the target attributes returned by
+// resolveTarget; a nil resolveTarget leaves the target empty.
+//
+// A request without claims gets a 401, a denied one a 403. A target that cannot be resolved gets
+// a 400 or a 404, see [PolicyTargetResolver]. A policy that fails to evaluate refuses the request
+// with a 500.
+func (middleware *Policy) Middleware(
+	policy string, resolveTarget PolicyTargetResolver,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := otel.Tracer().Start(r.Context(), "middlewares.Policy")
+			defer span.End()
+
+			span.SetAttributes(attribute.String("policy", policy))
+
+			input, err := middleware.input(r.WithContext(ctx), resolveTarget)
+			if err != nil {
+				httpf.HandleError(ctx, middleware.logger, w, span, httpf.ErrMap{
+					ErrMissingAuth:          http.StatusUnauthorized,
+					ErrPolicyTargetNotFound: http.StatusNotFound,
+					ErrPolicyTargetInvalid:  http.StatusBadRequest,
+				}, err)
+
+				return
+			}
+
+			allowed, err := middleware.evaluator.Eval(ctx, policy, input)
+			if err != nil {
+				httpf.HandleError(ctx, middleware.logger, w, span, nil, err)
+
+				return
+			}
+
+			if !allowed {
+				httpf.HandleError(
+					ctx, middleware.logger, w, span,
+					httpf.ErrMap{nil: http.StatusForbidden},
+					fmt.Errorf("%w %q", ErrPolicyDenied, policy),
+				)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+			otel.ReportSuccessNoContent(span)
+		})
+	}
+}
+
+func (middleware *Policy) input(r *http.Request, resolveTarget PolicyTargetResolver) (lib.PolicyInput, error) {
+	claims, err := MustGetClaimsContext(r.Context())
+	if err != nil {
+		return lib.PolicyInput{}, err
+	}
+
+	rank, err := middleware.roles.Rank(claims.Roles)
+	if err != nil {
+		return lib.PolicyInput{}, fmt.Errorf("rank caller: %w", err)
+	}
+
+	permissionsByRole := middleware.roles.PermissionsByRole()
+
+	var permissions []string
+	for _, role := range claims.Roles {
+		permissions = append(permissions, permissionsByRole[role]...)
+	}
+
+	slices.Sort(permissions)
+
+	var userID string
+	if claims.UserID != nil {
+		userID = claims.UserID.String()
+	}
+
+	input := lib.PolicyInput{
+		Claims: map[string]any{
+			"userID":        userID,
+			"roles":         claims.Roles,
+			"permissions":   slices.Compact(permissions),
+			"rank":          rank,
+			"emailVerified": claims.EmailVerified,
+		},
+		Request: requestAttributes(r),
+	}
+
+	if resolveTarget != nil {
+		input.Target, err = resolveTarget(r)
+		if err != nil {
+			return lib.PolicyInput{}, fmt.Errorf("resolve target: %w", err)
+		}
+	}
+
+	return input, nil
+}
+
+func requestAttributes(r *http.Request) map[string]any {
+	params := map[string]any{}
+
+	if routeContext := chi.RouteContext(r.Context()); routeContext != nil {
+		for i, key := range routeContext.URLParams.Keys {
+			params[key] = routeContext.URLParams.Values[i]
+		}
+	}
+
+	query := map[string]any{}
+	for key := range r.URL.Query() {
+		query[key] = r.URL.Query().Get(key)
+	}
+
+	return map[string]any{
+		"method": r.Method,
+		"path":   r.URL.Path,
+		"params": params,
+		"query":  query,
+	}
+}
@@ -0,0 +1,179 @@
+package middlewares_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	middlewaresmocks "github.com/a-novel/service-authentication/v2/internal/handlers/middlewares/mocks"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestPolicy(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	claims := &core.AccessTokenClaims{
+		UserID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+		Roles:         []string{"role1", "role2"},
+		EmailVerified: true,
+	}
+
+	target := map[string]any{"userID": "00000000-0000-0000-0000-000000000002", "rank": 1}
+
+	// The input the policy receives for a request to /users/00000000-0000-0000-0000-000000000002?full=true.
+	expectInput := lib.PolicyInput{
+		Claims: map[string]any{
+			"userID":        "00000000-0000-0000-0000-000000000001",
+			"roles":         []string{"role1", "role2"},
+			"permissions":   []string{"read", "write"},
+			"rank":          2,
+			"emailVerified": true,
+		},
+		Request: map[string]any{
+			"method": http.MethodGet,
+			"path":   "/users/00000000-0000-0000-0000-000000000002",
+			"params": map[string]any{"id": "00000000-0000-0000-0000-000000000002"},
+			"query":  map[string]any{"full": "true"},
+		},
+		Target: target,
+	}
+
+	type evalMock struct {
+		resp bool
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		claims        *core.AccessTokenClaims
+		resolveTarget middlewares.PolicyTargetResolver
+
+		evalMock *evalMock
+
+		expectStatus int
+	}{
+		{
+			name: "Allowed",
+
+			claims: claims,
+			resolveTarget: func(r *http.Request) (map[string]any, error) {
+				require.Equal(t, "00000000-0000-0000-0000-000000000002", chi.URLParam(r, "id"))
+
+				return target, nil
+			},
+
+			evalMock: &evalMock{resp: true},
+
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Denied",
+
+			claims: claims,
+			resolveTarget: func(_ *http.Request) (map[string]any, error) {
+				return target, nil
+			},
+
+			evalMock: &evalMock{resp: false},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/NoClaims",
+
+			expectStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Error/TargetNotFound",
+
+			claims: claims,
+			resolveTarget: func(_ *http.Request) (map[string]any, error) {
+				return nil, middlewares.ErrPolicyTargetNotFound
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/TargetInvalid",
+
+			claims: claims,
+			resolveTarget: func(_ *http.Request) (map[string]any, error) {
+				return nil, middlewares.ErrPolicyTargetInvalid
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/Eval",
+
+			claims: claims,
+			resolveTarget: func(_ *http.Request) (map[string]any, error) {
+				return target, nil
+			},
+
+			evalMock: &evalMock{err: errFoo},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator := middlewaresmocks.NewMockPolicyEvaluator(t)
+			roles := middlewaresmocks.NewMockPolicyRoles(t)
+
+			roles.EXPECT().Rank([]string{"role1", "role2"}).Return(2, nil).Maybe()
+			roles.EXPECT().
+				PermissionsByRole().
+				Return(map[string][]string{"role1": {"write", "read"}, "role2": {"read"}}).
+				Maybe()
+
+			if testCase.evalMock != nil {
+				evaluator.EXPECT().
+					Eval(mock.Anything, "test", expectInput).
+					Return(testCase.evalMock.resp, testCase.evalMock.err)
+			}
+
+			middleware := middlewares.NewPolicy(evaluator, roles, config.LoggerDev)
+
+			router := chi.NewRouter()
+			router.
+				With(func(next http.Handler) http.Handler {
+					return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						ctx := r.Context()
+						if testCase.claims != nil {
+							ctx = middlewares.SetClaimsContext(ctx, testCase.claims)
+						}
+
+						next.ServeHTTP(w, r.WithContext(ctx))
+					})
+				}).
+				With(middleware.Middleware("test", testCase.resolveTarget)).
+				Get("/users/{id}", func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequestWithContext(
+				t.Context(), http.MethodGet, "/users/00000000-0000-0000-0000-000000000002?full=true", nil,
+			))
+
+			require.Equal(t, testCase.expectStatus, w.Result().StatusCode)
+		})
+	}
+}
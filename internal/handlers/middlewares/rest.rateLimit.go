@@ -0,0 +1,138 @@
+package middlewares
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"golang.org/x/time/rate"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+)
+
+// ErrRateLimited indicates the client sent too many requests to a rate limited route.
+var ErrRateLimited = errors.New("too many requests")
+
+type rateLimitClient struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimit caps how often each client may call a route, following a token bucket per client
+// IP. The IP is read from the context set by chi's ClientIP middlewares, or from the remote
+// address of the request otherwise.
+//
+// Limits are kept in memory, so each instance of the service enforces its own.
+type RateLimit struct {
+	config config.RateLimit
+	logger logging.Log
+
+	mu        sync.Mutex
+	clients   map[string]*rateLimitClient
+	lastSweep time.Time
+}
+
+// NewRateLimit returns a [RateLimit] that reports refusals to logger.
+func NewRateLimit(config config.RateLimit, logger logging.Log) *RateLimit {
+	return &RateLimit{
+		config:    config,
+		logger:    logger,
+		clients:   make(map[string]*rateLimitClient),
+		lastSweep: time.Now(),
+	}
+}
+
+// Middleware returns an HTTP middleware that refuses the request with a 429 once the client
+// spent its burst, with a Retry-After header telling when it regains a request.
+func (middleware *RateLimit) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := otel.Tracer().Start(r.Context(), "middlewares.RateLimit")
+			defer span.End()
+
+			delay := middleware.reserve(rateLimitKey(r), time.Now())
+			if delay > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+				httpf.HandleError(ctx, middleware.logger, w, span, httpf.ErrMap{
+					ErrRateLimited: http.StatusTooManyRequests,
+				}, ErrRateLimited)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+			otel.ReportSuccessNoContent(span)
+		})
+	}
+}
+
+// reserve takes a request from the bucket of the client. It returns how long the client must wait
+// when the bucket is empty, in which case nothing is taken.
+func (middleware *RateLimit) reserve(key string, now time.Time) time.Duration {
+	middleware.mu.Lock()
+	defer middleware.mu.Unlock()
+
+	middleware.sweep(now)
+
+	client, ok := middleware.clients[key]
+	if !ok {
+		client = &rateLimitClient{
+			limiter: rate.NewLimiter(rate.Every(middleware.config.Interval), middleware.config.Burst),
+		}
+		middleware.clients[key] = client
+	}
+
+	client.lastSeen = now
+
+	reservation := client.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		// A burst of 0 refuses every request.
+		return middleware.config.Interval
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+
+	return delay
+}
+
+// sweep forgets the clients whose bucket is full again, which behave as new clients. It runs at
+// most once per refill period, so the map stays bounded by the clients of that period.
+func (middleware *RateLimit) sweep(now time.Time) {
+	refill := middleware.config.Interval * time.Duration(max(middleware.config.Burst, 1))
+	if now.Sub(middleware.lastSweep) < refill {
+		return
+	}
+
+	for key, client := range middleware.clients {
+		if now.Sub(client.lastSeen) >= refill {
+			delete(middleware.clients, key)
+		}
+	}
+
+	middleware.lastSweep = now
+}
+
+func rateLimitKey(r *http.Request) string {
+	if ip := middleware.GetClientIP(r.Context()); ip != "" {
+		return ip
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
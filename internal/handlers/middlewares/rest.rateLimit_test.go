@@ -0,0 +1,100 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		remoteAddr string
+
+		expectStatus     int
+		expectRetryAfter string
+	}
+
+	testCases := []struct {
+		name string
+
+		config config.RateLimit
+
+		requests []request
+	}{
+		{
+			name: "Success",
+
+			config: config.RateLimit{Interval: time.Hour, Burst: 2},
+
+			requests: []request{
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusOK},
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusOK},
+			},
+		},
+		{
+			name: "Error/BurstSpent",
+
+			config: config.RateLimit{Interval: time.Hour, Burst: 2},
+
+			requests: []request{
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusOK},
+				// Another port is the same client.
+				{remoteAddr: "10.0.0.1:5678", expectStatus: http.StatusOK},
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusTooManyRequests, expectRetryAfter: "3600"},
+			},
+		},
+		{
+			name: "Success/PerClient",
+
+			config: config.RateLimit{Interval: time.Hour, Burst: 1},
+
+			requests: []request{
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusOK},
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusTooManyRequests, expectRetryAfter: "3600"},
+				{remoteAddr: "10.0.0.2:1234", expectStatus: http.StatusOK},
+			},
+		},
+		{
+			name: "Error/NoBurst",
+
+			config: config.RateLimit{Interval: time.Minute},
+
+			requests: []request{
+				{remoteAddr: "10.0.0.1:1234", expectStatus: http.StatusTooManyRequests, expectRetryAfter: "60"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			middleware := middlewares.NewRateLimit(testCase.config, config.LoggerDev)
+			handler := middleware.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			for _, request := range testCase.requests {
+				req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", nil)
+				req.RemoteAddr = request.remoteAddr
+
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, req)
+
+				res := w.Result()
+				require.NoError(t, res.Body.Close())
+
+				require.Equal(t, request.expectStatus, res.StatusCode)
+				require.Equal(t, request.expectRetryAfter, res.Header.Get("Retry-After"))
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+)
+
+// ErrEmailNotVerified indicates the authenticated user has not verified their email.
+var ErrEmailNotVerified = errors.New("email not verified")
+
+// VerifiedEmail gates routes on a verified email. It reads the claims [Auth] stored on the
+// context, so it must be mounted after it.
+type VerifiedEmail struct {
+	logger logging.Log
+}
+
+// NewVerifiedEmail returns a [VerifiedEmail] that reports refusals to logger.
+func NewVerifiedEmail(logger logging.Log) *VerifiedEmail {
+	return &VerifiedEmail{logger: logger}
+}
+
+// Middleware returns an HTTP middleware that admits the request only when its claims
+// report a verified email. A request without claims is refused as unauthenticated, even
+// on an optional-auth route.
+func (middleware *VerifiedEmail) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := otel.Tracer().Start(r.Context(), "middlewares.VerifiedEmail")
+			defer span.End()
+
+			claims, err := MustGetClaimsContext(ctx)
+			if err != nil {
+				httpf.HandleError(ctx, middleware.logger, w, span, httpf.ErrMap{
+					ErrMissingAuth: http.StatusUnauthorized,
+				}, err)
+
+				return
+			}
+
+			if !claims.EmailVerified {
+				httpf.HandleError(
+					ctx, middleware.logger, w, span,
+					httpf.ErrMap{nil: http.StatusForbidden},
+					fmt.Errorf("%w: %w", ErrInvalidAuth, ErrEmailNotVerified),
+				)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+			otel.ReportSuccessNoContent(span)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+func TestVerifiedEmail(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		claims *core.AccessTokenClaims
+
+		expectStatus int
+	}{
+		{
+			name: "Success",
+
+			claims: &core.AccessTokenClaims{
+				UserID:        lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Roles:         []string{"role1"},
+				EmailVerified: true,
+			},
+
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/NotVerified",
+
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Roles:  []string{"role1"},
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/Anonymous",
+
+			claims: &core.AccessTokenClaims{
+				Roles: []string{"role1"},
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/NoClaims",
+
+			expectStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			middleware := middlewares.NewVerifiedEmail(config.LoggerDev)
+
+			var called bool
+
+			handler := middleware.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			ctx := t.Context()
+			if testCase.claims != nil {
+				ctx = middlewares.SetClaimsContext(ctx, testCase.claims)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequestWithContext(ctx, http.MethodGet, "/", nil))
+
+			require.Equal(t, testCase.expectStatus, w.Code)
+			require.Equal(t, testCase.expectStatus == http.StatusOK, called)
+		})
+	}
+}
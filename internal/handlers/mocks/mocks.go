@@ -15,13 +15,13 @@ import (
 	"google.golang.org/grpc"
 )
 
-// NewMockCredentialsCreateService creates a new instance of MockCredentialsCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAuditListService creates a new instance of MockAuditListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsCreateService(t interface {
+func NewMockAuditListService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsCreateService {
-	mock := &MockCredentialsCreateService{}
+}) *MockAuditListService {
+	mock := &MockAuditListService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -29,40 +29,40 @@ func NewMockCredentialsCreateService(t interface {
 	return mock
 }
 
-// MockCredentialsCreateService is an autogenerated mock type for the CredentialsCreateService type
-type MockCredentialsCreateService struct {
+// MockAuditListService is an autogenerated mock type for the AuditListService type
+type MockAuditListService struct {
 	mock.Mock
 }
 
-type MockCredentialsCreateService_Expecter struct {
+type MockAuditListService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsCreateService) EXPECT() *MockCredentialsCreateService_Expecter {
-	return &MockCredentialsCreateService_Expecter{mock: &_m.Mock}
+func (_m *MockAuditListService) EXPECT() *MockAuditListService_Expecter {
+	return &MockAuditListService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsCreateService
-func (_mock *MockCredentialsCreateService) Exec(ctx context.Context, request *core.CredentialsCreateRequest) (*core.Token, error) {
+// Exec provides a mock function for the type MockAuditListService
+func (_mock *MockAuditListService) Exec(ctx context.Context, request *core.AuditEventListRequest) ([]*core.AuditEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Token
+	var r0 []*core.AuditEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsCreateRequest) (*core.Token, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.AuditEventListRequest) ([]*core.AuditEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsCreateRequest) *core.Token); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.AuditEventListRequest) []*core.AuditEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Token)
+			r0 = ret.Get(0).([]*core.AuditEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.AuditEventListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -70,27 +70,27 @@ func (_mock *MockCredentialsCreateService) Exec(ctx context.Context, request *co
 	return r0, r1
 }
 
-// MockCredentialsCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsCreateService_Exec_Call struct {
+// MockAuditListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockAuditListService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsCreateRequest
-func (_e *MockCredentialsCreateService_Expecter) Exec(ctx any, request any) *MockCredentialsCreateService_Exec_Call {
-	return &MockCredentialsCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.AuditEventListRequest
+func (_e *MockAuditListService_Expecter) Exec(ctx any, request any) *MockAuditListService_Exec_Call {
+	return &MockAuditListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsCreateService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsCreateRequest)) *MockCredentialsCreateService_Exec_Call {
+func (_c *MockAuditListService_Exec_Call) Run(run func(ctx context.Context, request *core.AuditEventListRequest)) *MockAuditListService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsCreateRequest
+		var arg1 *core.AuditEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsCreateRequest)
+			arg1 = args[1].(*core.AuditEventListRequest)
 		}
 		run(
 			arg0,
@@ -100,23 +100,23 @@ func (_c *MockCredentialsCreateService_Exec_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockCredentialsCreateService_Exec_Call) Return(token *core.Token, err error) *MockCredentialsCreateService_Exec_Call {
-	_c.Call.Return(token, err)
+func (_c *MockAuditListService_Exec_Call) Return(auditEvents []*core.AuditEvent, err error) *MockAuditListService_Exec_Call {
+	_c.Call.Return(auditEvents, err)
 	return _c
 }
 
-func (_c *MockCredentialsCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsCreateRequest) (*core.Token, error)) *MockCredentialsCreateService_Exec_Call {
+func (_c *MockAuditListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.AuditEventListRequest) ([]*core.AuditEvent, error)) *MockAuditListService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsExistService creates a new instance of MockCredentialsExistService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateService creates a new instance of MockCredentialsCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsExistService(t interface {
+func NewMockCredentialsCreateService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsExistService {
-	mock := &MockCredentialsExistService{}
+}) *MockCredentialsCreateService {
+	mock := &MockCredentialsCreateService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -124,38 +124,40 @@ func NewMockCredentialsExistService(t interface {
 	return mock
 }
 
-// MockCredentialsExistService is an autogenerated mock type for the CredentialsExistService type
-type MockCredentialsExistService struct {
+// MockCredentialsCreateService is an autogenerated mock type for the CredentialsCreateService type
+type MockCredentialsCreateService struct {
 	mock.Mock
 }
 
-type MockCredentialsExistService_Expecter struct {
+type MockCredentialsCreateService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsExistService) EXPECT() *MockCredentialsExistService_Expecter {
-	return &MockCredentialsExistService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateService) EXPECT() *MockCredentialsCreateService_Expecter {
+	return &MockCredentialsCreateService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsExistService
-func (_mock *MockCredentialsExistService) Exec(ctx context.Context, request *core.CredentialsExistRequest) (bool, error) {
+// Exec provides a mock function for the type MockCredentialsCreateService
+func (_mock *MockCredentialsCreateService) Exec(ctx context.Context, request *core.CredentialsCreateRequest) (*core.Token, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 bool
+	var r0 *core.Token
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsExistRequest) (bool, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsCreateRequest) (*core.Token, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsExistRequest) bool); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsCreateRequest) *core.Token); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Token)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsExistRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsCreateRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -163,27 +165,27 @@ func (_mock *MockCredentialsExistService) Exec(ctx context.Context, request *cor
 	return r0, r1
 }
 
-// MockCredentialsExistService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsExistService_Exec_Call struct {
+// MockCredentialsCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsExistRequest
-func (_e *MockCredentialsExistService_Expecter) Exec(ctx any, request any) *MockCredentialsExistService_Exec_Call {
-	return &MockCredentialsExistService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsCreateRequest
+func (_e *MockCredentialsCreateService_Expecter) Exec(ctx any, request any) *MockCredentialsCreateService_Exec_Call {
+	return &MockCredentialsCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsExistService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsExistRequest)) *MockCredentialsExistService_Exec_Call {
+func (_c *MockCredentialsCreateService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsCreateRequest)) *MockCredentialsCreateService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsExistRequest
+		var arg1 *core.CredentialsCreateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsExistRequest)
+			arg1 = args[1].(*core.CredentialsCreateRequest)
 		}
 		run(
 			arg0,
@@ -193,23 +195,23 @@ func (_c *MockCredentialsExistService_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockCredentialsExistService_Exec_Call) Return(b bool, err error) *MockCredentialsExistService_Exec_Call {
-	_c.Call.Return(b, err)
+func (_c *MockCredentialsCreateService_Exec_Call) Return(token *core.Token, err error) *MockCredentialsCreateService_Exec_Call {
+	_c.Call.Return(token, err)
 	return _c
 }
 
-func (_c *MockCredentialsExistService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsExistRequest) (bool, error)) *MockCredentialsExistService_Exec_Call {
+func (_c *MockCredentialsCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsCreateRequest) (*core.Token, error)) *MockCredentialsCreateService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsGetService creates a new instance of MockCredentialsGetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsCreateInviteService creates a new instance of MockCredentialsCreateInviteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsGetService(t interface {
+func NewMockCredentialsCreateInviteService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsGetService {
-	mock := &MockCredentialsGetService{}
+}) *MockCredentialsCreateInviteService {
+	mock := &MockCredentialsCreateInviteService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -217,40 +219,40 @@ func NewMockCredentialsGetService(t interface {
 	return mock
 }
 
-// MockCredentialsGetService is an autogenerated mock type for the CredentialsGetService type
-type MockCredentialsGetService struct {
+// MockCredentialsCreateInviteService is an autogenerated mock type for the CredentialsCreateInviteService type
+type MockCredentialsCreateInviteService struct {
 	mock.Mock
 }
 
-type MockCredentialsGetService_Expecter struct {
+type MockCredentialsCreateInviteService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsGetService) EXPECT() *MockCredentialsGetService_Expecter {
-	return &MockCredentialsGetService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsCreateInviteService) EXPECT() *MockCredentialsCreateInviteService_Expecter {
+	return &MockCredentialsCreateInviteService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsGetService
-func (_mock *MockCredentialsGetService) Exec(ctx context.Context, request *core.CredentialsGetRequest) (*core.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsCreateInviteService
+func (_mock *MockCredentialsCreateInviteService) Exec(ctx context.Context, request *core.CredentialsCreateInviteRequest) (*core.Token, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Credentials
+	var r0 *core.Token
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGetRequest) (*core.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsCreateInviteRequest) (*core.Token, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGetRequest) *core.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsCreateInviteRequest) *core.Token); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Credentials)
+			r0 = ret.Get(0).(*core.Token)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsGetRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsCreateInviteRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -258,27 +260,27 @@ func (_mock *MockCredentialsGetService) Exec(ctx context.Context, request *core.
 	return r0, r1
 }
 
-// MockCredentialsGetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsGetService_Exec_Call struct {
+// MockCredentialsCreateInviteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsCreateInviteService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsGetRequest
-func (_e *MockCredentialsGetService_Expecter) Exec(ctx any, request any) *MockCredentialsGetService_Exec_Call {
-	return &MockCredentialsGetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsCreateInviteRequest
+func (_e *MockCredentialsCreateInviteService_Expecter) Exec(ctx any, request any) *MockCredentialsCreateInviteService_Exec_Call {
+	return &MockCredentialsCreateInviteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsGetService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsGetRequest)) *MockCredentialsGetService_Exec_Call {
+func (_c *MockCredentialsCreateInviteService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsCreateInviteRequest)) *MockCredentialsCreateInviteService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsGetRequest
+		var arg1 *core.CredentialsCreateInviteRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsGetRequest)
+			arg1 = args[1].(*core.CredentialsCreateInviteRequest)
 		}
 		run(
 			arg0,
@@ -288,23 +290,23 @@ func (_c *MockCredentialsGetService_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockCredentialsGetService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsGetService_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsCreateInviteService_Exec_Call) Return(token *core.Token, err error) *MockCredentialsCreateInviteService_Exec_Call {
+	_c.Call.Return(token, err)
 	return _c
 }
 
-func (_c *MockCredentialsGetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsGetRequest) (*core.Credentials, error)) *MockCredentialsGetService_Exec_Call {
+func (_c *MockCredentialsCreateInviteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsCreateInviteRequest) (*core.Token, error)) *MockCredentialsCreateInviteService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsListService creates a new instance of MockCredentialsListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsEventListService creates a new instance of MockCredentialsEventListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsListService(t interface {
+func NewMockCredentialsEventListService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsListService {
-	mock := &MockCredentialsListService{}
+}) *MockCredentialsEventListService {
+	mock := &MockCredentialsEventListService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -312,40 +314,40 @@ func NewMockCredentialsListService(t interface {
 	return mock
 }
 
-// MockCredentialsListService is an autogenerated mock type for the CredentialsListService type
-type MockCredentialsListService struct {
+// MockCredentialsEventListService is an autogenerated mock type for the CredentialsEventListService type
+type MockCredentialsEventListService struct {
 	mock.Mock
 }
 
-type MockCredentialsListService_Expecter struct {
+type MockCredentialsEventListService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsListService) EXPECT() *MockCredentialsListService_Expecter {
-	return &MockCredentialsListService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsEventListService) EXPECT() *MockCredentialsEventListService_Expecter {
+	return &MockCredentialsEventListService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsListService
-func (_mock *MockCredentialsListService) Exec(ctx context.Context, request *core.CredentialsListRequest) ([]*core.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsEventListService
+func (_mock *MockCredentialsEventListService) Exec(ctx context.Context, request *core.CredentialsEventListRequest) ([]*core.CredentialsEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*core.Credentials
+	var r0 []*core.CredentialsEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsListRequest) ([]*core.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsEventListRequest) ([]*core.CredentialsEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsListRequest) []*core.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsEventListRequest) []*core.CredentialsEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*core.Credentials)
+			r0 = ret.Get(0).([]*core.CredentialsEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsListRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsEventListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -353,27 +355,27 @@ func (_mock *MockCredentialsListService) Exec(ctx context.Context, request *core
 	return r0, r1
 }
 
-// MockCredentialsListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsListService_Exec_Call struct {
+// MockCredentialsEventListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsEventListService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsListRequest
-func (_e *MockCredentialsListService_Expecter) Exec(ctx any, request any) *MockCredentialsListService_Exec_Call {
-	return &MockCredentialsListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsEventListRequest
+func (_e *MockCredentialsEventListService_Expecter) Exec(ctx any, request any) *MockCredentialsEventListService_Exec_Call {
+	return &MockCredentialsEventListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsListService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsListRequest)) *MockCredentialsListService_Exec_Call {
+func (_c *MockCredentialsEventListService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsEventListRequest)) *MockCredentialsEventListService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsListRequest
+		var arg1 *core.CredentialsEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsListRequest)
+			arg1 = args[1].(*core.CredentialsEventListRequest)
 		}
 		run(
 			arg0,
@@ -383,23 +385,23 @@ func (_c *MockCredentialsListService_Exec_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockCredentialsListService_Exec_Call) Return(credentialss []*core.Credentials, err error) *MockCredentialsListService_Exec_Call {
-	_c.Call.Return(credentialss, err)
+func (_c *MockCredentialsEventListService_Exec_Call) Return(credentialsEvents []*core.CredentialsEvent, err error) *MockCredentialsEventListService_Exec_Call {
+	_c.Call.Return(credentialsEvents, err)
 	return _c
 }
 
-func (_c *MockCredentialsListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsListRequest) ([]*core.Credentials, error)) *MockCredentialsListService_Exec_Call {
+func (_c *MockCredentialsEventListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsEventListRequest) ([]*core.CredentialsEvent, error)) *MockCredentialsEventListService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsResetPasswordService creates a new instance of MockCredentialsResetPasswordService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExistService creates a new instance of MockCredentialsExistService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsResetPasswordService(t interface {
+func NewMockCredentialsExistService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsResetPasswordService {
-	mock := &MockCredentialsResetPasswordService{}
+}) *MockCredentialsExistService {
+	mock := &MockCredentialsExistService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -407,40 +409,38 @@ func NewMockCredentialsResetPasswordService(t interface {
 	return mock
 }
 
-// MockCredentialsResetPasswordService is an autogenerated mock type for the CredentialsResetPasswordService type
-type MockCredentialsResetPasswordService struct {
+// MockCredentialsExistService is an autogenerated mock type for the CredentialsExistService type
+type MockCredentialsExistService struct {
 	mock.Mock
 }
 
-type MockCredentialsResetPasswordService_Expecter struct {
+type MockCredentialsExistService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsResetPasswordService) EXPECT() *MockCredentialsResetPasswordService_Expecter {
-	return &MockCredentialsResetPasswordService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExistService) EXPECT() *MockCredentialsExistService_Expecter {
+	return &MockCredentialsExistService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsResetPasswordService
-func (_mock *MockCredentialsResetPasswordService) Exec(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsExistService
+func (_mock *MockCredentialsExistService) Exec(ctx context.Context, request *core.CredentialsExistRequest) (bool, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Credentials
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsExistRequest) (bool, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) *core.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsExistRequest) bool); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Credentials)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdatePasswordRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsExistRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -448,27 +448,27 @@ func (_mock *MockCredentialsResetPasswordService) Exec(ctx context.Context, requ
 	return r0, r1
 }
 
-// MockCredentialsResetPasswordService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsResetPasswordService_Exec_Call struct {
+// MockCredentialsExistService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExistService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsUpdatePasswordRequest
-func (_e *MockCredentialsResetPasswordService_Expecter) Exec(ctx any, request any) *MockCredentialsResetPasswordService_Exec_Call {
-	return &MockCredentialsResetPasswordService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsExistRequest
+func (_e *MockCredentialsExistService_Expecter) Exec(ctx any, request any) *MockCredentialsExistService_Exec_Call {
+	return &MockCredentialsExistService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsResetPasswordService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest)) *MockCredentialsResetPasswordService_Exec_Call {
+func (_c *MockCredentialsExistService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsExistRequest)) *MockCredentialsExistService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsUpdatePasswordRequest
+		var arg1 *core.CredentialsExistRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsUpdatePasswordRequest)
+			arg1 = args[1].(*core.CredentialsExistRequest)
 		}
 		run(
 			arg0,
@@ -478,23 +478,23 @@ func (_c *MockCredentialsResetPasswordService_Exec_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockCredentialsResetPasswordService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsResetPasswordService_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsExistService_Exec_Call) Return(b bool, err error) *MockCredentialsExistService_Exec_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockCredentialsResetPasswordService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)) *MockCredentialsResetPasswordService_Exec_Call {
+func (_c *MockCredentialsExistService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsExistRequest) (bool, error)) *MockCredentialsExistService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdateEmailService creates a new instance of MockCredentialsUpdateEmailService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsExportService creates a new instance of MockCredentialsExportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdateEmailService(t interface {
+func NewMockCredentialsExportService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdateEmailService {
-	mock := &MockCredentialsUpdateEmailService{}
+}) *MockCredentialsExportService {
+	mock := &MockCredentialsExportService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -502,40 +502,40 @@ func NewMockCredentialsUpdateEmailService(t interface {
 	return mock
 }
 
-// MockCredentialsUpdateEmailService is an autogenerated mock type for the CredentialsUpdateEmailService type
-type MockCredentialsUpdateEmailService struct {
+// MockCredentialsExportService is an autogenerated mock type for the CredentialsExportService type
+type MockCredentialsExportService struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdateEmailService_Expecter struct {
+type MockCredentialsExportService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdateEmailService) EXPECT() *MockCredentialsUpdateEmailService_Expecter {
-	return &MockCredentialsUpdateEmailService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsExportService) EXPECT() *MockCredentialsExportService_Expecter {
+	return &MockCredentialsExportService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdateEmailService
-func (_mock *MockCredentialsUpdateEmailService) Exec(ctx context.Context, request *core.CredentialsUpdateEmailRequest) (*core.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsExportService
+func (_mock *MockCredentialsExportService) Exec(ctx context.Context, request *core.CredentialsExportRequest) (*core.PersonalData, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Credentials
+	var r0 *core.PersonalData
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateEmailRequest) (*core.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsExportRequest) (*core.PersonalData, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateEmailRequest) *core.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsExportRequest) *core.PersonalData); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Credentials)
+			r0 = ret.Get(0).(*core.PersonalData)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdateEmailRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsExportRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -543,27 +543,27 @@ func (_mock *MockCredentialsUpdateEmailService) Exec(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockCredentialsUpdateEmailService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdateEmailService_Exec_Call struct {
+// MockCredentialsExportService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsExportService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsUpdateEmailRequest
-func (_e *MockCredentialsUpdateEmailService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailService_Exec_Call {
-	return &MockCredentialsUpdateEmailService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsExportRequest
+func (_e *MockCredentialsExportService_Expecter) Exec(ctx any, request any) *MockCredentialsExportService_Exec_Call {
+	return &MockCredentialsExportService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdateEmailService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdateEmailRequest)) *MockCredentialsUpdateEmailService_Exec_Call {
+func (_c *MockCredentialsExportService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsExportRequest)) *MockCredentialsExportService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsUpdateEmailRequest
+		var arg1 *core.CredentialsExportRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsUpdateEmailRequest)
+			arg1 = args[1].(*core.CredentialsExportRequest)
 		}
 		run(
 			arg0,
@@ -573,23 +573,23 @@ func (_c *MockCredentialsUpdateEmailService_Exec_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockCredentialsUpdateEmailService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdateEmailService_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsExportService_Exec_Call) Return(personalData *core.PersonalData, err error) *MockCredentialsExportService_Exec_Call {
+	_c.Call.Return(personalData, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdateEmailService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdateEmailRequest) (*core.Credentials, error)) *MockCredentialsUpdateEmailService_Exec_Call {
+func (_c *MockCredentialsExportService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsExportRequest) (*core.PersonalData, error)) *MockCredentialsExportService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdatePasswordService creates a new instance of MockCredentialsUpdatePasswordService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsGetService creates a new instance of MockCredentialsGetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdatePasswordService(t interface {
+func NewMockCredentialsGetService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdatePasswordService {
-	mock := &MockCredentialsUpdatePasswordService{}
+}) *MockCredentialsGetService {
+	mock := &MockCredentialsGetService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -597,21 +597,21 @@ func NewMockCredentialsUpdatePasswordService(t interface {
 	return mock
 }
 
-// MockCredentialsUpdatePasswordService is an autogenerated mock type for the CredentialsUpdatePasswordService type
-type MockCredentialsUpdatePasswordService struct {
+// MockCredentialsGetService is an autogenerated mock type for the CredentialsGetService type
+type MockCredentialsGetService struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdatePasswordService_Expecter struct {
+type MockCredentialsGetService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdatePasswordService) EXPECT() *MockCredentialsUpdatePasswordService_Expecter {
-	return &MockCredentialsUpdatePasswordService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsGetService) EXPECT() *MockCredentialsGetService_Expecter {
+	return &MockCredentialsGetService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdatePasswordService
-func (_mock *MockCredentialsUpdatePasswordService) Exec(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsGetService
+func (_mock *MockCredentialsGetService) Exec(ctx context.Context, request *core.CredentialsGetRequest) (*core.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -620,17 +620,17 @@ func (_mock *MockCredentialsUpdatePasswordService) Exec(ctx context.Context, req
 
 	var r0 *core.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGetRequest) (*core.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) *core.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGetRequest) *core.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*core.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdatePasswordRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsGetRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -638,27 +638,27 @@ func (_mock *MockCredentialsUpdatePasswordService) Exec(ctx context.Context, req
 	return r0, r1
 }
 
-// MockCredentialsUpdatePasswordService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdatePasswordService_Exec_Call struct {
+// MockCredentialsGetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGetService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsUpdatePasswordRequest
-func (_e *MockCredentialsUpdatePasswordService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordService_Exec_Call {
-	return &MockCredentialsUpdatePasswordService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsGetRequest
+func (_e *MockCredentialsGetService_Expecter) Exec(ctx any, request any) *MockCredentialsGetService_Exec_Call {
+	return &MockCredentialsGetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdatePasswordService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest)) *MockCredentialsUpdatePasswordService_Exec_Call {
+func (_c *MockCredentialsGetService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsGetRequest)) *MockCredentialsGetService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsUpdatePasswordRequest
+		var arg1 *core.CredentialsGetRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsUpdatePasswordRequest)
+			arg1 = args[1].(*core.CredentialsGetRequest)
 		}
 		run(
 			arg0,
@@ -668,23 +668,23 @@ func (_c *MockCredentialsUpdatePasswordService_Exec_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdatePasswordService_Exec_Call {
+func (_c *MockCredentialsGetService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsGetService_Exec_Call {
 	_c.Call.Return(credentials, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdatePasswordService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)) *MockCredentialsUpdatePasswordService_Exec_Call {
+func (_c *MockCredentialsGetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsGetRequest) (*core.Credentials, error)) *MockCredentialsGetService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCredentialsUpdateRoleService creates a new instance of MockCredentialsUpdateRoleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsGetBatchService creates a new instance of MockCredentialsGetBatchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCredentialsUpdateRoleService(t interface {
+func NewMockCredentialsGetBatchService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCredentialsUpdateRoleService {
-	mock := &MockCredentialsUpdateRoleService{}
+}) *MockCredentialsGetBatchService {
+	mock := &MockCredentialsGetBatchService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -692,40 +692,40 @@ func NewMockCredentialsUpdateRoleService(t interface {
 	return mock
 }
 
-// MockCredentialsUpdateRoleService is an autogenerated mock type for the CredentialsUpdateRoleService type
-type MockCredentialsUpdateRoleService struct {
+// MockCredentialsGetBatchService is an autogenerated mock type for the CredentialsGetBatchService type
+type MockCredentialsGetBatchService struct {
 	mock.Mock
 }
 
-type MockCredentialsUpdateRoleService_Expecter struct {
+type MockCredentialsGetBatchService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCredentialsUpdateRoleService) EXPECT() *MockCredentialsUpdateRoleService_Expecter {
-	return &MockCredentialsUpdateRoleService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsGetBatchService) EXPECT() *MockCredentialsGetBatchService_Expecter {
+	return &MockCredentialsGetBatchService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockCredentialsUpdateRoleService
-func (_mock *MockCredentialsUpdateRoleService) Exec(ctx context.Context, request *core.CredentialsUpdateRoleRequest) (*core.Credentials, error) {
+// Exec provides a mock function for the type MockCredentialsGetBatchService
+func (_mock *MockCredentialsGetBatchService) Exec(ctx context.Context, request *core.CredentialsGetBatchRequest) (*core.CredentialsBatch, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.Credentials
+	var r0 *core.CredentialsBatch
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateRoleRequest) (*core.Credentials, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGetBatchRequest) (*core.CredentialsBatch, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateRoleRequest) *core.Credentials); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGetBatchRequest) *core.CredentialsBatch); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.Credentials)
+			r0 = ret.Get(0).(*core.CredentialsBatch)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdateRoleRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsGetBatchRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -733,27 +733,27 @@ func (_mock *MockCredentialsUpdateRoleService) Exec(ctx context.Context, request
 	return r0, r1
 }
 
-// MockCredentialsUpdateRoleService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockCredentialsUpdateRoleService_Exec_Call struct {
+// MockCredentialsGetBatchService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGetBatchService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.CredentialsUpdateRoleRequest
-func (_e *MockCredentialsUpdateRoleService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleService_Exec_Call {
-	return &MockCredentialsUpdateRoleService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.CredentialsGetBatchRequest
+func (_e *MockCredentialsGetBatchService_Expecter) Exec(ctx any, request any) *MockCredentialsGetBatchService_Exec_Call {
+	return &MockCredentialsGetBatchService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockCredentialsUpdateRoleService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdateRoleRequest)) *MockCredentialsUpdateRoleService_Exec_Call {
+func (_c *MockCredentialsGetBatchService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsGetBatchRequest)) *MockCredentialsGetBatchService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.CredentialsUpdateRoleRequest
+		var arg1 *core.CredentialsGetBatchRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.CredentialsUpdateRoleRequest)
+			arg1 = args[1].(*core.CredentialsGetBatchRequest)
 		}
 		run(
 			arg0,
@@ -763,23 +763,23 @@ func (_c *MockCredentialsUpdateRoleService_Exec_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockCredentialsUpdateRoleService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdateRoleService_Exec_Call {
-	_c.Call.Return(credentials, err)
+func (_c *MockCredentialsGetBatchService_Exec_Call) Return(credentialsBatch *core.CredentialsBatch, err error) *MockCredentialsGetBatchService_Exec_Call {
+	_c.Call.Return(credentialsBatch, err)
 	return _c
 }
 
-func (_c *MockCredentialsUpdateRoleService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdateRoleRequest) (*core.Credentials, error)) *MockCredentialsUpdateRoleService_Exec_Call {
+func (_c *MockCredentialsGetBatchService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsGetBatchRequest) (*core.CredentialsBatch, error)) *MockCredentialsGetBatchService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRestHealthClientSmtp creates a new instance of MockRestHealthClientSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsGrantRoleService creates a new instance of MockCredentialsGrantRoleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRestHealthClientSmtp(t interface {
+func NewMockCredentialsGrantRoleService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRestHealthClientSmtp {
-	mock := &MockRestHealthClientSmtp{}
+}) *MockCredentialsGrantRoleService {
+	mock := &MockCredentialsGrantRoleService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -787,139 +787,94 @@ func NewMockRestHealthClientSmtp(t interface {
 	return mock
 }
 
-// MockRestHealthClientSmtp is an autogenerated mock type for the RestHealthClientSmtp type
-type MockRestHealthClientSmtp struct {
+// MockCredentialsGrantRoleService is an autogenerated mock type for the CredentialsGrantRoleService type
+type MockCredentialsGrantRoleService struct {
 	mock.Mock
 }
 
-type MockRestHealthClientSmtp_Expecter struct {
+type MockCredentialsGrantRoleService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRestHealthClientSmtp) EXPECT() *MockRestHealthClientSmtp_Expecter {
-	return &MockRestHealthClientSmtp_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsGrantRoleService) EXPECT() *MockCredentialsGrantRoleService_Expecter {
+	return &MockCredentialsGrantRoleService_Expecter{mock: &_m.Mock}
 }
 
-// Ping provides a mock function for the type MockRestHealthClientSmtp
-func (_mock *MockRestHealthClientSmtp) Ping() error {
-	ret := _mock.Called()
+// Exec provides a mock function for the type MockCredentialsGrantRoleService
+func (_mock *MockCredentialsGrantRoleService) Exec(ctx context.Context, request *core.CredentialsGrantRoleRequest) (*core.RoleGrant, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Ping")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Error(0)
+	var r0 *core.RoleGrant
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGrantRoleRequest) (*core.RoleGrant, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	return r0
-}
-
-// MockRestHealthClientSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
-type MockRestHealthClientSmtp_Ping_Call struct {
-	*mock.Call
-}
-
-// Ping is a helper method to define mock.On call
-func (_e *MockRestHealthClientSmtp_Expecter) Ping() *MockRestHealthClientSmtp_Ping_Call {
-	return &MockRestHealthClientSmtp_Ping_Call{Call: _e.mock.On("Ping")}
-}
-
-func (_c *MockRestHealthClientSmtp_Ping_Call) Run(run func()) *MockRestHealthClientSmtp_Ping_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *MockRestHealthClientSmtp_Ping_Call) Return(err error) *MockRestHealthClientSmtp_Ping_Call {
-	_c.Call.Return(err)
-	return _c
-}
-
-func (_c *MockRestHealthClientSmtp_Ping_Call) RunAndReturn(run func() error) *MockRestHealthClientSmtp_Ping_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// SendMail provides a mock function for the type MockRestHealthClientSmtp
-func (_mock *MockRestHealthClientSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
-	ret := _mock.Called(to, t, tName, data)
-
-	if len(ret) == 0 {
-		panic("no return value specified for SendMail")
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsGrantRoleRequest) *core.RoleGrant); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.RoleGrant)
+		}
 	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
-		r0 = returnFunc(to, t, tName, data)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsGrantRoleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
-// MockRestHealthClientSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
-type MockRestHealthClientSmtp_SendMail_Call struct {
+// MockCredentialsGrantRoleService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsGrantRoleService_Exec_Call struct {
 	*mock.Call
 }
 
-// SendMail is a helper method to define mock.On call
-//   - to smtp.MailUsers
-//   - t *template.Template
-//   - tName string
-//   - data any
-func (_e *MockRestHealthClientSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockRestHealthClientSmtp_SendMail_Call {
-	return &MockRestHealthClientSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsGrantRoleRequest
+func (_e *MockCredentialsGrantRoleService_Expecter) Exec(ctx any, request any) *MockCredentialsGrantRoleService_Exec_Call {
+	return &MockCredentialsGrantRoleService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockRestHealthClientSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockRestHealthClientSmtp_SendMail_Call {
+func (_c *MockCredentialsGrantRoleService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsGrantRoleRequest)) *MockCredentialsGrantRoleService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 smtp.MailUsers
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(smtp.MailUsers)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 *template.Template
+		var arg1 *core.CredentialsGrantRoleRequest
 		if args[1] != nil {
-			arg1 = args[1].(*template.Template)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 any
-		if args[3] != nil {
-			arg3 = args[3].(any)
+			arg1 = args[1].(*core.CredentialsGrantRoleRequest)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRestHealthClientSmtp_SendMail_Call) Return(err error) *MockRestHealthClientSmtp_SendMail_Call {
-	_c.Call.Return(err)
+func (_c *MockCredentialsGrantRoleService_Exec_Call) Return(roleGrant *core.RoleGrant, err error) *MockCredentialsGrantRoleService_Exec_Call {
+	_c.Call.Return(roleGrant, err)
 	return _c
 }
 
-func (_c *MockRestHealthClientSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockRestHealthClientSmtp_SendMail_Call {
+func (_c *MockCredentialsGrantRoleService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsGrantRoleRequest) (*core.RoleGrant, error)) *MockCredentialsGrantRoleService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRestHealthApiJsonKeys creates a new instance of MockRestHealthApiJsonKeys. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsListService creates a new instance of MockCredentialsListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRestHealthApiJsonKeys(t interface {
+func NewMockCredentialsListService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRestHealthApiJsonKeys {
-	mock := &MockRestHealthApiJsonKeys{}
+}) *MockCredentialsListService {
+	mock := &MockCredentialsListService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -927,109 +882,94 @@ func NewMockRestHealthApiJsonKeys(t interface {
 	return mock
 }
 
-// MockRestHealthApiJsonKeys is an autogenerated mock type for the RestHealthApiJsonKeys type
-type MockRestHealthApiJsonKeys struct {
+// MockCredentialsListService is an autogenerated mock type for the CredentialsListService type
+type MockCredentialsListService struct {
 	mock.Mock
 }
 
-type MockRestHealthApiJsonKeys_Expecter struct {
+type MockCredentialsListService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRestHealthApiJsonKeys) EXPECT() *MockRestHealthApiJsonKeys_Expecter {
-	return &MockRestHealthApiJsonKeys_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsListService) EXPECT() *MockCredentialsListService_Expecter {
+	return &MockCredentialsListService_Expecter{mock: &_m.Mock}
 }
 
-// Status provides a mock function for the type MockRestHealthApiJsonKeys
-func (_mock *MockRestHealthApiJsonKeys) Status(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error) {
-	var tmpRet mock.Arguments
-	if len(opts) > 0 {
-		tmpRet = _mock.Called(ctx, req, opts)
-	} else {
-		tmpRet = _mock.Called(ctx, req)
-	}
-	ret := tmpRet
+// Exec provides a mock function for the type MockCredentialsListService
+func (_mock *MockCredentialsListService) Exec(ctx context.Context, request *core.CredentialsListRequest) (*core.CredentialsListPage, error) {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Status")
+		panic("no return value specified for Exec")
 	}
 
-	var r0 *servicejsonkeys.StatusResponse
+	var r0 *core.CredentialsListPage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)); ok {
-		return returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsListRequest) (*core.CredentialsListPage, error)); ok {
+		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) *servicejsonkeys.StatusResponse); ok {
-		r0 = returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsListRequest) *core.CredentialsListPage); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*servicejsonkeys.StatusResponse)
+			r0 = ret.Get(0).(*core.CredentialsListPage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) error); ok {
-		r1 = returnFunc(ctx, req, opts...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRestHealthApiJsonKeys_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
-type MockRestHealthApiJsonKeys_Status_Call struct {
+// MockCredentialsListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsListService_Exec_Call struct {
 	*mock.Call
 }
 
-// Status is a helper method to define mock.On call
+// Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *servicejsonkeys.StatusRequest
-//   - opts ...grpc.CallOption
-func (_e *MockRestHealthApiJsonKeys_Expecter) Status(ctx any, req any, opts ...any) *MockRestHealthApiJsonKeys_Status_Call {
-	return &MockRestHealthApiJsonKeys_Status_Call{Call: _e.mock.On("Status",
-		append([]any{ctx, req}, opts...)...)}
+//   - request *core.CredentialsListRequest
+func (_e *MockCredentialsListService_Expecter) Exec(ctx any, request any) *MockCredentialsListService_Exec_Call {
+	return &MockCredentialsListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockRestHealthApiJsonKeys_Status_Call) Run(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption)) *MockRestHealthApiJsonKeys_Status_Call {
+func (_c *MockCredentialsListService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsListRequest)) *MockCredentialsListService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *servicejsonkeys.StatusRequest
+		var arg1 *core.CredentialsListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*servicejsonkeys.StatusRequest)
-		}
-		var arg2 []grpc.CallOption
-		var variadicArgs []grpc.CallOption
-		if len(args) > 2 {
-			variadicArgs = args[2].([]grpc.CallOption)
+			arg1 = args[1].(*core.CredentialsListRequest)
 		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRestHealthApiJsonKeys_Status_Call) Return(v *servicejsonkeys.StatusResponse, err error) *MockRestHealthApiJsonKeys_Status_Call {
-	_c.Call.Return(v, err)
+func (_c *MockCredentialsListService_Exec_Call) Return(credentialsListPage *core.CredentialsListPage, err error) *MockCredentialsListService_Exec_Call {
+	_c.Call.Return(credentialsListPage, err)
 	return _c
 }
 
-func (_c *MockRestHealthApiJsonKeys_Status_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)) *MockRestHealthApiJsonKeys_Status_Call {
+func (_c *MockCredentialsListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsListRequest) (*core.CredentialsListPage, error)) *MockCredentialsListService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreateEmailUpdateService creates a new instance of MockShortCodeCreateEmailUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsLoginsService creates a new instance of MockCredentialsLoginsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreateEmailUpdateService(t interface {
+func NewMockCredentialsLoginsService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreateEmailUpdateService {
-	mock := &MockShortCodeCreateEmailUpdateService{}
+}) *MockCredentialsLoginsService {
+	mock := &MockCredentialsLoginsService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1037,40 +977,40 @@ func NewMockShortCodeCreateEmailUpdateService(t interface {
 	return mock
 }
 
-// MockShortCodeCreateEmailUpdateService is an autogenerated mock type for the ShortCodeCreateEmailUpdateService type
-type MockShortCodeCreateEmailUpdateService struct {
+// MockCredentialsLoginsService is an autogenerated mock type for the CredentialsLoginsService type
+type MockCredentialsLoginsService struct {
 	mock.Mock
 }
 
-type MockShortCodeCreateEmailUpdateService_Expecter struct {
+type MockCredentialsLoginsService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreateEmailUpdateService) EXPECT() *MockShortCodeCreateEmailUpdateService_Expecter {
-	return &MockShortCodeCreateEmailUpdateService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsLoginsService) EXPECT() *MockCredentialsLoginsService_Expecter {
+	return &MockCredentialsLoginsService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateService
-func (_mock *MockShortCodeCreateEmailUpdateService) Exec(ctx context.Context, request *core.ShortCodeCreateEmailUpdateRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsLoginsService
+func (_mock *MockCredentialsLoginsService) Exec(ctx context.Context, request *core.LoginEventListRequest) ([]*core.LoginEvent, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 []*core.LoginEvent
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateEmailUpdateRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.LoginEventListRequest) ([]*core.LoginEvent, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateEmailUpdateRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.LoginEventListRequest) []*core.LoginEvent); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).([]*core.LoginEvent)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateEmailUpdateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.LoginEventListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1078,27 +1018,27 @@ func (_mock *MockShortCodeCreateEmailUpdateService) Exec(ctx context.Context, re
 	return r0, r1
 }
 
-// MockShortCodeCreateEmailUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreateEmailUpdateService_Exec_Call struct {
+// MockCredentialsLoginsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsLoginsService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *core.ShortCodeCreateEmailUpdateRequest
-func (_e *MockShortCodeCreateEmailUpdateService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateService_Exec_Call {
-	return &MockShortCodeCreateEmailUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *core.LoginEventListRequest
+func (_e *MockCredentialsLoginsService_Expecter) Exec(ctx any, request any) *MockCredentialsLoginsService_Exec_Call {
+	return &MockCredentialsLoginsService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateEmailUpdateRequest)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+func (_c *MockCredentialsLoginsService_Exec_Call) Run(run func(ctx context.Context, request *core.LoginEventListRequest)) *MockCredentialsLoginsService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *core.ShortCodeCreateEmailUpdateRequest
+		var arg1 *core.LoginEventListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*core.ShortCodeCreateEmailUpdateRequest)
+			arg1 = args[1].(*core.LoginEventListRequest)
 		}
 		run(
 			arg0,
@@ -1108,23 +1048,23 @@ func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Run(run func(ctx cont
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateEmailUpdateService_Exec_Call {
-	_c.Call.Return(shortCode, err)
+func (_c *MockCredentialsLoginsService_Exec_Call) Return(loginEvents []*core.LoginEvent, err error) *MockCredentialsLoginsService_Exec_Call {
+	_c.Call.Return(loginEvents, err)
 	return _c
 }
 
-func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateEmailUpdateRequest) (*core.ShortCode, error)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+func (_c *MockCredentialsLoginsService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.LoginEventListRequest) ([]*core.LoginEvent, error)) *MockCredentialsLoginsService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockShortCodeCreatePasswordResetService creates a new instance of MockShortCodeCreatePasswordResetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCredentialsMergeService creates a new instance of MockCredentialsMergeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockShortCodeCreatePasswordResetService(t interface {
+func NewMockCredentialsMergeService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockShortCodeCreatePasswordResetService {
-	mock := &MockShortCodeCreatePasswordResetService{}
+}) *MockCredentialsMergeService {
+	mock := &MockCredentialsMergeService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1132,40 +1072,40 @@ func NewMockShortCodeCreatePasswordResetService(t interface {
 	return mock
 }
 
-// MockShortCodeCreatePasswordResetService is an autogenerated mock type for the ShortCodeCreatePasswordResetService type
-type MockShortCodeCreatePasswordResetService struct {
+// MockCredentialsMergeService is an autogenerated mock type for the CredentialsMergeService type
+type MockCredentialsMergeService struct {
 	mock.Mock
 }
 
-type MockShortCodeCreatePasswordResetService_Expecter struct {
+type MockCredentialsMergeService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockShortCodeCreatePasswordResetService) EXPECT() *MockShortCodeCreatePasswordResetService_Expecter {
-	return &MockShortCodeCreatePasswordResetService_Expecter{mock: &_m.Mock}
+func (_m *MockCredentialsMergeService) EXPECT() *MockCredentialsMergeService_Expecter {
+	return &MockCredentialsMergeService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockShortCodeCreatePasswordResetService
-func (_mock *MockShortCodeCreatePasswordResetService) Exec(ctx context.Context, request *core.ShortCodeCreatePasswordResetRequest) (*core.ShortCode, error) {
+// Exec provides a mock function for the type MockCredentialsMergeService
+func (_mock *MockCredentialsMergeService) Exec(ctx context.Context, request *core.CredentialsMergeRequest) (*core.Credentials, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *core.ShortCode
+	var r0 *core.Credentials
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreatePasswordResetRequest) (*core.ShortCode, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsMergeRequest) (*core.Credentials, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreatePasswordResetRequest) *core.ShortCode); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsMergeRequest) *core.Credentials); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*core.ShortCode)
+			r0 = ret.Get(0).(*core.Credentials)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreatePasswordResetRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsMergeRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1173,8 +1113,2063 @@ func (_mock *MockShortCodeCreatePasswordResetService) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockShortCodeCreatePasswordResetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockShortCodeCreatePasswordResetService_Exec_Call struct {
+// MockCredentialsMergeService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsMergeService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsMergeRequest
+func (_e *MockCredentialsMergeService_Expecter) Exec(ctx any, request any) *MockCredentialsMergeService_Exec_Call {
+	return &MockCredentialsMergeService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsMergeService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsMergeRequest)) *MockCredentialsMergeService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsMergeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsMergeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsMergeService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsMergeService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsMergeService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsMergeRequest) (*core.Credentials, error)) *MockCredentialsMergeService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsPolicyTargetService creates a new instance of MockCredentialsPolicyTargetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsPolicyTargetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsPolicyTargetService {
+	mock := &MockCredentialsPolicyTargetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsPolicyTargetService is an autogenerated mock type for the CredentialsPolicyTargetService type
+type MockCredentialsPolicyTargetService struct {
+	mock.Mock
+}
+
+type MockCredentialsPolicyTargetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsPolicyTargetService) EXPECT() *MockCredentialsPolicyTargetService_Expecter {
+	return &MockCredentialsPolicyTargetService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsPolicyTargetService
+func (_mock *MockCredentialsPolicyTargetService) Exec(ctx context.Context, request *core.CredentialsPolicyTargetGetRequest) (*core.CredentialsPolicyTarget, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.CredentialsPolicyTarget
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsPolicyTargetGetRequest) (*core.CredentialsPolicyTarget, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsPolicyTargetGetRequest) *core.CredentialsPolicyTarget); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.CredentialsPolicyTarget)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsPolicyTargetGetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsPolicyTargetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsPolicyTargetService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsPolicyTargetGetRequest
+func (_e *MockCredentialsPolicyTargetService_Expecter) Exec(ctx any, request any) *MockCredentialsPolicyTargetService_Exec_Call {
+	return &MockCredentialsPolicyTargetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsPolicyTargetService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsPolicyTargetGetRequest)) *MockCredentialsPolicyTargetService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsPolicyTargetGetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsPolicyTargetGetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsPolicyTargetService_Exec_Call) Return(credentialsPolicyTarget *core.CredentialsPolicyTarget, err error) *MockCredentialsPolicyTargetService_Exec_Call {
+	_c.Call.Return(credentialsPolicyTarget, err)
+	return _c
+}
+
+func (_c *MockCredentialsPolicyTargetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsPolicyTargetGetRequest) (*core.CredentialsPolicyTarget, error)) *MockCredentialsPolicyTargetService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsRedirectGetService creates a new instance of MockCredentialsRedirectGetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsRedirectGetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsRedirectGetService {
+	mock := &MockCredentialsRedirectGetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsRedirectGetService is an autogenerated mock type for the CredentialsRedirectGetService type
+type MockCredentialsRedirectGetService struct {
+	mock.Mock
+}
+
+type MockCredentialsRedirectGetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsRedirectGetService) EXPECT() *MockCredentialsRedirectGetService_Expecter {
+	return &MockCredentialsRedirectGetService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsRedirectGetService
+func (_mock *MockCredentialsRedirectGetService) Exec(ctx context.Context, request *core.CredentialsRedirectGetRequest) (*core.CredentialsRedirect, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.CredentialsRedirect
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsRedirectGetRequest) (*core.CredentialsRedirect, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsRedirectGetRequest) *core.CredentialsRedirect); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.CredentialsRedirect)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsRedirectGetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsRedirectGetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsRedirectGetService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsRedirectGetRequest
+func (_e *MockCredentialsRedirectGetService_Expecter) Exec(ctx any, request any) *MockCredentialsRedirectGetService_Exec_Call {
+	return &MockCredentialsRedirectGetService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsRedirectGetService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsRedirectGetRequest)) *MockCredentialsRedirectGetService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsRedirectGetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsRedirectGetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsRedirectGetService_Exec_Call) Return(credentialsRedirect *core.CredentialsRedirect, err error) *MockCredentialsRedirectGetService_Exec_Call {
+	_c.Call.Return(credentialsRedirect, err)
+	return _c
+}
+
+func (_c *MockCredentialsRedirectGetService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsRedirectGetRequest) (*core.CredentialsRedirect, error)) *MockCredentialsRedirectGetService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsResetPasswordService creates a new instance of MockCredentialsResetPasswordService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsResetPasswordService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsResetPasswordService {
+	mock := &MockCredentialsResetPasswordService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsResetPasswordService is an autogenerated mock type for the CredentialsResetPasswordService type
+type MockCredentialsResetPasswordService struct {
+	mock.Mock
+}
+
+type MockCredentialsResetPasswordService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsResetPasswordService) EXPECT() *MockCredentialsResetPasswordService_Expecter {
+	return &MockCredentialsResetPasswordService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsResetPasswordService
+func (_mock *MockCredentialsResetPasswordService) Exec(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdatePasswordRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsResetPasswordService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsResetPasswordService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsUpdatePasswordRequest
+func (_e *MockCredentialsResetPasswordService_Expecter) Exec(ctx any, request any) *MockCredentialsResetPasswordService_Exec_Call {
+	return &MockCredentialsResetPasswordService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsResetPasswordService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest)) *MockCredentialsResetPasswordService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsUpdatePasswordRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsUpdatePasswordRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsResetPasswordService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsResetPasswordService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsResetPasswordService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)) *MockCredentialsResetPasswordService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateEmailService creates a new instance of MockCredentialsUpdateEmailService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateEmailService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateEmailService {
+	mock := &MockCredentialsUpdateEmailService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateEmailService is an autogenerated mock type for the CredentialsUpdateEmailService type
+type MockCredentialsUpdateEmailService struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateEmailService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateEmailService) EXPECT() *MockCredentialsUpdateEmailService_Expecter {
+	return &MockCredentialsUpdateEmailService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateEmailService
+func (_mock *MockCredentialsUpdateEmailService) Exec(ctx context.Context, request *core.CredentialsUpdateEmailRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateEmailRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateEmailRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdateEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateEmailService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateEmailService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsUpdateEmailRequest
+func (_e *MockCredentialsUpdateEmailService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateEmailService_Exec_Call {
+	return &MockCredentialsUpdateEmailService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateEmailService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdateEmailRequest)) *MockCredentialsUpdateEmailService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsUpdateEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsUpdateEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdateEmailService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateEmailService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdateEmailRequest) (*core.Credentials, error)) *MockCredentialsUpdateEmailService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateLocaleService creates a new instance of MockCredentialsUpdateLocaleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateLocaleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateLocaleService {
+	mock := &MockCredentialsUpdateLocaleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateLocaleService is an autogenerated mock type for the CredentialsUpdateLocaleService type
+type MockCredentialsUpdateLocaleService struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateLocaleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateLocaleService) EXPECT() *MockCredentialsUpdateLocaleService_Expecter {
+	return &MockCredentialsUpdateLocaleService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateLocaleService
+func (_mock *MockCredentialsUpdateLocaleService) Exec(ctx context.Context, request *core.CredentialsUpdateLocaleRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateLocaleRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateLocaleRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdateLocaleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateLocaleService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateLocaleService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsUpdateLocaleRequest
+func (_e *MockCredentialsUpdateLocaleService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateLocaleService_Exec_Call {
+	return &MockCredentialsUpdateLocaleService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateLocaleService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdateLocaleRequest)) *MockCredentialsUpdateLocaleService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsUpdateLocaleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsUpdateLocaleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateLocaleService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdateLocaleService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateLocaleService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdateLocaleRequest) (*core.Credentials, error)) *MockCredentialsUpdateLocaleService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateNoticesService creates a new instance of MockCredentialsUpdateNoticesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateNoticesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateNoticesService {
+	mock := &MockCredentialsUpdateNoticesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateNoticesService is an autogenerated mock type for the CredentialsUpdateNoticesService type
+type MockCredentialsUpdateNoticesService struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateNoticesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateNoticesService) EXPECT() *MockCredentialsUpdateNoticesService_Expecter {
+	return &MockCredentialsUpdateNoticesService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateNoticesService
+func (_mock *MockCredentialsUpdateNoticesService) Exec(ctx context.Context, request *core.CredentialsUpdateNoticesRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateNoticesRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateNoticesRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdateNoticesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateNoticesService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateNoticesService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsUpdateNoticesRequest
+func (_e *MockCredentialsUpdateNoticesService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateNoticesService_Exec_Call {
+	return &MockCredentialsUpdateNoticesService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateNoticesService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdateNoticesRequest)) *MockCredentialsUpdateNoticesService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsUpdateNoticesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsUpdateNoticesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateNoticesService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdateNoticesService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateNoticesService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdateNoticesRequest) (*core.Credentials, error)) *MockCredentialsUpdateNoticesService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdatePasswordService creates a new instance of MockCredentialsUpdatePasswordService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdatePasswordService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdatePasswordService {
+	mock := &MockCredentialsUpdatePasswordService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdatePasswordService is an autogenerated mock type for the CredentialsUpdatePasswordService type
+type MockCredentialsUpdatePasswordService struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdatePasswordService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdatePasswordService) EXPECT() *MockCredentialsUpdatePasswordService_Expecter {
+	return &MockCredentialsUpdatePasswordService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdatePasswordService
+func (_mock *MockCredentialsUpdatePasswordService) Exec(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdatePasswordRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdatePasswordRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdatePasswordService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdatePasswordService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsUpdatePasswordRequest
+func (_e *MockCredentialsUpdatePasswordService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdatePasswordService_Exec_Call {
+	return &MockCredentialsUpdatePasswordService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdatePasswordService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest)) *MockCredentialsUpdatePasswordService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsUpdatePasswordRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsUpdatePasswordRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdatePasswordService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdatePasswordService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdatePasswordRequest) (*core.Credentials, error)) *MockCredentialsUpdatePasswordService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsUpdateRoleService creates a new instance of MockCredentialsUpdateRoleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsUpdateRoleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsUpdateRoleService {
+	mock := &MockCredentialsUpdateRoleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsUpdateRoleService is an autogenerated mock type for the CredentialsUpdateRoleService type
+type MockCredentialsUpdateRoleService struct {
+	mock.Mock
+}
+
+type MockCredentialsUpdateRoleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsUpdateRoleService) EXPECT() *MockCredentialsUpdateRoleService_Expecter {
+	return &MockCredentialsUpdateRoleService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsUpdateRoleService
+func (_mock *MockCredentialsUpdateRoleService) Exec(ctx context.Context, request *core.CredentialsUpdateRoleRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateRoleRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsUpdateRoleRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsUpdateRoleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsUpdateRoleService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsUpdateRoleService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsUpdateRoleRequest
+func (_e *MockCredentialsUpdateRoleService_Expecter) Exec(ctx any, request any) *MockCredentialsUpdateRoleService_Exec_Call {
+	return &MockCredentialsUpdateRoleService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsUpdateRoleService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsUpdateRoleRequest)) *MockCredentialsUpdateRoleService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsUpdateRoleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsUpdateRoleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsUpdateRoleService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsUpdateRoleService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsUpdateRoleRequest) (*core.Credentials, error)) *MockCredentialsUpdateRoleService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCredentialsVerifyEmailService creates a new instance of MockCredentialsVerifyEmailService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialsVerifyEmailService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialsVerifyEmailService {
+	mock := &MockCredentialsVerifyEmailService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialsVerifyEmailService is an autogenerated mock type for the CredentialsVerifyEmailService type
+type MockCredentialsVerifyEmailService struct {
+	mock.Mock
+}
+
+type MockCredentialsVerifyEmailService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialsVerifyEmailService) EXPECT() *MockCredentialsVerifyEmailService_Expecter {
+	return &MockCredentialsVerifyEmailService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockCredentialsVerifyEmailService
+func (_mock *MockCredentialsVerifyEmailService) Exec(ctx context.Context, request *core.CredentialsVerifyEmailRequest) (*core.Credentials, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Credentials
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsVerifyEmailRequest) (*core.Credentials, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.CredentialsVerifyEmailRequest) *core.Credentials); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Credentials)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.CredentialsVerifyEmailRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialsVerifyEmailService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockCredentialsVerifyEmailService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.CredentialsVerifyEmailRequest
+func (_e *MockCredentialsVerifyEmailService_Expecter) Exec(ctx any, request any) *MockCredentialsVerifyEmailService_Exec_Call {
+	return &MockCredentialsVerifyEmailService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockCredentialsVerifyEmailService_Exec_Call) Run(run func(ctx context.Context, request *core.CredentialsVerifyEmailRequest)) *MockCredentialsVerifyEmailService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.CredentialsVerifyEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.CredentialsVerifyEmailRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailService_Exec_Call) Return(credentials *core.Credentials, err error) *MockCredentialsVerifyEmailService_Exec_Call {
+	_c.Call.Return(credentials, err)
+	return _c
+}
+
+func (_c *MockCredentialsVerifyEmailService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.CredentialsVerifyEmailRequest) (*core.Credentials, error)) *MockCredentialsVerifyEmailService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestHealthClientSmtp creates a new instance of MockRestHealthClientSmtp. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestHealthClientSmtp(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestHealthClientSmtp {
+	mock := &MockRestHealthClientSmtp{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestHealthClientSmtp is an autogenerated mock type for the RestHealthClientSmtp type
+type MockRestHealthClientSmtp struct {
+	mock.Mock
+}
+
+type MockRestHealthClientSmtp_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestHealthClientSmtp) EXPECT() *MockRestHealthClientSmtp_Expecter {
+	return &MockRestHealthClientSmtp_Expecter{mock: &_m.Mock}
+}
+
+// Ping provides a mock function for the type MockRestHealthClientSmtp
+func (_mock *MockRestHealthClientSmtp) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRestHealthClientSmtp_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockRestHealthClientSmtp_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+func (_e *MockRestHealthClientSmtp_Expecter) Ping() *MockRestHealthClientSmtp_Ping_Call {
+	return &MockRestHealthClientSmtp_Ping_Call{Call: _e.mock.On("Ping")}
+}
+
+func (_c *MockRestHealthClientSmtp_Ping_Call) Run(run func()) *MockRestHealthClientSmtp_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRestHealthClientSmtp_Ping_Call) Return(err error) *MockRestHealthClientSmtp_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRestHealthClientSmtp_Ping_Call) RunAndReturn(run func() error) *MockRestHealthClientSmtp_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMail provides a mock function for the type MockRestHealthClientSmtp
+func (_mock *MockRestHealthClientSmtp) SendMail(to smtp.MailUsers, t *template.Template, tName string, data any) error {
+	ret := _mock.Called(to, t, tName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(smtp.MailUsers, *template.Template, string, any) error); ok {
+		r0 = returnFunc(to, t, tName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRestHealthClientSmtp_SendMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMail'
+type MockRestHealthClientSmtp_SendMail_Call struct {
+	*mock.Call
+}
+
+// SendMail is a helper method to define mock.On call
+//   - to smtp.MailUsers
+//   - t *template.Template
+//   - tName string
+//   - data any
+func (_e *MockRestHealthClientSmtp_Expecter) SendMail(to any, t any, tName any, data any) *MockRestHealthClientSmtp_SendMail_Call {
+	return &MockRestHealthClientSmtp_SendMail_Call{Call: _e.mock.On("SendMail", to, t, tName, data)}
+}
+
+func (_c *MockRestHealthClientSmtp_SendMail_Call) Run(run func(to smtp.MailUsers, t *template.Template, tName string, data any)) *MockRestHealthClientSmtp_SendMail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 smtp.MailUsers
+		if args[0] != nil {
+			arg0 = args[0].(smtp.MailUsers)
+		}
+		var arg1 *template.Template
+		if args[1] != nil {
+			arg1 = args[1].(*template.Template)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestHealthClientSmtp_SendMail_Call) Return(err error) *MockRestHealthClientSmtp_SendMail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRestHealthClientSmtp_SendMail_Call) RunAndReturn(run func(to smtp.MailUsers, t *template.Template, tName string, data any) error) *MockRestHealthClientSmtp_SendMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRestHealthApiJsonKeys creates a new instance of MockRestHealthApiJsonKeys. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRestHealthApiJsonKeys(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRestHealthApiJsonKeys {
+	mock := &MockRestHealthApiJsonKeys{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRestHealthApiJsonKeys is an autogenerated mock type for the RestHealthApiJsonKeys type
+type MockRestHealthApiJsonKeys struct {
+	mock.Mock
+}
+
+type MockRestHealthApiJsonKeys_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRestHealthApiJsonKeys) EXPECT() *MockRestHealthApiJsonKeys_Expecter {
+	return &MockRestHealthApiJsonKeys_Expecter{mock: &_m.Mock}
+}
+
+// Status provides a mock function for the type MockRestHealthApiJsonKeys
+func (_mock *MockRestHealthApiJsonKeys) Status(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 *servicejsonkeys.StatusResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) *servicejsonkeys.StatusResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*servicejsonkeys.StatusResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *servicejsonkeys.StatusRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRestHealthApiJsonKeys_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockRestHealthApiJsonKeys_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *servicejsonkeys.StatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRestHealthApiJsonKeys_Expecter) Status(ctx any, req any, opts ...any) *MockRestHealthApiJsonKeys_Status_Call {
+	return &MockRestHealthApiJsonKeys_Status_Call{Call: _e.mock.On("Status",
+		append([]any{ctx, req}, opts...)...)}
+}
+
+func (_c *MockRestHealthApiJsonKeys_Status_Call) Run(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption)) *MockRestHealthApiJsonKeys_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *servicejsonkeys.StatusRequest
+		if args[1] != nil {
+			arg1 = args[1].(*servicejsonkeys.StatusRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRestHealthApiJsonKeys_Status_Call) Return(v *servicejsonkeys.StatusResponse, err error) *MockRestHealthApiJsonKeys_Status_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockRestHealthApiJsonKeys_Status_Call) RunAndReturn(run func(ctx context.Context, req *servicejsonkeys.StatusRequest, opts ...grpc.CallOption) (*servicejsonkeys.StatusResponse, error)) *MockRestHealthApiJsonKeys_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPermissionsCheckService creates a new instance of MockPermissionsCheckService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPermissionsCheckService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPermissionsCheckService {
+	mock := &MockPermissionsCheckService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPermissionsCheckService is an autogenerated mock type for the PermissionsCheckService type
+type MockPermissionsCheckService struct {
+	mock.Mock
+}
+
+type MockPermissionsCheckService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPermissionsCheckService) EXPECT() *MockPermissionsCheckService_Expecter {
+	return &MockPermissionsCheckService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockPermissionsCheckService
+func (_mock *MockPermissionsCheckService) Exec(ctx context.Context, request *core.PermissionsCheckRequest) (*core.PermissionsCheckResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.PermissionsCheckResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.PermissionsCheckRequest) (*core.PermissionsCheckResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.PermissionsCheckRequest) *core.PermissionsCheckResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.PermissionsCheckResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.PermissionsCheckRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPermissionsCheckService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockPermissionsCheckService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.PermissionsCheckRequest
+func (_e *MockPermissionsCheckService_Expecter) Exec(ctx any, request any) *MockPermissionsCheckService_Exec_Call {
+	return &MockPermissionsCheckService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockPermissionsCheckService_Exec_Call) Run(run func(ctx context.Context, request *core.PermissionsCheckRequest)) *MockPermissionsCheckService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.PermissionsCheckRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.PermissionsCheckRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPermissionsCheckService_Exec_Call) Return(permissionsCheckResult *core.PermissionsCheckResult, err error) *MockPermissionsCheckService_Exec_Call {
+	_c.Call.Return(permissionsCheckResult, err)
+	return _c
+}
+
+func (_c *MockPermissionsCheckService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.PermissionsCheckRequest) (*core.PermissionsCheckResult, error)) *MockPermissionsCheckService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPermissionsRolesService creates a new instance of MockPermissionsRolesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPermissionsRolesService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPermissionsRolesService {
+	mock := &MockPermissionsRolesService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPermissionsRolesService is an autogenerated mock type for the PermissionsRolesService type
+type MockPermissionsRolesService struct {
+	mock.Mock
+}
+
+type MockPermissionsRolesService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPermissionsRolesService) EXPECT() *MockPermissionsRolesService_Expecter {
+	return &MockPermissionsRolesService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockPermissionsRolesService
+func (_mock *MockPermissionsRolesService) Exec(ctx context.Context, request *core.PermissionsRolesRequest) (map[string][]string, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 map[string][]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.PermissionsRolesRequest) (map[string][]string, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.PermissionsRolesRequest) map[string][]string); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.PermissionsRolesRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPermissionsRolesService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockPermissionsRolesService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.PermissionsRolesRequest
+func (_e *MockPermissionsRolesService_Expecter) Exec(ctx any, request any) *MockPermissionsRolesService_Exec_Call {
+	return &MockPermissionsRolesService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockPermissionsRolesService_Exec_Call) Run(run func(ctx context.Context, request *core.PermissionsRolesRequest)) *MockPermissionsRolesService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.PermissionsRolesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.PermissionsRolesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPermissionsRolesService_Exec_Call) Return(stringToStrings map[string][]string, err error) *MockPermissionsRolesService_Exec_Call {
+	_c.Call.Return(stringToStrings, err)
+	return _c
+}
+
+func (_c *MockPermissionsRolesService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.PermissionsRolesRequest) (map[string][]string, error)) *MockPermissionsRolesService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleCreateService creates a new instance of MockRoleCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleCreateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleCreateService {
+	mock := &MockRoleCreateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleCreateService is an autogenerated mock type for the RoleCreateService type
+type MockRoleCreateService struct {
+	mock.Mock
+}
+
+type MockRoleCreateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleCreateService) EXPECT() *MockRoleCreateService_Expecter {
+	return &MockRoleCreateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleCreateService
+func (_mock *MockRoleCreateService) Exec(ctx context.Context, request *core.RoleCreateRequest) (*core.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleCreateRequest) (*core.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleCreateRequest) *core.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.RoleCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleCreateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RoleCreateRequest
+func (_e *MockRoleCreateService_Expecter) Exec(ctx any, request any) *MockRoleCreateService_Exec_Call {
+	return &MockRoleCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleCreateService_Exec_Call) Run(run func(ctx context.Context, request *core.RoleCreateRequest)) *MockRoleCreateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RoleCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RoleCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleCreateService_Exec_Call) Return(role *core.Role, err error) *MockRoleCreateService_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RoleCreateRequest) (*core.Role, error)) *MockRoleCreateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleDeleteService creates a new instance of MockRoleDeleteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleDeleteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleDeleteService {
+	mock := &MockRoleDeleteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleDeleteService is an autogenerated mock type for the RoleDeleteService type
+type MockRoleDeleteService struct {
+	mock.Mock
+}
+
+type MockRoleDeleteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleDeleteService) EXPECT() *MockRoleDeleteService_Expecter {
+	return &MockRoleDeleteService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleDeleteService
+func (_mock *MockRoleDeleteService) Exec(ctx context.Context, request *core.RoleDeleteRequest) (*core.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleDeleteRequest) (*core.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleDeleteRequest) *core.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.RoleDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleDeleteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleDeleteService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RoleDeleteRequest
+func (_e *MockRoleDeleteService_Expecter) Exec(ctx any, request any) *MockRoleDeleteService_Exec_Call {
+	return &MockRoleDeleteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleDeleteService_Exec_Call) Run(run func(ctx context.Context, request *core.RoleDeleteRequest)) *MockRoleDeleteService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RoleDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RoleDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleDeleteService_Exec_Call) Return(role *core.Role, err error) *MockRoleDeleteService_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleDeleteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RoleDeleteRequest) (*core.Role, error)) *MockRoleDeleteService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleListService creates a new instance of MockRoleListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleListService {
+	mock := &MockRoleListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleListService is an autogenerated mock type for the RoleListService type
+type MockRoleListService struct {
+	mock.Mock
+}
+
+type MockRoleListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleListService) EXPECT() *MockRoleListService_Expecter {
+	return &MockRoleListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleListService
+func (_mock *MockRoleListService) Exec(ctx context.Context, request *core.RoleListRequest) ([]*core.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleListRequest) ([]*core.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleListRequest) []*core.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.RoleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RoleListRequest
+func (_e *MockRoleListService_Expecter) Exec(ctx any, request any) *MockRoleListService_Exec_Call {
+	return &MockRoleListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleListService_Exec_Call) Run(run func(ctx context.Context, request *core.RoleListRequest)) *MockRoleListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RoleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RoleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleListService_Exec_Call) Return(roles []*core.Role, err error) *MockRoleListService_Exec_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *MockRoleListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RoleListRequest) ([]*core.Role, error)) *MockRoleListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoleUpdateService creates a new instance of MockRoleUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoleUpdateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoleUpdateService {
+	mock := &MockRoleUpdateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRoleUpdateService is an autogenerated mock type for the RoleUpdateService type
+type MockRoleUpdateService struct {
+	mock.Mock
+}
+
+type MockRoleUpdateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoleUpdateService) EXPECT() *MockRoleUpdateService_Expecter {
+	return &MockRoleUpdateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockRoleUpdateService
+func (_mock *MockRoleUpdateService) Exec(ctx context.Context, request *core.RoleUpdateRequest) (*core.Role, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleUpdateRequest) (*core.Role, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.RoleUpdateRequest) *core.Role); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.RoleUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoleUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockRoleUpdateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.RoleUpdateRequest
+func (_e *MockRoleUpdateService_Expecter) Exec(ctx any, request any) *MockRoleUpdateService_Exec_Call {
+	return &MockRoleUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockRoleUpdateService_Exec_Call) Run(run func(ctx context.Context, request *core.RoleUpdateRequest)) *MockRoleUpdateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.RoleUpdateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.RoleUpdateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoleUpdateService_Exec_Call) Return(role *core.Role, err error) *MockRoleUpdateService_Exec_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockRoleUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.RoleUpdateRequest) (*core.Role, error)) *MockRoleUpdateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailUpdateService creates a new instance of MockShortCodeCreateEmailUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailUpdateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailUpdateService {
+	mock := &MockShortCodeCreateEmailUpdateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailUpdateService is an autogenerated mock type for the ShortCodeCreateEmailUpdateService type
+type MockShortCodeCreateEmailUpdateService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailUpdateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailUpdateService) EXPECT() *MockShortCodeCreateEmailUpdateService_Expecter {
+	return &MockShortCodeCreateEmailUpdateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailUpdateService
+func (_mock *MockShortCodeCreateEmailUpdateService) Exec(ctx context.Context, request *core.ShortCodeCreateEmailUpdateRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateEmailUpdateRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateEmailUpdateRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateEmailUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailUpdateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateEmailUpdateRequest
+func (_e *MockShortCodeCreateEmailUpdateService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	return &MockShortCodeCreateEmailUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateEmailUpdateRequest)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateEmailUpdateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateEmailUpdateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateEmailUpdateRequest) (*core.ShortCode, error)) *MockShortCodeCreateEmailUpdateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateEmailVerificationService creates a new instance of MockShortCodeCreateEmailVerificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateEmailVerificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateEmailVerificationService {
+	mock := &MockShortCodeCreateEmailVerificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateEmailVerificationService is an autogenerated mock type for the ShortCodeCreateEmailVerificationService type
+type MockShortCodeCreateEmailVerificationService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateEmailVerificationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateEmailVerificationService) EXPECT() *MockShortCodeCreateEmailVerificationService_Expecter {
+	return &MockShortCodeCreateEmailVerificationService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateEmailVerificationService
+func (_mock *MockShortCodeCreateEmailVerificationService) Exec(ctx context.Context, request *core.ShortCodeCreateEmailVerificationRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateEmailVerificationRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateEmailVerificationRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateEmailVerificationRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateEmailVerificationService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateEmailVerificationService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateEmailVerificationRequest
+func (_e *MockShortCodeCreateEmailVerificationService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	return &MockShortCodeCreateEmailVerificationService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateEmailVerificationService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateEmailVerificationRequest)) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateEmailVerificationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateEmailVerificationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateEmailVerificationService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateEmailVerificationRequest) (*core.ShortCode, error)) *MockShortCodeCreateEmailVerificationService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreateInviteService creates a new instance of MockShortCodeCreateInviteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreateInviteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreateInviteService {
+	mock := &MockShortCodeCreateInviteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreateInviteService is an autogenerated mock type for the ShortCodeCreateInviteService type
+type MockShortCodeCreateInviteService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreateInviteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreateInviteService) EXPECT() *MockShortCodeCreateInviteService_Expecter {
+	return &MockShortCodeCreateInviteService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreateInviteService
+func (_mock *MockShortCodeCreateInviteService) Exec(ctx context.Context, request *core.ShortCodeCreateInviteRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateInviteRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreateInviteRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreateInviteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreateInviteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreateInviteService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeCreateInviteRequest
+func (_e *MockShortCodeCreateInviteService_Expecter) Exec(ctx any, request any) *MockShortCodeCreateInviteService_Exec_Call {
+	return &MockShortCodeCreateInviteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeCreateInviteService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeCreateInviteRequest)) *MockShortCodeCreateInviteService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeCreateInviteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeCreateInviteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteService_Exec_Call) Return(shortCode *core.ShortCode, err error) *MockShortCodeCreateInviteService_Exec_Call {
+	_c.Call.Return(shortCode, err)
+	return _c
+}
+
+func (_c *MockShortCodeCreateInviteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeCreateInviteRequest) (*core.ShortCode, error)) *MockShortCodeCreateInviteService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeCreatePasswordResetService creates a new instance of MockShortCodeCreatePasswordResetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeCreatePasswordResetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeCreatePasswordResetService {
+	mock := &MockShortCodeCreatePasswordResetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeCreatePasswordResetService is an autogenerated mock type for the ShortCodeCreatePasswordResetService type
+type MockShortCodeCreatePasswordResetService struct {
+	mock.Mock
+}
+
+type MockShortCodeCreatePasswordResetService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeCreatePasswordResetService) EXPECT() *MockShortCodeCreatePasswordResetService_Expecter {
+	return &MockShortCodeCreatePasswordResetService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeCreatePasswordResetService
+func (_mock *MockShortCodeCreatePasswordResetService) Exec(ctx context.Context, request *core.ShortCodeCreatePasswordResetRequest) (*core.ShortCode, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreatePasswordResetRequest) (*core.ShortCode, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeCreatePasswordResetRequest) *core.ShortCode); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeCreatePasswordResetRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeCreatePasswordResetService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeCreatePasswordResetService_Exec_Call struct {
 	*mock.Call
 }
 
@@ -1308,6 +3303,289 @@ func (_c *MockShortCodeCreateRegisterService_Exec_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// NewMockShortCodeListService creates a new instance of MockShortCodeListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeListService {
+	mock := &MockShortCodeListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeListService is an autogenerated mock type for the ShortCodeListService type
+type MockShortCodeListService struct {
+	mock.Mock
+}
+
+type MockShortCodeListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeListService) EXPECT() *MockShortCodeListService_Expecter {
+	return &MockShortCodeListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeListService
+func (_mock *MockShortCodeListService) Exec(ctx context.Context, request *core.ShortCodeListRequest) ([]*core.ShortCodeMetadata, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*core.ShortCodeMetadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeListRequest) ([]*core.ShortCodeMetadata, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeListRequest) []*core.ShortCodeMetadata); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*core.ShortCodeMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeListRequest
+func (_e *MockShortCodeListService_Expecter) Exec(ctx any, request any) *MockShortCodeListService_Exec_Call {
+	return &MockShortCodeListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeListService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeListRequest)) *MockShortCodeListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeListService_Exec_Call) Return(shortCodeMetadatas []*core.ShortCodeMetadata, err error) *MockShortCodeListService_Exec_Call {
+	_c.Call.Return(shortCodeMetadatas, err)
+	return _c
+}
+
+func (_c *MockShortCodeListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeListRequest) ([]*core.ShortCodeMetadata, error)) *MockShortCodeListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeRevokeService creates a new instance of MockShortCodeRevokeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeRevokeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeRevokeService {
+	mock := &MockShortCodeRevokeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeRevokeService is an autogenerated mock type for the ShortCodeRevokeService type
+type MockShortCodeRevokeService struct {
+	mock.Mock
+}
+
+type MockShortCodeRevokeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeRevokeService) EXPECT() *MockShortCodeRevokeService_Expecter {
+	return &MockShortCodeRevokeService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeRevokeService
+func (_mock *MockShortCodeRevokeService) Exec(ctx context.Context, request *core.ShortCodeRevokeRequest) (*core.ShortCodeMetadata, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *core.ShortCodeMetadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeRevokeRequest) (*core.ShortCodeMetadata, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeRevokeRequest) *core.ShortCodeMetadata); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.ShortCodeMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeRevokeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeRevokeService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeRevokeService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeRevokeRequest
+func (_e *MockShortCodeRevokeService_Expecter) Exec(ctx any, request any) *MockShortCodeRevokeService_Exec_Call {
+	return &MockShortCodeRevokeService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeRevokeService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeRevokeRequest)) *MockShortCodeRevokeService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeRevokeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeRevokeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeRevokeService_Exec_Call) Return(shortCodeMetadata *core.ShortCodeMetadata, err error) *MockShortCodeRevokeService_Exec_Call {
+	_c.Call.Return(shortCodeMetadata, err)
+	return _c
+}
+
+func (_c *MockShortCodeRevokeService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeRevokeRequest) (*core.ShortCodeMetadata, error)) *MockShortCodeRevokeService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShortCodeVerifyService creates a new instance of MockShortCodeVerifyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShortCodeVerifyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShortCodeVerifyService {
+	mock := &MockShortCodeVerifyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShortCodeVerifyService is an autogenerated mock type for the ShortCodeVerifyService type
+type MockShortCodeVerifyService struct {
+	mock.Mock
+}
+
+type MockShortCodeVerifyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShortCodeVerifyService) EXPECT() *MockShortCodeVerifyService_Expecter {
+	return &MockShortCodeVerifyService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockShortCodeVerifyService
+func (_mock *MockShortCodeVerifyService) Exec(ctx context.Context, request *core.ShortCodeVerifyRequest) (string, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeVerifyRequest) (string, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *core.ShortCodeVerifyRequest) string); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *core.ShortCodeVerifyRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShortCodeVerifyService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockShortCodeVerifyService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *core.ShortCodeVerifyRequest
+func (_e *MockShortCodeVerifyService_Expecter) Exec(ctx any, request any) *MockShortCodeVerifyService_Exec_Call {
+	return &MockShortCodeVerifyService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockShortCodeVerifyService_Exec_Call) Run(run func(ctx context.Context, request *core.ShortCodeVerifyRequest)) *MockShortCodeVerifyService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *core.ShortCodeVerifyRequest
+		if args[1] != nil {
+			arg1 = args[1].(*core.ShortCodeVerifyRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShortCodeVerifyService_Exec_Call) Return(s string, err error) *MockShortCodeVerifyService_Exec_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockShortCodeVerifyService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *core.ShortCodeVerifyRequest) (string, error)) *MockShortCodeVerifyService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTokenCreateService creates a new instance of MockTokenCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTokenCreateService(t interface {
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+type AuditListService interface {
+	Exec(ctx context.Context, request *core.AuditEventListRequest) ([]*core.AuditEvent, error)
+}
+
+type AuditListRequest struct {
+	ActorID  *uuid.UUID `schema:"actorID"`
+	TargetID *uuid.UUID `schema:"targetID"`
+	Limit    int        `schema:"limit"`
+	Offset   int        `schema:"offset"`
+}
+
+// AuditEvent is the JSON representation of an entry of the audit trail.
+type AuditEvent struct {
+	ID        uuid.UUID       `json:"id"`
+	Seq       int64           `json:"seq"`
+	ActorID   *uuid.UUID      `json:"actorID,omitempty"`
+	TargetID  *uuid.UUID      `json:"targetID,omitempty"`
+	Action    string          `json:"action"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	RequestID string          `json:"requestID,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	// Hash is hex-encoded. Empty for reads, recorded outside the chain.
+	Hash string `json:"hash,omitempty"`
+}
+
+func loadAuditEvent(item *core.AuditEvent, _ int) AuditEvent {
+	return AuditEvent{
+		ID:        item.ID,
+		Seq:       item.Seq,
+		ActorID:   item.ActorID,
+		TargetID:  item.TargetID,
+		Action:    item.Action,
+		Before:    item.Before,
+		After:     item.After,
+		RequestID: item.RequestID,
+		CreatedAt: item.CreatedAt,
+		Hash:      hex.EncodeToString(item.Hash),
+	}
+}
+
+// AuditListResponse is the JSON representation of a page of the audit trail.
+type AuditListResponse struct {
+	Events []AuditEvent `json:"events"`
+}
+
+// AuditList is the REST handler that lists the audit trail of administrative actions.
+type AuditList struct {
+	service AuditListService
+	logger  logging.Log
+}
+
+func NewAuditList(service AuditListService, logger logging.Log) *AuditList {
+	return &AuditList{service: service, logger: logger}
+}
+
+func (handler *AuditList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.AuditList")
+	defer span.End()
+
+	var request AuditListRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.AuditEventListRequest{
+		ActorID:  request.ActorID,
+		TargetID: request.TargetID,
+		Limit:    request.Limit,
+		Offset:   request.Offset,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, AuditListResponse{
+		Events: lo.Map(res, loadAuditEvent),
+	})
+}
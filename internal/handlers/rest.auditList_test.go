@@ -0,0 +1,216 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestAuditList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.AuditEventListRequest
+		resp []*core.AuditEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?actorID=00000000-0000-0000-0000-000000000001&targetID=00000000-0000-0000-0000-000000000002"+
+					"&limit=10&offset=5",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.AuditEventListRequest{
+					ActorID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					TargetID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+					Limit:    10,
+					Offset:   5,
+				},
+				resp: []*core.AuditEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000013"),
+						Seq:       3,
+						ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						Action:    core.AuditActionCredentialsList,
+						RequestID: "request-2",
+						CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000012"),
+						Seq:       2,
+						ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						Action:    core.AuditActionCredentialsUpdateRole,
+						Before:    json.RawMessage(`{"role":"auth:user"}`),
+						After:     json.RawMessage(`{"role":"auth:admin"}`),
+						RequestID: "request-1",
+						CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+						Hash:      []byte{0xca, 0xfe},
+					},
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Seq:       1,
+						TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+						Action:    core.AuditActionCredentialsSuperAdminCreate,
+						After:     json.RawMessage(`{"role":"auth:superadmin"}`),
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						Hash:      []byte{0xbe, 0xef},
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"events": []any{
+					// Reads are recorded outside the chain, without a hash.
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000013",
+						"seq":       float64(3),
+						"actorID":   "00000000-0000-0000-0000-000000000001",
+						"action":    core.AuditActionCredentialsList,
+						"requestID": "request-2",
+						"createdAt": "2021-01-03T00:00:00Z",
+					},
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000012",
+						"seq":       float64(2),
+						"actorID":   "00000000-0000-0000-0000-000000000001",
+						"targetID":  "00000000-0000-0000-0000-000000000002",
+						"action":    core.AuditActionCredentialsUpdateRole,
+						"before":    map[string]any{"role": "auth:user"},
+						"after":     map[string]any{"role": "auth:admin"},
+						"requestID": "request-1",
+						"createdAt": "2021-01-02T00:00:00Z",
+						"hash":      "cafe",
+					},
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000011",
+						"seq":       float64(1),
+						"targetID":  "00000000-0000-0000-0000-000000000002",
+						"action":    core.AuditActionCredentialsSuperAdminCreate,
+						"after":     map[string]any{"role": "auth:superadmin"},
+						"createdAt": "2021-01-01T00:00:00Z",
+						"hash":      "beef",
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/Empty",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.AuditEventListRequest{
+					Limit: 10,
+				},
+				resp: []*core.AuditEvent{},
+			},
+
+			expectResponse: map[string]any{
+				"events": []any{},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadQuery",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?actorID=abc&limit=10", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=1000", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.AuditEventListRequest{
+					Limit: 1000,
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.AuditEventListRequest{
+					Limit: 10,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockAuditListService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewAuditList(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
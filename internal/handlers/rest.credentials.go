@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/a-novel/service-authentication/v2/internal/core"
 )
@@ -11,20 +12,28 @@ import (
 // Credentials is the JSON representation of a user's account record returned by
 // the credentials endpoints.
 type Credentials struct {
-	ID        uuid.UUID `json:"id"`
-	Email     string    `json:"email"`
-	Role      string    `json:"role"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID              uuid.UUID  `json:"id"`
+	Email           string     `json:"email"`
+	Roles           []string   `json:"roles"`
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+	Locale          string     `json:"locale,omitempty"`
+	NoticesOptOut   bool       `json:"noticesOptOut,omitempty"`
+	LastLoginAt     *time.Time `json:"lastLoginAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
 }
 
 func loadCredentials(s *core.Credentials) Credentials {
 	return Credentials{
-		ID:        s.ID,
-		Email:     s.Email,
-		Role:      s.Role,
-		CreatedAt: s.CreatedAt,
-		UpdatedAt: s.UpdatedAt,
+		ID:              s.ID,
+		Email:           s.Email,
+		Roles:           lo.CoalesceSliceOrEmpty(s.Roles),
+		EmailVerifiedAt: s.EmailVerifiedAt,
+		Locale:          s.Locale,
+		NoticesOptOut:   s.NoticesOptOut,
+		LastLoginAt:     s.LastLoginAt,
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
 	}
 }
 
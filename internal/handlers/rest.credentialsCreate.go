@@ -21,6 +21,7 @@ type CredentialsCreateRequest struct {
 	Email     string `json:"email"`
 	Password  string `json:"password"`
 	ShortCode string `json:"shortCode"`
+	Lang      string `json:"lang"`
 }
 
 type CredentialsCreate struct {
@@ -51,13 +52,19 @@ func (handler *CredentialsCreate) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		Email:     request.Email,
 		Password:  request.Password,
 		ShortCode: request.ShortCode,
+		Lang:      request.Lang,
 	})
+	if handleRegistrationRefused(ctx, handler.logger, w, span, err) {
+		return
+	}
+
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			dao.ErrCredentialsInsertAlreadyExists: http.StatusConflict,
-			dao.ErrShortCodeSelectNotFound:        http.StatusForbidden,
-			core.ErrShortCodeConsumeInvalid:       http.StatusForbidden,
-			core.ErrInvalidRequest:                http.StatusUnprocessableEntity,
+			dao.ErrCredentialsInsertAlreadyExists:   http.StatusConflict,
+			dao.ErrShortCodeSelectNotFound:          http.StatusForbidden,
+			core.ErrShortCodeConsumeInvalid:         http.StatusForbidden,
+			core.ErrShortCodeConsumeTooManyAttempts: http.StatusGone,
+			core.ErrInvalidRequest:                  http.StatusUnprocessableEntity,
 		}, err)
 
 		return
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type CredentialsCreateInviteService interface {
+	Exec(ctx context.Context, request *core.CredentialsCreateInviteRequest) (*core.Token, error)
+}
+
+type CredentialsCreateInviteRequest struct {
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	ShortCode string `json:"shortCode"`
+	Lang      string `json:"lang"`
+}
+
+type CredentialsCreateInvite struct {
+	service CredentialsCreateInviteService
+	logger  logging.Log
+}
+
+func NewCredentialsCreateInvite(service CredentialsCreateInviteService, logger logging.Log) *CredentialsCreateInvite {
+	return &CredentialsCreateInvite{service: service, logger: logger}
+}
+
+func (handler *CredentialsCreateInvite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsCreateInvite")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsCreateInviteRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsCreateInviteRequest{
+		Email:     request.Email,
+		Password:  request.Password,
+		ShortCode: request.ShortCode,
+		Lang:      request.Lang,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsInsertAlreadyExists:   http.StatusConflict,
+			dao.ErrShortCodeSelectNotFound:          http.StatusForbidden,
+			core.ErrShortCodeConsumeInvalid:         http.StatusForbidden,
+			core.ErrShortCodeConsumeTooManyAttempts: http.StatusGone,
+			// The inviter was deleted or demoted since the invitation was issued.
+			dao.ErrCredentialsSelectNotFound:      http.StatusForbidden,
+			core.ErrCredentialsUpdateRoleToHigher: http.StatusForbidden,
+			core.ErrInvalidRequest:                http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadToken(res))
+}
@@ -0,0 +1,250 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsCreateInvite(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsCreateInviteRequest
+		resp *core.Token
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				resp: &core.Token{
+					AccessToken:  "token",
+					RefreshToken: "refresh",
+				},
+			},
+
+			expectResponse: map[string]any{
+				"accessToken":  "token",
+				"refreshToken": "refresh",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{`)),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/CredentialsAlreadyExists",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: dao.ErrCredentialsInsertAlreadyExists,
+			},
+
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Error/InvalidShortCode",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: core.ErrShortCodeConsumeInvalid,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/TooManyShortCodeAttempts",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: core.ErrShortCodeConsumeTooManyAttempts,
+			},
+
+			expectStatus: http.StatusGone,
+		},
+		{
+			name: "Error/RoleAboveInviter",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: core.ErrCredentialsUpdateRoleToHigher,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InviterNotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateInviteRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsCreateInviteService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsCreateInvite(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
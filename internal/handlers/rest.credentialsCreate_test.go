@@ -67,6 +67,13 @@ func TestCredentialsCreate(t *testing.T) {
 			},
 			expectStatus: http.StatusOK,
 		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{`)),
+
+			expectStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Error/CredentialsAlreadyExists",
 
@@ -127,6 +134,47 @@ func TestCredentialsCreate(t *testing.T) {
 
 			expectStatus: http.StatusForbidden,
 		},
+		{
+			name: "Error/ShortCodeTooManyAttempts",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: core.ErrShortCodeConsumeTooManyAttempts,
+			},
+
+			expectStatus: http.StatusGone,
+		},
+		{
+			name: "Error/RegistrationDomainDenied",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"password": "Louvre",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsCreateRequest{
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+				},
+				err: core.ErrRegistrationDomainDenied,
+			},
+
+			expectStatus:   http.StatusForbidden,
+			expectResponse: map[string]any{"reason": handlers.RegistrationRefusedDomainDenied},
+		},
 		{
 			name: "Error/Internal",
 
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+type CredentialsEventListService interface {
+	Exec(ctx context.Context, request *core.CredentialsEventListRequest) ([]*core.CredentialsEvent, error)
+}
+
+type CredentialsEventListRequest struct {
+	After *int64 `schema:"after"`
+	Limit int    `schema:"limit"`
+}
+
+// CredentialsEvent is the JSON representation of an entry of the account changes outbox.
+type CredentialsEvent struct {
+	ID        uuid.UUID  `json:"id"`
+	Seq       int64      `json:"seq"`
+	Kind      string     `json:"kind"`
+	UserID    uuid.UUID  `json:"userID"`
+	SourceID  *uuid.UUID `json:"sourceID,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func loadCredentialsEvent(item *core.CredentialsEvent, _ int) CredentialsEvent {
+	return CredentialsEvent{
+		ID:        item.ID,
+		Seq:       item.Seq,
+		Kind:      item.Kind,
+		UserID:    item.UserID,
+		SourceID:  item.SourceID,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+// CredentialsEventListResponse is the JSON representation of a page of the account changes outbox.
+type CredentialsEventListResponse struct {
+	Events []CredentialsEvent `json:"events"`
+}
+
+// CredentialsEventList is the REST handler other services poll to act on account changes.
+type CredentialsEventList struct {
+	service CredentialsEventListService
+	logger  logging.Log
+}
+
+func NewCredentialsEventList(service CredentialsEventListService, logger logging.Log) *CredentialsEventList {
+	return &CredentialsEventList{service: service, logger: logger}
+}
+
+func (handler *CredentialsEventList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsEventList")
+	defer span.End()
+
+	var request CredentialsEventListRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsEventListRequest{
+		AfterSeq: request.After,
+		Limit:    request.Limit,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, CredentialsEventListResponse{
+		Events: lo.Map(res, loadCredentialsEvent),
+	})
+}
@@ -0,0 +1,166 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsEventList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsEventListRequest
+		resp []*core.CredentialsEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?after=3&limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsEventListRequest{
+					AfterSeq: lo.ToPtr[int64](3),
+					Limit:    10,
+				},
+				resp: []*core.CredentialsEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Seq:       4,
+						Kind:      dao.CredentialsEventKindMerge,
+						UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						SourceID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"events": []any{
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000011",
+						"seq":       float64(4),
+						"kind":      dao.CredentialsEventKindMerge,
+						"userID":    "00000000-0000-0000-0000-000000000002",
+						"sourceID":  "00000000-0000-0000-0000-000000000001",
+						"createdAt": "2021-01-01T00:00:00Z",
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/Empty",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsEventListRequest{
+					Limit: 10,
+				},
+				resp: []*core.CredentialsEvent{},
+			},
+
+			expectResponse: map[string]any{
+				"events": []any{},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadQuery",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?after=abc&limit=10", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=1000", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsEventListRequest{
+					Limit: 1000,
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsEventListRequest{
+					Limit: 10,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsEventListService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsEventList(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+const (
+	// CredentialsExportFormatJSON returns the export as a plain JSON body. This is the
+	// default when no format is requested.
+	CredentialsExportFormatJSON = "json"
+	// CredentialsExportFormatZip returns the same JSON document, wrapped in a zip
+	// archive served as an attachment.
+	CredentialsExportFormatZip = "zip"
+)
+
+// credentialsExportFileName is the name of the JSON document, inside the zip archive and
+// as the archive's own base name.
+const credentialsExportFileName = "personal-data"
+
+// ErrCredentialsExportFormat is returned when the requested export format is not one of
+// the CredentialsExportFormat* constants.
+var ErrCredentialsExportFormat = errors.New("unsupported export format")
+
+type CredentialsExportService interface {
+	Exec(ctx context.Context, request *core.CredentialsExportRequest) (*core.PersonalData, error)
+}
+
+type CredentialsExportRequest struct {
+	Format string `schema:"format"`
+}
+
+// PersonalData is the JSON representation of a personal data export.
+type PersonalData struct {
+	Credentials Credentials             `json:"credentials"`
+	ShortCodes  []PersonalDataShortCode `json:"shortCodes"`
+	LoginEvents []LoginEvent            `json:"loginEvents"`
+	AuditEvents []AuditEvent            `json:"auditEvents"`
+}
+
+// PersonalDataShortCode is the JSON representation of an exported short code.
+type PersonalDataShortCode struct {
+	ID             uuid.UUID       `json:"id"`
+	Usage          string          `json:"usage"`
+	Target         string          `json:"target"`
+	Data           json.RawMessage `json:"data,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	ExpiresAt      time.Time       `json:"expiresAt"`
+	DeletedAt      *time.Time      `json:"deletedAt,omitempty"`
+	DeletedComment *string         `json:"deletedComment,omitempty"`
+}
+
+func loadPersonalDataShortCode(item *core.PersonalDataShortCode, _ int) PersonalDataShortCode {
+	var data json.RawMessage
+
+	// Payloads are stored JSON-encoded. Anything else is exported as a plain string
+	// rather than producing an invalid document.
+	if len(item.Data) > 0 {
+		if json.Valid(item.Data) {
+			data = item.Data
+		} else {
+			data = lo.Must(json.Marshal(string(item.Data)))
+		}
+	}
+
+	return PersonalDataShortCode{
+		ID:             item.ID,
+		Usage:          item.Usage,
+		Target:         item.Target,
+		Data:           data,
+		CreatedAt:      item.CreatedAt,
+		ExpiresAt:      item.ExpiresAt,
+		DeletedAt:      item.DeletedAt,
+		DeletedComment: item.DeletedComment,
+	}
+}
+
+func loadPersonalData(s *core.PersonalData) PersonalData {
+	return PersonalData{
+		Credentials: loadCredentials(s.Credentials),
+		ShortCodes:  lo.Map(s.ShortCodes, loadPersonalDataShortCode),
+		LoginEvents: lo.Map(s.LoginEvents, loadLoginEvent),
+		AuditEvents: lo.Map(s.AuditEvents, loadAuditEvent),
+	}
+}
+
+// sendPersonalData writes the export in the requested format. The zip archive is built
+// in memory before anything is written, so a failure still answers a clean 500.
+func sendPersonalData(
+	ctx context.Context, logger logging.Log, w http.ResponseWriter, span trace.Span, format string, data PersonalData,
+) {
+	if format != CredentialsExportFormatZip {
+		httpf.SendJSONStatus(ctx, w, span, http.StatusOK, data)
+
+		return
+	}
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	err := func() error {
+		file, err := archive.Create(credentialsExportFileName + ".json")
+		if err != nil {
+			return fmt.Errorf("create archive entry: %w", err)
+		}
+
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+
+		err = encoder.Encode(data)
+		if err != nil {
+			return fmt.Errorf("encode export: %w", err)
+		}
+
+		return archive.Close()
+	}()
+	if err != nil {
+		httpf.HandleError(ctx, logger, w, span, nil, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+credentialsExportFileName+`.zip"`)
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(buf.Bytes())
+	if err != nil {
+		_ = otel.ReportError(span, err)
+
+		return
+	}
+
+	otel.ReportSuccessNoContent(span)
+}
+
+func checkCredentialsExportFormat(format string) error {
+	switch format {
+	case "", CredentialsExportFormatJSON, CredentialsExportFormatZip:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrCredentialsExportFormat, format)
+	}
+}
+
+// CredentialsExport is the REST handler that exports the personal data of the caller.
+type CredentialsExport struct {
+	service CredentialsExportService
+	logger  logging.Log
+}
+
+func NewCredentialsExport(service CredentialsExportService, logger logging.Log) *CredentialsExport {
+	return &CredentialsExport{service: service, logger: logger}
+}
+
+func (handler *CredentialsExport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsExport")
+	defer span.End()
+
+	var request CredentialsExportRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err == nil {
+		err = checkCredentialsExportFormat(request.Format)
+	}
+
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsExportRequest{
+		ID:            lo.FromPtr(claims.UserID),
+		CurrentUserID: lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsSelectNotFound: http.StatusNotFound,
+			core.ErrInvalidRequest:           http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	sendPersonalData(ctx, handler.logger, w, span, request.Format, loadPersonalData(res))
+}
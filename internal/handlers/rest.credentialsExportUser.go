@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsExportUserRequest struct {
+	ID     uuid.UUID `schema:"id"`
+	Format string    `schema:"format"`
+}
+
+// CredentialsExportUser is the REST handler that exports the personal data of any
+// account, by ID. It serves administrators handling a subject-access request on a
+// user's behalf; users export their own data through [CredentialsExport].
+type CredentialsExportUser struct {
+	service CredentialsExportService
+	logger  logging.Log
+}
+
+func NewCredentialsExportUser(service CredentialsExportService, logger logging.Log) *CredentialsExportUser {
+	return &CredentialsExportUser{service: service, logger: logger}
+}
+
+func (handler *CredentialsExportUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsExportUser")
+	defer span.End()
+
+	var request CredentialsExportUserRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err == nil {
+		err = checkCredentialsExportFormat(request.Format)
+	}
+
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsExportRequest{
+		ID:            request.ID,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsSelectNotFound: http.StatusNotFound,
+			core.ErrInvalidRequest:           http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	sendPersonalData(ctx, handler.logger, w, span, request.Format, loadPersonalData(res))
+}
@@ -0,0 +1,206 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsExportUser(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsExportRequest
+		resp *core.PersonalData
+		err  error
+	}
+
+	personalData := &core.PersonalData{
+		Credentials: &core.Credentials{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Email:     "user@provider.com",
+			Roles:     []string{config.RoleUser},
+			CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+		},
+		ShortCodes: []*core.PersonalDataShortCode{},
+	}
+
+	expectPersonalData := map[string]any{
+		"credentials": map[string]any{
+			"id":        "00000000-0000-0000-0000-000000000001",
+			"email":     "user@provider.com",
+			"roles":     []any{config.RoleUser},
+			"createdAt": "2018-02-02T12:00:00Z",
+			"updatedAt": "2020-02-02T12:00:00Z",
+		},
+		"shortCodes":  []any{},
+		"loginEvents": []any{},
+		"auditEvents": []any{},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus      int
+		expectContentType string
+		expectResponse    any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				resp: personalData,
+			},
+
+			expectResponse:    expectPersonalData,
+			expectContentType: "application/json",
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "Success/Zip",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001&format=zip",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				resp: personalData,
+			},
+
+			expectResponse:    expectPersonalData,
+			expectContentType: "application/zip",
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "Error/UnsupportedFormat",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001&format=xml",
+				nil,
+			),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsExportService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsExportUser(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			})
+			rCtx = context.WithValue(rCtx, middleware.RequestIDKey, "request-1")
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				require.Equal(t, testCase.expectContentType, res.Header.Get("Content-Type"))
+				require.Equal(t, testCase.expectResponse, readExport(t, res))
+			}
+		})
+	}
+}
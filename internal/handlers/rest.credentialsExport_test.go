@@ -0,0 +1,314 @@
+package handlers_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+// readExport decodes an export response body, unwrapping the zip archive when the
+// handler served one.
+func readExport(t *testing.T, res *http.Response) any {
+	t.Helper()
+
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, errors.Join(err, res.Body.Close()))
+
+	if res.Header.Get("Content-Type") == "application/zip" {
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		require.Len(t, archive.File, 1)
+		require.Equal(t, "personal-data.json", archive.File[0].Name)
+
+		file, err := archive.File[0].Open()
+		require.NoError(t, err)
+
+		data, err = io.ReadAll(file)
+		require.NoError(t, errors.Join(err, file.Close()))
+	}
+
+	var jsonRes any
+	require.NoError(t, json.Unmarshal(data, &jsonRes))
+
+	return jsonRes
+}
+
+func TestCredentialsExport(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsExportRequest
+		resp *core.PersonalData
+		err  error
+	}
+
+	personalData := &core.PersonalData{
+		Credentials: &core.Credentials{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			Email:     "user@provider.com",
+			Roles:     []string{config.RoleUser},
+			CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+		},
+		ShortCodes: []*core.PersonalDataShortCode{
+			{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				Usage:     core.ShortCodeUsageValidateEmail,
+				Target:    "00000000-0000-0000-0000-000000000001",
+				Data:      []byte(`"new@provider.com"`),
+				CreatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+				ExpiresAt: time.Date(2020, time.February, 4, 12, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:             uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Usage:          core.ShortCodeUsageRegister,
+				Target:         "user@provider.com",
+				CreatedAt:      time.Date(2018, time.February, 2, 11, 0, 0, 0, time.UTC),
+				ExpiresAt:      time.Date(2018, time.February, 4, 11, 0, 0, 0, time.UTC),
+				DeletedAt:      lo.ToPtr(time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC)),
+				DeletedComment: lo.ToPtr(dao.ShortCodeDeleteConsumed),
+			},
+		},
+		LoginEvents: []*core.LoginEvent{
+			{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000021"),
+				Email:     "user@provider.com",
+				Kind:      dao.LoginEventKindLogin,
+				Outcome:   dao.LoginEventOutcomeSuccess,
+				IP:        "192.0.2.1",
+				UserAgent: "Mozilla/5.0",
+				CreatedAt: time.Date(2020, time.February, 3, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		AuditEvents: []*core.AuditEvent{
+			{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000031"),
+				Seq:       2,
+				ActorID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				TargetID:  lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Action:    core.AuditActionCredentialsUpdateRole,
+				Before:    []byte(`{"roles":["auth:user"]}`),
+				After:     []byte(`{"roles":["auth:admin"]}`),
+				CreatedAt: time.Date(2020, time.February, 4, 12, 0, 0, 0, time.UTC),
+				Hash:      []byte{0xab, 0xcd},
+			},
+		},
+	}
+
+	expectPersonalData := map[string]any{
+		"credentials": map[string]any{
+			"id":        "00000000-0000-0000-0000-000000000001",
+			"email":     "user@provider.com",
+			"roles":     []any{config.RoleUser},
+			"createdAt": "2018-02-02T12:00:00Z",
+			"updatedAt": "2020-02-02T12:00:00Z",
+		},
+		"shortCodes": []any{
+			map[string]any{
+				"id":        "00000000-0000-0000-0000-000000000011",
+				"usage":     core.ShortCodeUsageValidateEmail,
+				"target":    "00000000-0000-0000-0000-000000000001",
+				"data":      "new@provider.com",
+				"createdAt": "2020-02-02T12:00:00Z",
+				"expiresAt": "2020-02-04T12:00:00Z",
+			},
+			map[string]any{
+				"id":             "00000000-0000-0000-0000-000000000010",
+				"usage":          core.ShortCodeUsageRegister,
+				"target":         "user@provider.com",
+				"createdAt":      "2018-02-02T11:00:00Z",
+				"expiresAt":      "2018-02-04T11:00:00Z",
+				"deletedAt":      "2018-02-02T12:00:00Z",
+				"deletedComment": dao.ShortCodeDeleteConsumed,
+			},
+		},
+		"loginEvents": []any{
+			map[string]any{
+				"id":        "00000000-0000-0000-0000-000000000021",
+				"email":     "user@provider.com",
+				"kind":      dao.LoginEventKindLogin,
+				"outcome":   dao.LoginEventOutcomeSuccess,
+				"ip":        "192.0.2.1",
+				"userAgent": "Mozilla/5.0",
+				"createdAt": "2020-02-03T12:00:00Z",
+			},
+		},
+		"auditEvents": []any{
+			map[string]any{
+				"id":        "00000000-0000-0000-0000-000000000031",
+				"seq":       float64(2),
+				"actorID":   "00000000-0000-0000-0000-000000000002",
+				"targetID":  "00000000-0000-0000-0000-000000000001",
+				"action":    core.AuditActionCredentialsUpdateRole,
+				"before":    map[string]any{"roles": []any{"auth:user"}},
+				"after":     map[string]any{"roles": []any{"auth:admin"}},
+				"createdAt": "2020-02-04T12:00:00Z",
+				"hash":      "abcd",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *core.AccessTokenClaims
+
+		serviceMock *serviceMock
+
+		expectStatus      int
+		expectContentType string
+		expectResponse    any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				resp: personalData,
+			},
+
+			expectResponse:    expectPersonalData,
+			expectContentType: "application/json",
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "Success/Zip",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?format=zip", nil),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				resp: personalData,
+			},
+
+			expectResponse:    expectPersonalData,
+			expectContentType: "application/zip",
+			expectStatus:      http.StatusOK,
+		},
+		{
+			name: "Error/UnsupportedFormat",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?format=xml", nil),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil),
+			claims:  &core.AccessTokenClaims{},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsExportRequest{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsExportService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsExport(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				require.Equal(t, testCase.expectContentType, res.Header.Get("Content-Type"))
+				require.Equal(t, testCase.expectResponse, readExport(t, res))
+			}
+		})
+	}
+}
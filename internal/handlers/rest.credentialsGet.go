@@ -4,7 +4,9 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/a-novel-kit/golib/httpf"
 	"github.com/a-novel-kit/golib/logging"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
 )
 
 type CredentialsGetService interface {
@@ -44,8 +47,17 @@ func (handler *CredentialsGet) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
 	res, err := handler.service.Exec(ctx, &core.CredentialsGetRequest{
-		ID: request.ID,
+		ID:            request.ID,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
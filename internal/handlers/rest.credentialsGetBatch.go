@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsGetBatchService interface {
+	Exec(ctx context.Context, request *core.CredentialsGetBatchRequest) (*core.CredentialsBatch, error)
+}
+
+type CredentialsGetBatchRequest struct {
+	IDs []uuid.UUID `json:"ids"`
+}
+
+type CredentialsBatch struct {
+	Credentials []Credentials `json:"credentials"`
+	Missing     []uuid.UUID   `json:"missing"`
+}
+
+type CredentialsGetBatch struct {
+	service CredentialsGetBatchService
+	logger  logging.Log
+}
+
+func NewCredentialsGetBatch(service CredentialsGetBatchService, logger logging.Log) *CredentialsGetBatch {
+	return &CredentialsGetBatch{service: service, logger: logger}
+}
+
+func (handler *CredentialsGetBatch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsGetBatch")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsGetBatchRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsGetBatchRequest{
+		IDs:           request.IDs,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, CredentialsBatch{
+		Credentials: lo.Map(res.Credentials, loadCredentialsMap),
+		Missing:     res.Missing,
+	})
+}
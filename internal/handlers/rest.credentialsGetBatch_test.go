@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsGetBatch(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsGetBatchRequest
+		resp *core.CredentialsBatch
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"ids": ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"]
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsGetBatchRequest{
+					IDs: []uuid.UUID{
+						uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					},
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				resp: &core.CredentialsBatch{
+					Credentials: []*core.Credentials{
+						{
+							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Email:     "user@provider.com",
+							Roles:     []string{config.RoleUser},
+							CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+						},
+					},
+					Missing: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"credentials": []any{
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000001",
+						"email":     "user@provider.com",
+						"roles":     []any{config.RoleUser},
+						"createdAt": "2018-02-02T12:00:00Z",
+						"updatedAt": "2020-02-02T12:00:00Z",
+					},
+				},
+				"missing": []any{"00000000-0000-0000-0000-000000000002"},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"ids": ["not-a-uuid"]
+			}`)),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"ids": []
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsGetBatchRequest{
+					IDs:           []uuid.UUID{},
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"ids": ["00000000-0000-0000-0000-000000000001"]
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsGetBatchRequest{
+					IDs:           []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsGetBatchService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsGetBatch(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			})
+			rCtx = context.WithValue(rCtx, middleware.RequestIDKey, "request-1")
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -9,7 +10,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +20,7 @@ import (
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	"github.com/a-novel/service-authentication/v2/internal/dao"
 	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
 	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
 )
 
@@ -53,12 +57,14 @@ func TestCredentialsGet(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsGetRequest{
-					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
 				},
 				resp: &core.Credentials{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "user@provider.com",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
 				},
@@ -67,7 +73,7 @@ func TestCredentialsGet(t *testing.T) {
 			expectResponse: map[string]any{
 				"id":        "00000000-0000-0000-0000-000000000001",
 				"email":     "user@provider.com",
-				"role":      config.RoleUser,
+				"roles":     []any{config.RoleUser},
 				"createdAt": "2018-02-02T12:00:00Z",
 				"updatedAt": "2020-02-02T12:00:00Z",
 			},
@@ -85,7 +91,9 @@ func TestCredentialsGet(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsGetRequest{
-					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
 				},
 				err: dao.ErrCredentialsSelectNotFound,
 			},
@@ -104,7 +112,9 @@ func TestCredentialsGet(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsGetRequest{
-					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
 				},
 				err: errFoo,
 			},
@@ -128,7 +138,13 @@ func TestCredentialsGet(t *testing.T) {
 			handler := handlers.NewCredentialsGet(service, config.LoggerDev)
 			w := httptest.NewRecorder()
 
-			handler.ServeHTTP(w, testCase.request)
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			})
+			rCtx = context.WithValue(rCtx, middleware.RequestIDKey, "request-1")
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
 
 			res := w.Result()
 
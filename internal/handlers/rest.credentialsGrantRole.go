@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsGrantRoleService interface {
+	Exec(ctx context.Context, request *core.CredentialsGrantRoleRequest) (*core.RoleGrant, error)
+}
+
+type CredentialsGrantRoleRequest struct {
+	UserID    uuid.UUID `json:"userID"`
+	Role      string    `json:"role"`
+	Reason    string    `json:"reason"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// RoleGrant is the JSON representation of a temporary role grant.
+type RoleGrant struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"userID"`
+	Role      string     `json:"role"`
+	Reason    string     `json:"reason"`
+	GrantedBy *uuid.UUID `json:"grantedBy,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+}
+
+func loadRoleGrant(s *core.RoleGrant) RoleGrant {
+	return RoleGrant{
+		ID:        s.ID,
+		UserID:    s.UserID,
+		Role:      s.Role,
+		Reason:    s.Reason,
+		GrantedBy: s.GrantedBy,
+		CreatedAt: s.CreatedAt,
+		ExpiresAt: s.ExpiresAt,
+	}
+}
+
+type CredentialsGrantRole struct {
+	service CredentialsGrantRoleService
+	logger  logging.Log
+}
+
+func NewCredentialsGrantRole(service CredentialsGrantRoleService, logger logging.Log) *CredentialsGrantRole {
+	return &CredentialsGrantRole{service: service, logger: logger}
+}
+
+func (handler *CredentialsGrantRole) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsGrantRole")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsGrantRoleRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsGrantRoleRequest{
+		TargetUserID:  request.UserID,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		Role:          request.Role,
+		Reason:        request.Reason,
+		ExpiresAt:     request.ExpiresAt,
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			// The select raises this when the target or actor credentials are missing, and the insert
+			// when the target or the role was deleted in between.
+			dao.ErrCredentialsSelectNotFound:               http.StatusNotFound,
+			dao.ErrCredentialRoleGrantInsertNotFound:       http.StatusNotFound,
+			core.ErrCredentialsUpdateRoleToHigher:          http.StatusForbidden,
+			core.ErrCredentialsUpdateRoleDowngradeSuperior: http.StatusForbidden,
+			core.ErrCredentialsUpdateRoleSelfUpdate:        http.StatusForbidden,
+			core.ErrCredentialsGrantRoleAlreadyHeld:        http.StatusConflict,
+			core.ErrInvalidRequest:                         http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadRoleGrant(res))
+}
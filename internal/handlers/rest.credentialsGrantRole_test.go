@@ -0,0 +1,203 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsGrantRole(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	newBody := func() io.Reader {
+		return strings.NewReader(`{
+			"userID": "00000000-0000-0000-0000-000000000002",
+			"role": "auth:admin",
+			"reason": "debug account",
+			"expiresAt": "2020-02-02T13:00:00Z"
+		}`)
+	}
+
+	serviceRequest := &core.CredentialsGrantRoleRequest{
+		TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Role:          config.RoleAdmin,
+		Reason:        "debug account",
+		ExpiresAt:     time.Date(2020, time.February, 2, 13, 0, 0, 0, time.UTC),
+	}
+
+	claims := &core.AccessTokenClaims{
+		UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+	}
+
+	type serviceMock struct {
+		req  *core.CredentialsGrantRoleRequest
+		resp *core.RoleGrant
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *core.AccessTokenClaims
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				resp: &core.RoleGrant{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Role:      config.RoleAdmin,
+					Reason:    "debug account",
+					GrantedBy: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+					CreatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+					ExpiresAt: time.Date(2020, time.February, 2, 13, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectStatus: http.StatusOK,
+			expectResponse: map[string]any{
+				"id":        "00000000-0000-0000-0000-000000000003",
+				"userID":    "00000000-0000-0000-0000-000000000002",
+				"role":      config.RoleAdmin,
+				"reason":    "debug account",
+				"grantedBy": "00000000-0000-0000-0000-000000000001",
+				"createdAt": "2020-02-02T12:00:00Z",
+				"expiresAt": "2020-02-02T13:00:00Z",
+			},
+		},
+		{
+			name: "Error/InvalidBody",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{`)),
+			claims:  claims,
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{req: serviceRequest, err: dao.ErrCredentialsSelectNotFound},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/ToHigher",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{req: serviceRequest, err: core.ErrCredentialsUpdateRoleToHigher},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/SelfUpdate",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{req: serviceRequest, err: core.ErrCredentialsUpdateRoleSelfUpdate},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/AlreadyHeld",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{req: serviceRequest, err: core.ErrCredentialsGrantRoleAlreadyHeld},
+
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{req: serviceRequest, err: core.ErrInvalidRequest},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", newBody()),
+			claims:  claims,
+
+			serviceMock: &serviceMock{req: serviceRequest, err: errFoo},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsGrantRoleService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsGrantRole(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
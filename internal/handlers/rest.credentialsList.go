@@ -3,7 +3,10 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/samber/lo"
 
 	"github.com/a-novel-kit/golib/httpf"
@@ -11,18 +14,37 @@ import (
 	"github.com/a-novel-kit/golib/otel"
 
 	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
 )
 
 type CredentialsListService interface {
-	Exec(ctx context.Context, request *core.CredentialsListRequest) ([]*core.Credentials, error)
+	Exec(ctx context.Context, request *core.CredentialsListRequest) (*core.CredentialsListPage, error)
 }
 
 type CredentialsListRequest struct {
-	Limit  int      `schema:"limit"`
-	Offset int      `schema:"offset"`
-	Roles  []string `schema:"roles"`
+	Limit         int        `schema:"limit"`
+	Offset        int        `schema:"offset"`
+	Roles         []string   `schema:"roles"`
+	Cursor        string     `schema:"cursor"`
+	Email         string     `schema:"email"`
+	EmailMatch    string     `schema:"emailMatch"`
+	CreatedAfter  *time.Time `schema:"createdAfter"`
+	CreatedBefore *time.Time `schema:"createdBefore"`
+	InactiveSince *time.Time `schema:"inactiveSince"`
+	Order         string     `schema:"order"`
+	WithTotal     bool       `schema:"withTotal"`
 }
 
+// Pagination headers of the list endpoints. The body stays a plain array, so the page metadata
+// travels in headers.
+const (
+	// HeaderNextCursor carries the cursor of the next page. It is omitted on the last page.
+	HeaderNextCursor = "X-Next-Cursor"
+	// HeaderTotalCount carries the number of items matching the filters, regardless of
+	// pagination. It is only set when the total is requested.
+	HeaderTotalCount = "X-Total-Count"
+)
+
 type CredentialsList struct {
 	service CredentialsListService
 	logger  logging.Log
@@ -45,10 +67,27 @@ func (handler *CredentialsList) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
 	res, err := handler.service.Exec(ctx, &core.CredentialsListRequest{
-		Limit:  request.Limit,
-		Offset: request.Offset,
-		Roles:  request.Roles,
+		Limit:         request.Limit,
+		Offset:        request.Offset,
+		Roles:         request.Roles,
+		Cursor:        request.Cursor,
+		Email:         request.Email,
+		EmailMatch:    request.EmailMatch,
+		CreatedAfter:  request.CreatedAfter,
+		CreatedBefore: request.CreatedBefore,
+		InactiveSince: request.InactiveSince,
+		Order:         request.Order,
+		WithTotal:     request.WithTotal,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
@@ -58,5 +97,13 @@ func (handler *CredentialsList) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, lo.Map(res, loadCredentialsMap))
+	if res.NextCursor != "" {
+		w.Header().Set(HeaderNextCursor, res.NextCursor)
+	}
+
+	if res.Total != nil {
+		w.Header().Set(HeaderTotalCount, strconv.Itoa(*res.Total))
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, lo.Map(res.Credentials, loadCredentialsMap))
 }
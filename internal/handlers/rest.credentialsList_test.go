@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -9,13 +10,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel/service-authentication/v2/internal/config"
 	"github.com/a-novel/service-authentication/v2/internal/core"
 	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
 	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
 )
 
@@ -26,7 +30,7 @@ func TestCredentialsList(t *testing.T) {
 
 	type serviceMock struct {
 		req  *core.CredentialsListRequest
-		resp []*core.Credentials
+		resp *core.CredentialsListPage
 		err  error
 	}
 
@@ -39,6 +43,8 @@ func TestCredentialsList(t *testing.T) {
 
 		expectStatus   int
 		expectResponse any
+		// expectHeaders lists the pagination headers of the response. The others must be absent.
+		expectHeaders map[string]string
 	}{
 		{
 			name: "Success",
@@ -47,53 +53,55 @@ func TestCredentialsList(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsListRequest{
-					Limit:  10,
-					Offset: 2,
+					Limit:         10,
+					Offset:        2,
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
 				},
-				resp: []*core.Credentials{
+				resp: &core.CredentialsListPage{Credentials: []*core.Credentials{
 					{
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 						Email:     "user3@email.com",
-						Role:      config.RoleUser,
+						Roles:     []string{config.RoleUser},
 						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 					},
 					{
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 						Email:     "user2@email.com",
-						Role:      config.RoleAdmin,
+						Roles:     []string{config.RoleAdmin},
 						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 					},
 					{
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 						Email:     "user1@email.com",
-						Role:      config.RoleUser,
+						Roles:     []string{config.RoleUser},
 						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 					},
-				},
+				}},
 			},
 
 			expectResponse: []any{
 				map[string]any{
 					"id":        "00000000-0000-0000-0000-000000000003",
 					"email":     "user3@email.com",
-					"role":      config.RoleUser,
+					"roles":     []any{config.RoleUser},
 					"createdAt": "2021-01-01T00:00:00Z",
 					"updatedAt": "2021-01-03T00:00:00Z",
 				},
 				map[string]any{
 					"id":        "00000000-0000-0000-0000-000000000002",
 					"email":     "user2@email.com",
-					"role":      config.RoleAdmin,
+					"roles":     []any{config.RoleAdmin},
 					"createdAt": "2021-01-01T00:00:00Z",
 					"updatedAt": "2021-01-02T00:00:00Z",
 				},
 				map[string]any{
 					"id":        "00000000-0000-0000-0000-000000000001",
 					"email":     "user1@email.com",
-					"role":      config.RoleUser,
+					"roles":     []any{config.RoleUser},
 					"createdAt": "2021-01-01T00:00:00Z",
 					"updatedAt": "2021-01-01T00:00:00Z",
 				},
@@ -107,31 +115,115 @@ func TestCredentialsList(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsListRequest{
-					Limit: 10,
-					Roles: []string{"role1", "role2"},
+					Limit:         10,
+					Roles:         []string{"role1", "role2"},
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
 				},
-				resp: []*core.Credentials{
+				resp: &core.CredentialsListPage{Credentials: []*core.Credentials{
 					{
 						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 						Email:     "user3@email.com",
-						Role:      config.RoleUser,
+						Roles:     []string{config.RoleUser},
 						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 					},
-				},
+				}},
 			},
 
 			expectResponse: []any{
 				map[string]any{
 					"id":        "00000000-0000-0000-0000-000000000003",
 					"email":     "user3@email.com",
-					"role":      config.RoleUser,
+					"roles":     []any{config.RoleUser},
 					"createdAt": "2021-01-01T00:00:00Z",
 					"updatedAt": "2021-01-03T00:00:00Z",
 				},
 			},
 			expectStatus: http.StatusOK,
 		},
+		{
+			name: "Success/Cursor",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?limit=1&cursor=abc&email=user&emailMatch=prefix"+
+					"&createdAfter=2021-01-01T00:00:00Z&createdBefore=2021-02-01T00:00:00Z&inactiveSince=2021-03-01T00:00:00Z"+
+					"&order=asc&withTotal=true",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsListRequest{
+					Limit:         1,
+					Cursor:        "abc",
+					Email:         "user",
+					EmailMatch:    core.CredentialsListEmailPrefix,
+					CreatedAfter:  lo.ToPtr(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+					CreatedBefore: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+					InactiveSince: lo.ToPtr(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)),
+					Order:         core.CredentialsListOrderAsc,
+					WithTotal:     true,
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				resp: &core.CredentialsListPage{
+					Credentials: []*core.Credentials{
+						{
+							ID:          uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+							Email:       "user3@email.com",
+							Roles:       []string{config.RoleUser},
+							LastLoginAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+							CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+							UpdatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: "def",
+					Total:      lo.ToPtr(12),
+				},
+			},
+
+			expectResponse: []any{
+				map[string]any{
+					"id":          "00000000-0000-0000-0000-000000000003",
+					"email":       "user3@email.com",
+					"roles":       []any{config.RoleUser},
+					"lastLoginAt": "2021-01-02T00:00:00Z",
+					"createdAt":   "2021-01-01T00:00:00Z",
+					"updatedAt":   "2021-01-03T00:00:00Z",
+				},
+			},
+			expectHeaders: map[string]string{
+				handlers.HeaderNextCursor: "def",
+				handlers.HeaderTotalCount: "12",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10&cursor=abc", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsListRequest{
+					Limit:         10,
+					Cursor:        "abc",
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/BadDate",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10&createdAfter=yesterday", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Error/Internal",
 
@@ -139,8 +231,10 @@ func TestCredentialsList(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsListRequest{
-					Limit:  10,
-					Offset: 2,
+					Limit:         10,
+					Offset:        2,
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
 				},
 				err: errFoo,
 			},
@@ -164,12 +258,22 @@ func TestCredentialsList(t *testing.T) {
 			handler := handlers.NewCredentialsList(service, config.LoggerDev)
 			w := httptest.NewRecorder()
 
-			handler.ServeHTTP(w, testCase.request)
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			})
+			rCtx = context.WithValue(rCtx, middleware.RequestIDKey, "request-1")
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
 
 			res := w.Result()
 
 			require.Equal(t, testCase.expectStatus, res.StatusCode)
 
+			for _, header := range []string{handlers.HeaderNextCursor, handlers.HeaderTotalCount} {
+				require.Equal(t, testCase.expectHeaders[header], res.Header.Get(header), header)
+			}
+
 			if testCase.expectResponse != nil {
 				data, err := io.ReadAll(res.Body)
 				require.NoError(t, errors.Join(err, res.Body.Close()))
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsLoginsService interface {
+	Exec(ctx context.Context, request *core.LoginEventListRequest) ([]*core.LoginEvent, error)
+}
+
+type CredentialsLoginsRequest struct {
+	Limit  int `schema:"limit"`
+	Offset int `schema:"offset"`
+}
+
+// LoginEvent is the JSON representation of an entry of the login history.
+type LoginEvent struct {
+	ID        uuid.UUID `json:"id"`
+	Kind      string    `json:"kind"`
+	Outcome   string    `json:"outcome"`
+	Email     string    `json:"email,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	UserAgent string    `json:"userAgent,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func loadLoginEvent(item *core.LoginEvent, _ int) LoginEvent {
+	return LoginEvent{
+		ID:        item.ID,
+		Kind:      item.Kind,
+		Outcome:   item.Outcome,
+		Email:     item.Email,
+		IP:        item.IP,
+		UserAgent: item.UserAgent,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+// CredentialsLoginsResponse is the JSON representation of a page of the login history.
+type CredentialsLoginsResponse struct {
+	Logins []LoginEvent `json:"logins"`
+}
+
+// CredentialsLogins is the REST handler that lists the login history of the caller.
+type CredentialsLogins struct {
+	service CredentialsLoginsService
+	logger  logging.Log
+}
+
+func NewCredentialsLogins(service CredentialsLoginsService, logger logging.Log) *CredentialsLogins {
+	return &CredentialsLogins{service: service, logger: logger}
+}
+
+func (handler *CredentialsLogins) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsLogins")
+	defer span.End()
+
+	var request CredentialsLoginsRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.LoginEventListRequest{
+		UserID:        lo.FromPtr(claims.UserID),
+		Limit:         request.Limit,
+		Offset:        request.Offset,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, CredentialsLoginsResponse{
+		Logins: lo.Map(res, loadLoginEvent),
+	})
+}
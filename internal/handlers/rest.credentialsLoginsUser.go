@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsLoginsUserRequest struct {
+	ID     uuid.UUID `schema:"id"`
+	Limit  int       `schema:"limit"`
+	Offset int       `schema:"offset"`
+}
+
+// CredentialsLoginsUser is the REST handler that lists the login history of any account,
+// by ID. It serves administrators investigating an account; users read their own history
+// through [CredentialsLogins].
+type CredentialsLoginsUser struct {
+	service CredentialsLoginsService
+	logger  logging.Log
+}
+
+func NewCredentialsLoginsUser(service CredentialsLoginsService, logger logging.Log) *CredentialsLoginsUser {
+	return &CredentialsLoginsUser{service: service, logger: logger}
+}
+
+func (handler *CredentialsLoginsUser) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsLoginsUser")
+	defer span.End()
+
+	var request CredentialsLoginsUserRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.LoginEventListRequest{
+		UserID:        request.ID,
+		Limit:         request.Limit,
+		Offset:        request.Offset,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, CredentialsLoginsResponse{
+		Logins: lo.Map(res, loadLoginEvent),
+	})
+}
@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsLoginsUser(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.LoginEventListRequest
+		resp []*core.LoginEvent
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001&limit=10&offset=5",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.LoginEventListRequest{
+					UserID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Limit:         10,
+					Offset:        5,
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				resp: []*core.LoginEvent{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Email:     "user@provider.com",
+						Kind:      dao.LoginEventKindLogin,
+						Outcome:   dao.LoginEventOutcomeSuccess,
+						IP:        "192.0.2.1",
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"logins": []any{
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000011",
+						"email":     "user@provider.com",
+						"kind":      dao.LoginEventKindLogin,
+						"outcome":   dao.LoginEventOutcomeSuccess,
+						"ip":        "192.0.2.1",
+						"createdAt": "2021-01-01T00:00:00Z",
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadQuery",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?id=abc&limit=10", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.LoginEventListRequest{
+					Limit:         10,
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001&limit=10",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.LoginEventListRequest{
+					UserID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Limit:         10,
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					RequestID:     "request-1",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsLoginsService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsLoginsUser(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000010")),
+			})
+			rCtx = context.WithValue(rCtx, middleware.RequestIDKey, "request-1")
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsMergeService interface {
+	Exec(ctx context.Context, request *core.CredentialsMergeRequest) (*core.Credentials, error)
+}
+
+type CredentialsMergeRequest struct {
+	SourceID      uuid.UUID `json:"sourceID"`
+	DestinationID uuid.UUID `json:"destinationID"`
+}
+
+type CredentialsMerge struct {
+	service CredentialsMergeService
+	logger  logging.Log
+}
+
+func NewCredentialsMerge(service CredentialsMergeService, logger logging.Log) *CredentialsMerge {
+	return &CredentialsMerge{service: service, logger: logger}
+}
+
+func (handler *CredentialsMerge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsMerge")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsMergeRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsMergeRequest{
+		SourceID:      request.SourceID,
+		DestinationID: request.DestinationID,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			// The select raises this when the source, destination or actor credentials are missing.
+			dao.ErrCredentialsSelectNotFound:    http.StatusNotFound,
+			dao.ErrCredentialsMergeNotFound:     http.StatusNotFound,
+			core.ErrCredentialsMergeSameAccount: http.StatusUnprocessableEntity,
+			core.ErrCredentialsMergeSelf:        http.StatusForbidden,
+			core.ErrCredentialsMergeSuperior:    http.StatusForbidden,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadCredentials(res))
+}
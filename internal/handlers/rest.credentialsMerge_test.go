@@ -0,0 +1,212 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsMerge(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsMergeRequest
+		resp *core.Credentials
+		err  error
+	}
+
+	body := `{
+		"sourceID": "00000000-0000-0000-0000-000000000002",
+		"destinationID": "00000000-0000-0000-0000-000000000003"
+	}`
+
+	serviceRequest := &core.CredentialsMergeRequest{
+		SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		DestinationID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		RequestID:     "request-1",
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *core.AccessTokenClaims
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(body)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				resp: &core.Credentials{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Email:     "user@provider.com",
+					Roles:     []string{config.RoleAdmin},
+					CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":        "00000000-0000-0000-0000-000000000003",
+				"email":     "user@provider.com",
+				"roles":     []any{config.RoleAdmin},
+				"createdAt": "2018-02-02T12:00:00Z",
+				"updatedAt": "2020-02-02T12:00:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(body)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/SameAccount",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(body)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				err: core.ErrCredentialsMergeSameAccount,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Self",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(body)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				err: core.ErrCredentialsMergeSelf,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/Superior",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(body)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				err: core.ErrCredentialsMergeSuperior,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(body)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: serviceRequest,
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsMergeService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsMerge(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
+			rCtx = context.WithValue(rCtx, middleware.RequestIDKey, "request-1")
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsPolicyTargetService interface {
+	Exec(ctx context.Context, request *core.CredentialsPolicyTargetGetRequest) (*core.CredentialsPolicyTarget, error)
+}
+
+// CredentialsPolicyTarget resolves the account a request acts on, for the policies of
+// middlewares.Policy. Policies read its userID and rank through their target variable.
+type CredentialsPolicyTarget struct {
+	service CredentialsPolicyTargetService
+}
+
+func NewCredentialsPolicyTarget(service CredentialsPolicyTargetService) *CredentialsPolicyTarget {
+	return &CredentialsPolicyTarget{service: service}
+}
+
+// BodyField returns a resolver that reads the ID of the account from the given field of the JSON
+// body. The body is put back once read, so the handler decodes it as usual.
+func (resolver *CredentialsPolicyTarget) BodyField(field string) middlewares.PolicyTargetResolver {
+	return func(r *http.Request) (map[string]any, error) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, errors.Join(err, middlewares.ErrPolicyTargetInvalid)
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var fields map[string]json.RawMessage
+
+		err = json.Unmarshal(body, &fields)
+		if err != nil {
+			return nil, errors.Join(err, middlewares.ErrPolicyTargetInvalid)
+		}
+
+		var id uuid.UUID
+
+		err = json.Unmarshal(fields[field], &id)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("read %q: %w", field, err), middlewares.ErrPolicyTargetInvalid)
+		}
+
+		target, err := resolver.service.Exec(r.Context(), &core.CredentialsPolicyTargetGetRequest{ID: id})
+		if errors.Is(err, dao.ErrCredentialsSelectNotFound) {
+			return nil, errors.Join(err, middlewares.ErrPolicyTargetNotFound)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("get target credentials: %w", err)
+		}
+
+		return map[string]any{
+			"userID": target.UserID.String(),
+			"rank":   target.Rank,
+		}, nil
+	}
+}
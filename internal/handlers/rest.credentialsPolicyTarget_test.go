@@ -0,0 +1,141 @@
+package handlers_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsPolicyTarget(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsPolicyTargetGetRequest
+		resp *core.CredentialsPolicyTarget
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		body string
+
+		serviceMock *serviceMock
+
+		expect    map[string]any
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			body: `{"userID":"00000000-0000-0000-0000-000000000001","add":["auth:admin"]}`,
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsPolicyTargetGetRequest{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				resp: &core.CredentialsPolicyTarget{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Rank:   1,
+				},
+			},
+
+			expect: map[string]any{"userID": "00000000-0000-0000-0000-000000000001", "rank": 1},
+		},
+		{
+			name: "Error/BadJSON",
+
+			body: `{"userID":`,
+
+			expectErr: middlewares.ErrPolicyTargetInvalid,
+		},
+		{
+			name: "Error/MissingField",
+
+			body: `{"sourceID":"00000000-0000-0000-0000-000000000001"}`,
+
+			expectErr: middlewares.ErrPolicyTargetInvalid,
+		},
+		{
+			name: "Error/BadID",
+
+			body: `{"userID":"abc"}`,
+
+			expectErr: middlewares.ErrPolicyTargetInvalid,
+		},
+		{
+			name: "Error/NotFound",
+
+			body: `{"userID":"00000000-0000-0000-0000-000000000001"}`,
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsPolicyTargetGetRequest{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectErr: middlewares.ErrPolicyTargetNotFound,
+		},
+		{
+			name: "Error/Internal",
+
+			body: `{"userID":"00000000-0000-0000-0000-000000000001"}`,
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsPolicyTargetGetRequest{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsPolicyTargetService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			resolve := handlers.NewCredentialsPolicyTarget(service).BodyField("userID")
+
+			request := httptest.NewRequestWithContext(
+				t.Context(), http.MethodPatch, "/", strings.NewReader(testCase.body),
+			)
+
+			target, err := resolve(request)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, target)
+
+			// The handler reads the body again.
+			body, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			require.Equal(t, testCase.body, string(body))
+
+			service.AssertExpectations(t)
+		})
+	}
+}
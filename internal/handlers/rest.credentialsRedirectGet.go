@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+// CredentialsRedirect is the JSON representation of a merged account, pointing to the account it
+// was merged into.
+type CredentialsRedirect struct {
+	SourceID      uuid.UUID `json:"sourceID"`
+	DestinationID uuid.UUID `json:"destinationID"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type CredentialsRedirectGetService interface {
+	Exec(ctx context.Context, request *core.CredentialsRedirectGetRequest) (*core.CredentialsRedirect, error)
+}
+
+type CredentialsRedirectGetRequest struct {
+	ID uuid.UUID `schema:"id"`
+}
+
+type CredentialsRedirectGet struct {
+	service CredentialsRedirectGetService
+	logger  logging.Log
+}
+
+func NewCredentialsRedirectGet(service CredentialsRedirectGetService, logger logging.Log) *CredentialsRedirectGet {
+	return &CredentialsRedirectGet{service: service, logger: logger}
+}
+
+func (handler *CredentialsRedirectGet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsRedirectGet")
+	defer span.End()
+
+	var request CredentialsRedirectGetRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsRedirectGetRequest{ID: request.ID})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsRedirectSelectNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, CredentialsRedirect{
+		SourceID:      res.SourceID,
+		DestinationID: res.DestinationID,
+		CreatedAt:     res.CreatedAt,
+	})
+}
@@ -0,0 +1,150 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsRedirectGet(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsRedirectGetRequest
+		resp *core.CredentialsRedirect
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsRedirectGetRequest{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				resp: &core.CredentialsRedirect{
+					SourceID:      uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					DestinationID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					CreatedAt:     time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"sourceID":      "00000000-0000-0000-0000-000000000001",
+				"destinationID": "00000000-0000-0000-0000-000000000002",
+				"createdAt":     "2018-02-02T12:00:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?id=not-a-uuid", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsRedirectGetRequest{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: dao.ErrCredentialsRedirectSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsRedirectGetRequest{
+					ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsRedirectGetService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsRedirectGet(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
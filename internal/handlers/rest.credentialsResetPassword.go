@@ -61,6 +61,7 @@ func (handler *CredentialsResetPassword) ServeHTTP(w http.ResponseWriter, r *htt
 			dao.ErrCredentialsUpdatePasswordNotFound: http.StatusForbidden,
 			dao.ErrShortCodeSelectNotFound:           http.StatusForbidden,
 			core.ErrShortCodeConsumeInvalid:          http.StatusForbidden,
+			core.ErrShortCodeConsumeTooManyAttempts:  http.StatusGone,
 			core.ErrShortCodeConsumeExpired:          http.StatusForbidden,
 			core.ErrInvalidRequest:                   http.StatusUnprocessableEntity,
 		}, err)
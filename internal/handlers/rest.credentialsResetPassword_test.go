@@ -60,7 +60,7 @@ func TestCredentialsResetPassword(t *testing.T) {
 				resp: &core.Credentials{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "user@provider.com",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
 				},
@@ -69,7 +69,7 @@ func TestCredentialsResetPassword(t *testing.T) {
 			expectResponse: map[string]any{
 				"id":        "00000000-0000-0000-0000-000000000001",
 				"email":     "user@provider.com",
-				"role":      config.RoleUser,
+				"roles":     []any{config.RoleUser},
 				"createdAt": "2018-02-02T12:00:00Z",
 				"updatedAt": "2020-02-02T12:00:00Z",
 			},
@@ -135,6 +135,26 @@ func TestCredentialsResetPassword(t *testing.T) {
 
 			expectStatus: http.StatusForbidden,
 		},
+		{
+			name: "Error/ShortCodeTooManyAttempts",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"password": "Louvre",
+				"shortCode": "abcdef",
+				"userID": "00000000-0000-0000-0000-000000000001"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdatePasswordRequest{
+					Password:  "Louvre",
+					ShortCode: "abcdef",
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: core.ErrShortCodeConsumeTooManyAttempts,
+			},
+
+			expectStatus: http.StatusGone,
+		},
 		{
 			name: "Error/Internal",
 
@@ -58,6 +58,7 @@ func (handler *CredentialsUpdateEmail) ServeHTTP(w http.ResponseWriter, r *http.
 			dao.ErrCredentialsUpdateEmailAlreadyExists: http.StatusConflict,
 			dao.ErrShortCodeSelectNotFound:             http.StatusForbidden,
 			core.ErrShortCodeConsumeInvalid:            http.StatusForbidden,
+			core.ErrShortCodeConsumeTooManyAttempts:    http.StatusGone,
 			core.ErrInvalidRequest:                     http.StatusUnprocessableEntity,
 		}, err)
 
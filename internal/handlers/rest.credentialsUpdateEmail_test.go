@@ -56,7 +56,7 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 				resp: &core.Credentials{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "user@provider.com",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
 				},
@@ -65,7 +65,7 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 			expectResponse: map[string]any{
 				"id":        "00000000-0000-0000-0000-000000000001",
 				"email":     "user@provider.com",
-				"role":      config.RoleUser,
+				"roles":     []any{config.RoleUser},
 				"createdAt": "2018-02-02T12:00:00Z",
 				"updatedAt": "2020-02-02T12:00:00Z",
 			},
@@ -85,7 +85,7 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 				resp: &core.Credentials{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "user@provider.com",
-					Role:      config.RoleUser,
+					Roles:     []string{config.RoleUser},
 					CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
 				},
@@ -94,7 +94,7 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 			expectResponse: map[string]any{
 				"id":        "00000000-0000-0000-0000-000000000001",
 				"email":     "user@provider.com",
-				"role":      config.RoleUser,
+				"roles":     []any{config.RoleUser},
 				"createdAt": "2018-02-02T12:00:00Z",
 				"updatedAt": "2020-02-02T12:00:00Z",
 			},
@@ -164,6 +164,22 @@ func TestCredentialsUpdateEmail(t *testing.T) {
 
 			expectStatus: http.StatusForbidden,
 		},
+		{
+			name: "Error/ShortCodeTooManyAttempts",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdateEmailRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: core.ErrShortCodeConsumeTooManyAttempts,
+			},
+
+			expectStatus: http.StatusGone,
+		},
 		{
 			name: "Error/Internal",
 
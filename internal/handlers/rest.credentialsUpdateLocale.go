@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsUpdateLocaleService interface {
+	Exec(ctx context.Context, request *core.CredentialsUpdateLocaleRequest) (*core.Credentials, error)
+}
+
+type CredentialsUpdateLocaleRequest struct {
+	Lang string `json:"lang"`
+}
+
+type CredentialsUpdateLocale struct {
+	service CredentialsUpdateLocaleService
+	logger  logging.Log
+}
+
+func NewCredentialsUpdateLocale(service CredentialsUpdateLocaleService, logger logging.Log) *CredentialsUpdateLocale {
+	return &CredentialsUpdateLocale{service: service, logger: logger}
+}
+
+func (handler *CredentialsUpdateLocale) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsUpdateLocale")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsUpdateLocaleRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsUpdateLocaleRequest{
+		UserID: lo.FromPtr(claims.UserID),
+		Lang:   request.Lang,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsUpdateLocaleNotFound: http.StatusNotFound,
+			core.ErrInvalidRequest:                 http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadCredentials(res))
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type CredentialsUpdateNoticesService interface {
+	Exec(ctx context.Context, request *core.CredentialsUpdateNoticesRequest) (*core.Credentials, error)
+}
+
+type CredentialsUpdateNoticesRequest struct {
+	OptOut bool `json:"optOut"`
+}
+
+type CredentialsUpdateNotices struct {
+	service CredentialsUpdateNoticesService
+	logger  logging.Log
+}
+
+func NewCredentialsUpdateNotices(
+	service CredentialsUpdateNoticesService, logger logging.Log,
+) *CredentialsUpdateNotices {
+	return &CredentialsUpdateNotices{service: service, logger: logger}
+}
+
+func (handler *CredentialsUpdateNotices) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsUpdateNotices")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsUpdateNoticesRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsUpdateNoticesRequest{
+		UserID: lo.FromPtr(claims.UserID),
+		OptOut: request.OptOut,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsUpdateNoticesNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadCredentials(res))
+}
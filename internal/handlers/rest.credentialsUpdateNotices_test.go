@@ -0,0 +1,169 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsUpdateNotices(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsUpdateNoticesRequest
+		resp *core.Credentials
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *core.AccessTokenClaims
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPatch, "/", strings.NewReader(`{
+				"optOut": true
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdateNoticesRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OptOut: true,
+				},
+				resp: &core.Credentials{
+					ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:         "user@provider.com",
+					Roles:         []string{config.RoleUser},
+					NoticesOptOut: true,
+					CreatedAt:     time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+					UpdatedAt:     time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":            "00000000-0000-0000-0000-000000000001",
+				"email":         "user@provider.com",
+				"roles":         []any{config.RoleUser},
+				"noticesOptOut": true,
+				"createdAt":     "2018-02-02T12:00:00Z",
+				"updatedAt":     "2020-02-02T12:00:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/CredentialsNotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPatch, "/", strings.NewReader(`{
+				"optOut": true
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdateNoticesRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OptOut: true,
+				},
+				err: dao.ErrCredentialsUpdateNoticesNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPatch, "/", strings.NewReader(`{`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPatch, "/", strings.NewReader(`{
+				"optOut": true
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdateNoticesRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					OptOut: true,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsUpdateNoticesService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsUpdateNotices(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
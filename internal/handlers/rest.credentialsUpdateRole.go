@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 
@@ -23,7 +24,8 @@ type CredentialsUpdateRoleService interface {
 
 type CredentialsUpdateRoleRequest struct {
 	UserID uuid.UUID `json:"userID"`
-	Role   string    `json:"role"`
+	Add    []string  `json:"add"`
+	Remove []string  `json:"remove"`
 }
 
 type CredentialsUpdateRole struct {
@@ -40,6 +42,8 @@ func (handler *CredentialsUpdateRole) ServeHTTP(w http.ResponseWriter, r *http.R
 	defer span.End()
 
 	decoder := json.NewDecoder(r.Body)
+	// Refuse the single "role" field of the previous body, rather than silently ignoring it.
+	decoder.DisallowUnknownFields()
 
 	var request CredentialsUpdateRoleRequest
 
@@ -60,7 +64,9 @@ func (handler *CredentialsUpdateRole) ServeHTTP(w http.ResponseWriter, r *http.R
 	res, err := handler.service.Exec(ctx, &core.CredentialsUpdateRoleRequest{
 		TargetUserID:  request.UserID,
 		CurrentUserID: lo.FromPtr(claims.UserID),
-		Role:          request.Role,
+		Add:           request.Add,
+		Remove:        request.Remove,
+		RequestID:     middleware.GetReqID(ctx),
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
@@ -70,6 +76,7 @@ func (handler *CredentialsUpdateRole) ServeHTTP(w http.ResponseWriter, r *http.R
 			core.ErrCredentialsUpdateRoleToHigher:          http.StatusForbidden,
 			core.ErrCredentialsUpdateRoleDowngradeSuperior: http.StatusForbidden,
 			core.ErrCredentialsUpdateRoleSelfUpdate:        http.StatusForbidden,
+			core.ErrCredentialsUpdateRoleNoRole:            http.StatusUnprocessableEntity,
 			core.ErrInvalidRequest:                         http.StatusUnprocessableEntity,
 		}, err)
 
@@ -50,7 +50,8 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
-				"role": "auth:admin"
+				"add": ["auth:admin"],
+				"remove": ["auth:user"]
 			}`)),
 			claims: &core.AccessTokenClaims{
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
@@ -58,14 +59,15 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
+					Remove:        []string{config.RoleUser},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
 				resp: &core.Credentials{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					Email:     "user@provider.com",
-					Role:      config.RoleAdmin,
+					Roles:     []string{config.RoleAdmin},
 					CreatedAt: time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
 					UpdatedAt: time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
 				},
@@ -74,7 +76,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 			expectResponse: map[string]any{
 				"id":        "00000000-0000-0000-0000-000000000001",
 				"email":     "user@provider.com",
-				"role":      config.RoleAdmin,
+				"roles":     []any{config.RoleAdmin},
 				"createdAt": "2018-02-02T12:00:00Z",
 				"updatedAt": "2020-02-02T12:00:00Z",
 			},
@@ -85,7 +87,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
-				"role": "auth:admin"
+				"add": ["auth:admin"]
 			}`)),
 			claims: &core.AccessTokenClaims{
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
@@ -93,7 +95,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
@@ -107,7 +109,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
-				"role": "auth:admin"
+				"add": ["auth:admin"]
 			}`)),
 			claims: &core.AccessTokenClaims{
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
@@ -115,7 +117,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
@@ -130,7 +132,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
-				"role": "auth:admin"
+				"add": ["auth:admin"]
 			}`)),
 			claims: &core.AccessTokenClaims{
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
@@ -138,7 +140,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
@@ -152,7 +154,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
-				"role": "auth:admin"
+				"add": ["auth:admin"]
 			}`)),
 			claims: &core.AccessTokenClaims{
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
@@ -160,7 +162,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
@@ -174,7 +176,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
-				"role": "auth:admin"
+				"add": ["auth:admin"]
 			}`)),
 			claims: &core.AccessTokenClaims{
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
@@ -182,7 +184,7 @@ func TestCredentialsUpdateRole(t *testing.T) {
 
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
@@ -192,8 +194,31 @@ func TestCredentialsUpdateRole(t *testing.T) {
 			expectStatus: http.StatusForbidden,
 		},
 		{
-			name: "Error/Internal",
+			name: "Error/NoRole",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000002",
+				"remove": ["auth:user"]
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
 
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdateRoleRequest{
+					Remove:        []string{config.RoleUser},
+					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: core.ErrCredentialsUpdateRoleNoRole,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/UnknownField",
+
+			// The single role field of the previous body.
 			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
 				"userID": "00000000-0000-0000-0000-000000000002",
 				"role": "auth:admin"
@@ -202,9 +227,42 @@ func TestCredentialsUpdateRole(t *testing.T) {
 				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
 			},
 
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NoChange",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000002"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsUpdateRoleRequest{
+					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000002",
+				"add": ["auth:admin"]
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
 			serviceMock: &serviceMock{
 				req: &core.CredentialsUpdateRoleRequest{
-					Role:          config.RoleAdmin,
+					Add:           []string{config.RoleAdmin},
 					TargetUserID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					CurrentUserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				},
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+)
+
+type CredentialsVerifyEmailService interface {
+	Exec(ctx context.Context, request *core.CredentialsVerifyEmailRequest) (*core.Credentials, error)
+}
+
+type CredentialsVerifyEmailRequest struct {
+	UserID    uuid.UUID `json:"userID"`
+	ShortCode string    `json:"shortCode"`
+}
+
+type CredentialsVerifyEmail struct {
+	service CredentialsVerifyEmailService
+	logger  logging.Log
+}
+
+func NewCredentialsVerifyEmail(service CredentialsVerifyEmailService, logger logging.Log) *CredentialsVerifyEmail {
+	return &CredentialsVerifyEmail{service: service, logger: logger}
+}
+
+func (handler *CredentialsVerifyEmail) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.CredentialsVerifyEmail")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request CredentialsVerifyEmailRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.CredentialsVerifyEmailRequest{
+		UserID:    request.UserID,
+		ShortCode: request.ShortCode,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsSelectNotFound:              http.StatusNotFound,
+			dao.ErrCredentialsUpdateEmailVerifiedNotFound: http.StatusNotFound,
+			dao.ErrShortCodeSelectNotFound:                http.StatusForbidden,
+			core.ErrShortCodeConsumeInvalid:               http.StatusForbidden,
+			core.ErrShortCodeConsumeTooManyAttempts:       http.StatusGone,
+			core.ErrCredentialsVerifyEmailChanged:         http.StatusForbidden,
+			core.ErrInvalidRequest:                        http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadCredentials(res))
+}
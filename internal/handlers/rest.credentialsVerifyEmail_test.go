@@ -0,0 +1,238 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestCredentialsVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.CredentialsVerifyEmailRequest
+		resp *core.Credentials
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				resp: &core.Credentials{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:           "user@provider.com",
+					Roles:           []string{config.RoleUser},
+					EmailVerifiedAt: lo.ToPtr(time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC)),
+					CreatedAt:       time.Date(2018, time.February, 2, 12, 0, 0, 0, time.UTC),
+					UpdatedAt:       time.Date(2020, time.February, 2, 12, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":              "00000000-0000-0000-0000-000000000001",
+				"email":           "user@provider.com",
+				"roles":           []any{config.RoleUser},
+				"emailVerifiedAt": "2020-02-02T12:00:00Z",
+				"createdAt":       "2018-02-02T12:00:00Z",
+				"updatedAt":       "2020-02-02T12:00:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/CredentialsNotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/ShortCodeNotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: dao.ErrShortCodeSelectNotFound,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/ShortCodeInvalid",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: core.ErrShortCodeConsumeInvalid,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/ShortCodeTooManyAttempts",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: core.ErrShortCodeConsumeTooManyAttempts,
+			},
+
+			expectStatus: http.StatusGone,
+		},
+		{
+			name: "Error/EmailChanged",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: core.ErrCredentialsVerifyEmailChanged,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000001",
+				"shortCode": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.CredentialsVerifyEmailRequest{
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ShortCode: "abcdef",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockCredentialsVerifyEmailService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewCredentialsVerifyEmail(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+)
+
+// langMatcher matches the languages accepted by a client against the languages the
+// service can render emails in.
+var langMatcher = language.NewMatcher(lo.Map(config.KnownLangs, func(lang string, _ int) language.Tag {
+	return language.Make(lang)
+}))
+
+// negotiateLang returns the known language that best matches the Accept-Language header of
+// the request, or an empty string when the header is missing, malformed, or matches none of
+// them.
+func negotiateLang(r *http.Request) string {
+	header := r.Header.Get("Accept-Language")
+	if header == "" {
+		return ""
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return ""
+	}
+
+	_, index, confidence := langMatcher.Match(tags...)
+	if confidence == language.No {
+		return ""
+	}
+
+	return config.KnownLangs[index]
+}
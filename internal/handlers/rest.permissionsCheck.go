@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type PermissionsCheckService interface {
+	Exec(ctx context.Context, request *core.PermissionsCheckRequest) (*core.PermissionsCheckResult, error)
+}
+
+type PermissionsCheckRequest struct {
+	AccessToken string     `json:"accessToken"`
+	UserID      *uuid.UUID `json:"userID"`
+	Permissions []string   `json:"permissions"`
+}
+
+// PermissionsCheckResponse tells, for every checked permission, whether it is granted.
+type PermissionsCheckResponse struct {
+	UserID      *uuid.UUID      `json:"userID,omitempty"`
+	Roles       []string        `json:"roles"`
+	Permissions map[string]bool `json:"permissions"`
+}
+
+type PermissionsCheck struct {
+	service PermissionsCheckService
+	logger  logging.Log
+}
+
+func NewPermissionsCheck(service PermissionsCheckService, logger logging.Log) *PermissionsCheck {
+	return &PermissionsCheck{service: service, logger: logger}
+}
+
+func (handler *PermissionsCheck) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.PermissionsCheck")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request PermissionsCheckRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.PermissionsCheckRequest{
+		AccessToken:   request.AccessToken,
+		UserID:        request.UserID,
+		Permissions:   request.Permissions,
+		CurrentUserID: claims.UserID,
+		CurrentRoles:  claims.Roles,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsSelectNotFound:     http.StatusNotFound,
+			core.ErrPermissionsCheckForbidden:    http.StatusForbidden,
+			core.ErrPermissionsCheckInvalidToken: http.StatusUnprocessableEntity,
+			core.ErrInvalidRequest:               http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, PermissionsCheckResponse{
+		UserID:      res.UserID,
+		Roles:       lo.CoalesceSliceOrEmpty(res.Roles),
+		Permissions: res.Permissions,
+	})
+}
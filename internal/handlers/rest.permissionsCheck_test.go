@@ -0,0 +1,246 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestPermissionsCheck(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	claims := &core.AccessTokenClaims{UserID: &callerID, Roles: []string{config.RoleAdmin}}
+
+	type serviceMock struct {
+		req  *core.PermissionsCheckRequest
+		resp *core.PermissionsCheckResult
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *core.AccessTokenClaims
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success/UserID",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000002",
+				"permissions": ["credentials:get", "credentials:list"]
+			}`)),
+			claims: claims,
+
+			serviceMock: &serviceMock{
+				req: &core.PermissionsCheckRequest{
+					UserID:        &userID,
+					Permissions:   []string{"credentials:get", "credentials:list"},
+					CurrentUserID: &callerID,
+					CurrentRoles:  []string{config.RoleAdmin},
+				},
+				resp: &core.PermissionsCheckResult{
+					UserID:      &userID,
+					Roles:       []string{config.RoleUser},
+					Permissions: map[string]bool{"credentials:get": false, "credentials:list": false},
+				},
+			},
+
+			expectStatus: http.StatusOK,
+			expectResponse: map[string]any{
+				"userID":      "00000000-0000-0000-0000-000000000002",
+				"roles":       []any{config.RoleUser},
+				"permissions": map[string]any{"credentials:get": false, "credentials:list": false},
+			},
+		},
+		{
+			name: "Success/Token",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"accessToken": "token",
+				"permissions": ["credentials:get"]
+			}`)),
+			claims: claims,
+
+			serviceMock: &serviceMock{
+				req: &core.PermissionsCheckRequest{
+					AccessToken:   "token",
+					Permissions:   []string{"credentials:get"},
+					CurrentUserID: &callerID,
+					CurrentRoles:  []string{config.RoleAdmin},
+				},
+				resp: &core.PermissionsCheckResult{
+					Roles:       []string{config.RoleAnon},
+					Permissions: map[string]bool{"credentials:get": false},
+				},
+			},
+
+			expectStatus: http.StatusOK,
+			expectResponse: map[string]any{
+				"roles":       []any{config.RoleAnon},
+				"permissions": map[string]any{"credentials:get": false},
+			},
+		},
+		{
+			name: "Error/InvalidBody",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{`)),
+			claims:  claims,
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NoClaims",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"permissions": ["credentials:get"]
+			}`)),
+
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000002",
+				"permissions": ["credentials:get"]
+			}`)),
+			claims: claims,
+
+			serviceMock: &serviceMock{
+				req: &core.PermissionsCheckRequest{
+					UserID:        &userID,
+					Permissions:   []string{"credentials:get"},
+					CurrentUserID: &callerID,
+					CurrentRoles:  []string{config.RoleAdmin},
+				},
+				err: dao.ErrCredentialsSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/Forbidden",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"userID": "00000000-0000-0000-0000-000000000002",
+				"permissions": ["credentials:get"]
+			}`)),
+			claims: claims,
+
+			serviceMock: &serviceMock{
+				req: &core.PermissionsCheckRequest{
+					UserID:        &userID,
+					Permissions:   []string{"credentials:get"},
+					CurrentUserID: &callerID,
+					CurrentRoles:  []string{config.RoleAdmin},
+				},
+				err: core.ErrPermissionsCheckForbidden,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidToken",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"accessToken": "token",
+				"permissions": ["credentials:get"]
+			}`)),
+			claims: claims,
+
+			serviceMock: &serviceMock{
+				req: &core.PermissionsCheckRequest{
+					AccessToken:   "token",
+					Permissions:   []string{"credentials:get"},
+					CurrentUserID: &callerID,
+					CurrentRoles:  []string{config.RoleAdmin},
+				},
+				err: core.ErrPermissionsCheckInvalidToken,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"permissions": ["credentials:get"]
+			}`)),
+			claims: claims,
+
+			serviceMock: &serviceMock{
+				req: &core.PermissionsCheckRequest{
+					Permissions:   []string{"credentials:get"},
+					CurrentUserID: &callerID,
+					CurrentRoles:  []string{config.RoleAdmin},
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockPermissionsCheckService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewPermissionsCheck(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			if testCase.claims != nil {
+				rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
+			}
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
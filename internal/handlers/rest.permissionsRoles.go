@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+type PermissionsRolesService interface {
+	Exec(ctx context.Context, request *core.PermissionsRolesRequest) (map[string][]string, error)
+}
+
+// PermissionsRolesResponse maps every role to the permissions it grants, inherited ones included.
+type PermissionsRolesResponse struct {
+	Roles map[string][]string `json:"roles"`
+}
+
+// PermissionsRoles is the REST handler that exposes the resolved permissions of every role.
+type PermissionsRoles struct {
+	service PermissionsRolesService
+	logger  logging.Log
+}
+
+func NewPermissionsRoles(service PermissionsRolesService, logger logging.Log) *PermissionsRoles {
+	return &PermissionsRoles{service: service, logger: logger}
+}
+
+func (handler *PermissionsRoles) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.PermissionsRoles")
+	defer span.End()
+
+	res, err := handler.service.Exec(ctx, &core.PermissionsRolesRequest{})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, PermissionsRolesResponse{Roles: res})
+}
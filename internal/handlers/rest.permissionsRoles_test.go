@@ -0,0 +1,96 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestPermissionsRoles(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		resp map[string][]string
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			serviceMock: &serviceMock{
+				resp: map[string][]string{
+					config.RoleAnon: {"credentials:create"},
+					config.RoleUser: {"credentials:create", "credentials:password:patch"},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"roles": map[string]any{
+					config.RoleAnon: []any{"credentials:create"},
+					config.RoleUser: []any{"credentials:create", "credentials:password:patch"},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/Internal",
+
+			serviceMock: &serviceMock{
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockPermissionsRolesService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, &core.PermissionsRolesRequest{}).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewPermissionsRoles(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
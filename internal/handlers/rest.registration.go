@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+// Reasons reported when the registration policy refuses an email address.
+const (
+	RegistrationRefusedClosed           = "registration_closed"
+	RegistrationRefusedDomainNotAllowed = "domain_not_allowed"
+	RegistrationRefusedDomainDenied     = "domain_denied"
+	RegistrationRefusedDisposableEmail  = "disposable_email"
+)
+
+var registrationRefusedReasons = []struct {
+	err    error
+	reason string
+}{
+	{core.ErrRegistrationClosed, RegistrationRefusedClosed},
+	{core.ErrRegistrationDomainNotAllowed, RegistrationRefusedDomainNotAllowed},
+	{core.ErrRegistrationDomainDenied, RegistrationRefusedDomainDenied},
+	{core.ErrRegistrationDisposableEmail, RegistrationRefusedDisposableEmail},
+}
+
+// RegistrationRefused is the body of a 403 answered when the registration policy refuses
+// an email address. Reason is one of the RegistrationRefused* constants, so clients can
+// explain the refusal.
+type RegistrationRefused struct {
+	Reason string `json:"reason"`
+}
+
+// handleRegistrationRefused answers a 403 with a [RegistrationRefused] body if err is a
+// registration policy refusal, and reports whether it did. Other errors are left to the
+// caller.
+func handleRegistrationRefused(
+	ctx context.Context, logger logging.Log, w http.ResponseWriter, span trace.Span, err error,
+) bool {
+	for _, item := range registrationRefusedReasons {
+		if !errors.Is(err, item.err) {
+			continue
+		}
+
+		// Not sent with httpf.SendJSONStatus, which would mark the span successful.
+		err = otel.ReportError(span, err)
+		logger.Warn(ctx, err.Error())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+
+		encodeErr := json.NewEncoder(w).Encode(RegistrationRefused{Reason: item.reason})
+		if encodeErr != nil {
+			logger.Err(ctx, encodeErr.Error())
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+// Role is the JSON representation of a role definition returned by the roles endpoints.
+type Role struct {
+	Name        string    `json:"name"`
+	Priority    int       `json:"priority"`
+	Permissions []string  `json:"permissions"`
+	Inherits    []string  `json:"inherits"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func loadRole(s *core.Role) Role {
+	return Role{
+		Name:        s.Name,
+		Priority:    s.Priority,
+		Permissions: lo.CoalesceSliceOrEmpty(s.Permissions),
+		Inherits:    lo.CoalesceSliceOrEmpty(s.Inherits),
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+func loadRoleMap(item *core.Role, _ int) Role {
+	return loadRole(item)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type RoleCreateService interface {
+	Exec(ctx context.Context, request *core.RoleCreateRequest) (*core.Role, error)
+}
+
+type RoleCreateRequest struct {
+	Name        string   `json:"name"`
+	Priority    int      `json:"priority"`
+	Permissions []string `json:"permissions"`
+	Inherits    []string `json:"inherits"`
+}
+
+type RoleCreate struct {
+	service RoleCreateService
+	logger  logging.Log
+}
+
+func NewRoleCreate(service RoleCreateService, logger logging.Log) *RoleCreate {
+	return &RoleCreate{service: service, logger: logger}
+}
+
+func (handler *RoleCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.RoleCreate")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request RoleCreateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.RoleCreateRequest{
+		Name:          request.Name,
+		Priority:      request.Priority,
+		Permissions:   request.Permissions,
+		Inherits:      request.Inherits,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrRoleInsertAlreadyExists: http.StatusConflict,
+			core.ErrInvalidRequest:         http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadRole(res))
+}
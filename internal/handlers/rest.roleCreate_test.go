@@ -0,0 +1,181 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestRoleCreate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type serviceMock struct {
+		req  *core.RoleCreateRequest
+		resp *core.Role
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodPut, "/",
+				strings.NewReader(`{"name":"test:custom","priority":1,"permissions":["custom:read"],"inherits":["auth:user"]}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.RoleCreateRequest{
+					Name:          "test:custom",
+					Priority:      1,
+					Permissions:   []string{"custom:read"},
+					Inherits:      []string{config.RoleUser},
+					CurrentUserID: callerID,
+				},
+				resp: &core.Role{
+					Name:        "test:custom",
+					Priority:    1,
+					Permissions: []string{"custom:read"},
+					Inherits:    []string{config.RoleUser},
+					CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"name":        "test:custom",
+				"priority":    float64(1),
+				"permissions": []any{"custom:read"},
+				"inherits":    []any{config.RoleUser},
+				"createdAt":   "2021-01-01T00:00:00Z",
+				"updatedAt":   "2021-01-02T00:00:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadBody",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodPut, "/", strings.NewReader(`{`),
+			),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodPut, "/", strings.NewReader(`{"name": "test:custom"}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.RoleCreateRequest{
+					Name:          "test:custom",
+					CurrentUserID: callerID,
+				},
+				err: dao.ErrRoleInsertAlreadyExists,
+			},
+
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodPut, "/", strings.NewReader(`{"name": "test:custom"}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.RoleCreateRequest{
+					Name:          "test:custom",
+					CurrentUserID: callerID,
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodPut, "/", strings.NewReader(`{"name": "test:custom"}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.RoleCreateRequest{
+					Name:          "test:custom",
+					CurrentUserID: callerID,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockRoleCreateService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewRoleCreate(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{UserID: lo.ToPtr(callerID)})
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
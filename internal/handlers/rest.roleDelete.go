@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type RoleDeleteService interface {
+	Exec(ctx context.Context, request *core.RoleDeleteRequest) (*core.Role, error)
+}
+
+type RoleDeleteRequest struct {
+	Name string `schema:"name"`
+}
+
+type RoleDelete struct {
+	service RoleDeleteService
+	logger  logging.Log
+}
+
+func NewRoleDelete(service RoleDeleteService, logger logging.Log) *RoleDelete {
+	return &RoleDelete{service: service, logger: logger}
+}
+
+func (handler *RoleDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.RoleDelete")
+	defer span.End()
+
+	var request RoleDeleteRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.RoleDeleteRequest{
+		Name:          request.Name,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrRoleDeleteNotFound: http.StatusNotFound,
+			dao.ErrRoleDeleteInUse:    http.StatusConflict,
+			core.ErrRoleDeleteBuiltIn: http.StatusForbidden,
+			core.ErrInvalidRequest:    http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadRole(res))
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+type RoleListService interface {
+	Exec(ctx context.Context, request *core.RoleListRequest) ([]*core.Role, error)
+}
+
+// RoleListResponse is the JSON representation of every role definition.
+type RoleListResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+// RoleList is the REST handler that lists the role definitions.
+type RoleList struct {
+	service RoleListService
+	logger  logging.Log
+}
+
+func NewRoleList(service RoleListService, logger logging.Log) *RoleList {
+	return &RoleList{service: service, logger: logger}
+}
+
+func (handler *RoleList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.RoleList")
+	defer span.End()
+
+	res, err := handler.service.Exec(ctx, &core.RoleListRequest{})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, RoleListResponse{
+		Roles: lo.Map(res, loadRoleMap),
+	})
+}
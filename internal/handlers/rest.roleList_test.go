@@ -0,0 +1,134 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestRoleList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		resp []*core.Role
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			serviceMock: &serviceMock{
+				resp: []*core.Role{
+					{
+						Name:      config.RoleUser,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						Name:        "test:custom",
+						Priority:    1,
+						Permissions: []string{"custom:read"},
+						Inherits:    []string{config.RoleUser},
+						CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"roles": []any{
+					map[string]any{
+						"name":        config.RoleUser,
+						"priority":    float64(0),
+						"permissions": []any{},
+						"inherits":    []any{},
+						"createdAt":   "2021-01-01T00:00:00Z",
+						"updatedAt":   "2021-01-01T00:00:00Z",
+					},
+					map[string]any{
+						"name":        "test:custom",
+						"priority":    float64(1),
+						"permissions": []any{"custom:read"},
+						"inherits":    []any{config.RoleUser},
+						"createdAt":   "2021-01-01T00:00:00Z",
+						"updatedAt":   "2021-01-02T00:00:00Z",
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/Empty",
+
+			serviceMock: &serviceMock{
+				resp: []*core.Role{},
+			},
+
+			expectResponse: map[string]any{
+				"roles": []any{},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/Internal",
+
+			serviceMock: &serviceMock{
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockRoleListService(t)
+
+			service.EXPECT().
+				Exec(mock.Anything, &core.RoleListRequest{}).
+				Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+
+			handler := handlers.NewRoleList(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type RoleUpdateService interface {
+	Exec(ctx context.Context, request *core.RoleUpdateRequest) (*core.Role, error)
+}
+
+type RoleUpdateRequest struct {
+	Name        string   `json:"name"`
+	Priority    int      `json:"priority"`
+	Permissions []string `json:"permissions"`
+	Inherits    []string `json:"inherits"`
+}
+
+type RoleUpdate struct {
+	service RoleUpdateService
+	logger  logging.Log
+}
+
+func NewRoleUpdate(service RoleUpdateService, logger logging.Log) *RoleUpdate {
+	return &RoleUpdate{service: service, logger: logger}
+}
+
+func (handler *RoleUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.RoleUpdate")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request RoleUpdateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.RoleUpdateRequest{
+		Name:          request.Name,
+		Priority:      request.Priority,
+		Permissions:   request.Permissions,
+		Inherits:      request.Inherits,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrRoleUpdateNotFound: http.StatusNotFound,
+			core.ErrInvalidRequest:    http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadRole(res))
+}
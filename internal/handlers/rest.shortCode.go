@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+// ShortCode is the JSON representation of the metadata of a short code, returned by the short
+// code administration endpoints. The code itself is never returned.
+type ShortCode struct {
+	ID             uuid.UUID  `json:"id"`
+	Usage          string     `json:"usage"`
+	Target         string     `json:"target"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	DeletedAt      *time.Time `json:"deletedAt,omitempty"`
+	DeletedComment *string    `json:"deletedComment,omitempty"`
+}
+
+func loadShortCode(s *core.ShortCodeMetadata) ShortCode {
+	return ShortCode{
+		ID:             s.ID,
+		Usage:          s.Usage,
+		Target:         s.Target,
+		Status:         s.Status,
+		Attempts:       s.Attempts,
+		CreatedAt:      s.CreatedAt,
+		ExpiresAt:      s.ExpiresAt,
+		DeletedAt:      s.DeletedAt,
+		DeletedComment: s.DeletedComment,
+	}
+}
+
+func loadShortCodeMap(item *core.ShortCodeMetadata, _ int) ShortCode {
+	return loadShortCode(item)
+}
+
+// setShortCodeRetryAfter tells the client when to try again, if a short code resend was refused
+// because of its cooldown.
+func setShortCodeRetryAfter(w http.ResponseWriter, err error) {
+	var cooldownErr *core.ShortCodeCreateResendCooldownError
+	if errors.As(err, &cooldownErr) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(cooldownErr.RetryAfter.Seconds()))))
+	}
+}
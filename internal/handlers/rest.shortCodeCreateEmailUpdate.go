@@ -29,6 +29,7 @@ type ShortCodeCreateEmailUpdateRequest struct {
 type ShortCodeCreateEmailUpdate struct {
 	service ShortCodeCreateEmailUpdateService
 	logger  logging.Log
+	resend  bool
 }
 
 func NewShortCodeCreateEmailUpdate(
@@ -37,6 +38,14 @@ func NewShortCodeCreateEmailUpdate(
 	return &ShortCodeCreateEmailUpdate{service: service, logger: logger}
 }
 
+// NewShortCodeResendEmailUpdate returns a handler that re-issues the active short code instead of creating
+// a new one. The new code keeps the expiry of the previous one.
+func NewShortCodeResendEmailUpdate(
+	service ShortCodeCreateEmailUpdateService, logger logging.Log,
+) *ShortCodeCreateEmailUpdate {
+	return &ShortCodeCreateEmailUpdate{service: service, logger: logger, resend: true}
+}
+
 func (handler *ShortCodeCreateEmailUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeCreateEmailUpdate")
 	defer span.End()
@@ -60,15 +69,20 @@ func (handler *ShortCodeCreateEmailUpdate) ServeHTTP(w http.ResponseWriter, r *h
 	}
 
 	_, err = handler.service.Exec(ctx, &core.ShortCodeCreateEmailUpdateRequest{
-		Email: request.Email,
-		Lang:  request.Lang,
-		ID:    lo.FromPtr(claims.UserID),
+		Email:        request.Email,
+		Lang:         request.Lang,
+		FallbackLang: negotiateLang(r),
+		ID:           lo.FromPtr(claims.UserID),
+		Resend:       handler.resend,
 	})
 	if err != nil {
 		// Silently succeed when the email already exists, so a caller cannot probe which addresses are registered.
 		if !errors.Is(err, dao.ErrCredentialsUpdateEmailAlreadyExists) {
+			setShortCodeRetryAfter(w, err)
 			httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-				core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+				core.ErrShortCodeCreateResendNotFound: http.StatusNotFound,
+				core.ErrShortCodeCreateResendCooldown: http.StatusTooManyRequests,
+				core.ErrInvalidRequest:                http.StatusUnprocessableEntity,
 			}, err)
 
 			return
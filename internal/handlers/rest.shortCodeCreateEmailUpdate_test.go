@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -36,13 +37,17 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		request *http.Request
-		claims  *core.AccessTokenClaims
+		request        *http.Request
+		acceptLanguage string
+		claims         *core.AccessTokenClaims
+
+		resend bool
 
 		serviceMock *serviceMock
 
-		expectStatus   int
-		expectResponse any
+		expectStatus     int
+		expectRetryAfter string
+		expectResponse   any
 	}{
 		{
 			name: "Success",
@@ -71,6 +76,35 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 
 			expectStatus: http.StatusAccepted,
 		},
+		{
+			name: "Success/AcceptLanguage",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "en"
+			}`)),
+			acceptLanguage: "fr",
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateEmailUpdateRequest{
+					Email:        "new_user@provider.com",
+					Lang:         "en",
+					FallbackLang: "fr",
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageValidateEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
 		{
 			name: "Success/EmailAlreadyExists",
 
@@ -93,6 +127,60 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 
 			expectStatus: http.StatusAccepted,
 		},
+		{
+			name: "Success/Resend",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateEmailUpdateRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Resend: true,
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageValidateEmail,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Error/ResendCooldown",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateEmailUpdateRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Resend: true,
+				},
+				err: &core.ShortCodeCreateResendCooldownError{RetryAfter: 49200 * time.Millisecond},
+			},
+
+			expectStatus:     http.StatusTooManyRequests,
+			expectRetryAfter: "50",
+		},
 		{
 			name: "Error/Internal",
 
@@ -130,8 +218,16 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 			}
 
 			handler := handlers.NewShortCodeCreateEmailUpdate(service, config.LoggerDev)
+			if testCase.resend {
+				handler = handlers.NewShortCodeResendEmailUpdate(service, config.LoggerDev)
+			}
+
 			w := httptest.NewRecorder()
 
+			if testCase.acceptLanguage != "" {
+				testCase.request.Header.Set("Accept-Language", testCase.acceptLanguage)
+			}
+
 			rCtx := testCase.request.Context()
 			rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
 
@@ -140,6 +236,7 @@ func TestShortCodeCreateEmailUpdate(t *testing.T) {
 			res := w.Result()
 
 			require.Equal(t, testCase.expectStatus, res.StatusCode)
+			require.Equal(t, testCase.expectRetryAfter, res.Header.Get("Retry-After"))
 
 			if testCase.expectResponse != nil {
 				data, err := io.ReadAll(res.Body)
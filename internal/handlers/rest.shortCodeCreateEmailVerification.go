@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type ShortCodeCreateEmailVerificationService interface {
+	Exec(ctx context.Context, request *core.ShortCodeCreateEmailVerificationRequest) (*core.ShortCode, error)
+}
+
+type ShortCodeCreateEmailVerificationRequest struct {
+	Lang string `json:"lang"`
+}
+
+type ShortCodeCreateEmailVerification struct {
+	service ShortCodeCreateEmailVerificationService
+	logger  logging.Log
+	resend  bool
+}
+
+func NewShortCodeCreateEmailVerification(
+	service ShortCodeCreateEmailVerificationService, logger logging.Log,
+) *ShortCodeCreateEmailVerification {
+	return &ShortCodeCreateEmailVerification{service: service, logger: logger}
+}
+
+// NewShortCodeResendEmailVerification returns a handler that re-issues the active short code instead of creating
+// a new one. The new code keeps the expiry of the previous one.
+func NewShortCodeResendEmailVerification(
+	service ShortCodeCreateEmailVerificationService, logger logging.Log,
+) *ShortCodeCreateEmailVerification {
+	return &ShortCodeCreateEmailVerification{service: service, logger: logger, resend: true}
+}
+
+func (handler *ShortCodeCreateEmailVerification) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeCreateEmailVerification")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ShortCodeCreateEmailVerificationRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	_, err = handler.service.Exec(ctx, &core.ShortCodeCreateEmailVerificationRequest{
+		ID:           lo.FromPtr(claims.UserID),
+		Lang:         request.Lang,
+		FallbackLang: negotiateLang(r),
+		Resend:       handler.resend,
+	})
+	if err != nil {
+		setShortCodeRetryAfter(w, err)
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsSelectNotFound:                        http.StatusNotFound,
+			core.ErrShortCodeCreateResendNotFound:                   http.StatusNotFound,
+			core.ErrShortCodeCreateResendCooldown:                   http.StatusTooManyRequests,
+			core.ErrShortCodeCreateEmailVerificationAlreadyVerified: http.StatusConflict,
+			core.ErrInvalidRequest:                                  http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+}
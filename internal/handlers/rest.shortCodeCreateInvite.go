@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type ShortCodeCreateInviteService interface {
+	Exec(ctx context.Context, request *core.ShortCodeCreateInviteRequest) (*core.ShortCode, error)
+}
+
+type ShortCodeCreateInviteRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	Lang  string `json:"lang"`
+}
+
+type ShortCodeCreateInvite struct {
+	service ShortCodeCreateInviteService
+	logger  logging.Log
+	resend  bool
+}
+
+func NewShortCodeCreateInvite(service ShortCodeCreateInviteService, logger logging.Log) *ShortCodeCreateInvite {
+	return &ShortCodeCreateInvite{service: service, logger: logger}
+}
+
+// NewShortCodeResendInvite returns a handler that re-issues the active short code instead of creating
+// a new one. The new code keeps the expiry of the previous one.
+func NewShortCodeResendInvite(service ShortCodeCreateInviteService, logger logging.Log) *ShortCodeCreateInvite {
+	return &ShortCodeCreateInvite{service: service, logger: logger, resend: true}
+}
+
+func (handler *ShortCodeCreateInvite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeCreateInvite")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ShortCodeCreateInviteRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	_, err = handler.service.Exec(ctx, &core.ShortCodeCreateInviteRequest{
+		InviterID: lo.FromPtr(claims.UserID),
+		Email:     request.Email,
+		Role:      request.Role,
+		Lang:      lo.CoalesceOrEmpty(request.Lang, negotiateLang(r)),
+		Resend:    handler.resend,
+	})
+	if err != nil {
+		// Unlike registration, the existing account is reported: inviters are trusted users,
+		// who can check for existing accounts anyway.
+		setShortCodeRetryAfter(w, err)
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrCredentialsInsertAlreadyExists: http.StatusConflict,
+			dao.ErrCredentialsSelectNotFound:      http.StatusNotFound,
+			core.ErrShortCodeCreateResendNotFound: http.StatusNotFound,
+			core.ErrShortCodeCreateResendCooldown: http.StatusTooManyRequests,
+			core.ErrCredentialsUpdateRoleToHigher: http.StatusForbidden,
+			core.ErrInvalidRequest:                http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,263 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestShortCodeCreateInvite(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.ShortCodeCreateInviteRequest
+		resp *core.ShortCode
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *core.AccessTokenClaims
+
+		resend bool
+
+		serviceMock *serviceMock
+
+		expectStatus     int
+		expectRetryAfter string
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+				},
+				resp: &core.ShortCode{},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+				},
+				err: dao.ErrCredentialsInsertAlreadyExists,
+			},
+
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Error/RoleAboveInviter",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+				},
+				err: core.ErrCredentialsUpdateRoleToHigher,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Success/Resend",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+					Resend:    true,
+				},
+				resp: &core.ShortCode{},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Error/ResendCooldown",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+					Resend:    true,
+				},
+				err: &core.ShortCodeCreateResendCooldownError{RetryAfter: 49200 * time.Millisecond},
+			},
+
+			expectStatus:     http.StatusTooManyRequests,
+			expectRetryAfter: "50",
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPut, "/", strings.NewReader(`{
+				"email": "user@provider.com",
+				"role": "auth:admin",
+				"lang": "fr"
+			}`)),
+			claims: &core.AccessTokenClaims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateInviteRequest{
+					InviterID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Email:     "user@provider.com",
+					Role:      config.RoleAdmin,
+					Lang:      config.LangFR,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockShortCodeCreateInviteService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewShortCodeCreateInvite(service, config.LoggerDev)
+			if testCase.resend {
+				handler = handlers.NewShortCodeResendInvite(service, config.LoggerDev)
+			}
+
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+			require.Equal(t, testCase.expectRetryAfter, res.Header.Get("Retry-After"))
+		})
+	}
+}
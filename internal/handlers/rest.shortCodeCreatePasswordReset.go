@@ -26,6 +26,7 @@ type ShortCodeCreatePasswordResetRequest struct {
 type ShortCodeCreatePasswordReset struct {
 	service ShortCodeCreatePasswordResetService
 	logger  logging.Log
+	resend  bool
 }
 
 func NewShortCodeCreatePasswordReset(
@@ -34,6 +35,14 @@ func NewShortCodeCreatePasswordReset(
 	return &ShortCodeCreatePasswordReset{service: service, logger: logger}
 }
 
+// NewShortCodeResendPasswordReset returns a handler that re-issues the active short code instead of creating
+// a new one. The new code keeps the expiry of the previous one.
+func NewShortCodeResendPasswordReset(
+	service ShortCodeCreatePasswordResetService, logger logging.Log,
+) *ShortCodeCreatePasswordReset {
+	return &ShortCodeCreatePasswordReset{service: service, logger: logger, resend: true}
+}
+
 func (handler *ShortCodeCreatePasswordReset) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeCreatePasswordReset")
 	defer span.End()
@@ -50,12 +59,18 @@ func (handler *ShortCodeCreatePasswordReset) ServeHTTP(w http.ResponseWriter, r
 	}
 
 	_, err = handler.service.Exec(ctx, &core.ShortCodeCreatePasswordResetRequest{
-		Email: request.Email,
-		Lang:  request.Lang,
+		Email:        request.Email,
+		Lang:         request.Lang,
+		FallbackLang: negotiateLang(r),
+		Resend:       handler.resend,
 	})
 	if err != nil {
 		// Silently succeed when the email is unknown, so a caller cannot probe which addresses are registered.
-		if !errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) {
+		// Codes are only issued to known addresses, so a missing code or a cooldown on resend would tell
+		// just as much.
+		if !errors.Is(err, dao.ErrCredentialsSelectByEmailNotFound) &&
+			!errors.Is(err, core.ErrShortCodeCreateResendNotFound) &&
+			!errors.Is(err, core.ErrShortCodeCreateResendCooldown) {
 			httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
 				core.ErrInvalidRequest: http.StatusUnprocessableEntity,
 			}, err)
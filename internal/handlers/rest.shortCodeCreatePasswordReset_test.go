@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -34,12 +35,16 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		request *http.Request
+		request        *http.Request
+		acceptLanguage string
+
+		resend bool
 
 		serviceMock *serviceMock
 
-		expectStatus   int
-		expectResponse any
+		expectStatus     int
+		expectRetryAfter string
+		expectResponse   any
 	}{
 		{
 			name: "Success",
@@ -64,6 +69,51 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 
 			expectStatus: http.StatusAccepted,
 		},
+		{
+			name: "Success/AcceptLanguage",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com"
+			}`)),
+			acceptLanguage: "fr-CH, fr;q=0.9, en;q=0.8",
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreatePasswordResetRequest{
+					Email:        "new_user@provider.com",
+					FallbackLang: "fr",
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageResetPassword,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Success/AcceptLanguageUnknown",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com"
+			}`)),
+			acceptLanguage: "de-DE",
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreatePasswordResetRequest{
+					Email: "new_user@provider.com",
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageResetPassword,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
 		{
 			name: "Success/EmailNotFound",
 
@@ -83,6 +133,73 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 			// Returns 202 to prevent email enumeration.
 			expectStatus: http.StatusAccepted,
 		},
+		{
+			name: "Success/Resend",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreatePasswordResetRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					Resend: true,
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageResetPassword,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Success/ResendNotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreatePasswordResetRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					Resend: true,
+				},
+				err: core.ErrShortCodeCreateResendNotFound,
+			},
+
+			// Returns 202 to prevent email enumeration.
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Success/ResendCooldown",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreatePasswordResetRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					Resend: true,
+				},
+				err: &core.ShortCodeCreateResendCooldownError{RetryAfter: 49200 * time.Millisecond},
+			},
+
+			// Returns 202 to prevent email enumeration.
+			expectStatus: http.StatusAccepted,
+		},
 		{
 			name: "Error/Internal",
 
@@ -116,13 +233,22 @@ func TestShortCodeCreatePasswordReset(t *testing.T) {
 			}
 
 			handler := handlers.NewShortCodeCreatePasswordReset(service, config.LoggerDev)
+			if testCase.resend {
+				handler = handlers.NewShortCodeResendPasswordReset(service, config.LoggerDev)
+			}
+
 			w := httptest.NewRecorder()
 
+			if testCase.acceptLanguage != "" {
+				testCase.request.Header.Set("Accept-Language", testCase.acceptLanguage)
+			}
+
 			handler.ServeHTTP(w, testCase.request)
 
 			res := w.Result()
 
 			require.Equal(t, testCase.expectStatus, res.StatusCode)
+			require.Equal(t, testCase.expectRetryAfter, res.Header.Get("Retry-After"))
 
 			if testCase.expectResponse != nil {
 				data, err := io.ReadAll(res.Body)
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/samber/lo"
+
 	"github.com/a-novel-kit/golib/httpf"
 	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
@@ -26,12 +28,19 @@ type ShortCodeCreateRegisterRequest struct {
 type ShortCodeCreateRegister struct {
 	service ShortCodeCreateRegisterService
 	logger  logging.Log
+	resend  bool
 }
 
 func NewShortCodeCreateRegister(service ShortCodeCreateRegisterService, logger logging.Log) *ShortCodeCreateRegister {
 	return &ShortCodeCreateRegister{service: service, logger: logger}
 }
 
+// NewShortCodeResendRegister returns a handler that re-issues the active short code instead of creating
+// a new one. The new code keeps the expiry of the previous one.
+func NewShortCodeResendRegister(service ShortCodeCreateRegisterService, logger logging.Log) *ShortCodeCreateRegister {
+	return &ShortCodeCreateRegister{service: service, logger: logger, resend: true}
+}
+
 func (handler *ShortCodeCreateRegister) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeCreateRegister")
 	defer span.End()
@@ -48,14 +57,22 @@ func (handler *ShortCodeCreateRegister) ServeHTTP(w http.ResponseWriter, r *http
 	}
 
 	_, err = handler.service.Exec(ctx, &core.ShortCodeCreateRegisterRequest{
-		Email: request.Email,
-		Lang:  request.Lang,
+		Email:  request.Email,
+		Lang:   lo.CoalesceOrEmpty(request.Lang, negotiateLang(r)),
+		Resend: handler.resend,
 	})
+	if handleRegistrationRefused(ctx, handler.logger, w, span, err) {
+		return
+	}
+
 	if err != nil {
 		// Silently succeed when the email already exists, so a caller cannot probe which addresses are registered.
 		if !errors.Is(err, dao.ErrCredentialsInsertAlreadyExists) {
+			setShortCodeRetryAfter(w, err)
 			httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-				core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+				core.ErrShortCodeCreateResendNotFound: http.StatusNotFound,
+				core.ErrShortCodeCreateResendCooldown: http.StatusTooManyRequests,
+				core.ErrInvalidRequest:                http.StatusUnprocessableEntity,
 			}, err)
 
 			return
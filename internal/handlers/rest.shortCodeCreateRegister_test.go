@@ -3,11 +3,13 @@ package handlers_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -34,12 +36,16 @@ func TestShortCodeCreateRegister(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		request *http.Request
+		request        *http.Request
+		acceptLanguage string
+
+		resend bool
 
 		serviceMock *serviceMock
 
-		expectStatus   int
-		expectResponse any
+		expectStatus     int
+		expectRetryAfter string
+		expectResponse   any
 	}{
 		{
 			name: "Success",
@@ -64,6 +70,53 @@ func TestShortCodeCreateRegister(t *testing.T) {
 
 			expectStatus: http.StatusAccepted,
 		},
+		{
+			name: "Success/AcceptLanguage",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com"
+			}`)),
+			acceptLanguage: "en-US,en;q=0.9",
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email: "new_user@provider.com",
+					Lang:  "en",
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageResetPassword,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Success/LangOverAcceptLanguage",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			acceptLanguage: "en-US,en;q=0.9",
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email: "new_user@provider.com",
+					Lang:  "fr",
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageResetPassword,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
 		{
 			name: "Success/AlreadyExists",
 
@@ -83,6 +136,129 @@ func TestShortCodeCreateRegister(t *testing.T) {
 			// Returns 202 to prevent email enumeration.
 			expectStatus: http.StatusAccepted,
 		},
+		{
+			name: "Error/RegistrationClosed",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email: "new_user@provider.com",
+					Lang:  "fr",
+				},
+				err: core.ErrRegistrationClosed,
+			},
+
+			expectStatus:   http.StatusForbidden,
+			expectResponse: map[string]any{"reason": handlers.RegistrationRefusedClosed},
+		},
+		{
+			name: "Error/RegistrationDomainNotAllowed",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email: "new_user@provider.com",
+					Lang:  "fr",
+				},
+				err: fmt.Errorf("%w: provider.com", core.ErrRegistrationDomainNotAllowed),
+			},
+
+			expectStatus:   http.StatusForbidden,
+			expectResponse: map[string]any{"reason": handlers.RegistrationRefusedDomainNotAllowed},
+		},
+		{
+			name: "Error/RegistrationDisposableEmail",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@mailinator.com",
+				"lang": "fr"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email: "new_user@mailinator.com",
+					Lang:  "fr",
+				},
+				err: core.ErrRegistrationDisposableEmail,
+			},
+
+			expectStatus:   http.StatusForbidden,
+			expectResponse: map[string]any{"reason": handlers.RegistrationRefusedDisposableEmail},
+		},
+		{
+			name: "Success/Resend",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					Resend: true,
+				},
+				resp: &core.ShortCode{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-111111111111"),
+					Usage:     core.ShortCodeUsageResetPassword,
+					Target:    "00000000-0000-0000-0000-000000000001",
+					PlainCode: "abcdef",
+				},
+			},
+
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Error/ResendNotFound",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					Resend: true,
+				},
+				err: core.ErrShortCodeCreateResendNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/ResendCooldown",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"email": "new_user@provider.com",
+				"lang": "fr"
+			}`)),
+			resend: true,
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeCreateRegisterRequest{
+					Email:  "new_user@provider.com",
+					Lang:   "fr",
+					Resend: true,
+				},
+				err: &core.ShortCodeCreateResendCooldownError{RetryAfter: 49200 * time.Millisecond},
+			},
+
+			expectStatus:     http.StatusTooManyRequests,
+			expectRetryAfter: "50",
+		},
 		{
 			name: "Error/Internal",
 
@@ -116,13 +292,22 @@ func TestShortCodeCreateRegister(t *testing.T) {
 			}
 
 			handler := handlers.NewShortCodeCreateRegister(service, config.LoggerDev)
+			if testCase.resend {
+				handler = handlers.NewShortCodeResendRegister(service, config.LoggerDev)
+			}
+
 			w := httptest.NewRecorder()
 
+			if testCase.acceptLanguage != "" {
+				testCase.request.Header.Set("Accept-Language", testCase.acceptLanguage)
+			}
+
 			handler.ServeHTTP(w, testCase.request)
 
 			res := w.Result()
 
 			require.Equal(t, testCase.expectStatus, res.StatusCode)
+			require.Equal(t, testCase.expectRetryAfter, res.Header.Get("Retry-After"))
 
 			if testCase.expectResponse != nil {
 				data, err := io.ReadAll(res.Body)
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type ShortCodeListService interface {
+	Exec(ctx context.Context, request *core.ShortCodeListRequest) ([]*core.ShortCodeMetadata, error)
+}
+
+type ShortCodeListRequest struct {
+	Limit  int    `schema:"limit"`
+	Offset int    `schema:"offset"`
+	Target string `schema:"target"`
+	Usage  string `schema:"usage"`
+	Status string `schema:"status"`
+}
+
+type ShortCodeList struct {
+	service ShortCodeListService
+	logger  logging.Log
+}
+
+func NewShortCodeList(service ShortCodeListService, logger logging.Log) *ShortCodeList {
+	return &ShortCodeList{service: service, logger: logger}
+}
+
+func (handler *ShortCodeList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeList")
+	defer span.End()
+
+	var request ShortCodeListRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.ShortCodeListRequest{
+		Limit:         request.Limit,
+		Offset:        request.Offset,
+		Target:        request.Target,
+		Usage:         request.Usage,
+		Status:        request.Status,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, lo.Map(res, loadShortCodeMap))
+}
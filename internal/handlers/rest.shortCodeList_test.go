@@ -0,0 +1,180 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestShortCodeList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	type serviceMock struct {
+		req  *core.ShortCodeListRequest
+		resp []*core.ShortCodeMetadata
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(
+				t.Context(), http.MethodGet,
+				"/?limit=10&offset=2&target=user@provider.com&usage=register&status=active", nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeListRequest{
+					Limit:         10,
+					Offset:        2,
+					Target:        "user@provider.com",
+					Usage:         core.ShortCodeUsageRegister,
+					Status:        core.ShortCodeStatusActive,
+					CurrentUserID: callerID,
+				},
+				resp: []*core.ShortCodeMetadata{
+					{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Usage:     core.ShortCodeUsageRegister,
+						Target:    "user@provider.com",
+						Status:    core.ShortCodeStatusActive,
+						Attempts:  2,
+						CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						ExpiresAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expectResponse: []any{
+				map[string]any{
+					"id":        "00000000-0000-0000-0000-000000000002",
+					"usage":     core.ShortCodeUsageRegister,
+					"target":    "user@provider.com",
+					"status":    core.ShortCodeStatusActive,
+					"attempts":  float64(2),
+					"createdAt": "2021-01-01T00:00:00Z",
+					"expiresAt": "2021-01-03T00:00:00Z",
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/Empty",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeListRequest{
+					Limit:         10,
+					CurrentUserID: callerID,
+				},
+				resp: []*core.ShortCodeMetadata{},
+			},
+
+			expectResponse: []any{},
+			expectStatus:   http.StatusOK,
+		},
+		{
+			name: "Error/BadQuery",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=abc", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10&status=fake", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeListRequest{
+					Limit:         10,
+					Status:        "fake",
+					CurrentUserID: callerID,
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeListRequest{
+					Limit:         10,
+					CurrentUserID: callerID,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockShortCodeListService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewShortCodeList(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{UserID: lo.ToPtr(callerID)})
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
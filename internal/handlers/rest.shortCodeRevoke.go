@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+)
+
+type ShortCodeRevokeService interface {
+	Exec(ctx context.Context, request *core.ShortCodeRevokeRequest) (*core.ShortCodeMetadata, error)
+}
+
+type ShortCodeRevokeRequest struct {
+	Reason string `schema:"reason"`
+}
+
+// ShortCodeRevoke revokes the short code whose ID is the "id" URL parameter of the route.
+type ShortCodeRevoke struct {
+	service ShortCodeRevokeService
+	logger  logging.Log
+}
+
+func NewShortCodeRevoke(service ShortCodeRevokeService, logger logging.Log) *ShortCodeRevoke {
+	return &ShortCodeRevoke{service: service, logger: logger}
+}
+
+func (handler *ShortCodeRevoke) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeRevoke")
+	defer span.End()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	var request ShortCodeRevokeRequest
+
+	err = muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := middlewares.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, nil, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &core.ShortCodeRevokeRequest{
+		ID:            id,
+		Reason:        request.Reason,
+		CurrentUserID: lo.FromPtr(claims.UserID),
+		RequestID:     middleware.GetReqID(ctx),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			dao.ErrShortCodeDeleteNotFound: http.StatusNotFound,
+			core.ErrInvalidRequest:         http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, loadShortCode(res))
+}
@@ -0,0 +1,197 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/dao"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	"github.com/a-novel/service-authentication/v2/internal/handlers/middlewares"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestShortCodeRevoke(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	callerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	shortCodeID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	deletedAt := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	type serviceMock struct {
+		req  *core.ShortCodeRevokeRequest
+		resp *core.ShortCodeMetadata
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		id      string
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			id:      shortCodeID.String(),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/?reason=leaked", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeRevokeRequest{
+					ID:            shortCodeID,
+					Reason:        "leaked",
+					CurrentUserID: callerID,
+				},
+				resp: &core.ShortCodeMetadata{
+					ID:             shortCodeID,
+					Usage:          core.ShortCodeUsageResetPassword,
+					Target:         "test-target",
+					Status:         core.ShortCodeStatusDeleted,
+					Attempts:       1,
+					CreatedAt:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ExpiresAt:      time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					DeletedAt:      &deletedAt,
+					DeletedComment: lo.ToPtr("revoked by admin: leaked"),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":             shortCodeID.String(),
+				"usage":          core.ShortCodeUsageResetPassword,
+				"target":         "test-target",
+				"status":         core.ShortCodeStatusDeleted,
+				"attempts":       float64(1),
+				"createdAt":      "2021-01-01T00:00:00Z",
+				"expiresAt":      "2021-01-03T00:00:00Z",
+				"deletedAt":      "2021-01-02T00:00:00Z",
+				"deletedComment": "revoked by admin: leaked",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/BadID",
+
+			id:      "not-a-uuid",
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/?reason=leaked", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/BadQuery",
+
+			id:      shortCodeID.String(),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/?reason=a&reason=b&unknown=1", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/NotFound",
+
+			id:      shortCodeID.String(),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/?reason=leaked", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeRevokeRequest{
+					ID:            shortCodeID,
+					Reason:        "leaked",
+					CurrentUserID: callerID,
+				},
+				err: dao.ErrShortCodeDeleteNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			id:      shortCodeID.String(),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeRevokeRequest{
+					ID:            shortCodeID,
+					CurrentUserID: callerID,
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			id:      shortCodeID.String(),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/?reason=leaked", nil),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeRevokeRequest{
+					ID:            shortCodeID,
+					Reason:        "leaked",
+					CurrentUserID: callerID,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockShortCodeRevokeService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewShortCodeRevoke(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", testCase.id)
+
+			rCtx := context.WithValue(testCase.request.Context(), chi.RouteCtxKey, routeCtx)
+			rCtx = middlewares.SetClaimsContext(rCtx, &core.AccessTokenClaims{UserID: lo.ToPtr(callerID)})
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
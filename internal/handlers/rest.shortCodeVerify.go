@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-authentication/v2/internal/core"
+)
+
+type ShortCodeVerifyService interface {
+	Exec(ctx context.Context, request *core.ShortCodeVerifyRequest) (string, error)
+}
+
+type ShortCodeVerifyRequest struct {
+	Usage  string `json:"usage"`
+	Target string `json:"target"`
+	Code   string `json:"code"`
+}
+
+// ShortCodeVerifyResponse tells whether a short code would be accepted. Status is one of the
+// core.ShortCodeVerifyStatus* constants.
+type ShortCodeVerifyResponse struct {
+	Status string `json:"status"`
+}
+
+type ShortCodeVerify struct {
+	service ShortCodeVerifyService
+	logger  logging.Log
+}
+
+func NewShortCodeVerify(service ShortCodeVerifyService, logger logging.Log) *ShortCodeVerify {
+	return &ShortCodeVerify{service: service, logger: logger}
+}
+
+func (handler *ShortCodeVerify) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "rest.ShortCodeVerify")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ShortCodeVerifyRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	status, err := handler.service.Exec(ctx, &core.ShortCodeVerifyRequest{
+		Usage:  request.Usage,
+		Target: request.Target,
+		Code:   request.Code,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			core.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSONStatus(ctx, w, span, http.StatusOK, ShortCodeVerifyResponse{Status: status})
+}
@@ -0,0 +1,185 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/core"
+	"github.com/a-novel/service-authentication/v2/internal/handlers"
+	handlersmocks "github.com/a-novel/service-authentication/v2/internal/handlers/mocks"
+)
+
+func TestShortCodeVerify(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *core.ShortCodeVerifyRequest
+		resp string
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"usage": "resetPassword",
+				"target": "00000000-0000-0000-0000-000000000001",
+				"code": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeVerifyRequest{
+					Usage:  core.ShortCodeUsageResetPassword,
+					Target: "00000000-0000-0000-0000-000000000001",
+					Code:   "abcdef",
+				},
+				resp: core.ShortCodeVerifyStatusValid,
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"status": "valid"},
+		},
+		{
+			name: "Success/Expired",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"usage": "resetPassword",
+				"target": "00000000-0000-0000-0000-000000000001",
+				"code": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeVerifyRequest{
+					Usage:  core.ShortCodeUsageResetPassword,
+					Target: "00000000-0000-0000-0000-000000000001",
+					Code:   "abcdef",
+				},
+				resp: core.ShortCodeVerifyStatusExpired,
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"status": "expired"},
+		},
+		{
+			name: "Success/Invalid",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"usage": "resetPassword",
+				"target": "00000000-0000-0000-0000-000000000001",
+				"code": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeVerifyRequest{
+					Usage:  core.ShortCodeUsageResetPassword,
+					Target: "00000000-0000-0000-0000-000000000001",
+					Code:   "abcdef",
+				},
+				resp: core.ShortCodeVerifyStatusInvalid,
+			},
+
+			expectStatus:   http.StatusOK,
+			expectResponse: map[string]any{"status": "invalid"},
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{`)),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"usage": "resetPassword",
+				"target": "00000000-0000-0000-0000-000000000001",
+				"code": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeVerifyRequest{
+					Usage:  core.ShortCodeUsageResetPassword,
+					Target: "00000000-0000-0000-0000-000000000001",
+					Code:   "abcdef",
+				},
+				err: core.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Internal",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"usage": "resetPassword",
+				"target": "00000000-0000-0000-0000-000000000001",
+				"code": "abcdef"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.ShortCodeVerifyRequest{
+					Usage:  core.ShortCodeUsageResetPassword,
+					Target: "00000000-0000-0000-0000-000000000001",
+					Code:   "abcdef",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockShortCodeVerifyService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewShortCodeVerify(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+		})
+	}
+}
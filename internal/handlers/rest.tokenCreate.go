@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/go-chi/chi/v5/middleware"
+
 	"github.com/a-novel-kit/golib/httpf"
 	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
@@ -48,8 +50,10 @@ func (handler *TokenCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := handler.service.Exec(ctx, &core.TokenCreateRequest{
-		Email:    request.Email,
-		Password: request.Password,
+		Email:     request.Email,
+		Password:  request.Password,
+		IP:        middleware.GetClientIP(ctx),
+		UserAgent: r.UserAgent(),
 	})
 	if err != nil {
 		// Both "email not found" and "invalid password" return 401 to prevent email enumeration.
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -34,7 +35,8 @@ func TestTokenCreate(t *testing.T) {
 	testCases := []struct {
 		name string
 
-		request *http.Request
+		request   *http.Request
+		userAgent string
 
 		serviceMock *serviceMock
 
@@ -48,11 +50,14 @@ func TestTokenCreate(t *testing.T) {
 				"email": "user@provider.com",
 				"password": "Louvre"
 			}`)),
+			userAgent: "Mozilla/5.0",
 
 			serviceMock: &serviceMock{
 				req: &core.TokenCreateRequest{
-					Email:    "user@provider.com",
-					Password: "Louvre",
+					Email:     "user@provider.com",
+					Password:  "Louvre",
+					IP:        "192.0.2.1",
+					UserAgent: "Mozilla/5.0",
 				},
 				resp: &core.Token{
 					AccessToken:  "token",
@@ -78,6 +83,7 @@ func TestTokenCreate(t *testing.T) {
 				req: &core.TokenCreateRequest{
 					Email:    "user@provider.com",
 					Password: "Louvre",
+					IP:       "192.0.2.1",
 				},
 				err: dao.ErrCredentialsSelectByEmailNotFound,
 			},
@@ -97,6 +103,7 @@ func TestTokenCreate(t *testing.T) {
 				req: &core.TokenCreateRequest{
 					Email:    "user@provider.com",
 					Password: "Louvre",
+					IP:       "192.0.2.1",
 				},
 				err: lib.ErrInvalidPassword,
 			},
@@ -116,6 +123,7 @@ func TestTokenCreate(t *testing.T) {
 				req: &core.TokenCreateRequest{
 					Email:    "user@provider.com",
 					Password: "Louvre",
+					IP:       "192.0.2.1",
 				},
 				err: errFoo,
 			},
@@ -136,10 +144,16 @@ func TestTokenCreate(t *testing.T) {
 					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
 			}
 
+			if testCase.userAgent != "" {
+				testCase.request.Header.Set("User-Agent", testCase.userAgent)
+			}
+
 			handler := handlers.NewTokenCreate(service, config.LoggerDev)
 			w := httptest.NewRecorder()
 
-			handler.ServeHTTP(w, testCase.request)
+			// The router resolves the client IP in a middleware; the handler reads it from the
+			// request context.
+			middleware.ClientIPFromRemoteAddr(handler).ServeHTTP(w, testCase.request)
 
 			res := w.Result()
 
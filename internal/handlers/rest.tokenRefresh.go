@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/go-chi/chi/v5/middleware"
+
 	"github.com/a-novel-kit/golib/httpf"
 	"github.com/a-novel-kit/golib/logging"
 	"github.com/a-novel-kit/golib/otel"
@@ -49,6 +51,8 @@ func (handler *TokenRefresh) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	res, err := handler.service.Exec(ctx, &core.TokenRefreshRequest{
 		AccessToken:  request.AccessToken,
 		RefreshToken: request.RefreshToken,
+		IP:           middleware.GetClientIP(ctx),
+		UserAgent:    r.UserAgent(),
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
@@ -57,8 +61,9 @@ func (handler *TokenRefresh) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			core.ErrTokenRefreshMismatchClaims:      http.StatusForbidden,
 			core.ErrTokenRefreshMismatchSource:      http.StatusForbidden,
 			// The credentials behind a still-valid refresh token were deleted — re-authenticate.
-			dao.ErrCredentialsSelectNotFound: http.StatusUnauthorized,
-			core.ErrInvalidRequest:           http.StatusUnprocessableEntity,
+			dao.ErrCredentialsSelectNotFound:  http.StatusUnauthorized,
+			core.ErrTokenRefreshAccountMerged: http.StatusUnauthorized,
+			core.ErrInvalidRequest:            http.StatusUnprocessableEntity,
 		}, err)
 
 		return
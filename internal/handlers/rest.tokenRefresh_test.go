@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -51,6 +52,7 @@ func TestTokenRefresh(t *testing.T) {
 				req: &core.TokenRefreshRequest{
 					AccessToken:  "access-token",
 					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
 				},
 				resp: &core.Token{
 					AccessToken:  "new-access-token",
@@ -76,6 +78,7 @@ func TestTokenRefresh(t *testing.T) {
 				req: &core.TokenRefreshRequest{
 					AccessToken:  "access-token",
 					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
 				},
 				err: core.ErrTokenRefreshInvalidAccessToken,
 			},
@@ -94,6 +97,7 @@ func TestTokenRefresh(t *testing.T) {
 				req: &core.TokenRefreshRequest{
 					AccessToken:  "access-token",
 					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
 				},
 				err: core.ErrTokenRefreshInvalidRefreshToken,
 			},
@@ -112,6 +116,7 @@ func TestTokenRefresh(t *testing.T) {
 				req: &core.TokenRefreshRequest{
 					AccessToken:  "access-token",
 					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
 				},
 				err: core.ErrTokenRefreshMismatchClaims,
 			},
@@ -130,12 +135,32 @@ func TestTokenRefresh(t *testing.T) {
 				req: &core.TokenRefreshRequest{
 					AccessToken:  "access-token",
 					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
 				},
 				err: core.ErrTokenRefreshMismatchSource,
 			},
 
 			expectStatus: http.StatusForbidden,
 		},
+		{
+			name: "Error/AccountMerged",
+
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{
+				"accessToken": "access-token",
+				"refreshToken": "refresh_token"
+			}`)),
+
+			serviceMock: &serviceMock{
+				req: &core.TokenRefreshRequest{
+					AccessToken:  "access-token",
+					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
+				},
+				err: core.ErrTokenRefreshAccountMerged,
+			},
+
+			expectStatus: http.StatusUnauthorized,
+		},
 		{
 			name: "Error/Internal",
 
@@ -148,6 +173,7 @@ func TestTokenRefresh(t *testing.T) {
 				req: &core.TokenRefreshRequest{
 					AccessToken:  "access-token",
 					RefreshToken: "refresh_token",
+					IP:           "192.0.2.1",
 				},
 				err: errFoo,
 			},
@@ -171,7 +197,9 @@ func TestTokenRefresh(t *testing.T) {
 			handler := handlers.NewTokenRefresh(service, config.LoggerDev)
 			w := httptest.NewRecorder()
 
-			handler.ServeHTTP(w, testCase.request)
+			// The router resolves the client IP in a middleware; the handler reads it from the
+			// request context.
+			middleware.ClientIPFromRemoteAddr(handler).ServeHTTP(w, testCase.request)
 
 			res := w.Result()
 
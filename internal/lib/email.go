@@ -0,0 +1,86 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// ErrInvalidEmail is returned by [CanonicalEmail] when the address has no local part, no
+// domain, or a domain that cannot be converted to its ASCII form.
+var ErrInvalidEmail = errors.New("invalid email")
+
+// EmailProvider describes how a mail provider maps addresses to mailboxes, so that
+// addresses delivered to the same mailbox share a canonical form.
+type EmailProvider struct {
+	// Aliases are other domains delivering to the same mailboxes, for example
+	// googlemail.com for gmail.com.
+	Aliases []string `json:"aliases" yaml:"aliases"`
+	// IgnoreDots drops the dots of the local part, which the provider ignores.
+	IgnoreDots bool `json:"ignoreDots" yaml:"ignoreDots"`
+	// TagSeparator, if set, drops the sub-address tag of the local part: everything from
+	// the first separator on. Usually "+".
+	TagSeparator string `json:"tagSeparator" yaml:"tagSeparator"`
+}
+
+// CanonicalEmail returns the identity of an email address: two addresses with the same
+// canonical form reach the same mailbox, and belong to the same account.
+//
+// The address is lowercased and its domain converted to punycode. If the domain, or one
+// of its aliases, is a key of providers, the provider rules are applied, and aliases are
+// replaced by the domain they stand for.
+func CanonicalEmail(email string, providers map[string]EmailProvider) (string, error) {
+	email = strings.TrimSpace(email)
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+	}
+
+	local := strings.ToLower(email[:at])
+
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(email[at+1:], "."))
+	if err != nil {
+		return "", errors.Join(fmt.Errorf("%w: %q", ErrInvalidEmail, email), err)
+	}
+
+	domain = strings.ToLower(domain)
+
+	domain, provider, ok := findEmailProvider(domain, providers)
+	if ok {
+		if provider.TagSeparator != "" {
+			local, _, _ = strings.Cut(local, provider.TagSeparator)
+		}
+
+		if provider.IgnoreDots {
+			local = strings.ReplaceAll(local, ".", "")
+		}
+
+		if local == "" {
+			return "", fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+		}
+	}
+
+	return local + "@" + domain, nil
+}
+
+// findEmailProvider looks up the provider serving domain, either directly or through one of
+// its aliases. It returns the main domain of the provider.
+func findEmailProvider(domain string, providers map[string]EmailProvider) (string, EmailProvider, bool) {
+	for name, provider := range providers {
+		name = strings.ToLower(name)
+		if name == domain {
+			return name, provider, true
+		}
+
+		for _, alias := range provider.Aliases {
+			if strings.ToLower(alias) == domain {
+				return name, provider, true
+			}
+		}
+	}
+
+	return domain, EmailProvider{}, false
+}
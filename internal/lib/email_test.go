@@ -0,0 +1,116 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestCanonicalEmail(t *testing.T) {
+	t.Parallel()
+
+	providers := map[string]lib.EmailProvider{
+		"gmail.com": {
+			Aliases:      []string{"googlemail.com"},
+			IgnoreDots:   true,
+			TagSeparator: "+",
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		email     string
+		providers map[string]lib.EmailProvider
+
+		expect    string
+		expectErr error
+	}{
+		{
+			name: "Lowercase",
+
+			email: "Foo.Bar@Example.COM",
+
+			expect: "foo.bar@example.com",
+		},
+		{
+			name: "TrimSpaces",
+
+			email: " foo@example.com ",
+
+			expect: "foo@example.com",
+		},
+		{
+			name: "IDN",
+
+			email: "user@Bücher.example",
+
+			expect: "user@xn--bcher-kva.example",
+		},
+		{
+			name: "NoProviderRules",
+
+			email: "Foo.Bar+news@gmail.com",
+
+			expect: "foo.bar+news@gmail.com",
+		},
+		{
+			name: "ProviderRules",
+
+			email:     "Foo.Bar+news@gmail.com",
+			providers: providers,
+
+			expect: "foobar@gmail.com",
+		},
+		{
+			name: "ProviderAlias",
+
+			email:     "foo.bar@GoogleMail.com",
+			providers: providers,
+
+			expect: "foobar@gmail.com",
+		},
+		{
+			name: "OtherProvider",
+
+			email:     "foo.bar+news@example.com",
+			providers: providers,
+
+			expect: "foo.bar+news@example.com",
+		},
+		{
+			name: "Error/NoDomain",
+
+			email: "foo@",
+
+			expectErr: lib.ErrInvalidEmail,
+		},
+		{
+			name: "Error/NoLocalPart",
+
+			email: "@example.com",
+
+			expectErr: lib.ErrInvalidEmail,
+		},
+		{
+			name: "Error/EmptyAfterRules",
+
+			email:     "+news@gmail.com",
+			providers: providers,
+
+			expectErr: lib.ErrInvalidEmail,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := lib.CanonicalEmail(testCase.email, testCase.providers)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+		})
+	}
+}
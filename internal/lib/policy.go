@@ -0,0 +1,124 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cel.dev/cel-go/cel"
+)
+
+var (
+	// ErrInvalidPolicy is returned by [NewPolicyEngine] when a policy expression does not compile,
+	// or does not evaluate to a boolean.
+	ErrInvalidPolicy = errors.New("invalid policy")
+	// ErrUnknownPolicy is returned by [PolicyEngine.Eval] for a policy name the engine was not
+	// built with.
+	ErrUnknownPolicy = errors.New("unknown policy")
+	// ErrPolicyEval is returned by [PolicyEngine.Eval] when a policy fails to evaluate, for
+	// instance when it reads an attribute the input does not have. Such a policy does not allow
+	// anything.
+	ErrPolicyEval = errors.New("policy evaluation failed")
+)
+
+// Variables a policy expression can read. Each is a map from attribute names to values.
+const (
+	// PolicyVarClaims describes the caller.
+	PolicyVarClaims = "claims"
+	// PolicyVarRequest describes the request.
+	PolicyVarRequest = "request"
+	// PolicyVarTarget describes the resource the request acts on.
+	PolicyVarTarget = "target"
+)
+
+// PolicyInput holds the attributes a policy is evaluated against. Nil maps are read as empty.
+type PolicyInput struct {
+	Claims  map[string]any
+	Request map[string]any
+	Target  map[string]any
+}
+
+// PolicyEngine evaluates named authorization policies, written as CEL expressions
+// (https://cel.dev). An expression reads the [PolicyVarClaims], [PolicyVarRequest] and
+// [PolicyVarTarget] variables, and must return a boolean: true allows the request.
+//
+//	claims.userID != target.userID && claims.rank > target.rank
+//
+// Policies compile once, when the engine is built; evaluating them is safe for concurrent use.
+type PolicyEngine struct {
+	programs map[string]cel.Program
+}
+
+// NewPolicyEngine compiles the policies, mapped by name. It fails with [ErrInvalidPolicy], naming
+// the policy, when one of them does not compile or does not return a boolean.
+func NewPolicyEngine(policies map[string]string) (*PolicyEngine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(PolicyVarClaims, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(PolicyVarRequest, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(PolicyVarTarget, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create policy environment: %w", err)
+	}
+
+	programs := make(map[string]cel.Program, len(policies))
+
+	for name, expression := range policies {
+		ast, issues := env.Compile(expression)
+		if issues.Err() != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidPolicy, name, issues.Err())
+		}
+
+		if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("%w %q: returns %s, not bool", ErrInvalidPolicy, name, ast.OutputType())
+		}
+
+		programs[name], err = env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidPolicy, name, err)
+		}
+	}
+
+	return &PolicyEngine{programs: programs}, nil
+}
+
+// Has reports whether the engine was built with the named policy.
+func (engine *PolicyEngine) Has(name string) bool {
+	_, ok := engine.programs[name]
+
+	return ok
+}
+
+// Eval tells whether the named policy allows the input. It fails with [ErrUnknownPolicy] when
+// the engine has no such policy, and with [ErrPolicyEval] when the policy fails to evaluate, or
+// returns something other than a boolean.
+func (engine *PolicyEngine) Eval(ctx context.Context, name string, input PolicyInput) (bool, error) {
+	program, ok := engine.programs[name]
+	if !ok {
+		return false, fmt.Errorf("%w: %q", ErrUnknownPolicy, name)
+	}
+
+	out, _, err := program.ContextEval(ctx, map[string]any{
+		PolicyVarClaims:  orEmpty(input.Claims),
+		PolicyVarRequest: orEmpty(input.Request),
+		PolicyVarTarget:  orEmpty(input.Target),
+	})
+	if err != nil {
+		return false, fmt.Errorf("%w: %q: %w", ErrPolicyEval, name, err)
+	}
+
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %q returned %T, not bool", ErrPolicyEval, name, out.Value())
+	}
+
+	return allowed, nil
+}
+
+func orEmpty(attributes map[string]any) map[string]any {
+	if attributes == nil {
+		return map[string]any{}
+	}
+
+	return attributes
+}
@@ -0,0 +1,129 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-authentication/v2/internal/config"
+	"github.com/a-novel/service-authentication/v2/internal/lib"
+)
+
+func TestPolicyEngine(t *testing.T) {
+	t.Parallel()
+
+	engine, err := lib.NewPolicyEngine(map[string]string{
+		"self":       `claims.userID == target.userID`,
+		"lowerRank":  `claims.userID != target.userID && claims.rank > target.rank`,
+		"readMethod": `request.method in ["GET", "HEAD"]`,
+	})
+	require.NoError(t, err)
+
+	require.True(t, engine.Has("self"))
+	require.False(t, engine.Has("other"))
+
+	testCases := []struct {
+		name string
+
+		policy string
+		input  lib.PolicyInput
+
+		expect    bool
+		expectErr error
+	}{
+		{
+			name: "Allowed",
+
+			policy: "self",
+			input: lib.PolicyInput{
+				Claims: map[string]any{"userID": "a"},
+				Target: map[string]any{"userID": "a"},
+			},
+
+			expect: true,
+		},
+		{
+			name: "Denied",
+
+			policy: "lowerRank",
+			input: lib.PolicyInput{
+				Claims: map[string]any{"userID": "a", "rank": 1},
+				Target: map[string]any{"userID": "b", "rank": 2},
+			},
+
+			expect: false,
+		},
+		{
+			name: "Request",
+
+			policy: "readMethod",
+			input:  lib.PolicyInput{Request: map[string]any{"method": "GET"}},
+
+			expect: true,
+		},
+		{
+			// Reading an attribute the input lacks fails the evaluation, rather than allowing.
+			name: "Error/MissingAttribute",
+
+			policy: "self",
+			input:  lib.PolicyInput{Claims: map[string]any{"userID": "a"}},
+
+			expectErr: lib.ErrPolicyEval,
+		},
+		{
+			name: "Error/UnknownPolicy",
+
+			policy: "other",
+
+			expectErr: lib.ErrUnknownPolicy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			allowed, err := engine.Eval(t.Context(), testCase.policy, testCase.input)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, allowed)
+		})
+	}
+}
+
+func TestNewPolicyEngineInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		expression string
+	}{
+		{name: "Syntax", expression: `claims.userID ==`},
+		{name: "UnknownVariable", expression: `caller.userID == target.userID`},
+		{name: "NotBool", expression: `"yes"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := lib.NewPolicyEngine(map[string]string{"policy": testCase.expression})
+			require.ErrorIs(t, err, lib.ErrInvalidPolicy)
+		})
+	}
+}
+
+// The shipped policies must compile, or the REST server refuses to start.
+func TestNewPolicyEngineDefault(t *testing.T) {
+	t.Parallel()
+
+	engine, err := lib.NewPolicyEngine(config.PoliciesConfigDefault.Expressions())
+	require.NoError(t, err)
+
+	allowed, err := engine.Eval(t.Context(), "outranksTarget", lib.PolicyInput{
+		Claims: map[string]any{"userID": "a", "rank": 2},
+		Target: map[string]any{"userID": "b", "rank": 1},
+	})
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -6,23 +6,40 @@ import (
 	"math/big"
 )
 
-// URLCharList is the alphabet of URL-safe characters that NewRandomURLString draws
-// from when generating random strings.
-var URLCharList = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
-
-var urlCharListLen = int64(len(URLCharList))
+var (
+	// URLCharList is the alphabet of URL-safe characters that NewRandomURLString draws
+	// from when generating random strings.
+	URLCharList = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	// NumericCharList is the alphabet of decimal digits, for codes typed on a numeric keypad.
+	NumericCharList = []rune("0123456789")
+	// CrockfordCharList is the alphabet of Crockford's base32: digits and uppercase letters,
+	// without I, L, O and U, which are easily confused with other characters.
+	CrockfordCharList = []rune("0123456789ABCDEFGHJKMNPQRSTVWXYZ")
+)
 
 // NewRandomURLString generates a random, URL-safe string of the given length.
 func NewRandomURLString(length int) (string, error) {
+	out, err := NewRandomString(URLCharList, length)
+	if err != nil {
+		return "", fmt.Errorf("generate random URL: %w", err)
+	}
+
+	return out, nil
+}
+
+// NewRandomString generates a random string of the given length, each character drawn
+// uniformly from charList.
+func NewRandomString(charList []rune, length int) (string, error) {
 	out := make([]rune, length)
+	charListLen := big.NewInt(int64(len(charList)))
 
 	for i := range out {
-		num, err := rand.Int(rand.Reader, big.NewInt(urlCharListLen))
+		num, err := rand.Int(rand.Reader, charListLen)
 		if err != nil {
-			return "", fmt.Errorf("generate random URL: %w", err)
+			return "", fmt.Errorf("draw random character: %w", err)
 		}
 
-		out[i] = URLCharList[num.Int64()]
+		out[i] = charList[num.Int64()]
 	}
 
 	return string(out), nil
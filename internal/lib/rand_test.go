@@ -16,3 +16,28 @@ func TestNewRandomURLString(t *testing.T) {
 	require.Len(t, str, 10)
 	require.Regexp(t, "^[a-zA-Z0-9]{10}$", str)
 }
+
+func TestNewRandomString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		charList []rune
+
+		expect string
+	}{
+		{name: "Numeric", charList: lib.NumericCharList, expect: "^[0-9]{10}$"},
+		{name: "Crockford", charList: lib.CrockfordCharList, expect: "^[0-9A-HJKMNP-TV-Z]{10}$"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			str, err := lib.NewRandomString(testCase.charList, 10)
+			require.NoError(t, err)
+			require.Regexp(t, testCase.expect, str)
+		})
+	}
+}
@@ -38,6 +38,19 @@ func printDepsGraph[Mod comparable](deps map[Mod]map[Mod]bool) string {
 //
 // Inherited dependencies come after the module's own, in resolution order.
 func ResolveDependants[Mod comparable, Deps any](mods map[Mod][]Deps, deps map[Mod][]Mod) (map[Mod][]Deps, error) {
+	return ResolveDependantsFunc(mods, deps, func(_ Mod, own, inherited []Deps) []Deps {
+		return append(own, inherited...)
+	})
+}
+
+// ResolveDependantsFunc is [ResolveDependants] with a custom merge. For every module, merge
+// receives its own dependencies and the resolved dependencies of the modules it inherits from,
+// concatenated in order, and returns its resolved dependencies. Modules are merged in depth
+// order, so what a module inherits is already merged; a merge that drops an inherited
+// dependency drops it for the modules below too, unless they bring it back.
+func ResolveDependantsFunc[Mod comparable, Deps any](
+	mods map[Mod][]Deps, deps map[Mod][]Mod, merge func(mod Mod, own, inherited []Deps) []Deps,
+) (map[Mod][]Deps, error) {
 	// Seed every mod as a leaf so callers can omit empty entries from `deps`. The seed also
 	// puts a mod that only appears as a parent into the graph, which gives the walk its
 	// depth-0 root.
@@ -88,12 +101,14 @@ func ResolveDependants[Mod comparable, Deps any](mods map[Mod][]Deps, deps map[M
 	resolved := map[Mod][]Deps{}
 
 	for _, mod := range resolvedMods {
-		resolved[mod] = mods[mod]
+		var inherited []Deps
 
 		// Depth order guarantees every dependency of mod is already fully resolved.
 		for _, dep := range deps[mod] {
-			resolved[mod] = append(resolved[mod], resolved[dep]...)
+			inherited = append(inherited, resolved[dep]...)
 		}
+
+		resolved[mod] = merge(mod, mods[mod], inherited)
 	}
 
 	return resolved, nil
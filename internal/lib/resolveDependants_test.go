@@ -3,6 +3,7 @@ package lib_test
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel/service-authentication/v2/internal/lib"
@@ -129,3 +130,33 @@ func TestResolveDependants(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveDependantsFunc(t *testing.T) {
+	t.Parallel()
+
+	mods := map[string][]string{
+		"mod:1": {"dep:1", "dep:2"},
+		"mod:2": {"dep:3"},
+		"mod:3": {"dep:4"},
+	}
+	deps := map[string][]string{
+		"mod:2": {"mod:1"},
+		"mod:3": {"mod:2"},
+	}
+
+	// mod:2 drops dep:1, which mod:3 then no longer inherits.
+	resolved, err := lib.ResolveDependantsFunc(mods, deps, func(mod string, own, inherited []string) []string {
+		merged := append(own, inherited...)
+		if mod == "mod:2" {
+			merged = lo.Without(merged, "dep:1")
+		}
+
+		return merged
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"mod:1": {"dep:1", "dep:2"},
+		"mod:2": {"dep:3", "dep:2"},
+		"mod:3": {"dep:4", "dep:3", "dep:2"},
+	}, resolved)
+}
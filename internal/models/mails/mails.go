@@ -11,8 +11,18 @@ import (
 )
 
 var (
+	//go:embed fr/email-changed.html
+	emailChangedFr string
 	//go:embed fr/email-update.html
 	emailUpdateFr string
+	//go:embed fr/email-verification.html
+	emailVerificationFr string
+	//go:embed fr/invite.html
+	inviteFr string
+	//go:embed fr/new-device.html
+	newDeviceFr string
+	//go:embed fr/password-changed.html
+	passwordChangedFr string
 	//go:embed fr/password-reset.html
 	passwordResetFr string
 	//go:embed fr/register.html
@@ -20,8 +30,18 @@ var (
 )
 
 var (
+	//go:embed en/email-changed.html
+	emailChangedEn string
 	//go:embed en/email-update.html
 	emailUpdateEn string
+	//go:embed en/email-verification.html
+	emailVerificationEn string
+	//go:embed en/invite.html
+	inviteEn string
+	//go:embed en/new-device.html
+	newDeviceEn string
+	//go:embed en/password-changed.html
+	passwordChangedEn string
 	//go:embed en/password-reset.html
 	passwordResetEn string
 	//go:embed en/register.html
@@ -30,28 +50,49 @@ var (
 
 // The templates read their values from a data map keyed by these names.
 const (
+	// TemplateVarShortCode is the short code, laid out in the format of its usage, such as
+	// ABCD-EFGH for Crockford's base32.
 	TemplateVarShortCode = "ShortCode"
 	TemplateVarTarget    = "Target"
 	TemplateVarURL       = "URL"
 	TemplateVarDuration  = "Duration"
 	TemplateVarBanner    = "Banner"
 	TemplateVarPurpose   = "_Purpose"
+	// TemplateVarEmail is the new address of an account, in the notice of an email change.
+	TemplateVarEmail = "Email"
+	// TemplateVarIP and TemplateVarUserAgent identify the device of a sign-in. The templates
+	// escape them, as the client sets them.
+	TemplateVarIP        = "IP"
+	TemplateVarUserAgent = "UserAgent"
 )
 
 // MailTemplates groups the parsed email templates by type. Each field carries every
 // language variant as named sub-templates, chosen by language when the mail is rendered.
 type MailTemplates struct {
-	EmailUpdate   *template.Template
-	PasswordReset *template.Template
-	Register      *template.Template
+	EmailUpdate       *template.Template
+	EmailVerification *template.Template
+	Invite            *template.Template
+	PasswordReset     *template.Template
+	Register          *template.Template
+
+	// Security notices, sent after the fact to the owner of an account.
+	EmailChanged    *template.Template
+	NewDevice       *template.Template
+	PasswordChanged *template.Template
 }
 
 // Mails holds the ready-to-render email templates, parsed once at package
 // initialization. A malformed template panics at startup.
 var Mails = MailTemplates{
-	EmailUpdate:   template.Must(template.New(config.LangEN).Parse(emailUpdateEn)),
-	PasswordReset: template.Must(template.New(config.LangEN).Parse(passwordResetEn)),
-	Register:      template.Must(template.New(config.LangEN).Parse(registerEn)),
+	EmailUpdate:       template.Must(template.New(config.LangEN).Parse(emailUpdateEn)),
+	EmailVerification: template.Must(template.New(config.LangEN).Parse(emailVerificationEn)),
+	Invite:            template.Must(template.New(config.LangEN).Parse(inviteEn)),
+	PasswordReset:     template.Must(template.New(config.LangEN).Parse(passwordResetEn)),
+	Register:          template.Must(template.New(config.LangEN).Parse(registerEn)),
+
+	EmailChanged:    template.Must(template.New(config.LangEN).Parse(emailChangedEn)),
+	NewDevice:       template.Must(template.New(config.LangEN).Parse(newDeviceEn)),
+	PasswordChanged: template.Must(template.New(config.LangEN).Parse(passwordChangedEn)),
 }
 
 // Attach the French variant to each template as an associated sub-template, so one
@@ -59,6 +100,12 @@ var Mails = MailTemplates{
 // so the results are discarded.
 var (
 	_ = template.Must(Mails.EmailUpdate.New(config.LangFR).Parse(emailUpdateFr))
+	_ = template.Must(Mails.EmailVerification.New(config.LangFR).Parse(emailVerificationFr))
+	_ = template.Must(Mails.Invite.New(config.LangFR).Parse(inviteFr))
 	_ = template.Must(Mails.PasswordReset.New(config.LangFR).Parse(passwordResetFr))
 	_ = template.Must(Mails.Register.New(config.LangFR).Parse(registerFr))
+
+	_ = template.Must(Mails.EmailChanged.New(config.LangFR).Parse(emailChangedFr))
+	_ = template.Must(Mails.NewDevice.New(config.LangFR).Parse(newDeviceFr))
+	_ = template.Must(Mails.PasswordChanged.New(config.LangFR).Parse(passwordChangedFr))
 )
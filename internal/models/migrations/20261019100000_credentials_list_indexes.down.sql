@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS credentials_email_lower_idx;
+
+DROP INDEX IF EXISTS credentials_created_at_id_idx;
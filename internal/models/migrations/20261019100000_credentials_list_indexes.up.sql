@@ -0,0 +1,7 @@
+-- Credential listing pages by keyset over (created_at, id), in either direction. A btree scans both
+-- ways, so a single ascending index serves the newest-first and oldest-first orders alike.
+CREATE INDEX credentials_created_at_id_idx ON credentials (created_at, id);
+
+-- Email search is case-insensitive. text_pattern_ops lets prefix searches (LIKE 'abc%') use the
+-- index whatever the database collation; substring searches still scan the table.
+CREATE INDEX credentials_email_lower_idx ON credentials (lower(email) text_pattern_ops);
@@ -0,0 +1,21 @@
+-- Records when the account last proved it controls its email, by redeeming a code sent to it. Null
+-- means the address was never verified.
+ALTER TABLE credentials
+ADD COLUMN email_verified_at timestamp(0) with time zone;
+
+-- Until now, registering redeemed a code sent to the email, in the transaction that created the
+-- account. Only the accounts with such a code on record are marked verified, at the time the code
+-- was redeemed. Every other account stays null and verifies through the email verification flow:
+-- imported accounts, the super-admin cmd/init creates without a code, and accounts whose code was
+-- purged since.
+UPDATE credentials
+SET
+  email_verified_at = short_codes.deleted_at
+FROM
+  short_codes
+WHERE
+  short_codes.usage = 'register'
+  AND short_codes.target = credentials.email
+  AND short_codes.deleted_comment = 'key consumed'
+  -- The code is consumed right before the account is inserted.
+  AND short_codes.deleted_at BETWEEN credentials.created_at - interval '1 minute' AND credentials.created_at;
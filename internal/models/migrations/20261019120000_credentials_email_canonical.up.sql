@@ -0,0 +1,82 @@
+-- Emails were unique as typed, so Foo@Example.com and foo@example.com could be two accounts. Accounts
+-- are now identified by the canonical form of their email, computed by the service: lowercased, with a
+-- punycode domain and the configured provider rules applied.
+--
+-- Existing rows are backfilled with their lowercased email. Addresses with an internationalized domain,
+-- or covered by provider rules, are recomputed by the migrations command once this has run.
+--
+-- Accounts that only differ by case cannot share a canonical email. They are reported, and the
+-- migration aborts before changing anything, so they can be merged or renamed first.
+DO $$
+DECLARE
+  collisions text;
+BEGIN
+  SELECT
+    string_agg(duplicates.accounts, '; ')
+  INTO
+    collisions
+  FROM
+    (
+      SELECT
+        lower(email) || ': ' || string_agg(email || ' (' || id || ')', ', ' ORDER BY created_at) AS accounts
+      FROM
+        credentials
+      GROUP BY
+        lower(email)
+      HAVING
+        count(*) > 1
+    ) AS duplicates;
+
+  IF collisions IS NOT NULL THEN
+    RAISE EXCEPTION 'credentials share the same canonical email'
+    USING
+      ERRCODE = 'unique_violation',
+      DETAIL = collisions,
+      HINT = 'Merge or rename the listed accounts, then run the migration again.';
+  END IF;
+END $$;
+
+ALTER TABLE credentials
+ADD COLUMN email_canonical text;
+
+UPDATE credentials
+SET
+  email_canonical = lower(email);
+
+ALTER TABLE credentials
+ALTER COLUMN email_canonical
+SET NOT NULL;
+
+ALTER TABLE credentials
+ADD CONSTRAINT credentials_email_canonical_key UNIQUE (email_canonical);
+
+-- Pending registration and invitation codes are addressed to an email, and are now looked up by its
+-- canonical form. When several pending codes only differ by case, the latest one is kept addressable.
+UPDATE short_codes
+SET
+  target = lower(target)
+WHERE
+  id IN (
+    SELECT DISTINCT ON (usage, lower(target))
+      id
+    FROM
+      short_codes
+    WHERE
+      usage IN ('register', 'invite')
+      AND deleted_at IS NULL
+    ORDER BY
+      usage,
+      lower(target),
+      created_at DESC
+  )
+  AND target <> lower(target)
+  AND NOT EXISTS (
+    SELECT
+      1
+    FROM
+      short_codes AS other
+    WHERE
+      other.usage = short_codes.usage
+      AND other.target = lower(short_codes.target)
+      AND other.deleted_at IS NULL
+  );
@@ -0,0 +1,2 @@
+ALTER TABLE credentials
+DROP COLUMN IF EXISTS locale;
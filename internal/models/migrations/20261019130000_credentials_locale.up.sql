@@ -0,0 +1,7 @@
+-- Language the user wants to receive emails in. Null means the user expressed no preference: mails
+-- then follow the language of the request that triggers them.
+--
+-- The known languages are listed by the service, not enforced here, so supporting a new language
+-- does not require a migration.
+ALTER TABLE credentials
+ADD COLUMN locale text;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS login_events;
+
+DROP INDEX IF EXISTS credentials_last_login_at_idx;
+
+ALTER TABLE credentials
+DROP COLUMN IF EXISTS last_login_at;
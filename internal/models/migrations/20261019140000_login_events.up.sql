@@ -0,0 +1,25 @@
+-- Last successful sign-in of the user, with a password. Refreshing a token does not count: it
+-- proves a session is still alive, not that the user came back. Null when the user never signed
+-- in.
+ALTER TABLE credentials
+ADD COLUMN last_login_at timestamp(0) with time zone;
+
+CREATE INDEX credentials_last_login_at_idx ON credentials (last_login_at);
+
+CREATE TABLE login_events (
+  id uuid PRIMARY KEY NOT NULL,
+  /* Account the attempt targeted. Null when the email matched no account. */
+  user_id uuid REFERENCES credentials (id) ON DELETE CASCADE,
+  /* Email submitted with the attempt, kept for attempts on unknown accounts. */
+  email text,
+  /* Whether the event is a sign-in with a password, or a token refresh. */
+  kind text NOT NULL CHECK (kind IN ('login', 'refresh')),
+  /* Result of the attempt. */
+  outcome text NOT NULL CHECK (outcome IN ('success', 'invalid_password', 'unknown_email')),
+  /* Address and client the attempt came from, as seen by the service. */
+  ip text,
+  user_agent text,
+  created_at timestamp(0) with time zone NOT NULL
+);
+
+CREATE INDEX login_events_user_id_created_at_idx ON login_events (user_id, created_at, id);
@@ -0,0 +1,26 @@
+-- Append-only trail of administrative actions. The service never updates nor deletes a row;
+-- each row carries a hash over its own content and the hash of the row before it, so any change
+-- made outside the service breaks the chain from that row on.
+CREATE TABLE audit_events (
+  id uuid PRIMARY KEY NOT NULL,
+  /* Position of the event in the hash chain. */
+  seq bigint GENERATED ALWAYS AS IDENTITY UNIQUE,
+  /* User that performed the action. Null for actions run by the system, such as bootstrap. Not
+  a foreign key: the trail outlives the accounts it mentions. */
+  actor_id uuid,
+  /* Account the action applied to, if any. */
+  target_id uuid,
+  action text NOT NULL,
+  /* State of the target before and after the action. json, not jsonb: the hash covers the exact
+  bytes written, which jsonb would normalize. */
+  before json,
+  after json,
+  /* Identifier of the HTTP request that caused the action, when there is one. */
+  request_id text,
+  created_at timestamp(0) with time zone NOT NULL,
+  hash bytea NOT NULL
+);
+
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id, seq);
+
+CREATE INDEX audit_events_target_id_idx ON audit_events (target_id, seq);
@@ -0,0 +1,4 @@
+-- Whether the user turned off the notices that are not critical to the security of the account,
+-- such as the sign-in from a new device. Notices of a password or email change are always sent.
+ALTER TABLE credentials
+ADD COLUMN notices_opt_out boolean NOT NULL DEFAULT false;
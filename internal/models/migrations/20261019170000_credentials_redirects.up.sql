@@ -0,0 +1,17 @@
+-- Tombstones of the accounts merged into another one. The merge deletes the source account; its
+-- ID keeps resolving to the account it was merged into, so sessions opened before the merge, and
+-- other services that stored the ID, can find it.
+CREATE TABLE credentials_redirects (
+  /* ID of the deleted account. */
+  source_id uuid PRIMARY KEY NOT NULL,
+  /* Account the source was merged into. A later merge of this account repoints its redirects,
+  so they never chain. */
+  destination_id uuid NOT NULL REFERENCES credentials (id) ON DELETE CASCADE,
+  /* Email of the source account at the time of the merge, for the record. */
+  source_email text NOT NULL,
+  /* User that merged the accounts. Not a foreign key, like in the audit trail. */
+  actor_id uuid,
+  created_at timestamp(0) with time zone NOT NULL
+);
+
+CREATE INDEX credentials_redirects_destination_id_idx ON credentials_redirects (destination_id);
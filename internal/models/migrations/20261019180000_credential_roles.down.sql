@@ -0,0 +1,40 @@
+ALTER TABLE credentials
+ADD COLUMN role text NOT NULL DEFAULT 'auth:user';
+
+-- The column holds a single role: accounts keep the highest-ranked one they hold. The ranks mirror
+-- internal/config/permissions.config.yaml.
+UPDATE credentials
+SET
+  role = ranked.role
+FROM
+  (
+    SELECT DISTINCT
+      ON (credential_id) credential_id,
+      role
+    FROM
+      credential_roles
+    ORDER BY
+      credential_id,
+      CASE role
+        WHEN 'auth:superadmin' THEN 3
+        WHEN 'auth:admin' THEN 2
+        WHEN 'auth:user' THEN 1
+        ELSE 0
+      END DESC
+  ) AS ranked
+WHERE
+  credentials.id = ranked.credential_id;
+
+ALTER TABLE credentials
+ADD CONSTRAINT credentials_role_check CHECK (
+  role IN (
+    'auth:anon',
+    'auth:user',
+    'auth:admin',
+    'auth:superadmin'
+  )
+);
+
+CREATE INDEX credentials_role_idx ON credentials (role);
+
+DROP TABLE credential_roles;
@@ -0,0 +1,33 @@
+-- Roles granted to each account. An account holds any number of roles, and its rank is the
+-- priority of the highest one.
+CREATE TABLE credential_roles (
+  credential_id uuid NOT NULL REFERENCES credentials (id) ON DELETE CASCADE,
+  /* The set mirrors internal/config/permissions.config.yaml and must be updated with it, like the
+  check on the former credentials.role column. */
+  role text NOT NULL CHECK (
+    role IN (
+      'auth:anon',
+      'auth:user',
+      'auth:admin',
+      'auth:superadmin'
+    )
+  ),
+  created_at timestamp(0) with time zone NOT NULL,
+  PRIMARY KEY (credential_id, role)
+);
+
+CREATE INDEX credential_roles_role_idx ON credential_roles (role);
+
+-- Every account keeps the role it held, granted at its last update: the column kept no trace of
+-- when the role was set.
+INSERT INTO
+  credential_roles (credential_id, role, created_at)
+SELECT
+  id,
+  role,
+  updated_at
+FROM
+  credentials;
+
+ALTER TABLE credentials
+DROP COLUMN role;
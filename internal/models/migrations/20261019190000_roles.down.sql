@@ -0,0 +1,26 @@
+ALTER TABLE credential_roles
+DROP CONSTRAINT credential_roles_role_fkey;
+
+-- The check only knows the built-in roles: grants of the roles created since are lost.
+DELETE FROM credential_roles
+WHERE
+  role NOT IN (
+    'auth:anon',
+    'auth:user',
+    'auth:admin',
+    'auth:superadmin'
+  );
+
+ALTER TABLE credential_roles
+ADD CONSTRAINT credential_roles_role_check CHECK (
+  role IN (
+    'auth:anon',
+    'auth:user',
+    'auth:admin',
+    'auth:superadmin'
+  )
+);
+
+DROP TABLE role_inherits;
+
+DROP TABLE roles;
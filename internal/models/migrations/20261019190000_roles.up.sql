@@ -0,0 +1,100 @@
+-- Role definitions. They used to be read from internal/config/permissions.config.yaml at startup;
+-- the file now only seeds this table, and super-admins manage roles through the API.
+CREATE TABLE roles (
+  name text PRIMARY KEY CHECK (name <> ''),
+  -- Ranks the role in the hierarchy: a higher value outranks a lower one.
+  priority integer NOT NULL,
+  permissions text[] NOT NULL DEFAULT '{}',
+  created_at timestamp(0) with time zone NOT NULL,
+  updated_at timestamp(0) with time zone NOT NULL
+);
+
+-- A role grants the permissions of every role it inherits, transitively. The service refuses
+-- cycles, and serializes its writes so two concurrent changes cannot close one.
+CREATE TABLE role_inherits (
+  role text NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+  inherits text NOT NULL REFERENCES roles (name),
+  PRIMARY KEY (role, inherits),
+  CHECK (role <> inherits)
+);
+
+CREATE INDEX role_inherits_inherits_idx ON role_inherits (inherits);
+
+-- The built-in roles, as defined in permissions.config.yaml when the table was created. The
+-- foreign key below needs them, since accounts already hold them; the init job adds the roles of
+-- the file that are still missing.
+INSERT INTO
+  roles (name, priority, permissions, created_at, updated_at)
+VALUES
+  (
+    'auth:anon',
+    0,
+    ARRAY[
+      'credentials:create',
+      'credentials:email:patch',
+      'credentials:email:verify',
+      'credentials:password:reset',
+      'shortCode:password:reset',
+      'shortCode:register'
+    ],
+    now(),
+    now()
+  ),
+  (
+    'auth:user',
+    1,
+    ARRAY[
+      'credentials:export',
+      'credentials:locale:patch',
+      'credentials:logins',
+      'credentials:notices:patch',
+      'credentials:password:patch',
+      'shortCode:email:update',
+      'shortCode:email:verify'
+    ],
+    now(),
+    now()
+  ),
+  (
+    'auth:admin',
+    2,
+    ARRAY[
+      'credentials:get',
+      'credentials:exist',
+      'credentials:list',
+      'credentials:export:user',
+      'credentials:logins:user',
+      'shortCode:invite'
+    ],
+    now(),
+    now()
+  ),
+  (
+    'auth:superadmin',
+    3,
+    ARRAY[
+      'audit:list',
+      'credentials:merge',
+      'credentials:role:patch',
+      'roles:create',
+      'roles:delete',
+      'roles:list',
+      'roles:patch'
+    ],
+    now(),
+    now()
+  );
+
+INSERT INTO
+  role_inherits (role, inherits)
+VALUES
+  ('auth:user', 'auth:anon'),
+  ('auth:admin', 'auth:user'),
+  ('auth:superadmin', 'auth:admin');
+
+-- Granted roles must now be defined, rather than belong to a fixed set.
+ALTER TABLE credential_roles
+DROP CONSTRAINT credential_roles_role_check;
+
+ALTER TABLE credential_roles
+ADD CONSTRAINT credential_roles_role_fkey FOREIGN KEY (role) REFERENCES roles (name);
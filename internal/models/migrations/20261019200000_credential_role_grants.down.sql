@@ -0,0 +1 @@
+DROP TABLE credential_role_grants;
@@ -0,0 +1,20 @@
+-- Roles granted to an account for a limited time, on top of the roles it holds. A grant is
+-- honored until it expires; a sweeper then deletes it, and records the revert in the audit trail.
+CREATE TABLE credential_role_grants (
+  id uuid PRIMARY KEY,
+  credential_id uuid NOT NULL REFERENCES credentials (id) ON DELETE CASCADE,
+  role text NOT NULL REFERENCES roles (name),
+  -- Why the role was granted, for the audit trail.
+  reason text NOT NULL CHECK (reason <> ''),
+  -- The user that granted the role. Kept when that user is deleted: the audit trail names it.
+  granted_by uuid REFERENCES credentials (id) ON DELETE SET NULL,
+  created_at timestamp(0) with time zone NOT NULL,
+  expires_at timestamp(0) with time zone NOT NULL,
+  CHECK (expires_at > created_at)
+);
+
+CREATE INDEX credential_role_grants_credential_id_idx ON credential_role_grants (credential_id, expires_at);
+
+CREATE INDEX credential_role_grants_expires_at_idx ON credential_role_grants (expires_at);
+
+CREATE INDEX credential_role_grants_role_idx ON credential_role_grants (role);
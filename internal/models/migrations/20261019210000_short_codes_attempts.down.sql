@@ -0,0 +1,2 @@
+ALTER TABLE short_codes
+DROP COLUMN attempts;
@@ -0,0 +1,4 @@
+-- Counts the failed attempts at redeeming a short code. Once the limit of its usage is reached,
+-- the code is deleted, so it cannot be guessed by brute force before it expires.
+ALTER TABLE short_codes
+ADD COLUMN attempts integer NOT NULL DEFAULT 0;
@@ -0,0 +1,16 @@
+-- Outbox of the account changes other services must act on, such as a merge. A row is written in
+-- the transaction of the change, so it exists if and only if the change committed. Services read
+-- the rows in seq order, and resume after the last one they handled.
+CREATE TABLE credentials_events (
+  id uuid PRIMARY KEY NOT NULL,
+  /* Position of the event in the outbox. Writers are serialized, so a row never commits after a
+  row with a higher seq. */
+  seq bigint GENERATED ALWAYS AS IDENTITY UNIQUE,
+  kind text NOT NULL CHECK (kind = 'credentials.merge'),
+  /* Account the event is about: for a merge, the destination. Not a foreign key, like in the
+  audit trail: the event outlives the account. */
+  user_id uuid NOT NULL,
+  /* For a merge, the deleted account whose data moves to user_id. */
+  source_id uuid,
+  created_at timestamp(0) with time zone NOT NULL
+);
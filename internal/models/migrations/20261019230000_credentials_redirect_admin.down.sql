@@ -0,0 +1,14 @@
+UPDATE roles
+SET
+  permissions = array_remove(array_remove(permissions, 'credentials:events'), 'credentials:redirect'),
+  updated_at = now()
+WHERE
+  name = 'auth:admin';
+
+UPDATE roles
+SET
+  permissions = array_append(permissions, 'credentials:redirect'),
+  updated_at = now()
+WHERE
+  name = 'auth:user'
+  AND NOT 'credentials:redirect' = ANY (permissions);
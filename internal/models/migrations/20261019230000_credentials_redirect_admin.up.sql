@@ -0,0 +1,24 @@
+-- Redirects and merge events tell which accounts belong to the same person: they move from every
+-- user to administrators and services. The init job only adds the roles it lacks, so the stored
+-- built-in roles are updated here.
+UPDATE roles
+SET
+  permissions = array_remove(permissions, 'credentials:redirect'),
+  updated_at = now()
+WHERE
+  name = 'auth:user'
+  AND 'credentials:redirect' = ANY (permissions);
+
+UPDATE roles
+SET
+  permissions = permissions || ARRAY(
+    SELECT
+      permission
+    FROM
+      unnest(ARRAY['credentials:events', 'credentials:redirect']) AS permission
+    WHERE
+      NOT permission = ANY (roles.permissions)
+  ),
+  updated_at = now()
+WHERE
+  name = 'auth:admin';
@@ -0,0 +1,27 @@
+UPDATE roles
+SET
+  permissions = ARRAY(
+    SELECT
+      permission
+    FROM
+      unnest(roles.permissions) AS permission
+    WHERE
+      NOT permission = ANY (removed.permissions)
+  ),
+  updated_at = now()
+FROM
+  (
+    VALUES
+      (
+        'auth:anon',
+        ARRAY['permissions:check', 'permissions:roles', 'shortCode:verify']
+      ),
+      (
+        'auth:admin',
+        ARRAY['permissions:check:user', 'shortCode:admin']
+      ),
+      ('auth:superadmin', ARRAY['credentials:role:grant'])
+  ) AS removed (name, permissions)
+WHERE
+  roles.name = removed.name
+  AND roles.permissions && removed.permissions;
@@ -0,0 +1,30 @@
+-- The init job no longer adds permissions to the roles it finds, so administrators keep control of
+-- stored roles. The permissions the built-in roles received from it since the roles table was
+-- created are added here once, for the databases created after them.
+UPDATE roles
+SET
+  permissions = roles.permissions || ARRAY(
+    SELECT
+      permission
+    FROM
+      unnest(added.permissions) AS permission
+    WHERE
+      NOT permission = ANY (roles.permissions)
+  ),
+  updated_at = now()
+FROM
+  (
+    VALUES
+      (
+        'auth:anon',
+        ARRAY['permissions:check', 'permissions:roles', 'shortCode:verify']
+      ),
+      (
+        'auth:admin',
+        ARRAY['permissions:check:user', 'shortCode:admin']
+      ),
+      ('auth:superadmin', ARRAY['credentials:role:grant'])
+  ) AS added (name, permissions)
+WHERE
+  roles.name = added.name
+  AND NOT added.permissions <@ roles.permissions;
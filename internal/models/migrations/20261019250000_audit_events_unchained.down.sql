@@ -0,0 +1,8 @@
+-- The chain cannot hold rows without a hash: the reads recorded outside it are dropped.
+DELETE FROM audit_events
+WHERE
+  hash IS NULL;
+
+ALTER TABLE audit_events
+ALTER COLUMN hash
+SET NOT NULL;
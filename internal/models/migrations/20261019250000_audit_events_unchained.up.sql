@@ -0,0 +1,6 @@
+-- Reads, such as an administrator looking an account up, are recorded outside the hash chain:
+-- their hash is null, and writing them takes no lock, so lookups never wait on one another nor
+-- on the writers of the chain. Only the rows with a hash form the chain.
+ALTER TABLE audit_events
+ALTER COLUMN hash
+DROP NOT NULL;
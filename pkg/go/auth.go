@@ -8,6 +8,7 @@ package serviceauthentication
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/samber/lo"
@@ -30,12 +31,50 @@ type Permissions = config.Permissions
 // stored in the request context by the auth middleware.
 type Claims = core.AccessTokenClaims
 
+// Requirement is a permission expression a route is gated on: a [Permission], or requirements
+// combined with [AnyOf] and [AllOf], nested at will.
+type Requirement = middlewares.Requirement
+
+// Permission is a [Requirement] on a single permission.
+type Permission = middlewares.Permission
+
+// AnyOf requires at least one of the requirements.
+func AnyOf(requirements ...Requirement) Requirement {
+	return middlewares.AnyOf(requirements...)
+}
+
+// AllOf requires every one of the requirements.
+func AllOf(requirements ...Requirement) Requirement {
+	return middlewares.AllOf(requirements...)
+}
+
+// AnyOfPermissions requires at least one of the permissions, like a [PermissionsHandler] does.
+func AnyOfPermissions(permissions ...string) Requirement {
+	return middlewares.AnyOfPermissions(permissions...)
+}
+
+// AllOfPermissions requires every one of the permissions.
+func AllOfPermissions(permissions ...string) Requirement {
+	return middlewares.AllOfPermissions(permissions...)
+}
+
 // PermissionsHandler returns a chi sub-router that enforces the listed permissions for the
 // routes mounted on it. Pass zero permissions for optional authentication: the request is
 // allowed through without an Authorization header, and a valid bearer token (if present)
 // still populates [Claims] in the request context for handlers that branch on identity.
+//
+// A route mounted with several permissions admits a caller granted any of them; use a
+// [RequirementHandler] to require all of them, or a nested combination.
 type PermissionsHandler func(r chi.Router, permissions ...string) chi.Router
 
+// RequirementHandler returns a chi sub-router that enforces a [Requirement] for the routes
+// mounted on it. A nil requirement makes authentication optional, like a [PermissionsHandler]
+// without permissions. When the caller is denied, the unmet part of the requirement is reported
+// on the span.
+//
+//	withRequirement(router, serviceauthentication.AllOfPermissions("post:read", "post:write")).Put(...)
+type RequirementHandler func(r chi.Router, requirement Requirement) chi.Router
+
 // NewAuthHandler constructs a [PermissionsHandler] backed by the given claims verifier and
 // permission map. Role inheritance is resolved at startup: a role inherits every permission
 // transitively granted by the roles in its Inherits list, so route mounts only need to
@@ -45,25 +84,106 @@ func NewAuthHandler(
 	permissions Permissions,
 	logger logging.Log,
 ) PermissionsHandler {
-	permissionsByRole := lo.Must(lib.ResolveDependants[string, string](
-		lo.MapEntries(
-			permissions.Roles,
-			func(key string, value config.Role) (string, []string) {
-				return key, value.Permissions
-			},
-		),
-		lo.MapEntries(permissions.Roles, func(key string, value config.Role) (string, []string) {
-			return key, value.Inherits
-		}),
-	))
-
-	middlewareAuth := middlewares.NewAuth(claimsVerifier, permissionsByRole, logger)
+	middlewareAuth := newAuth(claimsVerifier, permissions, logger)
 
 	return func(r chi.Router, permissions ...string) chi.Router {
 		return r.With(middlewareAuth.Middleware(permissions))
 	}
 }
 
+// NewRequirementHandler constructs a [RequirementHandler]. It resolves the permission map like
+// [NewAuthHandler], and both can be built from the same one.
+func NewRequirementHandler(
+	claimsVerifier middlewares.AuthClaimsVerifier,
+	permissions Permissions,
+	logger logging.Log,
+) RequirementHandler {
+	middlewareAuth := newAuth(claimsVerifier, permissions, logger)
+
+	return func(r chi.Router, requirement Requirement) chi.Router {
+		return r.With(middlewareAuth.Require(requirement))
+	}
+}
+
+func newAuth(
+	claimsVerifier middlewares.AuthClaimsVerifier, permissions Permissions, logger logging.Log,
+) *middlewares.Auth {
+	permissionsByRole := lo.Must(permissions.Resolve())
+
+	return middlewares.NewAuth(claimsVerifier, middlewares.StaticPermissions(permissionsByRole), logger)
+}
+
+// Policies maps policy names to their CEL expressions; see [NewPolicyHandler].
+type Policies = config.Policies
+
+// Policy is a single authorization policy.
+type Policy = config.Policy
+
+// PolicyTargetResolver returns the attributes of the resource a request acts on, that policies
+// read through their target variable. It returns [ErrPolicyTargetNotFound] when the resource does
+// not exist.
+type PolicyTargetResolver = middlewares.PolicyTargetResolver
+
+// ErrPolicyTargetNotFound is returned by a [PolicyTargetResolver] when the resource the request
+// acts on does not exist. The request gets a 404.
+var ErrPolicyTargetNotFound = middlewares.ErrPolicyTargetNotFound
+
+// ErrPolicyTargetInvalid is returned by a [PolicyTargetResolver] when the request does not name
+// the resource it acts on in a readable way. The request gets a 400.
+var ErrPolicyTargetInvalid = middlewares.ErrPolicyTargetInvalid
+
+// PolicyHandler returns a chi sub-router that admits a request only when the named policy allows
+// it. The caller's claims must already be on the context: mount the routes through a
+// [PermissionsHandler] or a [RequirementHandler] first.
+//
+//	withPolicy(withAuth(router, "post:write"), "postOwner", resolvePost).Patch("/posts/{id}", ...)
+type PolicyHandler func(r chi.Router, policy string, resolveTarget PolicyTargetResolver) chi.Router
+
+// NewPolicyHandler constructs a [PolicyHandler]. The policies compile at startup, and the handler
+// panics on one that does not; it also panics when mounting a route on an unknown policy.
+//
+// Policies are CEL expressions (https://cel.dev) that return true to allow the request, and read
+// three maps: claims (userID, roles, permissions, rank, emailVerified), request (method, path,
+// params, query), and target, returned by the route's resolver. This is the same policy language
+// as the authentication service's own policies.config.yaml.
+func NewPolicyHandler(permissions Permissions, policies Policies, logger logging.Log) PolicyHandler {
+	engine := lo.Must(lib.NewPolicyEngine(policies.Expressions()))
+
+	middlewarePolicy := middlewares.NewPolicy(engine, policyRoles{
+		StaticPermissions: lo.Must(permissions.Resolve()),
+		Permissions:       permissions,
+	}, logger)
+
+	return func(r chi.Router, policy string, resolveTarget PolicyTargetResolver) chi.Router {
+		if !engine.Has(policy) {
+			panic(fmt.Errorf("%w: %q", lib.ErrUnknownPolicy, policy))
+		}
+
+		return r.With(middlewarePolicy.Middleware(policy, resolveTarget))
+	}
+}
+
+// policyRoles resolves roles to permissions and ranks from a static permission map.
+type policyRoles struct {
+	middlewares.StaticPermissions
+	Permissions
+}
+
+// RequireVerifiedEmail wraps a [PermissionsHandler] so the routes it mounts also require the
+// caller's email to be verified, as reported by the emailVerified claim. Unverified callers get
+// a 403; requests without a token get a 401, even when no permission is listed. Mount the routes
+// that need it through the returned handler, and the others through the original one:
+//
+//	withAuth := serviceauthentication.NewAuthHandler(verifier, permissions, logger)
+//	withVerifiedAuth := serviceauthentication.RequireVerifiedEmail(withAuth, logger)
+func RequireVerifiedEmail(handler PermissionsHandler, logger logging.Log) PermissionsHandler {
+	middlewareVerifiedEmail := middlewares.NewVerifiedEmail(logger)
+
+	return func(r chi.Router, permissions ...string) chi.Router {
+		return handler(r, permissions...).With(middlewareVerifiedEmail.Middleware())
+	}
+}
+
 // SetClaimsContext stores the authenticated user's claims in the context. The auth
 // middleware calls this after successful token verification; downstream handlers should
 // not need to call it directly.
@@ -19,13 +19,14 @@ import (
 // fakeVerifier stands in for the JSON-keys claims verifier: it returns fixed claims for any
 // token, so the test exercises NewAuthHandler's role resolution without a running service.
 type fakeVerifier struct {
-	roles []string
+	roles         []string
+	emailVerified bool
 }
 
 func (f fakeVerifier) VerifyClaims(
 	_ context.Context, _ *servicejsonkeys.VerifyClaimsRequest,
 ) (*core.AccessTokenClaims, error) {
-	return &core.AccessTokenClaims{Roles: f.roles}, nil
+	return &core.AccessTokenClaims{Roles: f.roles, EmailVerified: f.emailVerified}, nil
 }
 
 // NewAuthHandler resolves role inheritance transitively at startup and wraps it in lo.Must.
@@ -103,3 +104,199 @@ func TestNewAuthHandlerPanicsOnCircularInheritance(t *testing.T) {
 		serviceauthentication.NewAuthHandler(fakeVerifier{}, permissions, config.LoggerDev)
 	})
 }
+
+// RequireVerifiedEmail stacks on top of the permission check: the caller needs both the
+// permission and a verified email.
+func TestRequireVerifiedEmail(t *testing.T) {
+	t.Parallel()
+
+	permissions := serviceauthentication.Permissions{
+		Roles: map[string]config.Role{
+			"user": {Permissions: []string{"user:read"}},
+		},
+	}
+
+	gatedStatus := func(t *testing.T, verifier fakeVerifier, permission string) int {
+		t.Helper()
+
+		handler := serviceauthentication.RequireVerifiedEmail(
+			serviceauthentication.NewAuthHandler(verifier, permissions, config.LoggerDev),
+			config.LoggerDev,
+		)
+
+		router := chi.NewRouter()
+		handler(router, permission).Get("/", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	t.Run("a verified user with the permission is admitted", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := fakeVerifier{roles: []string{"user"}, emailVerified: true}
+		require.Equal(t, http.StatusOK, gatedStatus(t, verifier, "user:read"))
+	})
+
+	t.Run("an unverified user is refused", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := fakeVerifier{roles: []string{"user"}}
+		require.Equal(t, http.StatusForbidden, gatedStatus(t, verifier, "user:read"))
+	})
+
+	t.Run("a verified email does not replace the permission", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := fakeVerifier{roles: []string{"user"}, emailVerified: true}
+		require.Equal(t, http.StatusForbidden, gatedStatus(t, verifier, "admin:read"))
+	})
+}
+
+// A RequirementHandler gates routes on permission expressions: AllOf needs every permission,
+// across the caller's roles, where the variadic PermissionsHandler needs any of them.
+func TestNewRequirementHandler(t *testing.T) {
+	t.Parallel()
+
+	permissions := serviceauthentication.Permissions{
+		Roles: map[string]config.Role{
+			"reader": {Permissions: []string{"post:read"}},
+			"writer": {Permissions: []string{"post:write"}},
+		},
+	}
+
+	gatedStatus := func(t *testing.T, roles []string, requirement serviceauthentication.Requirement) int {
+		t.Helper()
+
+		handler := serviceauthentication.NewRequirementHandler(
+			fakeVerifier{roles: roles}, permissions, config.LoggerDev,
+		)
+
+		router := chi.NewRouter()
+		handler(router, requirement).Get("/", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	t.Run("all of the permissions are required", func(t *testing.T) {
+		t.Parallel()
+
+		requirement := serviceauthentication.AllOfPermissions("post:read", "post:write")
+
+		require.Equal(t, http.StatusForbidden, gatedStatus(t, []string{"reader"}, requirement))
+		require.Equal(t, http.StatusOK, gatedStatus(t, []string{"reader", "writer"}, requirement))
+	})
+
+	t.Run("requirements nest", func(t *testing.T) {
+		t.Parallel()
+
+		requirement := serviceauthentication.AnyOf(
+			serviceauthentication.AllOfPermissions("post:read", "post:write"),
+			serviceauthentication.Permission("post:write"),
+		)
+
+		require.Equal(t, http.StatusForbidden, gatedStatus(t, []string{"reader"}, requirement))
+		require.Equal(t, http.StatusOK, gatedStatus(t, []string{"writer"}, requirement))
+	})
+}
+
+// A PolicyHandler gates routes on CEL policies, evaluated against the caller's claims and the
+// target the route resolves.
+func TestNewPolicyHandler(t *testing.T) {
+	t.Parallel()
+
+	permissions := serviceauthentication.Permissions{
+		Roles: map[string]config.Role{
+			"user":  {Priority: 0, Permissions: []string{"post:write"}},
+			"admin": {Priority: 1, Inherits: []string{"user"}},
+		},
+	}
+
+	policies := serviceauthentication.Policies{
+		Rules: map[string]serviceauthentication.Policy{
+			"moderate": {Expression: `"post:write" in claims.permissions && claims.rank > target.authorRank`},
+		},
+	}
+
+	gatedStatus := func(t *testing.T, roles []string, resolveTarget serviceauthentication.PolicyTargetResolver) int {
+		t.Helper()
+
+		withAuth := serviceauthentication.NewAuthHandler(fakeVerifier{roles: roles}, permissions, config.LoggerDev)
+		withPolicy := serviceauthentication.NewPolicyHandler(permissions, policies, config.LoggerDev)
+
+		router := chi.NewRouter()
+		withPolicy(withAuth(router, "post:write"), "moderate", resolveTarget).
+			Get("/", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	userPost := func(_ *http.Request) (map[string]any, error) {
+		return map[string]any{"authorRank": 0}, nil
+	}
+
+	t.Run("the policy allows", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, http.StatusOK, gatedStatus(t, []string{"admin"}, userPost))
+	})
+
+	t.Run("the policy denies", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, http.StatusForbidden, gatedStatus(t, []string{"user"}, userPost))
+	})
+
+	t.Run("the target does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		missingPost := func(_ *http.Request) (map[string]any, error) {
+			return nil, serviceauthentication.ErrPolicyTargetNotFound
+		}
+
+		require.Equal(t, http.StatusNotFound, gatedStatus(t, []string{"admin"}, missingPost))
+	})
+
+	t.Run("an invalid policy panics", func(t *testing.T) {
+		t.Parallel()
+
+		require.Panics(t, func() {
+			serviceauthentication.NewPolicyHandler(permissions, serviceauthentication.Policies{
+				Rules: map[string]serviceauthentication.Policy{"broken": {Expression: `claims.rank >`}},
+			}, config.LoggerDev)
+		})
+	})
+
+	t.Run("an unknown policy panics", func(t *testing.T) {
+		t.Parallel()
+
+		withPolicy := serviceauthentication.NewPolicyHandler(permissions, policies, config.LoggerDev)
+
+		require.Panics(t, func() {
+			withPolicy(chi.NewRouter(), "unknown", nil)
+		})
+	})
+}
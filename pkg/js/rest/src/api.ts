@@ -52,6 +52,21 @@ export class AuthenticationApi {
       .then(validator ? decodeHttpResponse(validator) : decodeRawHttpResponse<T>);
   }
 
+  /**
+   * Same as `fetch`, but also returns the headers of the response, for endpoints that send metadata
+   * alongside the body.
+   */
+  async fetchWithHeaders<T>(
+    input: string,
+    validator?: ZodType<T>,
+    init?: RequestInit
+  ): Promise<{ data: T; headers: Headers }> {
+    const response = await fetch(`${this._baseUrl}${input}`, init).then(handleHttpResponse);
+    const data = await (validator ? decodeHttpResponse(validator) : decodeRawHttpResponse<T>)(response);
+
+    return { data, headers: response.headers };
+  }
+
   /** Checks that the server is reachable. Throws on any non-2xx response. */
   async ping(): Promise<void> {
     await this.fetchVoid("/v2/ping", { method: "GET" });
@@ -0,0 +1,76 @@
+import type { AuthenticationApi } from "./api";
+
+import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
+
+import { z } from "zod";
+
+/**
+ * An administrative action, as recorded in the audit trail. `before` and `after` hold the state the action
+ * changed, when it changed any. `hash` chains the event to the one before it, hex-encoded; reads are recorded
+ * outside the chain, without one.
+ */
+export const AuditEventSchema = z.object({
+  id: z.string(),
+  seq: z.int(),
+  actorID: z.string().optional(),
+  targetID: z.string().optional(),
+  action: z.enum([
+    "credentials.get",
+    "credentials.list",
+    "credentials.export",
+    "credentials.logins",
+    "credentials.updateRole",
+    "credentials.grantRole",
+    "credentials.grantRole.expire",
+    "credentials.merge",
+    "credentials.superAdmin.create",
+    "credentials.superAdmin.update",
+    "roles.create",
+    "roles.update",
+    "roles.delete",
+    "shortCodes.list",
+    "shortCodes.revoke",
+  ]),
+  before: z.record(z.string(), z.unknown()).optional(),
+  after: z.record(z.string(), z.unknown()).optional(),
+  requestID: z.string().optional(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  hash: z.string().optional(),
+});
+
+export type AuditEvent = z.infer<typeof AuditEventSchema>;
+
+/** Pagination window and optional filters for browsing the audit trail. */
+export const AuditListRequestSchema = z.object({
+  actorID: z.uuid().optional(),
+  targetID: z.uuid().optional(),
+  limit: z.int().max(100).optional(),
+  offset: z.int().min(0).optional(),
+});
+
+export type AuditListRequest = z.infer<typeof AuditListRequestSchema>;
+
+/** A page of the audit trail, newest first. */
+export const AuditListResponseSchema = z.object({
+  events: z.array(AuditEventSchema),
+});
+
+export type AuditListResponse = z.infer<typeof AuditListResponseSchema>;
+
+/** Lists a page of the audit trail, defaulting to the latest 100 events. */
+export async function auditList(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: AuditListRequest
+): Promise<AuditListResponse> {
+  const params = new URLSearchParams();
+  params.set("limit", `${form.limit || 100}`);
+  if (form.offset) params.set("offset", `${form.offset}`);
+  if (form.actorID) params.set("actorID", form.actorID);
+  if (form.targetID) params.set("targetID", form.targetID);
+
+  return await api.fetch(`/v2/audit?${params.toString()}`, AuditListResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
@@ -8,12 +8,18 @@ import { z } from "zod";
 /**
  * Identity encoded in a session's access token: the authenticated user, their roles, and the
  * identifier of the refresh token that issued the session. An anonymous session carries roles
- * but no user, so every field is optional.
+ * but no user, so every field is optional. `emailVerified` is only present once the user verified
+ * their email, and `grantExpiresAt` while the user holds a temporary role: the token is refused past it.
  */
 export const ClaimsSchema = z.object({
   userID: z.string().optional(),
   roles: z.array(RoleSchema).optional(),
   refreshTokenID: z.string().optional(),
+  emailVerified: z.boolean().optional(),
+  grantExpiresAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
 });
 
 export type Claims = z.infer<typeof ClaimsSchema>;
@@ -2,3 +2,4 @@ export const MAX_PASSWORD_LENGTH = 1024;
 export const MIN_PASSWORD_LENGTH = 4;
 export const MAX_EMAIL_LENGTH = 1024;
 export const MAX_SHORT_CODE_LENGTH = 1024;
+export const MAX_ROLE_LENGTH = 64;
@@ -1,5 +1,6 @@
 import type { AuthenticationApi } from "./api";
-import { EmailSchema, PasswordSchema, RoleSchema, ShortCodeSchema } from "./form";
+import { EmailSchema, LangSchema, PasswordSchema, RoleSchema, ShortCodeSchema } from "./form";
+import { AuditEventSchema } from "./audit";
 import { type Token, TokenSchema } from "./token";
 
 import { HTTP_HEADERS, isHttpStatusError } from "@a-novel-kit/nodelib-browser/http";
@@ -7,24 +8,88 @@ import { HTTP_HEADERS, isHttpStatusError } from "@a-novel-kit/nodelib-browser/ht
 import { z } from "zod";
 
 /**
- * An account record: its identifier, current email and role, and lifecycle timestamps. The
- * `createdAt` and `updatedAt` fields arrive as ISO strings and are parsed into `Date` objects.
+ * An account record: its identifier, current email and roles, and lifecycle timestamps. The
+ * timestamps arrive as ISO strings and are parsed into `Date` objects. `emailVerifiedAt` is
+ * absent while the email was never verified, `locale` while the user has no preferred language,
+ * `noticesOptOut` while the user receives every security notice, and `lastLoginAt` while the user
+ * never signed in.
  */
 export const CredentialsSchema = z.object({
   id: z.string(),
   email: z.string(),
-  role: z.string(),
+  roles: z.array(z.string()),
+  emailVerifiedAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
+  locale: LangSchema.optional(),
+  noticesOptOut: z.boolean().optional(),
+  lastLoginAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
   createdAt: z.iso.datetime().transform((value) => new Date(value)),
   updatedAt: z.iso.datetime().transform((value) => new Date(value)),
 });
 
 export type Credentials = z.infer<typeof CredentialsSchema>;
 
-/** New-account details: login email, password, and the short code emailed to confirm the address. */
+/**
+ * The metadata of a short code issued to an account, as found in a personal data export. The code
+ * itself is never exported; `deletedAt` and `deletedComment` are set when it was invalidated early.
+ */
+export const PersonalDataShortCodeSchema = z.object({
+  id: z.string(),
+  usage: z.string(),
+  target: z.string(),
+  data: z.unknown().optional(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  expiresAt: z.iso.datetime().transform((value) => new Date(value)),
+  deletedAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
+  deletedComment: z.string().optional(),
+});
+
+export type PersonalDataShortCode = z.infer<typeof PersonalDataShortCodeSchema>;
+
+/**
+ * An attempt to obtain a token, from the login history of an account. `email` is only set for
+ * sign-ins; `ip` and `userAgent` are absent when the client could not be identified.
+ */
+export const LoginEventSchema = z.object({
+  id: z.string(),
+  kind: z.enum(["login", "refresh"]),
+  outcome: z.enum(["success", "invalid_password", "unknown_email"]),
+  email: z.string().optional(),
+  ip: z.string().optional(),
+  userAgent: z.string().optional(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type LoginEvent = z.infer<typeof LoginEventSchema>;
+
+/** Every record the service holds about a single account. */
+export const PersonalDataSchema = z.object({
+  credentials: CredentialsSchema,
+  shortCodes: z.array(PersonalDataShortCodeSchema),
+  loginEvents: z.array(LoginEventSchema),
+  /** Administrative actions the account performed, or that applied to it, newest first. */
+  auditEvents: z.array(AuditEventSchema),
+});
+
+export type PersonalData = z.infer<typeof PersonalDataSchema>;
+
+/**
+ * New-account details: login email, password, and the short code emailed to confirm the address. `lang` optionally
+ * sets the language the account receives emails in.
+ */
 export const CredentialsCreateRequestSchema = z.object({
   email: EmailSchema,
   password: PasswordSchema,
   shortCode: ShortCodeSchema,
+  lang: LangSchema.optional(),
 });
 
 export type CredentialsCreateRequest = z.infer<typeof CredentialsCreateRequestSchema>;
@@ -43,15 +108,81 @@ export const CredentialsGetRequestSchema = z.object({
 
 export type CredentialsGetRequest = z.infer<typeof CredentialsGetRequestSchema>;
 
-/** Pagination window and an optional role filter for listing accounts. */
+/** The identifiers of the accounts to fetch, at most 500 per call. */
+export const CredentialsGetBatchRequestSchema = z.object({
+  ids: z.array(z.uuid()).min(1).max(500),
+});
+
+export type CredentialsGetBatchRequest = z.infer<typeof CredentialsGetBatchRequestSchema>;
+
+/** The accounts found, in request order, and the requested identifiers that matched no account. */
+export const CredentialsBatchSchema = z.object({
+  credentials: z.array(CredentialsSchema),
+  missing: z.array(z.string()),
+});
+
+export type CredentialsBatch = z.infer<typeof CredentialsBatchSchema>;
+
+/** The identifier of the account to export. */
+export const CredentialsExportUserRequestSchema = z.object({
+  id: z.uuid(),
+});
+
+export type CredentialsExportUserRequest = z.infer<typeof CredentialsExportUserRequestSchema>;
+
+/** Pagination window for browsing the login history of the authenticated account. */
+export const CredentialsLoginsRequestSchema = z.object({
+  limit: z.int().max(100).optional(),
+  offset: z.int().min(0).optional(),
+});
+
+export type CredentialsLoginsRequest = z.infer<typeof CredentialsLoginsRequestSchema>;
+
+/** The account whose login history to browse, and the pagination window. */
+export const CredentialsLoginsUserRequestSchema = CredentialsLoginsRequestSchema.extend({
+  id: z.uuid(),
+});
+
+export type CredentialsLoginsUserRequest = z.infer<typeof CredentialsLoginsUserRequestSchema>;
+
+/** A page of the login history, newest first. */
+export const CredentialsLoginsResponseSchema = z.object({
+  logins: z.array(LoginEventSchema),
+});
+
+export type CredentialsLoginsResponse = z.infer<typeof CredentialsLoginsResponseSchema>;
+
+/**
+ * Pagination window and optional filters for listing accounts. Pass the `nextCursor` of a page, from
+ * `credentialsListPage`, as `cursor` to fetch the next one, keeping the other filters unchanged;
+ * `offset` cannot be combined with a cursor.
+ */
 export const CredentialsListRequestSchema = z.object({
   limit: z.int().max(100).optional(),
   offset: z.int().min(0).optional(),
   roles: z.array(RoleSchema).max(10).optional(),
+  cursor: z.string().max(1024).optional(),
+  email: z.string().max(1024).optional(),
+  emailMatch: z.enum(["contains", "prefix"]).optional(),
+  createdAfter: z.date().optional(),
+  createdBefore: z.date().optional(),
+  inactiveSince: z.date().optional(),
+  order: z.enum(["desc", "asc"]).optional(),
+  withTotal: z.boolean().optional(),
 });
 
 export type CredentialsListRequest = z.infer<typeof CredentialsListRequestSchema>;
 
+/**
+ * A page of accounts, with the cursor of the next page if any, and the total count when requested. The server
+ * sends the cursor and the count in the `X-Next-Cursor` and `X-Total-Count` headers.
+ */
+export type CredentialsListPage = {
+  credentials: Credentials[];
+  nextCursor?: string;
+  total?: number;
+};
+
 /** Reset details for the forgotten-password flow: the new password, the emailed short code, and the target account. */
 export const CredentialsResetPasswordRequestSchema = z.object({
   password: PasswordSchema,
@@ -69,6 +200,14 @@ export const CredentialsUpdateEmailRequestSchema = z.object({
 
 export type CredentialsUpdateEmailRequest = z.infer<typeof CredentialsUpdateEmailRequestSchema>;
 
+/** The target account and the short code emailed to verify its current address. */
+export const CredentialsVerifyEmailRequestSchema = z.object({
+  userID: z.uuid(),
+  shortCode: ShortCodeSchema,
+});
+
+export type CredentialsVerifyEmailRequest = z.infer<typeof CredentialsVerifyEmailRequestSchema>;
+
 /** The new password, guarded by the current one to prove the caller owns the account. */
 export const CredentialsUpdatePasswordRequestSchema = z.object({
   password: PasswordSchema,
@@ -77,13 +216,115 @@ export const CredentialsUpdatePasswordRequestSchema = z.object({
 
 export type CredentialsUpdatePasswordRequest = z.infer<typeof CredentialsUpdatePasswordRequestSchema>;
 
-/** The target account and the role to grant it. */
-export const CredentialsUpdateRoleRequestSchema = z.object({
+/** The language the authenticated account receives emails in. */
+export const CredentialsUpdateLocaleRequestSchema = z.object({
+  lang: LangSchema,
+});
+
+export type CredentialsUpdateLocaleRequest = z.infer<typeof CredentialsUpdateLocaleRequestSchema>;
+
+/** Whether the authenticated account stops receiving non-critical security notices. */
+export const CredentialsUpdateNoticesRequestSchema = z.object({
+  optOut: z.boolean(),
+});
+
+export type CredentialsUpdateNoticesRequest = z.infer<typeof CredentialsUpdateNoticesRequestSchema>;
+
+/**
+ * The target account, and the roles to grant or revoke. At least one list must be set, and a role cannot appear
+ * in both lists.
+ */
+export const CredentialsUpdateRoleRequestSchema = z
+  .object({
+    userID: z.uuid(),
+    add: z.array(RoleSchema).max(16).optional(),
+    remove: z.array(RoleSchema).max(16).optional(),
+  })
+  .refine((form) => (form.add?.length ?? 0) + (form.remove?.length ?? 0) > 0, {
+    message: "at least one role must be added or removed",
+  });
+
+export type CredentialsUpdateRoleRequest = z.infer<typeof CredentialsUpdateRoleRequestSchema>;
+
+/**
+ * The target account, the role to grant it for a limited time, why, and until when. The grant cannot outlast
+ * the maximum the service is configured with (24 hours by default).
+ */
+export const CredentialsGrantRoleRequestSchema = z.object({
   userID: z.uuid(),
   role: RoleSchema,
+  reason: z.string().min(1).max(512),
+  expiresAt: z.date(),
 });
 
-export type CredentialsUpdateRoleRequest = z.infer<typeof CredentialsUpdateRoleRequestSchema>;
+export type CredentialsGrantRoleRequest = z.infer<typeof CredentialsGrantRoleRequestSchema>;
+
+/**
+ * A role granted to an account for a limited time. `grantedBy` is absent once the granting account is
+ * deleted.
+ */
+export const RoleGrantSchema = z.object({
+  id: z.string(),
+  userID: z.string(),
+  role: z.string(),
+  reason: z.string(),
+  grantedBy: z.string().optional(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  expiresAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type RoleGrant = z.infer<typeof RoleGrantSchema>;
+
+/** The duplicate account to merge, and the account it merges into. */
+export const CredentialsMergeRequestSchema = z.object({
+  sourceID: z.uuid(),
+  destinationID: z.uuid(),
+});
+
+export type CredentialsMergeRequest = z.infer<typeof CredentialsMergeRequestSchema>;
+
+/** The ID of a merged account to resolve. */
+export const CredentialsRedirectGetRequestSchema = z.object({
+  id: z.uuid(),
+});
+
+export type CredentialsRedirectGetRequest = z.infer<typeof CredentialsRedirectGetRequestSchema>;
+
+/** The tombstone of an account merged into another one: `sourceID` now resolves to `destinationID`. */
+export const CredentialsRedirectSchema = z.object({
+  sourceID: z.string(),
+  destinationID: z.string(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type CredentialsRedirect = z.infer<typeof CredentialsRedirectSchema>;
+
+/** An account change, as written to the outbox. For a merge, `sourceID` moves to `userID`. */
+export const CredentialsEventSchema = z.object({
+  id: z.string(),
+  seq: z.int(),
+  kind: z.enum(["credentials.merge"]),
+  userID: z.string(),
+  sourceID: z.string().optional(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type CredentialsEvent = z.infer<typeof CredentialsEventSchema>;
+
+/** The position to resume the outbox from, and the size of the page. */
+export const CredentialsEventListRequestSchema = z.object({
+  after: z.int().min(0).optional(),
+  limit: z.int().max(100).optional(),
+});
+
+export type CredentialsEventListRequest = z.infer<typeof CredentialsEventListRequestSchema>;
+
+/** A page of the outbox of account changes, oldest first. */
+export const CredentialsEventListResponseSchema = z.object({
+  events: z.array(CredentialsEventSchema),
+});
+
+export type CredentialsEventListResponse = z.infer<typeof CredentialsEventListResponseSchema>;
 
 /** Fetches a single account by its identifier. */
 export async function credentialsGet(
@@ -100,6 +341,19 @@ export async function credentialsGet(
   });
 }
 
+/** Fetches several accounts by their identifiers in a single call. Unknown identifiers are listed in `missing`. */
+export async function credentialsGetBatch(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsGetBatchRequest
+): Promise<CredentialsBatch> {
+  return await api.fetch("/v2/credentials/batch", CredentialsBatchSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
 /** Reports whether an account exists for the given email, resolving to `false` on a 404 rather than throwing. */
 export async function credentialsExists(
   api: AuthenticationApi,
@@ -121,18 +375,111 @@ export async function credentialsExists(
     });
 }
 
-/** Lists accounts within the request's pagination window, defaulting to the first 100. */
+function credentialsListParams(form: CredentialsListRequest): URLSearchParams {
+  const params = new URLSearchParams();
+  params.set("limit", `${form.limit || 100}`);
+  if (form.offset) params.set("offset", `${form.offset}`);
+  form.roles?.forEach((role) => params.append("roles", role));
+  if (form.cursor) params.set("cursor", form.cursor);
+  if (form.email) params.set("email", form.email);
+  if (form.emailMatch) params.set("emailMatch", form.emailMatch);
+  if (form.createdAfter) params.set("createdAfter", form.createdAfter.toISOString());
+  if (form.createdBefore) params.set("createdBefore", form.createdBefore.toISOString());
+  if (form.inactiveSince) params.set("inactiveSince", form.inactiveSince.toISOString());
+  if (form.order) params.set("order", form.order);
+  if (form.withTotal) params.set("withTotal", "true");
+
+  return params;
+}
+
+/** Lists a page of accounts within the request's pagination window, defaulting to the first 100. */
 export async function credentialsList(
   api: AuthenticationApi,
   accessToken: string,
   form: CredentialsListRequest
 ): Promise<Credentials[]> {
+  return await api.fetch(`/v2/credentials/all?${credentialsListParams(form).toString()}`, z.array(CredentialsSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/** Same as `credentialsList`, with the cursor of the next page and the total count when requested. */
+export async function credentialsListPage(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsListRequest
+): Promise<CredentialsListPage> {
+  const { data, headers } = await api.fetchWithHeaders(
+    `/v2/credentials/all?${credentialsListParams(form).toString()}`,
+    z.array(CredentialsSchema),
+    {
+      headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+      method: "GET",
+    }
+  );
+
+  const nextCursor = headers.get("X-Next-Cursor");
+  const total = headers.get("X-Total-Count");
+
+  return {
+    credentials: data,
+    nextCursor: nextCursor || undefined,
+    total: total === null ? undefined : Number(total),
+  };
+}
+
+/** Exports the personal data held about the authenticated account, as a JSON document. */
+export async function credentialsExport(api: AuthenticationApi, accessToken: string): Promise<PersonalData> {
+  return await api.fetch("/v2/credentials/export", PersonalDataSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/** Exports the personal data held about any account, by identifier, as a JSON document. */
+export async function credentialsExportUser(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsExportUserRequest
+): Promise<PersonalData> {
+  const params = new URLSearchParams();
+  params.set("id", form.id);
+
+  return await api.fetch(`/v2/credentials/export/user?${params.toString()}`, PersonalDataSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/** Lists a page of the login history of the authenticated account, defaulting to the latest 100 attempts. */
+export async function credentialsLogins(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsLoginsRequest
+): Promise<CredentialsLoginsResponse> {
   const params = new URLSearchParams();
   params.set("limit", `${form.limit || 100}`);
-  params.set("offset", `${form.offset || 0}`);
-  form.roles?.forEach((role) => params.append("roles", role));
+  if (form.offset) params.set("offset", `${form.offset}`);
+
+  return await api.fetch(`/v2/credentials/logins?${params.toString()}`, CredentialsLoginsResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/** Lists a page of the login history of any account, by identifier. */
+export async function credentialsLoginsUser(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsLoginsUserRequest
+): Promise<CredentialsLoginsResponse> {
+  const params = new URLSearchParams();
+  params.set("id", form.id);
+  params.set("limit", `${form.limit || 100}`);
+  if (form.offset) params.set("offset", `${form.offset}`);
 
-  return await api.fetch(`/v2/credentials/all?${params.toString()}`, z.array(CredentialsSchema), {
+  return await api.fetch(`/v2/credentials/logins/user?${params.toString()}`, CredentialsLoginsResponseSchema, {
     headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
     method: "GET",
   });
@@ -151,6 +498,22 @@ export async function credentialsCreate(
   });
 }
 
+/**
+ * Accepts an invitation: registers a new account with the role chosen by the inviter, and returns the token pair
+ * for its opening session. The short code is the one emailed with the invitation.
+ */
+export async function credentialsCreateInvite(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsCreateRequest
+): Promise<Token> {
+  return await api.fetch("/v2/credentials/invite", TokenSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
 /** Applies a short-code-confirmed email change and returns the updated account. */
 export async function credentialsUpdateEmail(
   api: AuthenticationApi,
@@ -164,6 +527,51 @@ export async function credentialsUpdateEmail(
   });
 }
 
+/**
+ * Marks the current email of an account as verified, using the short code emailed to it, and returns the updated
+ * account. Refresh the session afterwards for the access token to carry the verification.
+ */
+export async function credentialsVerifyEmail(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsVerifyEmailRequest
+): Promise<Credentials> {
+  return await api.fetch("/v2/credentials/email/verify", CredentialsSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PATCH",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Sets the language the authenticated account receives emails in, and returns the updated account. */
+export async function credentialsUpdateLocale(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsUpdateLocaleRequest
+): Promise<Credentials> {
+  return await api.fetch("/v2/credentials/locale", CredentialsSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PATCH",
+    body: JSON.stringify(form),
+  });
+}
+
+/**
+ * Opts the authenticated account out of non-critical security notices, such as sign-ins from a new
+ * device, or back in, and returns the updated account. Password and email change notices are always sent.
+ */
+export async function credentialsUpdateNotices(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsUpdateNoticesRequest
+): Promise<Credentials> {
+  return await api.fetch("/v2/credentials/notices", CredentialsSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PATCH",
+    body: JSON.stringify(form),
+  });
+}
+
 /** Changes the password of the authenticated account, verified by its current password, and returns the updated account. */
 export async function credentialsUpdatePassword(
   api: AuthenticationApi,
@@ -190,7 +598,7 @@ export async function credentialsResetPassword(
   });
 }
 
-/** Grants a new role to the target account and returns the updated account. */
+/** Grants or revokes roles of the target account and returns the updated account. */
 export async function credentialsUpdateRole(
   api: AuthenticationApi,
   accessToken: string,
@@ -202,3 +610,73 @@ export async function credentialsUpdateRole(
     body: JSON.stringify(form),
   });
 }
+
+/**
+ * Grants a role to the target account until the given time, and returns the grant. Sessions carry the role
+ * until then; past it, they must refresh to drop it.
+ */
+export async function credentialsGrantRole(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsGrantRoleRequest
+): Promise<RoleGrant> {
+  return await api.fetch("/v2/credentials/role/grant", RoleGrantSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+/**
+ * Merges a duplicate account into another one, and returns the destination account. The source is deleted,
+ * and its sessions end at their next refresh.
+ */
+export async function credentialsMerge(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsMergeRequest
+): Promise<Credentials> {
+  return await api.fetch("/v2/credentials/merge", CredentialsSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/**
+ * Resolves the ID of a merged account to the account it was merged into. Services call it to move the data
+ * they stored under the ID. Fails with a 404 when the ID was never merged. Reserved to administrators and
+ * services.
+ */
+export async function credentialsRedirectGet(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsRedirectGetRequest
+): Promise<CredentialsRedirect> {
+  const params = new URLSearchParams();
+  params.set("id", form.id);
+
+  return await api.fetch(`/v2/credentials/redirect?${params.toString()}`, CredentialsRedirectSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/**
+ * Lists the account changes written after `after`, oldest first, defaulting to 100 events. Services store the
+ * `seq` of the last event they handled, and pass it back to resume.
+ */
+export async function credentialsEventList(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: CredentialsEventListRequest
+): Promise<CredentialsEventListResponse> {
+  const params = new URLSearchParams();
+  params.set("limit", `${form.limit || 100}`);
+  if (form.after !== undefined) params.set("after", `${form.after}`);
+
+  return await api.fetch(`/v2/credentials/events?${params.toString()}`, CredentialsEventListResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
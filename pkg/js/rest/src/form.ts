@@ -1,4 +1,10 @@
-import { MAX_EMAIL_LENGTH, MAX_PASSWORD_LENGTH, MAX_SHORT_CODE_LENGTH, MIN_PASSWORD_LENGTH } from "./const";
+import {
+  MAX_EMAIL_LENGTH,
+  MAX_PASSWORD_LENGTH,
+  MAX_ROLE_LENGTH,
+  MAX_SHORT_CODE_LENGTH,
+  MIN_PASSWORD_LENGTH,
+} from "./const";
 
 import { z } from "zod";
 
@@ -11,7 +17,10 @@ export const PasswordSchema = z.string().min(MIN_PASSWORD_LENGTH).max(MAX_PASSWO
 
 export const ShortCodeSchema = z.string().max(MAX_SHORT_CODE_LENGTH);
 
-/** Authorization level granted to a credential and carried by a session's claims. */
+/**
+ * The built-in authorization levels granted to a credential and carried by a session's claims.
+ * Administrators can define more through the roles API.
+ */
 export enum Role {
   /** Unauthenticated session, issued without credentials. */
   Anon = "auth:anon",
@@ -23,7 +32,20 @@ export enum Role {
   SuperAdmin = "auth:superadmin",
 }
 
-export const RoleSchema = z.enum([Role.Anon, Role.User, Role.Admin, Role.SuperAdmin]);
+/** Names of roles and permissions: colon-separated segments, such as `auth:admin` or `credentials:role:patch`. */
+const ROLE_NAME_REGEXP = /^[a-zA-Z0-9_-]+(:[a-zA-Z0-9_-]+)*$/;
+
+export const RoleSchema = z.string().max(MAX_ROLE_LENGTH).regex(ROLE_NAME_REGEXP);
+
+export const PermissionSchema = z.string().max(MAX_ROLE_LENGTH).regex(ROLE_NAME_REGEXP);
+
+/**
+ * Permissions granted by a role. A `*` segment matches any segment, or any number of trailing segments when last,
+ * such as `credentials:*`. A leading `!` revokes the matching permissions, inherited ones included.
+ */
+const PERMISSION_GRANT_REGEXP = /^!?([a-zA-Z0-9_-]+|\*)(:([a-zA-Z0-9_-]+|\*))*$/;
+
+export const PermissionGrantSchema = z.string().max(MAX_ROLE_LENGTH).regex(PERMISSION_GRANT_REGEXP);
 
 /** Language used to localize the emails the service sends, such as short-code messages. */
 export enum Lang {
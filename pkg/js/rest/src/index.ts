@@ -1,7 +1,10 @@
 export * from "./api";
+export * from "./audit";
 export * from "./claims";
 export * from "./credentials";
 export * from "./form";
+export * from "./permissions";
+export * from "./roles";
 export * from "./shortCode";
 export * from "./token";
 export * from "./const";
@@ -0,0 +1,59 @@
+import type { AuthenticationApi } from "./api";
+import { PermissionSchema, RoleSchema } from "./form";
+
+import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
+
+import { z } from "zod";
+
+/**
+ * The user whose permissions are checked, and the permissions to check. The user is the bearer of
+ * `accessToken`, the account `userID` refers to, or the caller when neither is set. Checking another
+ * account by ID requires the `permissions:check:user` permission.
+ */
+export const PermissionsCheckRequestSchema = z.object({
+  accessToken: z.string().optional(),
+  userID: z.uuid().optional(),
+  permissions: z.array(PermissionSchema).min(1).max(64),
+});
+
+export type PermissionsCheckRequest = z.infer<typeof PermissionsCheckRequestSchema>;
+
+/**
+ * Whether the user is granted each checked permission, and the roles it was resolved from. `userID`
+ * is absent for an anonymous token.
+ */
+export const PermissionsCheckResponseSchema = z.object({
+  userID: z.string().optional(),
+  roles: z.array(RoleSchema),
+  permissions: z.record(z.string(), z.boolean()),
+});
+
+export type PermissionsCheckResponse = z.infer<typeof PermissionsCheckResponseSchema>;
+
+/** Maps every role to the permissions it grants, inherited ones included. */
+export const PermissionsRolesResponseSchema = z.object({
+  roles: z.record(z.string(), z.array(PermissionSchema)),
+});
+
+export type PermissionsRolesResponse = z.infer<typeof PermissionsRolesResponseSchema>;
+
+/** Tells, for each permission, whether the user is granted it. */
+export async function permissionsCheck(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: PermissionsCheckRequest
+): Promise<PermissionsCheckResponse> {
+  return await api.fetch("/v2/permissions/check", PermissionsCheckResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Returns the permissions of every role, to resolve the `roles` claim of a token client-side. */
+export async function permissionsRoles(api: AuthenticationApi, accessToken: string): Promise<PermissionsRolesResponse> {
+  return await api.fetch("/v2/permissions/roles", PermissionsRolesResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
@@ -0,0 +1,94 @@
+import type { AuthenticationApi } from "./api";
+import { PermissionGrantSchema, RoleSchema } from "./form";
+
+import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
+
+import { z } from "zod";
+
+/**
+ * The definition of a role. `permissions` lists the permissions the role grants directly, patterns and negations
+ * included; it also grants the permissions of the roles it `inherits`, minus the ones it negates.
+ */
+export const RoleDefinitionSchema = z.object({
+  name: RoleSchema,
+  priority: z.int().min(0),
+  permissions: z.array(PermissionGrantSchema),
+  inherits: z.array(RoleSchema),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  updatedAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type RoleDefinition = z.infer<typeof RoleDefinitionSchema>;
+
+/** Every role definition, sorted by name. */
+export const RolesListResponseSchema = z.object({
+  roles: z.array(RoleDefinitionSchema),
+});
+
+export type RolesListResponse = z.infer<typeof RolesListResponseSchema>;
+
+/** The definition of a role to create, or to replace. Omitted lists are emptied. */
+export const RoleWriteRequestSchema = z.object({
+  name: RoleSchema,
+  priority: z.int().min(0).optional(),
+  permissions: z.array(PermissionGrantSchema).max(256).optional(),
+  inherits: z.array(RoleSchema).max(16).optional(),
+});
+
+export type RoleWriteRequest = z.infer<typeof RoleWriteRequestSchema>;
+
+/** Identifies the role to delete. */
+export const RoleDeleteRequestSchema = z.object({
+  name: RoleSchema,
+});
+
+export type RoleDeleteRequest = z.infer<typeof RoleDeleteRequestSchema>;
+
+/** Lists every role definition. */
+export async function rolesList(api: AuthenticationApi, accessToken: string): Promise<RolesListResponse> {
+  return await api.fetch("/v2/roles", RolesListResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/** Creates a role, and returns its definition. */
+export async function rolesCreate(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: RoleWriteRequest
+): Promise<RoleDefinition> {
+  return await api.fetch("/v2/roles", RoleDefinitionSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Replaces the definition of an existing role, and returns it. */
+export async function rolesUpdate(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: RoleWriteRequest
+): Promise<RoleDefinition> {
+  return await api.fetch("/v2/roles", RoleDefinitionSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PATCH",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Deletes a role that no account holds and no role inherits, and returns its last definition. */
+export async function rolesDelete(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: RoleDeleteRequest
+): Promise<RoleDefinition> {
+  const params = new URLSearchParams();
+  params.set("name", form.name);
+
+  return await api.fetch(`/v2/roles?${params.toString()}`, RoleDefinitionSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "DELETE",
+  });
+}
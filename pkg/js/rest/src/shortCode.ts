@@ -1,5 +1,5 @@
 import type { AuthenticationApi } from "./api";
-import { EmailSchema, LangSchema } from "./form";
+import { EmailSchema, LangSchema, RoleSchema } from "./form";
 
 import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
 
@@ -8,29 +8,57 @@ import { z } from "zod";
 // A short code is a one-time secret the service emails to a user to authorize a sensitive action.
 // The request here asks the service to generate and send one; the user later submits the received
 // code back through the matching credentials endpoint to complete the action. Each request names
-// the target email and the language to send the email in.
+// the target email and, optionally, the language to send the email in. Without it, emails to an
+// existing account follow its preferred language, and the Accept-Language header of the request
+// applies next.
 
 export const ShortCodeCreateEmailUpdateRequestSchema = z.object({
   email: EmailSchema,
-  lang: LangSchema,
+  lang: LangSchema.optional(),
 });
 
 export type ShortCodeCreateEmailUpdateRequest = z.infer<typeof ShortCodeCreateEmailUpdateRequestSchema>;
 
 export const ShortCodeCreatePasswordResetRequestSchema = z.object({
   email: EmailSchema,
-  lang: LangSchema,
+  lang: LangSchema.optional(),
 });
 
 export type ShortCodeCreatePasswordResetRequest = z.infer<typeof ShortCodeCreatePasswordResetRequestSchema>;
 
 export const ShortCodeCreateRegisterRequestSchema = z.object({
   email: EmailSchema,
-  lang: LangSchema,
+  lang: LangSchema.optional(),
 });
 
 export type ShortCodeCreateRegisterRequest = z.infer<typeof ShortCodeCreateRegisterRequestSchema>;
 
+/** Verification of the current address: the service already knows it, so only the mail language is needed. */
+export const ShortCodeCreateEmailVerificationRequestSchema = z.object({
+  lang: LangSchema.optional(),
+});
+
+export type ShortCodeCreateEmailVerificationRequest = z.infer<typeof ShortCodeCreateEmailVerificationRequestSchema>;
+
+/**
+ * Body of the 403 answered by the registration endpoints when the server's registration policy refuses the email
+ * address, whether registrations are closed, limited to some domains, or the address is disposable.
+ */
+export const RegistrationRefusedSchema = z.object({
+  reason: z.enum(["registration_closed", "domain_not_allowed", "domain_denied", "disposable_email"]),
+});
+
+export type RegistrationRefused = z.infer<typeof RegistrationRefusedSchema>;
+
+/** An invitation also names the role granted to the account; it cannot be above the inviter's own. */
+export const ShortCodeCreateInviteRequestSchema = z.object({
+  email: EmailSchema,
+  role: RoleSchema,
+  lang: LangSchema.optional(),
+});
+
+export type ShortCodeCreateInviteRequest = z.infer<typeof ShortCodeCreateInviteRequestSchema>;
+
 /** Emails a short code that authorizes changing the account's email address. */
 export async function shortCodeCreateEmailUpdate(
   api: AuthenticationApi,
@@ -44,6 +72,19 @@ export async function shortCodeCreateEmailUpdate(
   });
 }
 
+/** Emails a short code to the account's current address, to verify it. */
+export async function shortCodeCreateEmailVerification(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreateEmailVerificationRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/verify-email", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
 /** Emails a short code that authorizes resetting the account's password. */
 export async function shortCodeCreatePasswordReset(
   api: AuthenticationApi,
@@ -69,3 +110,204 @@ export async function shortCodeCreateRegister(
     body: JSON.stringify(form),
   });
 }
+
+/** Emails an invitation to create an account with a preassigned role under the given address. */
+export async function shortCodeCreateInvite(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreateInviteRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/invite", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+// Resending re-issues the active short code of the target, when the email did not arrive: the code is replaced
+// with a new one, but keeps its expiry. A resend too soon after the previous code is answered with a 429, whose
+// Retry-After header tells when to try again. Password reset resends always succeed, so they do not reveal which
+// addresses are registered.
+
+/** Emails the active email update code again, to the new address. */
+export async function shortCodeResendEmailUpdate(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreateEmailUpdateRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/update-email/resend", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Emails the active email verification code again. */
+export async function shortCodeResendEmailVerification(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreateEmailVerificationRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/verify-email/resend", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Emails the active password reset code again. */
+export async function shortCodeResendPasswordReset(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreatePasswordResetRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/update-password/resend", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Emails the active registration code again. */
+export async function shortCodeResendRegister(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreateRegisterRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/register/resend", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/** Emails the active invitation of the given address again. */
+export async function shortCodeResendInvite(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeCreateInviteRequest
+): Promise<void> {
+  return await api.fetchVoid("/v2/short-code/invite/resend", {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/** The operation a short code authorizes. */
+export const ShortCodeUsageSchema = z.enum(["register", "validateEmail", "resetPassword", "invite", "verifyEmail"]);
+
+export type ShortCodeUsage = z.infer<typeof ShortCodeUsageSchema>;
+
+/** A short code to check, with the flow and target it was issued for, as decoded from the link of the email. */
+export const ShortCodeVerifyRequestSchema = z.object({
+  usage: ShortCodeUsageSchema,
+  target: z.string().max(1024),
+  code: z.string().max(1024),
+});
+
+export type ShortCodeVerifyRequest = z.infer<typeof ShortCodeVerifyRequestSchema>;
+
+/** A code is only reported expired when it matches: any other failure is reported invalid. */
+export const ShortCodeVerifyResponseSchema = z.object({
+  status: z.enum(["valid", "expired", "invalid"]),
+});
+
+export type ShortCodeVerifyResponse = z.infer<typeof ShortCodeVerifyResponseSchema>;
+
+/**
+ * Tells whether a short code would be accepted, without redeeming it. A wrong code still counts as a failed attempt,
+ * and the endpoint is rate limited per client.
+ */
+export async function shortCodeVerify(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeVerifyRequest
+): Promise<ShortCodeVerifyResponse> {
+  return await api.fetch("/v2/short-code/verify", ShortCodeVerifyResponseSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+/**
+ * The state of a short code. A code is deleted when it was invalidated before it expired: consumed, superseded,
+ * locked after too many failed attempts, or revoked. A code the cleanup job deleted once it expired stays expired.
+ */
+export const ShortCodeStatusSchema = z.enum(["active", "expired", "deleted"]);
+
+export type ShortCodeStatus = z.infer<typeof ShortCodeStatusSchema>;
+
+/** The metadata of a short code, as listed for administrators. Neither the code nor its data are exposed. */
+export const ShortCodeMetadataSchema = z.object({
+  id: z.string(),
+  usage: ShortCodeUsageSchema,
+  target: z.string(),
+  status: ShortCodeStatusSchema,
+  attempts: z.int(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  expiresAt: z.iso.datetime().transform((value) => new Date(value)),
+  deletedAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
+  deletedComment: z.string().optional(),
+});
+
+export type ShortCodeMetadata = z.infer<typeof ShortCodeMetadataSchema>;
+
+/** Pagination window and optional filters for browsing short codes. */
+export const ShortCodeListRequestSchema = z.object({
+  target: z.string().max(1024).optional(),
+  usage: ShortCodeUsageSchema.optional(),
+  status: ShortCodeStatusSchema.optional(),
+  limit: z.int().max(100).optional(),
+  offset: z.int().min(0).optional(),
+});
+
+export type ShortCodeListRequest = z.infer<typeof ShortCodeListRequestSchema>;
+
+/** A revocation names the code, and why it is revoked. The reason is stored on the revoked code. */
+export const ShortCodeRevokeRequestSchema = z.object({
+  id: z.uuid(),
+  reason: z.string().min(1).max(512),
+});
+
+export type ShortCodeRevokeRequest = z.infer<typeof ShortCodeRevokeRequestSchema>;
+
+/** Lists a page of short codes, newest first, defaulting to the latest 100. */
+export async function shortCodeList(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeListRequest
+): Promise<ShortCodeMetadata[]> {
+  const params = new URLSearchParams();
+  params.set("limit", `${form.limit || 100}`);
+  if (form.offset) params.set("offset", `${form.offset}`);
+  if (form.target) params.set("target", form.target);
+  if (form.usage) params.set("usage", form.usage);
+  if (form.status) params.set("status", form.status);
+
+  return await api.fetch(`/v2/short-code?${params.toString()}`, z.array(ShortCodeMetadataSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/** Revokes an active short code, so it can no longer be redeemed. */
+export async function shortCodeRevoke(
+  api: AuthenticationApi,
+  accessToken: string,
+  form: ShortCodeRevokeRequest
+): Promise<ShortCodeMetadata> {
+  const params = new URLSearchParams();
+  params.set("reason", form.reason);
+
+  const path = `/v2/short-code/${encodeURIComponent(form.id)}?${params.toString()}`;
+
+  return await api.fetch(path, ShortCodeMetadataSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "DELETE",
+  });
+}
@@ -0,0 +1,92 @@
+import { describe, expect, it } from "vitest";
+
+import { expectStatus } from "@a-novel-kit/nodelib-test/http";
+import {
+  AuthenticationApi,
+  Role,
+  auditList,
+  claimsGet,
+  credentialsExportUser,
+  credentialsUpdateRole,
+  tokenCreate,
+} from "@a-novel/service-authentication-rest";
+import { preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
+
+// The managed local test rail supplies a dynamic URL; legacy CI still exports MAIL_HOST.
+const mailUrl = (() => {
+  const value = process.env.MAIL_UI_URL ?? process.env.MAIL_HOST;
+  if (!value) throw new Error("MAIL_UI_URL or MAIL_HOST must be set");
+  return value;
+})();
+
+describe("auditList", () => {
+  it("records role changes", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+    const superAdminClaims = await claimsGet(api, superAdminToken.accessToken);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await credentialsUpdateRole(api, superAdminToken.accessToken, {
+      userID: user.claims.userID!,
+      add: [Role.Admin],
+      remove: [Role.User],
+    });
+
+    const audit = await auditList(api, superAdminToken.accessToken, {
+      targetID: user.claims.userID!,
+    });
+
+    expect(audit.events).toHaveLength(1);
+    expect(audit.events[0]).toMatchObject({
+      actorID: superAdminClaims.userID,
+      targetID: user.claims.userID,
+      action: "credentials.updateRole",
+      before: { roles: [Role.User] },
+      after: { roles: [Role.Admin] },
+    });
+    expect(audit.events[0].requestID).toBeTruthy();
+    expect(audit.events[0].hash).toMatch(/^[0-9a-f]{64}$/);
+  });
+
+  it("records reads outside the hash chain", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await credentialsExportUser(api, superAdminToken.accessToken, { id: user.claims.userID! });
+
+    const audit = await auditList(api, superAdminToken.accessToken, {
+      targetID: user.claims.userID!,
+    });
+
+    expect(audit.events).toHaveLength(1);
+    expect(audit.events[0].action).toBe("credentials.export");
+    expect(audit.events[0].hash).toBeUndefined();
+  });
+
+  it("refuses non super-admin users", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const userToken = await tokenCreate(api, {
+      email: user.email,
+      password: user.password,
+    });
+
+    await expectStatus(auditList(api, userToken.accessToken, {}), 403);
+  });
+});
@@ -3,21 +3,37 @@ import { describe, expect, it } from "vitest";
 import { expectStatus } from "@a-novel-kit/nodelib-test/http";
 import {
   AuthenticationApi,
+  type CredentialsEvent,
   Lang,
   Role,
   type Token,
   claimsGet,
+  credentialsCreateInvite,
+  credentialsEventList,
   credentialsExists,
+  credentialsExport,
+  credentialsExportUser,
   credentialsGet,
+  credentialsGetBatch,
+  credentialsGrantRole,
   credentialsList,
+  credentialsListPage,
+  credentialsLogins,
+  credentialsLoginsUser,
+  credentialsMerge,
+  credentialsRedirectGet,
   credentialsResetPassword,
   credentialsUpdateEmail,
+  credentialsUpdateLocale,
+  credentialsUpdateNotices,
   credentialsUpdatePassword,
   credentialsUpdateRole,
   shortCodeCreateEmailUpdate,
+  shortCodeCreateInvite,
   shortCodeCreatePasswordReset,
   tokenCreate,
   tokenCreateAnon,
+  tokenRefresh,
 } from "@a-novel/service-authentication-rest";
 import {
   checkEmail,
@@ -40,7 +56,10 @@ describe("credentialsCreate", () => {
     const api = new AuthenticationApi(process.env.REST_URL!);
 
     const preRegister = await preRegisterUser(api, mailUrl);
-    await registerUser(api, preRegister);
+    const user = await registerUser(api, preRegister);
+
+    // Registration redeems a code sent to the address, so the email starts verified.
+    expect(user.claims.emailVerified).toBe(true);
   });
 
   it("does not register with wrong link", async () => {
@@ -70,6 +89,111 @@ describe("credentialsCreate", () => {
   });
 });
 
+async function requestInvite(api: AuthenticationApi, token: Token, role: Role, email: string = generateRandomMail()) {
+  await shortCodeCreateInvite(api, token.accessToken, { email, role, lang: Lang.En });
+
+  const mailData = await checkEmail(mailUrl, `to:"${email}" subject:"Invitation."`);
+
+  expect(mailData.html).toBeTruthy();
+  const links = getHtmlMail(mailData.html as string, "a");
+  expect(links).toHaveLength(1);
+
+  const parsedUrl = new URL((links[0] as HTMLAnchorElement).href);
+  const shortCode = parsedUrl.searchParams.get("shortCode");
+  const target = parsedUrl.searchParams.get("target");
+
+  expect(shortCode).toBeTruthy();
+  expect(atob(target!)).toBe(email);
+
+  return { email, shortCode: shortCode! };
+}
+
+describe("credentialsCreateInvite", () => {
+  it("registers the user with the invited role", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const invite = await requestInvite(api, superAdminToken, Role.Admin);
+
+    const anonToken = await tokenCreateAnon(api);
+    const token = await credentialsCreateInvite(api, anonToken.accessToken, {
+      email: invite.email,
+      password: generateRandomPassword(),
+      shortCode: invite.shortCode,
+    });
+
+    const claims = await claimsGet(api, token.accessToken);
+    expect(claims.roles).toEqual([Role.Admin]);
+  });
+
+  it("does not accept a registration code", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+
+    const anonToken = await tokenCreateAnon(api);
+    await expectStatus(
+      credentialsCreateInvite(api, anonToken.accessToken, {
+        email: preRegister.email,
+        password: generateRandomPassword(),
+        shortCode: preRegister.shortCode,
+      }),
+      403
+    );
+  });
+
+  it("does not invite above the inviter role", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const invite = await requestInvite(api, superAdminToken, Role.Admin);
+    const anonToken = await tokenCreateAnon(api);
+    const admin = await credentialsCreateInvite(api, anonToken.accessToken, {
+      email: invite.email,
+      password: generateRandomPassword(),
+      shortCode: invite.shortCode,
+    });
+
+    await expectStatus(
+      shortCodeCreateInvite(api, admin.accessToken, {
+        email: generateRandomMail(),
+        role: Role.SuperAdmin,
+        lang: Lang.En,
+      }),
+      403
+    );
+  });
+
+  it("does not invite an existing user", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await expectStatus(
+      shortCodeCreateInvite(api, superAdminToken.accessToken, {
+        email: user.email,
+        role: Role.User,
+        lang: Lang.En,
+      }),
+      409
+    );
+  });
+});
+
 async function requestEmailUpdate(api: AuthenticationApi, token: Token, newEmail: string = generateRandomMail()) {
   await shortCodeCreateEmailUpdate(api, token.accessToken, {
     email: newEmail,
@@ -240,6 +364,9 @@ describe("credentialsUpdatePassword", () => {
       email: user.email,
       password: newPassword,
     });
+
+    const mailData = await checkEmail(mailUrl, `to:"${user.email}" subject:"Your password was changed."`);
+    expect(mailData.html).toBeTruthy();
   });
 
   it("refuses to update if current password is incorrect", async () => {
@@ -278,6 +405,52 @@ describe("credentialsUpdatePassword", () => {
   });
 });
 
+describe("credentialsUpdateLocale", () => {
+  it("sends emails in the preferred language of the user", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const userToken = await tokenCreate(api, {
+      email: user.email,
+      password: user.password,
+    });
+
+    const credentials = await credentialsUpdateLocale(api, userToken.accessToken, { lang: Lang.Fr });
+    expect(credentials.locale).toBe(Lang.Fr);
+
+    const anonToken = await tokenCreateAnon(api);
+    await shortCodeCreatePasswordReset(api, anonToken.accessToken, { email: user.email });
+
+    const mailData = await checkEmail(
+      mailUrl,
+      `to:"${user.email}" subject:"Demande de réinitialisation du mot de passe."`
+    );
+    expect(mailData.html).toBeTruthy();
+  });
+});
+
+describe("credentialsUpdateNotices", () => {
+  it("toggles the opt-out of non-critical notices", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const userToken = await tokenCreate(api, {
+      email: user.email,
+      password: user.password,
+    });
+
+    const optedOut = await credentialsUpdateNotices(api, userToken.accessToken, { optOut: true });
+    expect(optedOut.noticesOptOut).toBe(true);
+
+    const optedIn = await credentialsUpdateNotices(api, userToken.accessToken, { optOut: false });
+    expect(optedIn.noticesOptOut).toBeUndefined();
+  });
+});
+
 async function requestPasswordReset(api: AuthenticationApi, token: Token, email: string) {
   await shortCodeCreatePasswordReset(api, token.accessToken, {
     email: email,
@@ -369,7 +542,7 @@ describe("credentialsResetPassword", () => {
 });
 
 describe("credentialsUpdateRole", () => {
-  it("changes the role of a user", async () => {
+  it("changes the roles of a user", async () => {
     const api = new AuthenticationApi(process.env.REST_URL!);
 
     const superAdminToken = await tokenCreate(api, {
@@ -382,11 +555,14 @@ describe("credentialsUpdateRole", () => {
 
     expect(user.claims.roles).toStrictEqual([Role.User]);
 
-    await credentialsUpdateRole(api, superAdminToken.accessToken, {
+    const updated = await credentialsUpdateRole(api, superAdminToken.accessToken, {
       userID: user.claims.userID!,
-      role: Role.Admin,
+      add: [Role.Admin],
+      remove: [Role.User],
     });
 
+    expect(updated.roles).toStrictEqual([Role.Admin]);
+
     // Relogging is necessary for new role to be effective.
     const userToken = await tokenCreate(api, {
       email: user.email,
@@ -411,8 +587,175 @@ describe("credentialsUpdateRole", () => {
 
     await expectStatus(
       credentialsUpdateRole(api, userToken.accessToken, {
+        userID: user.claims.userID!,
+        add: [Role.Admin],
+      }),
+      403
+    );
+  });
+});
+
+describe("credentialsGrantRole", () => {
+  it("grants a role until it expires", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const expiresAt = new Date(Date.now() + 60 * 60 * 1000);
+
+    const grant = await credentialsGrantRole(api, superAdminToken.accessToken, {
+      userID: user.claims.userID!,
+      role: Role.Admin,
+      reason: "debug account",
+      expiresAt,
+    });
+
+    expect(grant.role).toBe(Role.Admin);
+    expect(grant.reason).toBe("debug account");
+
+    // Relogging is necessary for the grant to be effective.
+    const userToken = await tokenCreate(api, {
+      email: user.email,
+      password: user.password,
+    });
+
+    const newUserClaims = await claimsGet(api, userToken.accessToken);
+
+    expect(newUserClaims.roles).toStrictEqual([Role.Admin, Role.User]);
+    expect(newUserClaims.grantExpiresAt).toBeDefined();
+    expect(newUserClaims.grantExpiresAt!.getTime()).toBeLessThanOrEqual(expiresAt.getTime());
+
+    // The user holds this one for good: a grant would change nothing.
+    await expectStatus(
+      credentialsGrantRole(api, superAdminToken.accessToken, {
+        userID: user.claims.userID!,
+        role: Role.User,
+        reason: "debug account",
+        expiresAt,
+      }),
+      409
+    );
+  });
+
+  it("refuses grants beyond the maximum duration", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await expectStatus(
+      credentialsGrantRole(api, superAdminToken.accessToken, {
         userID: user.claims.userID!,
         role: Role.Admin,
+        reason: "debug account",
+        expiresAt: new Date(Date.now() + 48 * 60 * 60 * 1000),
+      }),
+      422
+    );
+  });
+});
+
+describe("credentialsMerge", () => {
+  it("merges an account into another one", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const source = await registerUser(api, await preRegisterUser(api, mailUrl));
+    const destination = await registerUser(api, await preRegisterUser(api, mailUrl));
+
+    await credentialsUpdateRole(api, superAdminToken.accessToken, {
+      userID: source.claims.userID!,
+      add: [Role.Admin],
+    });
+
+    const merged = await credentialsMerge(api, superAdminToken.accessToken, {
+      sourceID: source.claims.userID!,
+      destinationID: destination.claims.userID!,
+    });
+
+    expect(merged.id).toBe(destination.claims.userID);
+    expect(merged.email).toBe(destination.email);
+    expect(merged.roles).toStrictEqual([Role.Admin, Role.User]);
+
+    await expectStatus(credentialsGet(api, superAdminToken.accessToken, { id: source.claims.userID! }), 404);
+
+    // Other services resolve the ID of the source with a privileged token. Users cannot tell which accounts
+    // were merged.
+    const redirect = await credentialsRedirectGet(api, superAdminToken.accessToken, { id: source.claims.userID! });
+
+    expect(redirect.sourceID).toBe(source.claims.userID);
+    expect(redirect.destinationID).toBe(destination.claims.userID);
+    await expectStatus(
+      credentialsRedirectGet(api, superAdminToken.accessToken, { id: destination.claims.userID! }),
+      404
+    );
+    await expectStatus(
+      credentialsRedirectGet(api, destination.token.accessToken, { id: source.claims.userID! }),
+      403
+    );
+
+    // The merge is written to the outbox.
+    let after: number | undefined;
+    let mergeEvent: CredentialsEvent | undefined;
+    for (;;) {
+      const page = await credentialsEventList(api, superAdminToken.accessToken, { after });
+      if (page.events.length === 0) break;
+      mergeEvent = page.events.find((event) => event.sourceID === source.claims.userID) ?? mergeEvent;
+      after = page.events[page.events.length - 1].seq;
+    }
+
+    expect(mergeEvent?.kind).toBe("credentials.merge");
+    expect(mergeEvent?.userID).toBe(destination.claims.userID);
+    await expectStatus(credentialsEventList(api, destination.token.accessToken, {}), 403);
+
+    // The session of the source ends: a leaked refresh token of the source must not open a session on the
+    // destination.
+    await expectStatus(
+      tokenRefresh(api, {
+        accessToken: source.token.accessToken,
+        refreshToken: source.token.refreshToken!,
+      }),
+      401
+    );
+  });
+
+  it("refuses admins", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const admin = await registerUser(api, await preRegisterUser(api, mailUrl));
+    const source = await registerUser(api, await preRegisterUser(api, mailUrl));
+
+    await credentialsUpdateRole(api, superAdminToken.accessToken, {
+      userID: admin.claims.userID!,
+      add: [Role.Admin],
+    });
+
+    const adminToken = await tokenCreate(api, { email: admin.email, password: admin.password });
+
+    await expectStatus(
+      credentialsMerge(api, adminToken.accessToken, {
+        sourceID: source.claims.userID!,
+        destinationID: admin.claims.userID!,
       }),
       403
     );
@@ -436,7 +779,7 @@ describe("credentialsGet", () => {
     });
 
     expect(credentials.email).toBe(user.email);
-    expect(credentials.role).toBe(Role.User);
+    expect(credentials.roles).toStrictEqual([Role.User]);
     expect(credentials.id).toBe(user.claims.userID);
   });
 
@@ -457,6 +800,42 @@ describe("credentialsGet", () => {
   });
 });
 
+describe("credentialsGetBatch", () => {
+  it("gets existing credentials and reports missing ones", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister1 = await preRegisterUser(api, mailUrl);
+    const user1 = await registerUser(api, preRegister1);
+    const preRegister2 = await preRegisterUser(api, mailUrl);
+    const user2 = await registerUser(api, preRegister2);
+
+    const missingID = crypto.randomUUID();
+
+    const batch = await credentialsGetBatch(api, superAdminToken.accessToken, {
+      ids: [user2.claims.userID!, missingID, user1.claims.userID!],
+    });
+
+    expect(batch.credentials.map((credentials) => credentials.email)).toEqual([user2.email, user1.email]);
+    expect(batch.missing).toEqual([missingID]);
+  });
+
+  it("rejects an empty batch", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    await expectStatus(credentialsGetBatch(api, superAdminToken.accessToken, { ids: [] }), 422);
+  });
+});
+
 describe("credentialsExists", () => {
   it("gets existing credentials", async () => {
     const api = new AuthenticationApi(process.env.REST_URL!);
@@ -504,9 +883,164 @@ describe("credentialsList", () => {
     const preRegister = await preRegisterUser(api, mailUrl);
     const user = await registerUser(api, preRegister);
 
-    const credentials = await credentialsList(api, superAdminToken.accessToken, {});
+    const users = await credentialsList(api, superAdminToken.accessToken, {});
+
+    expect(users.length).toBeGreaterThan(0);
+    expect(users.some((item) => item.id === user.claims.userID)).toBeTruthy();
+  });
+
+  it("searches users by email", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const page = await credentialsListPage(api, superAdminToken.accessToken, {
+      email: user.email.toUpperCase(),
+      emailMatch: "prefix",
+      withTotal: true,
+    });
+
+    expect(page.credentials.map((item) => item.id)).toEqual([user.claims.userID]);
+    expect(page.total).toBe(1);
+    expect(page.nextCursor).toBeUndefined();
+  });
+
+  it("paginates with a cursor", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    await registerUser(api, await preRegisterUser(api, mailUrl));
+    await registerUser(api, await preRegisterUser(api, mailUrl));
+
+    const page1 = await credentialsListPage(api, superAdminToken.accessToken, { limit: 1 });
+    expect(page1.credentials.length).toBe(1);
+    expect(page1.nextCursor).toBeDefined();
+
+    const page2 = await credentialsListPage(api, superAdminToken.accessToken, { limit: 1, cursor: page1.nextCursor });
+    expect(page2.credentials.length).toBe(1);
+    expect(page2.credentials[0].id).not.toBe(page1.credentials[0].id);
+  });
+});
+
+describe("credentialsExport", () => {
+  it("exports the caller's data", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const data = await credentialsExport(api, user.token.accessToken);
+
+    expect(data.credentials.id).toBe(user.claims.userID);
+    expect(data.credentials.email).toBe(user.email);
+    // The registration code, consumed on account creation.
+    expect(data.shortCodes.some((item) => item.usage === "register" && item.target === user.email)).toBeTruthy();
+  });
+
+  it("exports the data of another user for admins", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const data = await credentialsExportUser(api, superAdminToken.accessToken, {
+      id: user.claims.userID!,
+    });
+
+    expect(data.credentials.id).toBe(user.claims.userID);
+    expect(data.credentials.email).toBe(user.email);
+    expect(data.auditEvents).toEqual([]);
+
+    // The first export is recorded in the audit trail of the account, so the next one includes it.
+    const next = await credentialsExportUser(api, superAdminToken.accessToken, {
+      id: user.claims.userID!,
+    });
 
-    expect(credentials.length).toBeGreaterThan(0);
-    expect(credentials.some((item) => item.id === user.claims.userID)).toBeTruthy();
+    expect(next.auditEvents.map((item) => item.action)).toEqual(["credentials.export"]);
+  });
+
+  it("refuses to export the data of another user for users", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await expectStatus(
+      credentialsExportUser(api, user.token.accessToken, {
+        id: crypto.randomUUID(),
+      }),
+      403
+    );
+  });
+});
+
+describe("credentialsLogins", () => {
+  it("records sign-ins in the login history", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await expectStatus(tokenCreate(api, { email: user.email, password: generateRandomPassword() }), 401);
+    const token = await tokenCreate(api, { email: user.email, password: user.password });
+
+    const history = await credentialsLogins(api, token.accessToken, {});
+
+    expect(history.logins.map((item) => item.outcome)).toEqual(["success", "invalid_password"]);
+    expect(history.logins.every((item) => item.kind === "login" && item.email === user.email)).toBeTruthy();
+
+    const data = await credentialsExport(api, token.accessToken);
+    expect(data.credentials.lastLoginAt).toBeDefined();
+    expect(data.loginEvents).toHaveLength(2);
+  });
+
+  it("lists the login history of another user for admins", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await tokenCreate(api, { email: user.email, password: user.password });
+
+    const history = await credentialsLoginsUser(api, superAdminToken.accessToken, {
+      id: user.claims.userID!,
+    });
+
+    expect(history.logins).toHaveLength(1);
+    expect(history.logins[0].outcome).toBe("success");
+  });
+
+  it("refuses to list the login history of another user for users", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await expectStatus(
+      credentialsLoginsUser(api, user.token.accessToken, {
+        id: crypto.randomUUID(),
+      }),
+      403
+    );
   });
 });
@@ -0,0 +1,104 @@
+import { describe, expect, it } from "vitest";
+
+import { expectStatus } from "@a-novel-kit/nodelib-test/http";
+import {
+  AuthenticationApi,
+  Role,
+  permissionsCheck,
+  permissionsRoles,
+  tokenCreate,
+  tokenCreateAnon,
+} from "@a-novel/service-authentication-rest";
+import { preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
+
+// The managed local test rail supplies a dynamic URL; legacy CI still exports MAIL_HOST.
+const mailUrl = (() => {
+  const value = process.env.MAIL_UI_URL ?? process.env.MAIL_HOST;
+  if (!value) throw new Error("MAIL_UI_URL or MAIL_HOST must be set");
+  return value;
+})();
+
+describe("permissionsCheck", () => {
+  it("checks the caller", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const anonToken = await tokenCreateAnon(api);
+
+    const res = await permissionsCheck(api, anonToken.accessToken, {
+      permissions: ["credentials:create", "credentials:list"],
+    });
+
+    expect(res.roles).toStrictEqual([Role.Anon]);
+    expect(res.permissions).toStrictEqual({ "credentials:create": true, "credentials:list": false });
+  });
+
+  it("checks a token", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const anonToken = await tokenCreateAnon(api);
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const res = await permissionsCheck(api, anonToken.accessToken, {
+      accessToken: user.token.accessToken,
+      permissions: ["credentials:export", "credentials:list"],
+    });
+
+    expect(res.userID).toBe(user.claims.userID);
+    expect(res.permissions).toStrictEqual({ "credentials:export": true, "credentials:list": false });
+
+    await expectStatus(
+      permissionsCheck(api, anonToken.accessToken, {
+        accessToken: "invalid",
+        permissions: ["credentials:export"],
+      }),
+      422
+    );
+  });
+
+  it("checks another user by ID for admins only", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const res = await permissionsCheck(api, superAdminToken.accessToken, {
+      userID: user.claims.userID!,
+      permissions: ["credentials:export", "credentials:list"],
+    });
+
+    expect(res.roles).toStrictEqual([Role.User]);
+    expect(res.permissions).toStrictEqual({ "credentials:export": true, "credentials:list": false });
+
+    const otherPreRegister = await preRegisterUser(api, mailUrl);
+    const other = await registerUser(api, otherPreRegister);
+
+    await expectStatus(
+      permissionsCheck(api, other.token.accessToken, {
+        userID: user.claims.userID!,
+        permissions: ["credentials:export"],
+      }),
+      403
+    );
+  });
+});
+
+describe("permissionsRoles", () => {
+  it("lists the resolved permissions of the built-in roles", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const anonToken = await tokenCreateAnon(api);
+
+    const res = await permissionsRoles(api, anonToken.accessToken);
+
+    expect(res.roles[Role.Anon]).toContain("credentials:create");
+    // Inherited permissions are included.
+    expect(res.roles[Role.SuperAdmin]).toContain("credentials:create");
+    expect(res.roles[Role.SuperAdmin]).toContain("roles:list");
+  });
+});
@@ -0,0 +1,155 @@
+import { describe, expect, it } from "vitest";
+
+import { expectStatus } from "@a-novel-kit/nodelib-test/http";
+import {
+  AuthenticationApi,
+  Role,
+  auditList,
+  credentialsUpdateRole,
+  permissionsRoles,
+  rolesCreate,
+  rolesDelete,
+  rolesList,
+  rolesUpdate,
+  tokenCreate,
+} from "@a-novel/service-authentication-rest";
+import { preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
+
+// The managed local test rail supplies a dynamic URL; legacy CI still exports MAIL_HOST.
+const mailUrl = (() => {
+  const value = process.env.MAIL_UI_URL ?? process.env.MAIL_HOST;
+  if (!value) throw new Error("MAIL_UI_URL or MAIL_HOST must be set");
+  return value;
+})();
+
+async function superAdminToken(api: AuthenticationApi): Promise<string> {
+  const token = await tokenCreate(api, {
+    email: process.env.SUPER_ADMIN_EMAIL!,
+    password: process.env.SUPER_ADMIN_PASSWORD!,
+  });
+
+  return token.accessToken;
+}
+
+// Roles are shared by every test running against the server: each test works on its own.
+function roleName(): string {
+  return `test:${crypto.randomUUID()}`;
+}
+
+describe("rolesList", () => {
+  it("lists the built-in roles", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const roles = await rolesList(api, await superAdminToken(api));
+
+    expect(roles.roles.map((role) => role.name)).toEqual(
+      expect.arrayContaining([Role.Anon, Role.User, Role.Admin, Role.SuperAdmin])
+    );
+  });
+
+  it("refuses non super-admin users", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const userToken = await tokenCreate(api, {
+      email: user.email,
+      password: user.password,
+    });
+
+    await expectStatus(rolesList(api, userToken.accessToken), 403);
+  });
+});
+
+describe("rolesCreate", () => {
+  it("creates, updates, then deletes a role", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const accessToken = await superAdminToken(api);
+    const name = roleName();
+
+    const created = await rolesCreate(api, accessToken, {
+      name,
+      priority: 1,
+      permissions: ["test:read"],
+      inherits: [Role.User],
+    });
+    expect(created).toMatchObject({ name, priority: 1, permissions: ["test:read"], inherits: [Role.User] });
+
+    await expectStatus(rolesCreate(api, accessToken, { name }), 409);
+
+    const updated = await rolesUpdate(api, accessToken, { name, priority: 2, permissions: ["test:write"] });
+    expect(updated).toMatchObject({ name, priority: 2, permissions: ["test:write"], inherits: [] });
+
+    const roles = await rolesList(api, accessToken);
+    expect(roles.roles).toContainEqual(updated);
+
+    const deleted = await rolesDelete(api, accessToken, { name });
+    expect(deleted).toEqual(updated);
+
+    await expectStatus(rolesDelete(api, accessToken, { name }), 404);
+
+    const audit = await auditList(api, accessToken, {});
+    const events = audit.events.filter((event) => event.after?.name === name || event.before?.name === name);
+    expect(events.map((event) => event.action)).toEqual(["roles.delete", "roles.update", "roles.create"]);
+  });
+
+  it("rejects inheritance cycles", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const accessToken = await superAdminToken(api);
+    const parent = roleName();
+    const child = roleName();
+
+    await rolesCreate(api, accessToken, { name: parent });
+    await rolesCreate(api, accessToken, { name: child, inherits: [parent] });
+
+    await expectStatus(rolesUpdate(api, accessToken, { name: parent, inherits: [child] }), 422);
+    await expectStatus(rolesCreate(api, accessToken, { name: roleName(), inherits: [roleName()] }), 422);
+
+    await rolesDelete(api, accessToken, { name: child });
+    await rolesDelete(api, accessToken, { name: parent });
+  });
+
+  it("expands wildcard and negated permissions", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const accessToken = await superAdminToken(api);
+    const parent = roleName();
+    const child = roleName();
+
+    await rolesCreate(api, accessToken, { name: parent, permissions: ["roles:*"] });
+    await rolesCreate(api, accessToken, { name: child, permissions: ["!roles:patch"], inherits: [parent] });
+
+    const resolved = await permissionsRoles(api, accessToken);
+    expect(resolved.roles[parent]).toEqual(["roles:create", "roles:delete", "roles:list", "roles:patch"]);
+    expect(resolved.roles[child]).toEqual(["roles:create", "roles:delete", "roles:list"]);
+
+    // A pattern matching no known permission is most likely a typo.
+    await expectStatus(rolesCreate(api, accessToken, { name: roleName(), permissions: ["role:*"] }), 422);
+
+    await rolesDelete(api, accessToken, { name: child });
+    await rolesDelete(api, accessToken, { name: parent });
+  });
+
+  it("refuses to delete a role in use", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const accessToken = await superAdminToken(api);
+    const name = roleName();
+
+    await rolesCreate(api, accessToken, { name, inherits: [Role.User] });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    await credentialsUpdateRole(api, accessToken, { userID: user.claims.userID!, add: [name] });
+    await expectStatus(rolesDelete(api, accessToken, { name }), 409);
+
+    await credentialsUpdateRole(api, accessToken, { userID: user.claims.userID!, remove: [name] });
+    await rolesDelete(api, accessToken, { name });
+  });
+
+  it("refuses to delete a built-in role", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    await expectStatus(rolesDelete(api, await superAdminToken(api), { name: Role.Admin }), 403);
+  });
+});
@@ -0,0 +1,142 @@
+import { describe, expect, it } from "vitest";
+
+import { expectStatus } from "@a-novel-kit/nodelib-test/http";
+import {
+  AuthenticationApi,
+  credentialsCreate,
+  shortCodeList,
+  shortCodeResendPasswordReset,
+  shortCodeResendRegister,
+  shortCodeRevoke,
+  shortCodeVerify,
+  tokenCreate,
+  tokenCreateAnon,
+} from "@a-novel/service-authentication-rest";
+import { generateRandomMail, preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
+
+// The managed local test rail supplies a dynamic URL; legacy CI still exports MAIL_HOST.
+const mailUrl = (() => {
+  const value = process.env.MAIL_UI_URL ?? process.env.MAIL_HOST;
+  if (!value) throw new Error("MAIL_UI_URL or MAIL_HOST must be set");
+  return value;
+})();
+
+describe("shortCodeList", () => {
+  it("lists and revokes short codes", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const superAdminToken = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!,
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+
+    const shortCodes = await shortCodeList(api, superAdminToken.accessToken, {
+      target: preRegister.email,
+      status: "active",
+    });
+
+    expect(shortCodes).toHaveLength(1);
+    expect(shortCodes[0]).toMatchObject({
+      usage: "register",
+      target: preRegister.email,
+      status: "active",
+      attempts: 0,
+    });
+    expect(shortCodes[0]).not.toHaveProperty("code");
+
+    const revoked = await shortCodeRevoke(api, superAdminToken.accessToken, {
+      id: shortCodes[0].id,
+      reason: "leaked",
+    });
+
+    expect(revoked).toMatchObject({
+      id: shortCodes[0].id,
+      status: "deleted",
+      deletedComment: "revoked by admin: leaked",
+    });
+
+    // A revoked code cannot be redeemed, nor revoked again.
+    const anonToken = await tokenCreateAnon(api);
+    await expectStatus(
+      credentialsCreate(api, anonToken.accessToken, {
+        email: preRegister.email,
+        password: "password123",
+        shortCode: preRegister.shortCode,
+      }),
+      403
+    );
+    await expectStatus(
+      shortCodeRevoke(api, superAdminToken.accessToken, { id: shortCodes[0].id, reason: "leaked" }),
+      404
+    );
+  });
+
+  it("refuses non admin users", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+    const user = await registerUser(api, preRegister);
+
+    const userToken = await tokenCreate(api, {
+      email: user.email,
+      password: user.password,
+    });
+
+    await expectStatus(shortCodeList(api, userToken.accessToken, {}), 403);
+  });
+});
+
+describe("shortCodeResend", () => {
+  it("refuses resends without an active code", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const anonToken = await tokenCreateAnon(api);
+
+    await expectStatus(shortCodeResendRegister(api, anonToken.accessToken, { email: generateRandomMail() }), 404);
+  });
+
+  it("refuses resends during the cooldown", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const anonToken = await tokenCreateAnon(api);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+
+    await expectStatus(shortCodeResendRegister(api, anonToken.accessToken, { email: preRegister.email }), 429);
+  });
+
+  it("does not reveal unknown emails on password reset", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const anonToken = await tokenCreateAnon(api);
+
+    await shortCodeResendPasswordReset(api, anonToken.accessToken, { email: generateRandomMail() });
+  });
+});
+
+describe("shortCodeVerify", () => {
+  it("verifies short codes without consuming them", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+    const anonToken = await tokenCreateAnon(api);
+
+    const preRegister = await preRegisterUser(api, mailUrl);
+
+    await expect(
+      shortCodeVerify(api, anonToken.accessToken, {
+        usage: "register",
+        target: preRegister.email,
+        code: "wrong-code",
+      })
+    ).resolves.toEqual({ status: "invalid" });
+
+    await expect(
+      shortCodeVerify(api, anonToken.accessToken, {
+        usage: "register",
+        target: preRegister.email,
+        code: preRegister.shortCode,
+      })
+    ).resolves.toEqual({ status: "valid" });
+
+    // The code is still redeemable.
+    await registerUser(api, preRegister);
+  });
+});
@@ -30,6 +30,17 @@ describe("tokenCreate", () => {
     });
   });
 
+  it("logs in whatever the case of the email", async () => {
+    const api = new AuthenticationApi(process.env.REST_URL!);
+
+    const res = await tokenCreate(api, {
+      email: process.env.SUPER_ADMIN_EMAIL!.toUpperCase(),
+      password: process.env.SUPER_ADMIN_PASSWORD!,
+    });
+
+    expect(res.accessToken).toBeTruthy();
+  });
+
   it("returns unauthorized when email does not exist", async () => {
     const api = new AuthenticationApi(process.env.REST_URL!);
 